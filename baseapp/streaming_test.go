@@ -0,0 +1,105 @@
+package baseapp
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ StreamingService = (*mockStreamingService)(nil)
+
+// mockStreamingService records the ABCI messages and the state changes it receives
+type mockStreamingService struct {
+	messages []string
+	writes   []string
+}
+
+func (m *mockStreamingService) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	m.writes = append(m.writes, storeKey.Name()+"/"+string(key))
+	return nil
+}
+
+func (m *mockStreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{capKey1: {m}}
+}
+
+func (m *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	m.messages = append(m.messages, "begin")
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if res.IsOK() {
+		m.messages = append(m.messages, "tx")
+	} else {
+		m.messages = append(m.messages, "failed tx")
+	}
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	m.messages = append(m.messages, "end")
+	return nil
+}
+
+func (m *mockStreamingService) ListenCommit(_ sdk.Context, _ abci.ResponseCommit) error {
+	m.messages = append(m.messages, "commit")
+	return nil
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	streamingService := &mockStreamingService{}
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+	streamingOpt := func(bapp *BaseApp) { bapp.SetStreamingService(streamingService) }
+
+	app := setupBaseApp(t, anteOpt, routerOpt, streamingOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := cdc.Marshal(newTxCounter(i, i))
+		require.NoError(t, err)
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK())
+	}
+
+	// a tx failing in the message handler does not expose its writes
+	tx := newTxCounter(2, 2)
+	tx.setFailOnHandler(true)
+	txBytes, err := cdc.Marshal(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+
+	// state changes are only flushed to the listeners on commit, so that the
+	// writes of discarded txs and of the check state never reach them
+	require.Empty(t, streamingService.writes)
+	app.Commit()
+
+	require.Equal(t, []string{"begin", "tx", "tx", "failed tx", "end", "commit"}, streamingService.messages)
+	require.Equal(t, []string{"key1/ante-key", "key1/deliver-key"}, streamingService.writes)
+}
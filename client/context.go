@@ -46,6 +46,7 @@ type Context struct {
 	Simulate          bool
 	GenerateOnly      bool
 	Offline           bool
+	IsAux             bool
 	SkipConfirm       bool
 	TxConfig          TxConfig
 	AccountRetriever  AccountRetriever
@@ -178,6 +179,12 @@ func (ctx Context) WithSimulation(simulate bool) Context {
 	return ctx
 }
 
+// WithAux returns a copy of the context with an updated IsAux value.
+func (ctx Context) WithAux(isAux bool) Context {
+	ctx.IsAux = isAux
+	return ctx
+}
+
 // WithOffline returns a copy of the context with updated Offline value.
 func (ctx Context) WithOffline(offline bool) Context {
 	ctx.Offline = offline
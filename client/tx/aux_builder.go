@@ -0,0 +1,209 @@
+package tx
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// AuxTxBuilder is a client-side builder for creating an AuxSignerData, the
+// partial signature document of an auxiliary signer. An auxiliary signer only
+// signs over the transaction body and its own signer data, so that the fee
+// payer can be chosen after it signed.
+type AuxTxBuilder struct {
+	body          *tx.TxBody
+	auxSignerData *tx.AuxSignerData
+}
+
+// NewAuxTxBuilder creates a new client-side builder for constructing an
+// AuxSignerData.
+func NewAuxTxBuilder() AuxTxBuilder {
+	return AuxTxBuilder{}
+}
+
+// SetAddress sets the auxiliary signer's bech32 address.
+func (b *AuxTxBuilder) SetAddress(addr string) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.Address = addr
+}
+
+// SetMemo sets a memo in the tx.
+func (b *AuxTxBuilder) SetMemo(memo string) {
+	b.checkEmptyFields()
+
+	b.body.Memo = memo
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// SetTimeoutHeight sets a timeout height in the tx.
+func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
+	b.checkEmptyFields()
+
+	b.body.TimeoutHeight = height
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// SetMsgs sets an array of Msgs in the tx.
+func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.checkEmptyFields()
+
+	b.body.Messages = anys
+	b.auxSignerData.SignDoc.BodyBytes = nil
+
+	return nil
+}
+
+// SetAccountNumber sets the auxiliary signer's account number in the
+// AuxSignerData.
+func (b *AuxTxBuilder) SetAccountNumber(accNum uint64) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.AccountNumber = accNum
+}
+
+// SetChainID sets the chain ID in the AuxSignerData.
+func (b *AuxTxBuilder) SetChainID(chainID string) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.ChainId = chainID
+}
+
+// SetSequence sets the auxiliary signer's sequence in the AuxSignerData.
+func (b *AuxTxBuilder) SetSequence(accSeq uint64) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.Sequence = accSeq
+}
+
+// SetPubKey sets the auxiliary signer's public key in the AuxSignerData.
+func (b *AuxTxBuilder) SetPubKey(pk cryptotypes.PubKey) error {
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return err
+	}
+
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.PublicKey = any
+
+	return nil
+}
+
+// SetTip sets the tip paid by the tipper to the fee payer in the
+// AuxSignerData.
+func (b *AuxTxBuilder) SetTip(tip *tx.Tip) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.Tip = tip
+}
+
+// SetSignMode sets the auxiliary signer's sign mode. Only
+// SIGN_MODE_DIRECT_AUX is supported.
+func (b *AuxTxBuilder) SetSignMode(mode signing.SignMode) error {
+	if mode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+		return sdkerrors.ErrInvalidRequest.Wrapf("AuxTxBuilder can only sign with %s, got %s", signing.SignMode_SIGN_MODE_DIRECT_AUX, mode)
+	}
+
+	b.checkEmptyFields()
+
+	b.auxSignerData.Mode = mode
+
+	return nil
+}
+
+// SetSignature sets the aux signer's signature in the AuxSignerData.
+func (b *AuxTxBuilder) SetSignature(sig []byte) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.Sig = sig
+}
+
+// SetExtensionOptions sets the aux signer's extension options.
+func (b *AuxTxBuilder) SetExtensionOptions(extOpts ...*codectypes.Any) {
+	b.checkEmptyFields()
+
+	b.body.ExtensionOptions = extOpts
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// SetNonCriticalExtensionOptions sets the aux signer's non-critical extension options.
+func (b *AuxTxBuilder) SetNonCriticalExtensionOptions(extOpts ...*codectypes.Any) {
+	b.checkEmptyFields()
+
+	b.body.NonCriticalExtensionOptions = extOpts
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// GetSignBytes returns the builder's sign bytes: the encoding of its
+// SignDocDirectAux.
+func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
+	auxTx := b.auxSignerData
+	if auxTx == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("aux tx is nil, call setters on AuxTxBuilder first")
+	}
+
+	body := b.body
+	if body == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("tx body is nil, call setters on AuxTxBuilder first")
+	}
+
+	sd := auxTx.SignDoc
+	if sd == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("sign doc is nil, call setters on AuxTxBuilder first")
+	}
+
+	bodyBz, err := proto.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	sd.BodyBytes = bodyBz
+
+	if err := sd.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if auxTx.Mode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("got unsupported sign mode %s", auxTx.Mode)
+	}
+
+	return sd.Marshal()
+}
+
+// GetAuxSignerData returns the builder's AuxSignerData, once it is signed.
+func (b *AuxTxBuilder) GetAuxSignerData() (tx.AuxSignerData, error) {
+	if b.auxSignerData == nil {
+		return tx.AuxSignerData{}, sdkerrors.ErrLogic.Wrap("aux tx is nil, call setters on AuxTxBuilder first")
+	}
+
+	if err := b.auxSignerData.ValidateBasic(); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	return *b.auxSignerData, nil
+}
+
+func (b *AuxTxBuilder) checkEmptyFields() {
+	if b.body == nil {
+		b.body = &tx.TxBody{}
+	}
+
+	if b.auxSignerData == nil {
+		b.auxSignerData = &tx.AuxSignerData{SignDoc: &tx.SignDocDirectAux{}}
+	}
+}
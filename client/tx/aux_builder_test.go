@@ -0,0 +1,249 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	typestx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestAuxTxBuilder(t *testing.T) {
+	_, pub1, addr1 := testdata.KeyTestPubAddr()
+	msg1 := testdata.NewTestMsg(addr1)
+	memo := "test-memo"
+	tip := &typestx.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tip-token", 10)), Tipper: addr1.String()}
+
+	var b clienttx.AuxTxBuilder
+
+	testcases := []struct {
+		name      string
+		malleate  func() error
+		expErr    bool
+		expErrStr string
+	}{
+		{
+			"cannot set SIGN_MODE_DIRECT",
+			func() error {
+				return b.SetSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+			},
+			true, "AuxTxBuilder can only sign with SIGN_MODE_DIRECT_AUX",
+		},
+		{
+			"cannot set invalid pubkey",
+			func() error {
+				return b.SetPubKey(nil)
+			},
+			true, "failed packing protobuf message to Any",
+		},
+		{
+			"cannot set invalid Msg",
+			func() error {
+				return b.SetMsgs(nil)
+			},
+			true, "failed packing protobuf message to Any",
+		},
+		{
+			"GetSignBytes body should not be empty",
+			func() error {
+				_, err := b.GetSignBytes()
+				return err
+			},
+			true, "aux tx is nil, call setters on AuxTxBuilder first",
+		},
+		{
+			"GetSignBytes pubkey should not be empty",
+			func() error {
+				require.NoError(t, b.SetMsgs(msg1))
+
+				_, err := b.GetSignBytes()
+				return err
+			},
+			true, "public key cannot be empty: invalid pubkey",
+		},
+		{
+			"GetSignBytes sign mode should be set",
+			func() error {
+				require.NoError(t, b.SetMsgs(msg1))
+				require.NoError(t, b.SetPubKey(pub1))
+
+				_, err := b.GetSignBytes()
+				return err
+			},
+			true, "got unsupported sign mode SIGN_MODE_UNSPECIFIED",
+		},
+		{
+			"GetSignBytes works for DIRECT_AUX",
+			func() error {
+				b.SetAccountNumber(1)
+				b.SetSequence(2)
+				b.SetTimeoutHeight(3)
+				b.SetMemo(memo)
+				b.SetChainID("test-chain")
+				require.NoError(t, b.SetMsgs(msg1))
+				require.NoError(t, b.SetPubKey(pub1))
+				require.NoError(t, b.SetSignMode(signing.SignMode_SIGN_MODE_DIRECT_AUX))
+
+				_, err := b.GetSignBytes()
+				require.NoError(t, err)
+
+				_, err = b.GetAuxSignerData()
+
+				return err
+			},
+			true, "address cannot be empty: invalid request",
+		},
+		{
+			"GetAuxSignerData works for DIRECT_AUX",
+			func() error {
+				b.SetAccountNumber(1)
+				b.SetSequence(2)
+				b.SetTimeoutHeight(3)
+				b.SetMemo(memo)
+				b.SetChainID("test-chain")
+				require.NoError(t, b.SetMsgs(msg1))
+				require.NoError(t, b.SetPubKey(pub1))
+				b.SetAddress(addr1.String())
+				b.SetTip(tip)
+				require.NoError(t, b.SetSignMode(signing.SignMode_SIGN_MODE_DIRECT_AUX))
+
+				signBz, err := b.GetSignBytes()
+				require.NoError(t, err)
+				b.SetSignature([]byte("sig"))
+
+				auxSignerData, err := b.GetAuxSignerData()
+				require.NoError(t, err)
+
+				// Make sure auxSignerData is correctly populated
+				checkCorrectData(t, auxSignerData, addr1, pub1)
+				require.Equal(t, tip, auxSignerData.SignDoc.Tip)
+
+				expectedSignBz, err := auxSignerData.SignDoc.Marshal()
+				require.NoError(t, err)
+				require.Equal(t, expectedSignBz, signBz)
+
+				return err
+			},
+			false, "",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			b = clienttx.NewAuxTxBuilder()
+			err := tc.malleate()
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrStr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// checkCorrectData checks that the given auxSignerData is correctly populated.
+func checkCorrectData(t *testing.T, auxSignerData typestx.AuxSignerData, addr sdk.AccAddress, pub cryptotypes.PubKey) {
+	pkAny, err := codectypes.NewAnyWithValue(pub)
+	require.NoError(t, err)
+
+	require.Equal(t, addr.String(), auxSignerData.Address)
+	require.Equal(t, uint64(1), auxSignerData.SignDoc.AccountNumber)
+	require.Equal(t, uint64(2), auxSignerData.SignDoc.Sequence)
+	require.Equal(t, "test-chain", auxSignerData.SignDoc.ChainId)
+	require.Equal(t, pkAny, auxSignerData.SignDoc.PublicKey)
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT_AUX, auxSignerData.Mode)
+	require.Equal(t, []byte("sig"), auxSignerData.Sig)
+
+	var body typestx.TxBody
+	require.NoError(t, body.Unmarshal(auxSignerData.SignDoc.BodyBytes))
+	require.Equal(t, "test-memo", body.Memo)
+	require.Equal(t, uint64(3), body.TimeoutHeight)
+	require.Len(t, body.Messages, 1)
+}
+
+func TestAuxTxBuilderFeePayer(t *testing.T) {
+	priv1, pub1, addr1 := testdata.KeyTestPubAddr()
+	priv2, pub2, addr2 := testdata.KeyTestPubAddr()
+	_, _, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes)
+
+	// Each auxiliary signer signs the body of the tx with its own signer data.
+	msg := testdata.NewTestMsg(addr1, addr2)
+	auxSignerData := make([]typestx.AuxSignerData, 2)
+	for i, signer := range []struct {
+		addr   sdk.AccAddress
+		pub    cryptotypes.PubKey
+		accNum uint64
+		sign   func([]byte) ([]byte, error)
+	}{
+		{addr1, pub1, 1, priv1.Sign},
+		{addr2, pub2, 2, priv2.Sign},
+	} {
+		b := clienttx.NewAuxTxBuilder()
+		b.SetAddress(signer.addr.String())
+		b.SetAccountNumber(signer.accNum)
+		b.SetSequence(0)
+		b.SetChainID("test-chain")
+		b.SetMemo("memo")
+		require.NoError(t, b.SetMsgs(msg))
+		require.NoError(t, b.SetPubKey(signer.pub))
+		require.NoError(t, b.SetSignMode(signing.SignMode_SIGN_MODE_DIRECT_AUX))
+
+		signBz, err := b.GetSignBytes()
+		require.NoError(t, err)
+		sig, err := signer.sign(signBz)
+		require.NoError(t, err)
+		b.SetSignature(sig)
+
+		auxSignerData[i], err = b.GetAuxSignerData()
+		require.NoError(t, err)
+	}
+
+	// The fee payer assembles the tx and sets the fee.
+	txf := clienttx.Factory{}.
+		WithTxConfig(txConfig).
+		WithChainID("test-chain").
+		WithFees("10stake").
+		WithGas(100000)
+	txBuilder, err := clienttx.BuildAuxTx(txf, auxSignerData...)
+	require.NoError(t, err)
+	txBuilder.SetFeePayer(feePayerAddr)
+
+	theTx := txBuilder.GetTx()
+	require.Equal(t, []sdk.AccAddress{addr1, addr2, feePayerAddr}, theTx.GetSigners())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), theTx.GetFee())
+	require.Equal(t, "memo", theTx.GetMemo())
+
+	// The signatures of the auxiliary signers are valid once the fee is set.
+	sigs, err := theTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+	for i, data := range auxSignerData {
+		signerData := authsigning.SignerData{
+			ChainID:       "test-chain",
+			AccountNumber: data.SignDoc.AccountNumber,
+			Sequence:      data.SignDoc.Sequence,
+			Address:       data.Address,
+			PubKey:        sigs[i].PubKey,
+		}
+		require.NoError(t, authsigning.VerifySignature(sigs[i].PubKey, signerData, sigs[i].Data, txConfig.SignModeHandler(), theTx))
+	}
+
+	// The auxiliary signers must have signed for the chain of the fee payer.
+	_, err = clienttx.BuildAuxTx(txf.WithChainID("other-chain"), auxSignerData...)
+	require.Error(t, err)
+}
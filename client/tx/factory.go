@@ -24,6 +24,7 @@ type Factory struct {
 	chainID            string
 	memo               string
 	fees               sdk.Coins
+	tips               sdk.Coins
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
@@ -39,6 +40,10 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case flags.SignModeLegacyAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case flags.SignModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
+	case flags.SignModeDirectAux:
+		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
@@ -68,6 +73,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	feesStr, _ := flagSet.GetString(flags.FlagFees)
 	f = f.WithFees(feesStr)
 
+	tipsStr, _ := flagSet.GetString(flags.FlagTip)
+	f = f.WithTips(tipsStr)
+
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
@@ -82,6 +90,7 @@ func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
+func (f Factory) Tips() sdk.Coins                           { return f.tips }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
@@ -125,6 +134,18 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
+// WithTips returns a copy of the Factory with an updated tip, paid by the
+// auxiliary signer to the fee payer.
+func (f Factory) WithTips(tips string) Factory {
+	parsedTips, err := sdk.ParseCoinsNormalized(tips)
+	if err != nil {
+		panic(err)
+	}
+
+	f.tips = parsedTips
+	return f
+}
+
 // WithGasPrices returns a copy of the Factory with updated gas prices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
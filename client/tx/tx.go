@@ -44,6 +44,20 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 		}
 	}
 
+	// If the --aux flag is set, we simply generate and print the AuxSignerData.
+	if !txf.tips.IsZero() && !clientCtx.IsAux {
+		return sdkerrors.ErrInvalidRequest.Wrap("a tip can only be paid by an auxiliary signer, use --aux")
+	}
+
+	if clientCtx.IsAux {
+		auxSignerData, err := makeAuxSignerData(clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(&auxSignerData)
+	}
+
 	if clientCtx.GenerateOnly {
 		return GenerateTx(clientCtx, txf, msgs...)
 	}
@@ -225,6 +239,58 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
+	fees, err := computeFees(txf)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := txf.txConfig.NewTxBuilder()
+
+	if err := tx.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+
+	tx.SetMemo(txf.memo)
+	tx.SetFeeAmount(fees)
+	tx.SetGasLimit(txf.gas)
+	tx.SetTimeoutHeight(txf.TimeoutHeight())
+
+	return tx, nil
+}
+
+// BuildAuxTx builds the transaction signed by the given auxiliary signers, to
+// be signed by the fee payer. The body of the transaction is the one signed by
+// the auxiliary signers, and the fee and gas limit are set from the factory.
+func BuildAuxTx(txf Factory, auxSignerData ...tx.AuxSignerData) (client.TxBuilder, error) {
+	if len(auxSignerData) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("no auxiliary signer data")
+	}
+
+	fees, err := computeFees(txf)
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder := txf.txConfig.NewTxBuilder()
+	for _, data := range auxSignerData {
+		if data.SignDoc != nil && data.SignDoc.ChainId != txf.chainID {
+			return nil, sdkerrors.ErrInvalidChainID.Wrapf("auxiliary signer %s signed for chain %s, expected %s", data.Address, data.SignDoc.ChainId, txf.chainID)
+		}
+
+		if err := txBuilder.AddAuxSignerData(data); err != nil {
+			return nil, err
+		}
+	}
+
+	txBuilder.SetFeeAmount(fees)
+	txBuilder.SetGasLimit(txf.gas)
+
+	return txBuilder, nil
+}
+
+// computeFees returns the fees of the factory, derived from its gas prices if
+// they are set.
+func computeFees(txf Factory) (sdk.Coins, error) {
 	fees := txf.fees
 
 	if !txf.gasPrices.IsZero() {
@@ -244,18 +310,7 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 		}
 	}
 
-	tx := txf.txConfig.NewTxBuilder()
-
-	if err := tx.SetMsgs(msgs...); err != nil {
-		return nil, err
-	}
-
-	tx.SetMemo(txf.memo)
-	tx.SetFeeAmount(fees)
-	tx.SetGasLimit(txf.gas)
-	tx.SetTimeoutHeight(txf.TimeoutHeight())
-
-	return tx, nil
+	return fees, nil
 }
 
 // BuildSimTx creates an unsigned tx with an empty single signature and returns
@@ -370,18 +425,50 @@ func SignWithPrivKey(
 	return sigV2, nil
 }
 
-func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
-	if mode == signing.SignMode_SIGN_MODE_DIRECT &&
-		len(tx.GetSigners()) > 1 {
-		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one signer only")
+// checkMultipleSigners checks that there is at most one DIRECT signer in the
+// tx, as the sign bytes of a DIRECT signer include the signer infos of the
+// other signers.
+func checkMultipleSigners(tx authsigning.Tx) error {
+	directSigners := 0
+	sigsV2, err := tx.GetSignaturesV2()
+	if err != nil {
+		return err
 	}
+	for _, sig := range sigsV2 {
+		directSigners += countDirectSigners(sig.Data)
+		if directSigners > 1 {
+			return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one DIRECT signer only")
+		}
+	}
+
 	return nil
 }
 
+// countDirectSigners counts the number of DIRECT signers in a signature data.
+func countDirectSigners(data signing.SignatureData) int {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		if data.SignMode == signing.SignMode_SIGN_MODE_DIRECT {
+			return 1
+		}
+
+		return 0
+	case *signing.MultiSignatureData:
+		directSigners := 0
+		for _, d := range data.Signatures {
+			directSigners += countDirectSigners(d)
+		}
+
+		return directSigners
+	default:
+		return 0
+	}
+}
+
 // Sign signs a given tx with a named key. The bytes signed over are canconical.
 // The resulting signature will be added to the transaction builder overwriting the previous
 // ones if overwrite=true (otherwise, the signature will be appended).
-// Signing a transaction with mutltiple signers in the DIRECT mode is not supprted and will
+// Signing a transaction with mutltiple DIRECT signers is not supprted and will
 // return an error.
 // An error is returned upon failure.
 func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) error {
@@ -394,9 +481,6 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		// use the SignModeHandler's default mode if unspecified
 		signMode = txf.txConfig.SignModeHandler().DefaultMode()
 	}
-	if err := checkMultipleSigners(signMode, txBuilder.GetTx()); err != nil {
-		return err
-	}
 
 	key, err := txf.keybase.Key(name)
 	if err != nil {
@@ -407,12 +491,15 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		ChainID:       txf.chainID,
 		AccountNumber: txf.accountNumber,
 		Sequence:      txf.sequence,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+		PubKey:        pubKey,
 	}
 
 	// For SIGN_MODE_DIRECT, calling SetSignatures calls setSignerInfos on
 	// TxBuilder under the hood, and SignerInfos is needed to generated the
 	// sign bytes. This is the reason for setting SetSignatures here, with a
-	// nil signature.
+	// nil signature, along with the signatures of the previous signers, such
+	// as the auxiliary signers when the fee payer signs last.
 	//
 	// Note: this line is not needed for SIGN_MODE_LEGACY_AMINO, but putting it
 	// also doesn't affect its generated sign bytes, so for code's simplicity
@@ -433,7 +520,20 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 			return err
 		}
 	}
-	if err := txBuilder.SetSignatures(sig); err != nil {
+
+	// Overwrite or append the signer infos.
+	var sigs []signing.SignatureV2
+	if overwriteSig {
+		sigs = []signing.SignatureV2{sig}
+	} else {
+		sigs = append(sigs, prevSignatures...)
+		sigs = append(sigs, sig)
+	}
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return err
+	}
+
+	if err := checkMultipleSigners(txBuilder.GetTx()); err != nil {
 		return err
 	}
 
@@ -475,3 +575,77 @@ type GasEstimateResponse struct {
 func (gr GasEstimateResponse) String() string {
 	return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
 }
+
+// makeAuxSignerData generates an AuxSignerData from the client inputs, signed
+// by the --from key.
+func makeAuxSignerData(clientCtx client.Context, f Factory, msgs ...sdk.Msg) (tx.AuxSignerData, error) {
+	if clientCtx.GenerateOnly {
+		return tx.AuxSignerData{}, sdkerrors.ErrInvalidRequest.Wrap("cannot use --generate-only with --aux, the keyring is needed to sign")
+	}
+
+	if !clientCtx.Offline {
+		var err error
+		f, err = prepareFactory(clientCtx, f)
+		if err != nil {
+			return tx.AuxSignerData{}, err
+		}
+	}
+
+	b := NewAuxTxBuilder()
+	b.SetMemo(f.Memo())
+	b.SetTimeoutHeight(f.TimeoutHeight())
+	if err := b.SetMsgs(msgs...); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	return SignAux(f, clientCtx.GetFromName(), &b)
+}
+
+// SignAux signs the body of the AuxTxBuilder with a named key, with the chain
+// ID, account number and sequence of the factory, and returns the resulting
+// AuxSignerData. The body must be set on the builder beforehand. The sign mode
+// of the factory defaults to SIGN_MODE_DIRECT_AUX if unspecified. If the
+// factory has tips, the signer pays them to the fee payer.
+func SignAux(txf Factory, name string, b *AuxTxBuilder) (tx.AuxSignerData, error) {
+	if txf.keybase == nil {
+		return tx.AuxSignerData{}, errors.New("keybase must be set prior to signing a transaction")
+	}
+
+	key, err := txf.keybase.Key(name)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	pubKey := key.GetPubKey()
+
+	b.SetAddress(sdk.AccAddress(pubKey.Address()).String())
+	b.SetChainID(txf.chainID)
+	b.SetAccountNumber(txf.accountNumber)
+	b.SetSequence(txf.sequence)
+	if err := b.SetPubKey(pubKey); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	if !txf.tips.IsZero() {
+		b.SetTip(&tx.Tip{Amount: txf.tips, Tipper: sdk.AccAddress(pubKey.Address()).String()})
+	}
+
+	signMode := txf.signMode
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
+	}
+	if err := b.SetSignMode(signMode); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	signBz, err := b.GetSignBytes()
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	sig, _, err := txf.keybase.Sign(name, signBz)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	b.SetSignature(sig)
+
+	return b.GetAuxSignerData()
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -43,5 +44,8 @@ type (
 		SetGasLimit(limit uint64)
 		SetTimeoutHeight(height uint64)
 		SetFeeGranter(feeGranter sdk.AccAddress)
+		SetFeePayer(feePayer sdk.AccAddress)
+		SetTip(tip *tx.Tip)
+		AddAuxSignerData(tx.AuxSignerData) error
 	}
 )
@@ -157,6 +157,36 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StoreConfig defines application configuration for state streaming and other
+// storage related operations.
+type StoreConfig struct {
+	// Streamers defines the list of streaming services enabled on the node. A
+	// streaming service exposes the state changes of every block, together with
+	// the ABCI requests and responses of the block, to external consumers.
+	Streamers []string `mapstructure:"streamers"`
+}
+
+// StreamersConfig defines concrete state streaming configuration options. These
+// fields are required to be set when state streaming is enabled via a non-empty
+// list defined by 'StoreConfig.Streamers'.
+type StreamersConfig struct {
+	File FileStreamerConfig `mapstructure:"file"`
+}
+
+// FileStreamerConfig defines the configuration of the file streaming service.
+type FileStreamerConfig struct {
+	// Keys defines the store keys whose state changes are streamed, "*" streams
+	// the state changes of every store.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir defines the directory the files are written to. It must exist and
+	// be writable by the node.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix defines an optional prefix prepended to the names of the files.
+	Prefix string `mapstructure:"prefix"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -168,6 +198,8 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
+	Streamers StreamersConfig  `mapstructure:"streamers"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -242,6 +274,16 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Store: StoreConfig{
+			Streamers: []string{},
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     []string{"*"},
+				WriteDir: "",
+				Prefix:   "",
+			},
+		},
 	}
 }
 
@@ -309,6 +351,16 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Store: StoreConfig{
+			Streamers: v.GetStringSlice("store.streamers"),
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     v.GetStringSlice("streamers.file.keys"),
+				WriteDir: v.GetString("streamers.file.write-dir"),
+				Prefix:   v.GetString("streamers.file.prefix"),
+			},
+		},
 	}
 }
 
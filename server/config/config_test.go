@@ -1,8 +1,10 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +20,21 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestStreamersConfigTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Store.Streamers = []string{"file"}
+	cfg.Streamers.File.Keys = []string{"bank", "staking"}
+	cfg.Streamers.File.WriteDir = "/tmp/streams"
+
+	configFile := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(configFile, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed := GetConfig(v)
+	require.Equal(t, cfg.Store, parsed.Store)
+	require.Equal(t, cfg.Streamers, parsed.Streamers)
+}
@@ -201,6 +201,33 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                         Store / State Streaming                         ###
+###############################################################################
+
+[store]
+
+# streamers defines the list of streaming services exposing the state changes of
+# every block, together with the ABCI requests and responses of the block
+# (e.g. ["file"]). Streaming is disabled when the list is empty.
+streamers = [{{ range .Store.Streamers }}{{ printf "%q, " . }}{{end}}]
+
+[streamers]
+
+# The file streaming service writes one file per block, named "{prefix}-block-{height}",
+# made of length-prefixed protobuf encoded ABCI requests and responses. The state
+# changes (StoreKVPair) of the block are all written before the Commit response.
+[streamers.file]
+
+# keys defines the store keys whose state changes are streamed, "*" streams every store.
+keys = [{{ range .Streamers.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# write-dir defines the existing directory the files are written to.
+write-dir = "{{ .Streamers.File.WriteDir }}"
+
+# prefix defines an optional prefix prepended to the names of the files.
+prefix = "{{ .Streamers.File.Prefix }}"
 `
 
 var configTemplate *template.Template
@@ -17,6 +17,7 @@ const (
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgCancelUnbondingDelegation   int = 100
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
@@ -25,4 +26,11 @@ const (
 	// feegrant
 	DefaultWeightGrantAllowance  int = 100
 	DefaultWeightRevokeAllowance int = 100
+
+	// vesting
+	DefaultWeightMsgCreateVestingAccount         int = 20
+	DefaultWeightMsgCreatePermanentLockedAccount int = 20
+	DefaultWeightMsgCreatePeriodicVestingAccount int = 20
+	DefaultWeightMsgCreateClawbackVestingAccount int = 20
+	DefaultWeightMsgClawback                     int = 10
 )
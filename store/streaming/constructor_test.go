@@ -0,0 +1,123 @@
+package streaming
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type fakeOptions map[string]interface{}
+
+func (f fakeOptions) Get(key string) interface{} { return f[key] }
+
+var (
+	mockOptions       = fakeOptions{}
+	mockKeys          = []types.StoreKey{sdk.NewKVStoreKey("mockKey1"), sdk.NewKVStoreKey("mockKey2")}
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+)
+
+func TestStreamingServiceConstructor(t *testing.T) {
+	_, err := NewServiceConstructor("unexpectedName")
+	require.NotNil(t, err)
+
+	constructor, err := NewServiceConstructor("file")
+	require.Nil(t, err)
+	var expectedType ServiceConstructor
+	require.IsType(t, expectedType, constructor)
+
+	serv, err := constructor(mockOptions, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &file.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+}
+
+func TestServiceType(t *testing.T) {
+	require.Equal(t, File, ServiceTypeFromString("FILE"))
+	require.Equal(t, File, ServiceTypeFromString("f"))
+	require.Equal(t, Unknown, ServiceTypeFromString("kafka"))
+	require.Equal(t, "file", File.String())
+	require.Equal(t, "unknown", Unknown.String())
+}
+
+func TestLoadStreamingServices(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	keys := sdk.NewKVStoreKeys("mockKey1", "mockKey2")
+	testCases := map[string]struct {
+		opts        fakeOptions
+		expServices int
+		expErr      bool
+	}{
+		"no streamers": {
+			opts:        fakeOptions{},
+			expServices: 0,
+		},
+		"file streamer exposing all keys": {
+			opts: fakeOptions{
+				OptStoreStreamers:     []string{"file"},
+				"streamers.file.keys": []string{"*"},
+				OptFileWriteDir:       dir,
+			},
+			expServices: 1,
+		},
+		"file streamer exposing unknown keys": {
+			opts: fakeOptions{
+				OptStoreStreamers:     []string{"file"},
+				"streamers.file.keys": []string{"unknown"},
+				OptFileWriteDir:       dir,
+			},
+			expServices: 0,
+		},
+		"unknown streamer": {
+			opts: fakeOptions{
+				OptStoreStreamers:      []string{"kafka"},
+				"streamers.kafka.keys": []string{"mockKey1"},
+			},
+			expErr: true,
+		},
+		"file streamer with missing write dir": {
+			opts: fakeOptions{
+				OptStoreStreamers:     []string{"file"},
+				"streamers.file.keys": []string{"mockKey1"},
+				OptFileWriteDir:       dir + "/missing",
+			},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			bApp := baseapp.NewBaseApp("app", log.NewNopLogger(), dbm.NewMemDB(), nil)
+			services, wg, err := LoadStreamingServices(bApp, tc.opts, testMarshaller, keys)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, services, tc.expServices)
+			for _, service := range services {
+				require.NoError(t, service.Close())
+			}
+			wg.Wait()
+		})
+	}
+}
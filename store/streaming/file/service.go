@@ -0,0 +1,229 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that writes
+// the state changes of every block, together with the ABCI requests and responses
+// of the block, out to a single file per block.
+//
+// The state changes received by the listeners are written out with the next ABCI
+// message, before its response. BaseApp only flushes the state changes of a block
+// to the listeners when it commits the block, so they are all written between the
+// EndBlock response and the Commit response of the file, and are not attributed to
+// the BeginBlock, DeliverTx or EndBlock stage which wrote them.
+type StreamingService struct {
+	listeners      map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	filePrefix     string                                   // optional prefix for each of the generated files
+	writeDir       string                                   // directory to write files into
+	codec          codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
+	stateCache     [][]byte                                 // cache the protobuf binary encoded StoreKVPairs in the order they are received
+	stateCacheLock *sync.Mutex                              // mutex for the state cache
+	dstFile        *os.File                                 // the file of the block currently being written
+	quitChan       chan struct{}                            // channel to synchronize closure
+}
+
+// cacheWriter is the io.Writer used by the StoreKVPairWriteListener of the service
+// to cache the length-prefixed StoreKVPairs until they are written out to a file
+type cacheWriter struct {
+	fss *StreamingService
+}
+
+// Write satisfies io.Writer
+func (cw cacheWriter) Write(b []byte) (int, error) {
+	cw.fss.stateCacheLock.Lock()
+	defer cw.fss.stateCacheLock.Unlock()
+	// the listener may reuse the buffer, so we keep a copy of it
+	cw.fss.stateCache = append(cw.fss.stateCache, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+// NewStreamingService creates a new StreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
+	// check that the writeDir exists and is writeable so that we can catch the error here at initialization if it is not
+	// we don't open a dstFile until we receive our first ABCI message
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+	fss := &StreamingService{
+		filePrefix:     filePrefix,
+		writeDir:       writeDir,
+		codec:          c,
+		stateCache:     make([][]byte, 0),
+		stateCacheLock: new(sync.Mutex),
+	}
+	listener := types.NewStoreKVPairWriteListener(cacheWriter{fss: fss}, c)
+	fss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		fss.listeners[key] = append(fss.listeners[key], listener)
+	}
+	return fss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It creates the file of the block, and writes the request, the state changes
+// received since the previous message, if any, and the response out to it
+func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	if err := fss.openBlockFile(req.Header.Height); err != nil {
+		return err
+	}
+	return fss.writeStage(&req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It writes the request, the state changes received since the previous message,
+// if any, and the response out to the file of the block
+func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return fss.writeStage(&req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It writes the request, the state changes received since the previous message,
+// if any, and the response out to the file of the block
+func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return fss.writeStage(&req, &res)
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes the state changes flushed on commit, which are all the state changes
+// of the block when used with BaseApp, and the response out to the file of the
+// block, and closes the file
+func (fss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	if err := fss.writeStage(nil, &res); err != nil {
+		return err
+	}
+	return fss.closeBlockFile()
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// The state changes are cached synchronously as they are written, so the
+// spawned goroutine only holds the WaitGroup until the service is closed
+func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if fss.quitChan != nil {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	quitChan := make(chan struct{})
+	fss.quitChan = quitChan
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-quitChan
+	}()
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+func (fss *StreamingService) Close() error {
+	if fss.quitChan != nil {
+		close(fss.quitChan)
+		fss.quitChan = nil
+	}
+	return fss.closeBlockFile()
+}
+
+// openBlockFile creates the file of the block with the given height, replacing
+// the file of an unfinished block if any
+func (fss *StreamingService) openBlockFile(height int64) error {
+	if err := fss.closeBlockFile(); err != nil {
+		return err
+	}
+	dstFile, err := os.OpenFile(filepath.Join(fss.writeDir, fss.fileName(height)), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	fss.dstFile = dstFile
+	return nil
+}
+
+// closeBlockFile closes the file of the current block, if any
+func (fss *StreamingService) closeBlockFile() error {
+	if fss.dstFile == nil {
+		return nil
+	}
+	err := fss.dstFile.Close()
+	fss.dstFile = nil
+	return err
+}
+
+// writeStage writes the length-prefixed request (if any), the state changes
+// received since the previous message and the length-prefixed response out to the file of the current block
+func (fss *StreamingService) writeStage(req, res codec.ProtoMarshaler) error {
+	if fss.dstFile == nil {
+		// we started listening in the middle of a block, drop the state changes
+		// which can not be attributed to a block file
+		fss.resetStateCache()
+		return errors.New("no block file is open, BeginBlock was not received")
+	}
+	if req != nil {
+		if err := fss.writeMessage(req); err != nil {
+			return err
+		}
+	}
+	fss.stateCacheLock.Lock()
+	for _, stateChange := range fss.stateCache {
+		if _, err := fss.dstFile.Write(stateChange); err != nil {
+			fss.stateCacheLock.Unlock()
+			return err
+		}
+	}
+	fss.stateCache = fss.stateCache[:0]
+	fss.stateCacheLock.Unlock()
+	return fss.writeMessage(res)
+}
+
+func (fss *StreamingService) writeMessage(msg codec.ProtoMarshaler) error {
+	bz, err := fss.codec.MarshalLengthPrefixed(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fss.dstFile.Write(bz)
+	return err
+}
+
+func (fss *StreamingService) resetStateCache() {
+	fss.stateCacheLock.Lock()
+	fss.stateCache = fss.stateCache[:0]
+	fss.stateCacheLock.Unlock()
+}
+
+// fileName returns the name of the file of the block with the given height
+func (fss *StreamingService) fileName(height int64) string {
+	fileName := fmt.Sprintf("block-%d", height)
+	if fss.filePrefix != "" {
+		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	}
+	return fileName
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+	return os.Remove(f)
+}
@@ -0,0 +1,299 @@
+package file
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	interfaceRegistry            = codecTypes.NewInterfaceRegistry()
+	testMarshaller               = codec.NewProtoCodec(interfaceRegistry)
+	testStreamingService         *StreamingService
+	testListener1, testListener2 types.WriteListener
+	emptyContext                 = sdk.Context{}
+
+	// test abci message types
+	mockHash          = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	testBeginBlockReq = abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height: 1,
+		},
+		ByzantineValidators: []abci.Evidence{},
+		Hash:                mockHash,
+		LastCommitInfo: abci.LastCommitInfo{
+			Round: 1,
+			Votes: []abci.VoteInfo{},
+		},
+	}
+	testBeginBlockRes = abci.ResponseBeginBlock{
+		Events: []abci.Event{
+			{
+				Type: "testEventType1",
+			},
+			{
+				Type: "testEventType2",
+			},
+		},
+	}
+	testEndBlockReq = abci.RequestEndBlock{
+		Height: 1,
+	}
+	testEndBlockRes = abci.ResponseEndBlock{
+		Events:                []abci.Event{},
+		ConsensusParamUpdates: &abci.ConsensusParams{},
+		ValidatorUpdates:      []abci.ValidatorUpdate{},
+	}
+	mockTxBytes1      = []byte{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	testDeliverTxReq1 = abci.RequestDeliverTx{
+		Tx: mockTxBytes1,
+	}
+	mockTxBytes2      = []byte{8, 7, 6, 5, 4, 3, 2}
+	testDeliverTxReq2 = abci.RequestDeliverTx{
+		Tx: mockTxBytes2,
+	}
+	mockTxResponseData1 = []byte{1, 3, 5, 7, 9}
+	testDeliverTxRes1   = abci.ResponseDeliverTx{
+		Events:    []abci.Event{},
+		Code:      1,
+		Codespace: "mockCodeSpace",
+		Data:      mockTxResponseData1,
+		GasUsed:   2,
+		GasWanted: 3,
+		Info:      "mockInfo",
+		Log:       "mockLog",
+	}
+	mockTxResponseData2 = []byte{1, 3, 5, 7, 9}
+	testDeliverTxRes2   = abci.ResponseDeliverTx{
+		Events:    []abci.Event{},
+		Code:      1,
+		Codespace: "mockCodeSpace",
+		Data:      mockTxResponseData2,
+		GasUsed:   2,
+		GasWanted: 3,
+		Info:      "mockInfo",
+		Log:       "mockLog",
+	}
+	testCommitRes = abci.ResponseCommit{
+		Data:         mockHash,
+		RetainHeight: 1,
+	}
+
+	// mock store keys
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	// file stuff
+	testPrefix = "testPrefix"
+	testDir    = "./.test"
+
+	// mock state changes
+	mockKey1   = []byte{1, 2, 3}
+	mockValue1 = []byte{3, 2, 1}
+	mockKey2   = []byte{2, 3, 4}
+	mockValue2 = []byte{4, 3, 2}
+	mockKey3   = []byte{3, 4, 5}
+	mockValue3 = []byte{5, 4, 3}
+)
+
+func TestCacheWriter(t *testing.T) {
+	fss := &StreamingService{stateCacheLock: new(sync.Mutex)}
+	writer := cacheWriter{fss: fss}
+	testBytes := []byte{1, 2, 3, 4, 5}
+	n, err := writer.Write(testBytes)
+	require.NoError(t, err)
+	require.Equal(t, len(testBytes), n)
+	// mutating the written buffer does not affect the cached state change
+	testBytes[0] = 9
+	require.Equal(t, [][]byte{{1, 2, 3, 4, 5}}, fss.stateCache)
+}
+
+func TestFileStreamingService(t *testing.T) {
+	err := os.Mkdir(testDir, 0700)
+	require.Nil(t, err)
+	defer os.RemoveAll(testDir)
+
+	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	testStreamingService, err = NewStreamingService(testDir, testPrefix, testKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &StreamingService{}, testStreamingService)
+	require.Equal(t, testPrefix, testStreamingService.filePrefix)
+	require.Equal(t, testDir, testStreamingService.writeDir)
+	require.Equal(t, testMarshaller, testStreamingService.codec)
+	testListener1 = testStreamingService.listeners[mockStoreKey1][0]
+	testListener2 = testStreamingService.listeners[mockStoreKey2][0]
+	wg := new(sync.WaitGroup)
+	require.NoError(t, testStreamingService.Stream(wg))
+	require.Error(t, testStreamingService.Stream(wg))
+	testListenBlock(t)
+	require.NoError(t, testStreamingService.Close())
+	wg.Wait()
+}
+
+func testListenBlock(t *testing.T) {
+	expectKVPairsStore1 := make([][]byte, 0)
+	expectKVPairsStore2 := make([][]byte, 0)
+
+	// write state changes
+	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
+	testListener2.OnWrite(mockStoreKey2, mockKey2, mockValue2, false)
+	testListener1.OnWrite(mockStoreKey1, mockKey3, mockValue3, false)
+
+	// expected KV pairs
+	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey1.Name(),
+		Key:      mockKey1,
+		Value:    mockValue1,
+		Delete:   false,
+	})
+	require.Nil(t, err)
+	expectedKVPair2, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey2.Name(),
+		Key:      mockKey2,
+		Value:    mockValue2,
+		Delete:   false,
+	})
+	require.Nil(t, err)
+	expectedKVPair3, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey1.Name(),
+		Key:      mockKey3,
+		Value:    mockValue3,
+		Delete:   false,
+	})
+	require.Nil(t, err)
+	expectKVPairsStore1 = append(expectKVPairsStore1, expectedKVPair1, expectedKVPair3)
+	expectKVPairsStore2 = append(expectKVPairsStore2, expectedKVPair2)
+
+	// send the ABCI messages
+	err = testStreamingService.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes)
+	require.Nil(t, err)
+
+	// write state changes
+	testListener1.OnWrite(mockStoreKey1, mockKey2, mockValue2, false)
+	testListener2.OnWrite(mockStoreKey2, mockKey3, mockValue3, true)
+
+	expectedKVPair4, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey1.Name(),
+		Key:      mockKey2,
+		Value:    mockValue2,
+		Delete:   false,
+	})
+	require.Nil(t, err)
+	expectedKVPair5, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey2.Name(),
+		Key:      mockKey3,
+		Value:    mockValue3,
+		Delete:   true,
+	})
+	require.Nil(t, err)
+
+	// send the ABCI messages
+	err = testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1)
+	require.Nil(t, err)
+	err = testStreamingService.ListenDeliverTx(emptyContext, testDeliverTxReq2, testDeliverTxRes2)
+	require.Nil(t, err)
+	err = testStreamingService.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes)
+	require.Nil(t, err)
+
+	// write state changes flushed on commit
+	testListener2.OnWrite(mockStoreKey2, mockKey1, mockValue1, false)
+	expectedKVPair6, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey2.Name(),
+		Key:      mockKey1,
+		Value:    mockValue1,
+		Delete:   false,
+	})
+	require.Nil(t, err)
+
+	err = testStreamingService.ListenCommit(emptyContext, testCommitRes)
+	require.Nil(t, err)
+	require.Nil(t, testStreamingService.dstFile)
+
+	// load the file and compare the contents
+	fileName := filepath.Join(testDir, "testPrefix-block-1")
+	require.Equal(t, fileName, filepath.Join(testDir, testStreamingService.fileName(1)))
+	segments, err := readInFile(fileName)
+	require.Nil(t, err)
+	require.Len(t, segments, 15)
+
+	expectedBeginBlockReqBytes, err := testMarshaller.Marshal(&testBeginBlockReq)
+	require.Nil(t, err)
+	expectedBeginBlockResBytes, err := testMarshaller.Marshal(&testBeginBlockRes)
+	require.Nil(t, err)
+	expectedDeliverTxReq1Bytes, err := testMarshaller.Marshal(&testDeliverTxReq1)
+	require.Nil(t, err)
+	expectedDeliverTxRes1Bytes, err := testMarshaller.Marshal(&testDeliverTxRes1)
+	require.Nil(t, err)
+	expectedDeliverTxReq2Bytes, err := testMarshaller.Marshal(&testDeliverTxReq2)
+	require.Nil(t, err)
+	expectedDeliverTxRes2Bytes, err := testMarshaller.Marshal(&testDeliverTxRes2)
+	require.Nil(t, err)
+	expectedEndBlockReqBytes, err := testMarshaller.Marshal(&testEndBlockReq)
+	require.Nil(t, err)
+	expectedEndBlockResBytes, err := testMarshaller.Marshal(&testEndBlockRes)
+	require.Nil(t, err)
+	expectedCommitResBytes, err := testMarshaller.Marshal(&testCommitRes)
+	require.Nil(t, err)
+
+	require.Equal(t, [][]byte{
+		expectedBeginBlockReqBytes,
+		expectKVPairsStore1[0],
+		expectKVPairsStore2[0],
+		expectKVPairsStore1[1],
+		expectedBeginBlockResBytes,
+		expectedDeliverTxReq1Bytes,
+		expectedKVPair4,
+		expectedKVPair5,
+		expectedDeliverTxRes1Bytes,
+		expectedDeliverTxReq2Bytes,
+		expectedDeliverTxRes2Bytes,
+		expectedEndBlockReqBytes,
+		expectedEndBlockResBytes,
+		expectedKVPair6,
+		expectedCommitResBytes,
+	}, segments)
+}
+
+func TestFileStreamingServiceWithoutBeginBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fss, err := NewStreamingService(dir, "", []types.StoreKey{mockStoreKey1}, testMarshaller)
+	require.NoError(t, err)
+	fss.listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
+	require.Error(t, fss.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1))
+	require.Empty(t, fss.stateCache)
+	require.NoError(t, fss.Close())
+}
+
+func TestNewStreamingServiceInvalidDir(t *testing.T) {
+	_, err := NewStreamingService(filepath.Join(testDir, "missing"), testPrefix, nil, testMarshaller)
+	require.Error(t, err)
+}
+
+func readInFile(name string) ([][]byte, error) {
+	bz, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([][]byte, 0)
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		segments = append(segments, bz[n:n+int(size)])
+		bz = bz[n+int(size):]
+	}
+	return segments, nil
+}
@@ -98,9 +98,13 @@ type Config struct {
 // testing requirements.
 func DefaultConfig() Config {
 	encCfg := simapp.MakeTestEncodingConfig()
+	genesisState := simapp.ModuleBasics.DefaultGenesis(encCfg.Marshaler)
 
 	// disable the EndHyperInflation mint functionality for the integration tests to keep backward compatibility.
-	minttypes.EndHyperInflation = sdk.ZeroInt()
+	var mintGenesis minttypes.GenesisState
+	encCfg.Marshaler.MustUnmarshalJSON(genesisState[minttypes.ModuleName], &mintGenesis)
+	mintGenesis.Params.EndHyperInflation = sdk.ZeroInt()
+	genesisState[minttypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(&mintGenesis)
 
 	return Config{
 		Codec:             encCfg.Marshaler,
@@ -109,7 +113,7 @@ func DefaultConfig() Config {
 		InterfaceRegistry: encCfg.InterfaceRegistry,
 		AccountRetriever:  authtypes.AccountRetriever{},
 		AppConstructor:    NewAppConstructor(encCfg),
-		GenesisState:      simapp.ModuleBasics.DefaultGenesis(encCfg.Marshaler),
+		GenesisState:      genesisState,
 		TimeoutCommit:     2 * time.Second,
 		ChainID:           "chain-" + tmrand.NewRand().Str(6),
 		NumValidators:     4,
@@ -228,6 +228,8 @@ var (
 	AttributeKeyAccountSequence = "acc_seq"
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
+	AttributeKeyTip             = "tip"
+	AttributeKeyTipper          = "tipper"
 
 	EventTypeMessage = "message"
 
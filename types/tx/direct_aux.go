@@ -0,0 +1,59 @@
+package tx
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Interface implementation checks.
+var _, _ codectypes.UnpackInterfacesMessage = &SignDocDirectAux{}, &AuxSignerData{}
+
+// ValidateBasic performs stateless validation of the sign doc.
+func (s *SignDocDirectAux) ValidateBasic() error {
+	if len(s.BodyBytes) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("body bytes cannot be empty")
+	}
+
+	if s.PublicKey == nil {
+		return sdkerrors.ErrInvalidPubKey.Wrap("public key cannot be empty")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (s *SignDocDirectAux) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(s.PublicKey, new(cryptotypes.PubKey))
+}
+
+// ValidateBasic performs stateless validation of the auxiliary signer data.
+func (a *AuxSignerData) ValidateBasic() error {
+	if a.Address == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("address cannot be empty")
+	}
+
+	if a.Mode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+		return sdkerrors.ErrInvalidRequest.Wrapf("auxiliary signers can only sign with %s, got %s", signing.SignMode_SIGN_MODE_DIRECT_AUX, a.Mode)
+	}
+
+	if len(a.Sig) == 0 {
+		return sdkerrors.ErrNoSignatures.Wrap("signature cannot be empty")
+	}
+
+	if a.SignDoc == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("sign doc cannot be empty")
+	}
+
+	return a.SignDoc.ValidateBasic()
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (a *AuxSignerData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if a.SignDoc == nil {
+		return nil
+	}
+
+	return a.SignDoc.UnpackInterfaces(unpacker)
+}
@@ -582,59 +582,59 @@ func init() {
 }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x3b, 0x65, 0x93, 0xbe, 0x64, 0x4b, 0x3a, 0xbb, 0x14, 0x93, 0x82, 0x37, 0x75, 0xc9,
-	0x36, 0x44, 0xc2, 0x56, 0x03, 0x48, 0x08, 0x71, 0x89, 0x93, 0x74, 0x59, 0x41, 0x9b, 0x6a, 0xb2,
-	0x08, 0x15, 0x21, 0x45, 0x4e, 0x32, 0xf5, 0x5a, 0x6c, 0x3c, 0x59, 0xcf, 0x64, 0xe5, 0xa8, 0xad,
-	0x90, 0x38, 0x72, 0x42, 0xe2, 0x67, 0xf0, 0x27, 0x38, 0x72, 0x5c, 0x89, 0x0b, 0x47, 0xb4, 0xe1,
-	0x47, 0x70, 0x44, 0x1e, 0x4f, 0x12, 0x27, 0xeb, 0x74, 0x11, 0xa7, 0xbc, 0xc9, 0x7c, 0xef, 0x7b,
-	0xdf, 0xfb, 0xe6, 0xcd, 0x18, 0xf6, 0x07, 0x94, 0x8d, 0x28, 0xb3, 0x78, 0x68, 0x9d, 0x3f, 0xec,
-	0x13, 0xee, 0x3c, 0xb4, 0x18, 0x09, 0xce, 0xbd, 0x01, 0x31, 0xc7, 0x01, 0xe5, 0x14, 0xdd, 0x8e,
-	0x01, 0x26, 0x0f, 0x4d, 0x09, 0x28, 0xbd, 0xeb, 0x52, 0xea, 0x9e, 0x12, 0xcb, 0x19, 0x7b, 0x96,
-	0xe3, 0xfb, 0x94, 0x3b, 0xdc, 0xa3, 0x3e, 0x8b, 0x13, 0x4a, 0xf7, 0x25, 0x63, 0xdf, 0x61, 0xc4,
-	0x72, 0xfa, 0x03, 0x6f, 0x41, 0x1c, 0x2d, 0x24, 0xa8, 0x74, 0xb5, 0x2c, 0x0f, 0xe5, 0xde, 0x9e,
-	0x4b, 0x5d, 0x2a, 0x42, 0x2b, 0x8a, 0xe4, 0xbf, 0xb5, 0x24, 0xed, 0xd9, 0x84, 0x04, 0xd3, 0x45,
-	0xe6, 0xd8, 0x71, 0x3d, 0x5f, 0x68, 0x88, 0xb1, 0xc6, 0xaf, 0x0a, 0xa0, 0x43, 0xc2, 0x8f, 0x43,
-	0xd6, 0x3e, 0x27, 0x3e, 0xc7, 0xe4, 0x6c, 0x42, 0x18, 0x47, 0x77, 0x60, 0x9b, 0x44, 0x6b, 0xa6,
-	0x29, 0xe5, 0x4c, 0xf5, 0x26, 0x96, 0x2b, 0xf4, 0x08, 0x60, 0x49, 0xa1, 0xa9, 0x65, 0xa5, 0x9a,
-	0xaf, 0x1f, 0x98, 0xb2, 0xef, 0xa8, 0x9e, 0x29, 0xea, 0xcd, 0xfb, 0x37, 0x9f, 0x3a, 0x2e, 0x91,
-	0x9c, 0x38, 0x91, 0x89, 0x3e, 0x81, 0x1c, 0x0d, 0x86, 0x24, 0xe8, 0xf5, 0xa7, 0x5a, 0xa6, 0xac,
-	0x54, 0x6f, 0xd5, 0x4b, 0xe6, 0x15, 0xf7, 0xcc, 0x4e, 0x04, 0xb1, 0xa7, 0x38, 0x4b, 0xe3, 0xc0,
-	0xb8, 0x50, 0x60, 0x77, 0x45, 0x2d, 0x1b, 0x53, 0x9f, 0x11, 0xf4, 0x00, 0x32, 0x3c, 0x8c, 0xb5,
-	0xe6, 0xeb, 0x6f, 0xa5, 0x30, 0x1d, 0x87, 0x38, 0x42, 0xa0, 0x43, 0x28, 0xf0, 0xb0, 0x17, 0xc8,
-	0x3c, 0xa6, 0xa9, 0x22, 0xe3, 0xfd, 0x95, 0x0e, 0x84, 0xf7, 0x89, 0x44, 0x09, 0xc6, 0x79, 0xbe,
-	0x88, 0x23, 0xa2, 0xa4, 0x11, 0x19, 0x61, 0xc4, 0x83, 0x6b, 0x8d, 0x90, 0x4c, 0x89, 0x54, 0x83,
-	0x00, 0xb2, 0x03, 0xea, 0x0c, 0x07, 0x0e, 0xe3, 0x51, 0xb1, 0xd8, 0xff, 0x77, 0x20, 0xc7, 0xc3,
-	0x5e, 0x7f, 0xca, 0x49, 0xd4, 0x95, 0x52, 0x2d, 0xe0, 0x2c, 0x0f, 0xed, 0x68, 0x89, 0x3e, 0x86,
-	0x1b, 0x23, 0x3a, 0x24, 0xc2, 0xfc, 0x5b, 0xf5, 0x72, 0x4a, 0xb3, 0x0b, 0xbe, 0xc7, 0x74, 0x48,
-	0xb0, 0x40, 0x1b, 0xdf, 0xc1, 0xee, 0x4a, 0x19, 0x69, 0x5c, 0x1b, 0xf2, 0x09, 0x3f, 0x44, 0xa9,
-	0xff, 0x6a, 0x07, 0x2c, 0xed, 0x30, 0xbe, 0x81, 0x37, 0xbb, 0xde, 0x68, 0x72, 0xea, 0xf0, 0xf9,
-	0x69, 0xa3, 0x0f, 0x40, 0xe5, 0xa1, 0x24, 0x4c, 0x3f, 0x11, 0x5b, 0xd5, 0x14, 0xac, 0xf2, 0x70,
-	0xa5, 0x59, 0x75, 0xa5, 0x59, 0xe3, 0x27, 0x05, 0x8a, 0x4b, 0x66, 0x29, 0xfa, 0x73, 0xc8, 0xb9,
-	0x0e, 0xeb, 0x79, 0xfe, 0x73, 0x2a, 0x0b, 0xdc, 0xdb, 0xac, 0xf8, 0xd0, 0x61, 0x47, 0xfe, 0x73,
-	0x8a, 0xb3, 0x6e, 0x1c, 0xa0, 0x4f, 0x61, 0x3b, 0x20, 0x6c, 0x72, 0xca, 0xe5, 0xf8, 0x96, 0x37,
-	0xe7, 0x62, 0x81, 0xc3, 0x12, 0x6f, 0x18, 0x50, 0x10, 0xc3, 0x37, 0x6f, 0x11, 0xc1, 0x8d, 0x13,
-	0x87, 0x9d, 0x08, 0x0d, 0x37, 0xb1, 0x88, 0x8d, 0x57, 0xb0, 0x23, 0x31, 0x52, 0x6c, 0xe5, 0x5a,
-	0x1f, 0x84, 0x07, 0x6b, 0x07, 0xa1, 0xfe, 0xbf, 0x83, 0xa8, 0x7d, 0x01, 0x59, 0x79, 0x69, 0x90,
-	0x06, 0x7b, 0x1d, 0xdc, 0x6a, 0xe3, 0x9e, 0xfd, 0xac, 0xf7, 0xf5, 0x93, 0xee, 0xd3, 0x76, 0xf3,
-	0xe8, 0xd1, 0x51, 0xbb, 0x55, 0xdc, 0x42, 0x45, 0x28, 0x2c, 0x76, 0x1a, 0xdd, 0x66, 0x51, 0x41,
-	0xb7, 0x61, 0x67, 0xf1, 0x4f, 0xab, 0xdd, 0x6d, 0x16, 0xd5, 0xda, 0x4b, 0xd8, 0x59, 0x99, 0x23,
-	0xa4, 0x43, 0xc9, 0xc6, 0x9d, 0x46, 0xab, 0xd9, 0xe8, 0x1e, 0xf7, 0x1e, 0x77, 0x5a, 0xed, 0x35,
-	0x56, 0x0d, 0xf6, 0xd6, 0xf6, 0xed, 0xaf, 0x3a, 0xcd, 0x2f, 0x8b, 0x0a, 0x7a, 0x1b, 0x76, 0xd7,
-	0x76, 0xba, 0xcf, 0x9e, 0x34, 0x8b, 0x6a, 0x4a, 0x4a, 0x43, 0xec, 0x64, 0xea, 0xff, 0x64, 0x20,
-	0xdb, 0x8d, 0x1f, 0x57, 0xf4, 0x02, 0x72, 0xf3, 0x11, 0x40, 0x46, 0x8a, 0x83, 0x6b, 0x93, 0x57,
-	0xba, 0xff, 0x5a, 0x8c, 0x9c, 0xd8, 0x83, 0x1f, 0xff, 0xf8, 0xfb, 0x17, 0xb5, 0x6c, 0xdc, 0xb5,
-	0x52, 0x5e, 0x75, 0x09, 0xfe, 0x4c, 0xa9, 0xa1, 0x33, 0x78, 0x43, 0x9c, 0x27, 0xda, 0x4f, 0x61,
-	0x4d, 0x4e, 0x43, 0xa9, 0xbc, 0x19, 0x20, 0x6b, 0x56, 0x44, 0xcd, 0x7d, 0xf4, 0x9e, 0x95, 0xf6,
-	0xa4, 0x33, 0xeb, 0x45, 0x34, 0x41, 0xaf, 0xd0, 0x0f, 0x90, 0x4f, 0x5c, 0x55, 0x54, 0x79, 0xdd,
-	0x0d, 0x5f, 0x96, 0x3f, 0xb8, 0x0e, 0x26, 0x45, 0xdc, 0x13, 0x22, 0xee, 0x1a, 0x77, 0xd2, 0x45,
-	0x44, 0x3d, 0xbf, 0x84, 0x7c, 0xe2, 0x91, 0x4d, 0x15, 0x70, 0xf5, 0x93, 0x91, 0x2a, 0x20, 0xe5,
-	0xad, 0x36, 0x74, 0x21, 0x40, 0x43, 0x1b, 0x04, 0xd8, 0xcd, 0xdf, 0x2f, 0x75, 0xe5, 0xe2, 0x52,
-	0x57, 0xfe, 0xba, 0xd4, 0x95, 0x9f, 0x67, 0xfa, 0xd6, 0x6f, 0x33, 0x5d, 0xb9, 0x98, 0xe9, 0x5b,
-	0x7f, 0xce, 0xf4, 0xad, 0x6f, 0x2b, 0xae, 0xc7, 0x4f, 0x26, 0x7d, 0x73, 0x40, 0x47, 0xf3, 0xfc,
-	0xf8, 0xe7, 0x43, 0x36, 0xfc, 0xde, 0xe2, 0xd3, 0x31, 0x89, 0x08, 0xfb, 0xdb, 0xe2, 0xeb, 0xf6,
-	0xd1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x86, 0xcd, 0x5c, 0xb4, 0x07, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xac, 0x4b, 0x9c, 0x3e, 0x27, 0xc5, 0x9d, 0x84, 0xb2, 0x6c, 0x61, 0xe3, 0x6e, 0x49,
+	0x1a, 0x2c, 0xb1, 0xab, 0x1a, 0x90, 0x50, 0xc5, 0x25, 0x6b, 0xbb, 0x21, 0x82, 0xd6, 0xd5, 0x38,
+	0x08, 0x15, 0x21, 0x59, 0x6b, 0x7b, 0xba, 0x59, 0x91, 0xec, 0x38, 0x3b, 0xe3, 0x68, 0xad, 0xb6,
+	0x42, 0xe2, 0xc8, 0x09, 0x89, 0x9f, 0xc1, 0x9f, 0xe0, 0xc8, 0x31, 0x12, 0x17, 0x8e, 0x28, 0xe1,
+	0x47, 0x70, 0x44, 0x3b, 0x3b, 0x76, 0xd6, 0xce, 0x3a, 0x46, 0x3d, 0xf9, 0x8d, 0xe7, 0x7b, 0xdf,
+	0xfb, 0xde, 0x37, 0x6f, 0x66, 0x61, 0xb3, 0xc7, 0xf8, 0x31, 0xe3, 0x8e, 0x88, 0x9d, 0xd3, 0x87,
+	0x5d, 0x2a, 0xbc, 0x87, 0x0e, 0xa7, 0xd1, 0x69, 0xd0, 0xa3, 0xf6, 0x20, 0x62, 0x82, 0xe1, 0xdb,
+	0x29, 0xc0, 0x16, 0xb1, 0xad, 0x00, 0xc6, 0xfb, 0x3e, 0x63, 0xfe, 0x11, 0x75, 0xbc, 0x41, 0xe0,
+	0x78, 0x61, 0xc8, 0x84, 0x27, 0x02, 0x16, 0xf2, 0x34, 0xc1, 0xb8, 0xaf, 0x18, 0xbb, 0x1e, 0xa7,
+	0x8e, 0xd7, 0xed, 0x05, 0x13, 0xe2, 0x64, 0xa1, 0x40, 0xc6, 0xd5, 0xb2, 0x22, 0x56, 0x7b, 0x1b,
+	0x3e, 0xf3, 0x99, 0x0c, 0x9d, 0x24, 0x52, 0xff, 0x56, 0xb3, 0xb4, 0x27, 0x43, 0x1a, 0x8d, 0x26,
+	0x99, 0x03, 0xcf, 0x0f, 0x42, 0xa9, 0x21, 0xc5, 0x5a, 0xbf, 0x21, 0xc0, 0x7b, 0x54, 0x1c, 0xc4,
+	0xbc, 0x79, 0x4a, 0x43, 0x41, 0xe8, 0xc9, 0x90, 0x72, 0x81, 0xef, 0xc0, 0x32, 0x4d, 0xd6, 0x5c,
+	0x47, 0x95, 0xc2, 0xce, 0x4d, 0xa2, 0x56, 0xf8, 0x31, 0xc0, 0x25, 0x85, 0xae, 0x55, 0xd0, 0x4e,
+	0xa9, 0xb6, 0x6d, 0xab, 0xbe, 0x93, 0x7a, 0xb6, 0xac, 0x37, 0xee, 0xdf, 0x7e, 0xe6, 0xf9, 0x54,
+	0x71, 0x92, 0x4c, 0x26, 0xfe, 0x0c, 0x56, 0x58, 0xd4, 0xa7, 0x51, 0xa7, 0x3b, 0xd2, 0x0b, 0x15,
+	0xb4, 0x73, 0xab, 0x66, 0xd8, 0x57, 0xdc, 0xb3, 0x5b, 0x09, 0xc4, 0x1d, 0x91, 0x22, 0x4b, 0x03,
+	0xeb, 0x0c, 0xc1, 0xfa, 0x94, 0x5a, 0x3e, 0x60, 0x21, 0xa7, 0xf8, 0x01, 0x14, 0x44, 0x9c, 0x6a,
+	0x2d, 0xd5, 0xde, 0xc9, 0x61, 0x3a, 0x88, 0x49, 0x82, 0xc0, 0x7b, 0xb0, 0x2a, 0xe2, 0x4e, 0xa4,
+	0xf2, 0xb8, 0xae, 0xc9, 0x8c, 0x0f, 0xa7, 0x3a, 0x90, 0xde, 0x67, 0x12, 0x15, 0x98, 0x94, 0xc4,
+	0x24, 0x4e, 0x88, 0xb2, 0x46, 0x14, 0xa4, 0x11, 0x0f, 0x16, 0x1a, 0xa1, 0x98, 0x32, 0xa9, 0x16,
+	0x05, 0xec, 0x46, 0xcc, 0xeb, 0xf7, 0x3c, 0x2e, 0x0e, 0x62, 0xe5, 0x15, 0x7e, 0x0f, 0x56, 0x44,
+	0xdc, 0xe9, 0x8e, 0x04, 0x4d, 0xba, 0x42, 0x3b, 0xab, 0xa4, 0x28, 0x62, 0x37, 0x59, 0xe2, 0x4f,
+	0xe1, 0xc6, 0x31, 0xeb, 0x53, 0x69, 0xfe, 0xad, 0x5a, 0x25, 0xa7, 0xd9, 0x09, 0xdf, 0x13, 0xd6,
+	0xa7, 0x44, 0xa2, 0xad, 0xef, 0x61, 0x7d, 0xaa, 0x8c, 0x32, 0xae, 0x09, 0xa5, 0x8c, 0x1f, 0xb2,
+	0xd4, 0xff, 0xb5, 0x03, 0x2e, 0xed, 0xb0, 0xbe, 0x85, 0xb7, 0xdb, 0xc1, 0xf1, 0xf0, 0xc8, 0x13,
+	0xe3, 0xd3, 0xc6, 0x1f, 0x81, 0x26, 0x62, 0x45, 0x98, 0x7f, 0x22, 0xae, 0xa6, 0x23, 0xa2, 0x89,
+	0x78, 0xaa, 0x59, 0x6d, 0xaa, 0x59, 0xeb, 0x67, 0x04, 0xe5, 0x4b, 0x66, 0x25, 0xfa, 0x0b, 0x58,
+	0xf1, 0x3d, 0xde, 0x09, 0xc2, 0x17, 0x4c, 0x15, 0xb8, 0x37, 0x5f, 0xf1, 0x9e, 0xc7, 0xf7, 0xc3,
+	0x17, 0x8c, 0x14, 0xfd, 0x34, 0xc0, 0x9f, 0xc3, 0x72, 0x44, 0xf9, 0xf0, 0x48, 0xa8, 0xf1, 0xad,
+	0xcc, 0xcf, 0x25, 0x12, 0x47, 0x14, 0xde, 0xb2, 0x60, 0x55, 0x0e, 0xdf, 0xb8, 0x45, 0x0c, 0x37,
+	0x0e, 0x3d, 0x7e, 0x28, 0x35, 0xdc, 0x24, 0x32, 0xb6, 0x5e, 0xc3, 0x9a, 0xc2, 0x28, 0xb1, 0x5b,
+	0x0b, 0x7d, 0x90, 0x1e, 0xcc, 0x1c, 0x84, 0xf6, 0x66, 0x07, 0x51, 0xfd, 0x12, 0x8a, 0xea, 0xd2,
+	0x60, 0x1d, 0x36, 0x5a, 0xa4, 0xd1, 0x24, 0x1d, 0xf7, 0x79, 0xe7, 0x9b, 0xa7, 0xed, 0x67, 0xcd,
+	0xfa, 0xfe, 0xe3, 0xfd, 0x66, 0xa3, 0xbc, 0x84, 0xcb, 0xb0, 0x3a, 0xd9, 0xd9, 0x6d, 0xd7, 0xcb,
+	0x08, 0xdf, 0x86, 0xb5, 0xc9, 0x3f, 0x8d, 0x66, 0xbb, 0x5e, 0xd6, 0xaa, 0xaf, 0x60, 0x6d, 0x6a,
+	0x8e, 0xb0, 0x09, 0x86, 0x4b, 0x5a, 0xbb, 0x8d, 0xfa, 0x6e, 0xfb, 0xa0, 0xf3, 0xa4, 0xd5, 0x68,
+	0xce, 0xb0, 0xea, 0xb0, 0x31, 0xb3, 0xef, 0x7e, 0xdd, 0xaa, 0x7f, 0x55, 0x46, 0xf8, 0x5d, 0x58,
+	0x9f, 0xd9, 0x69, 0x3f, 0x7f, 0x5a, 0x2f, 0x6b, 0x39, 0x29, 0xbb, 0x72, 0xa7, 0x50, 0xfb, 0xb7,
+	0x00, 0xc5, 0x76, 0xfa, 0xb8, 0xe2, 0x97, 0xb0, 0x32, 0x1e, 0x01, 0x6c, 0xe5, 0x38, 0x38, 0x33,
+	0x79, 0xc6, 0xfd, 0x6b, 0x31, 0x6a, 0x62, 0xb7, 0x7f, 0xfa, 0xf3, 0x9f, 0x5f, 0xb5, 0x8a, 0x75,
+	0xd7, 0xc9, 0x79, 0xd5, 0x15, 0xf8, 0x11, 0xaa, 0xe2, 0x13, 0x78, 0x4b, 0x9e, 0x27, 0xde, 0xcc,
+	0x61, 0xcd, 0x4e, 0x83, 0x51, 0x99, 0x0f, 0x50, 0x35, 0xb7, 0x64, 0xcd, 0x4d, 0xfc, 0x81, 0x93,
+	0xf7, 0xa4, 0x73, 0xe7, 0x65, 0x32, 0x41, 0xaf, 0xf1, 0x8f, 0x50, 0xca, 0x5c, 0x55, 0xbc, 0x75,
+	0xdd, 0x0d, 0xbf, 0x2c, 0xbf, 0xbd, 0x08, 0xa6, 0x44, 0xdc, 0x93, 0x22, 0xee, 0x3e, 0x42, 0x55,
+	0xeb, 0x4e, 0xbe, 0x0e, 0xfc, 0x0a, 0x4a, 0x99, 0x47, 0x36, 0x57, 0xc0, 0xd5, 0x4f, 0x86, 0xb1,
+	0xbd, 0x08, 0xa6, 0x04, 0x98, 0x52, 0x80, 0x8e, 0xe7, 0x54, 0x77, 0xeb, 0x7f, 0x9c, 0x9b, 0xe8,
+	0xec, 0xdc, 0x44, 0x7f, 0x9f, 0x9b, 0xe8, 0x97, 0x0b, 0x73, 0xe9, 0xf7, 0x0b, 0x13, 0x9d, 0x5d,
+	0x98, 0x4b, 0x7f, 0x5d, 0x98, 0x4b, 0xdf, 0x6d, 0xf9, 0x81, 0x38, 0x1c, 0x76, 0xed, 0x1e, 0x3b,
+	0x1e, 0xe7, 0xa7, 0x3f, 0x1f, 0xf3, 0xfe, 0x0f, 0x8e, 0x18, 0x0d, 0x68, 0x42, 0xd8, 0x5d, 0x96,
+	0x5f, 0xb7, 0x4f, 0xfe, 0x1b, 0x00, 0xdf, 0x5b, 0x56, 0x74, 0xb4, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
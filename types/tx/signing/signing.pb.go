@@ -38,6 +38,11 @@ const (
 	// human-readable textual representation on top of the binary representation
 	// from SIGN_MODE_DIRECT
 	SignMode_SIGN_MODE_TEXTUAL SignMode = 2
+	// SIGN_MODE_DIRECT_AUX specifies a signing mode which uses
+	// SignDocDirectAux. As opposed to SIGN_MODE_DIRECT, this sign mode does not
+	// require signers signing over other signers' `signer_info` or the fee,
+	// which lets auxiliary signers sign before the fee payer is known.
+	SignMode_SIGN_MODE_DIRECT_AUX SignMode = 3
 	// SIGN_MODE_LEGACY_AMINO_JSON is a backwards compatibility mode which uses
 	// Amino JSON and will be removed in the future
 	SignMode_SIGN_MODE_LEGACY_AMINO_JSON SignMode = 127
@@ -47,6 +52,7 @@ var SignMode_name = map[int32]string{
 	0:   "SIGN_MODE_UNSPECIFIED",
 	1:   "SIGN_MODE_DIRECT",
 	2:   "SIGN_MODE_TEXTUAL",
+	3:   "SIGN_MODE_DIRECT_AUX",
 	127: "SIGN_MODE_LEGACY_AMINO_JSON",
 }
 
@@ -54,6 +60,7 @@ var SignMode_value = map[string]int32{
 	"SIGN_MODE_UNSPECIFIED":       0,
 	"SIGN_MODE_DIRECT":            1,
 	"SIGN_MODE_TEXTUAL":           2,
+	"SIGN_MODE_DIRECT_AUX":        3,
 	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
 }
 
@@ -391,41 +398,42 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xed, 0x26, 0x8d, 0xd2, 0x29, 0x42, 0x61, 0x49, 0xa5, 0xc4, 0x20, 0x13, 0x95, 0x03,
-	0x11, 0x52, 0xd6, 0x6a, 0x72, 0x40, 0x70, 0xcb, 0x1f, 0x93, 0x86, 0x36, 0x09, 0xd8, 0xa9, 0x04,
-	0x5c, 0x2c, 0xdb, 0xd9, 0x1a, 0xab, 0xb1, 0xd7, 0x78, 0xd7, 0xa8, 0x3e, 0xf1, 0x0a, 0xbc, 0x06,
-	0xcf, 0xc1, 0x85, 0x63, 0x8f, 0x1c, 0x51, 0xf2, 0x0c, 0xdc, 0x51, 0xec, 0x38, 0x09, 0x52, 0x11,
-	0x22, 0x27, 0x6b, 0x66, 0xbe, 0xfd, 0xcd, 0xb7, 0x9a, 0x59, 0xc3, 0x13, 0x9b, 0x32, 0x8f, 0x32,
-	0x85, 0x5f, 0x2b, 0xcc, 0x75, 0x7c, 0xd7, 0x77, 0x94, 0x4f, 0x27, 0x16, 0xe1, 0xe6, 0x49, 0x16,
-	0xe3, 0x20, 0xa4, 0x9c, 0xa2, 0x6a, 0x2a, 0xc4, 0xfc, 0x1a, 0x67, 0x85, 0x95, 0x50, 0x6a, 0xac,
-	0x18, 0x76, 0x18, 0x07, 0x9c, 0x2a, 0x5e, 0x34, 0xe3, 0x2e, 0x73, 0x37, 0xa0, 0x2c, 0x91, 0x92,
-	0xa4, 0xaa, 0x43, 0xa9, 0x33, 0x23, 0x4a, 0x12, 0x59, 0xd1, 0xa5, 0x62, 0xfa, 0x71, 0x5a, 0x3a,
-	0xbe, 0x84, 0xb2, 0xee, 0x3a, 0xbe, 0xc9, 0xa3, 0x90, 0xf4, 0x08, 0xb3, 0x43, 0x37, 0xe0, 0x34,
-	0x64, 0x68, 0x04, 0xc0, 0xb2, 0x3c, 0xab, 0x88, 0xb5, 0x5c, 0xfd, 0xb0, 0x89, 0xf1, 0x5f, 0x1d,
-	0xe1, 0x5b, 0x20, 0xda, 0x16, 0xe1, 0xf8, 0x57, 0x1e, 0xee, 0xdf, 0xa2, 0x41, 0x2d, 0x80, 0x20,
-	0xb2, 0x66, 0xae, 0x6d, 0x5c, 0x91, 0xb8, 0x22, 0xd6, 0xc4, 0xfa, 0x61, 0xb3, 0x8c, 0x53, 0xbf,
-	0x38, 0xf3, 0x8b, 0xdb, 0x7e, 0xac, 0x1d, 0xa4, 0xba, 0x33, 0x12, 0xa3, 0x3e, 0xe4, 0xa7, 0x26,
-	0x37, 0x2b, 0x7b, 0x89, 0xbc, 0xf5, 0x7f, 0xb6, 0x70, 0xcf, 0xe4, 0xa6, 0x96, 0x00, 0x90, 0x04,
-	0x45, 0x46, 0x3e, 0x46, 0xc4, 0xb7, 0x49, 0x25, 0x57, 0x13, 0xeb, 0x79, 0x6d, 0x1d, 0x4b, 0xdf,
-	0x72, 0x90, 0x5f, 0x4a, 0xd1, 0x04, 0x0a, 0xcc, 0xf5, 0x9d, 0x19, 0x59, 0xd9, 0x7b, 0xb1, 0x43,
-	0x3f, 0xac, 0x27, 0x84, 0x53, 0x41, 0x5b, 0xb1, 0xd0, 0x1b, 0xd8, 0x4f, 0xa6, 0xb4, 0xba, 0xc4,
-	0xf3, 0x5d, 0xa0, 0xc3, 0x25, 0xe0, 0x54, 0xd0, 0x52, 0x92, 0x64, 0x40, 0x21, 0x6d, 0x83, 0x9e,
-	0x41, 0xde, 0xa3, 0xd3, 0xd4, 0xf0, 0xdd, 0xe6, 0xe3, 0x7f, 0xb0, 0x87, 0x74, 0x4a, 0xb4, 0xe4,
-	0x00, 0x7a, 0x08, 0x07, 0xeb, 0xa1, 0x25, 0xce, 0xee, 0x68, 0x9b, 0x84, 0xf4, 0x55, 0x84, 0xfd,
-	0xa4, 0x27, 0x3a, 0x83, 0xa2, 0xe5, 0x72, 0x33, 0x0c, 0xcd, 0x6c, 0x68, 0x4a, 0xd6, 0x24, 0xdd,
-	0x49, 0xbc, 0x5e, 0xc1, 0xac, 0x53, 0x97, 0x7a, 0x81, 0x69, 0xf3, 0x8e, 0xcb, 0xdb, 0xcb, 0x63,
-	0xda, 0x1a, 0x80, 0xf4, 0x3f, 0x76, 0x6d, 0x2f, 0xd9, 0xb5, 0x9d, 0x86, 0xba, 0x85, 0xe9, 0xec,
-	0x43, 0x8e, 0x45, 0xde, 0x53, 0x06, 0xc5, 0xec, 0x8a, 0xa8, 0x0a, 0x47, 0xfa, 0xa0, 0x3f, 0x32,
-	0x86, 0xe3, 0x9e, 0x6a, 0x5c, 0x8c, 0xf4, 0xd7, 0x6a, 0x77, 0xf0, 0x72, 0xa0, 0xf6, 0x4a, 0x02,
-	0x2a, 0x43, 0x69, 0x53, 0xea, 0x0d, 0x34, 0xb5, 0x3b, 0x29, 0x89, 0xe8, 0x08, 0xee, 0x6d, 0xb2,
-	0x13, 0xf5, 0xed, 0xe4, 0xa2, 0x7d, 0x5e, 0xda, 0x43, 0x8f, 0xe0, 0xc1, 0x26, 0x7d, 0xae, 0xf6,
-	0xdb, 0xdd, 0x77, 0x46, 0x7b, 0x38, 0x18, 0x8d, 0x8d, 0x57, 0xfa, 0x78, 0x54, 0xfa, 0xdc, 0xe9,
-	0x7f, 0x9f, 0xcb, 0xe2, 0xcd, 0x5c, 0x16, 0x7f, 0xce, 0x65, 0xf1, 0xcb, 0x42, 0x16, 0x6e, 0x16,
-	0xb2, 0xf0, 0x63, 0x21, 0x0b, 0xef, 0x1b, 0x8e, 0xcb, 0x3f, 0x44, 0x16, 0xb6, 0xa9, 0xa7, 0x64,
-	0x6f, 0x38, 0xf9, 0x34, 0xd8, 0xf4, 0x4a, 0xe1, 0x71, 0x40, 0xb6, 0x7f, 0x0c, 0x56, 0x21, 0x79,
-	0x01, 0xad, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x87, 0x55, 0xbf, 0x34, 0x04, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xed, 0x3a, 0xad, 0xda, 0xe9, 0xa7, 0x4f, 0x66, 0x49, 0xa5, 0xd4, 0x20, 0x13, 0x95,
+	0x03, 0x15, 0x52, 0xd7, 0x6a, 0x7b, 0x40, 0x70, 0x73, 0x13, 0x93, 0x86, 0x36, 0x09, 0xd8, 0x89,
+	0x54, 0xb8, 0x58, 0xb6, 0xb3, 0x35, 0x56, 0x63, 0xaf, 0xf1, 0xae, 0x51, 0x7d, 0xe2, 0x09, 0x90,
+	0x78, 0x0d, 0x9e, 0x83, 0x0b, 0xc7, 0x1e, 0x39, 0xa2, 0xe4, 0x19, 0xb8, 0xa3, 0xd8, 0x71, 0x12,
+	0x50, 0x11, 0x22, 0x27, 0x6b, 0x66, 0xfe, 0xfb, 0x9b, 0xff, 0x6a, 0x66, 0x0d, 0x8f, 0x3c, 0xca,
+	0x42, 0xca, 0x34, 0x7e, 0xad, 0xb1, 0xc0, 0x8f, 0x82, 0xc8, 0xd7, 0xde, 0x1f, 0xba, 0x84, 0x3b,
+	0x87, 0x65, 0x8c, 0xe3, 0x84, 0x72, 0x8a, 0x76, 0x0b, 0x21, 0xe6, 0xd7, 0xb8, 0x2c, 0xcc, 0x84,
+	0xca, 0xc1, 0x8c, 0xe1, 0x25, 0x59, 0xcc, 0xa9, 0x16, 0xa6, 0x23, 0x1e, 0xb0, 0x60, 0x01, 0x2a,
+	0x13, 0x05, 0x49, 0xd9, 0xf5, 0x29, 0xf5, 0x47, 0x44, 0xcb, 0x23, 0x37, 0xbd, 0xd4, 0x9c, 0x28,
+	0x2b, 0x4a, 0x7b, 0x97, 0x50, 0xb5, 0x02, 0x3f, 0x72, 0x78, 0x9a, 0x90, 0x26, 0x61, 0x5e, 0x12,
+	0xc4, 0x9c, 0x26, 0x0c, 0x75, 0x01, 0x58, 0x99, 0x67, 0x35, 0xb1, 0x2e, 0xed, 0x6f, 0x1f, 0x61,
+	0xfc, 0x47, 0x47, 0xf8, 0x16, 0x88, 0xb9, 0x44, 0xd8, 0xfb, 0x51, 0x81, 0xbb, 0xb7, 0x68, 0xd0,
+	0x31, 0x40, 0x9c, 0xba, 0xa3, 0xc0, 0xb3, 0xaf, 0x48, 0x56, 0x13, 0xeb, 0xe2, 0xfe, 0xf6, 0x51,
+	0x15, 0x17, 0x7e, 0x71, 0xe9, 0x17, 0xeb, 0x51, 0x66, 0x6e, 0x15, 0xba, 0x33, 0x92, 0xa1, 0x16,
+	0x54, 0x86, 0x0e, 0x77, 0x6a, 0x6b, 0xb9, 0xfc, 0xf8, 0xdf, 0x6c, 0xe1, 0xa6, 0xc3, 0x1d, 0x33,
+	0x07, 0x20, 0x05, 0x36, 0x19, 0x79, 0x97, 0x92, 0xc8, 0x23, 0x35, 0xa9, 0x2e, 0xee, 0x57, 0xcc,
+	0x79, 0xac, 0x7c, 0x91, 0xa0, 0x32, 0x95, 0xa2, 0x3e, 0x6c, 0xb0, 0x20, 0xf2, 0x47, 0x64, 0x66,
+	0xef, 0xd9, 0x0a, 0xfd, 0xb0, 0x95, 0x13, 0x4e, 0x05, 0x73, 0xc6, 0x42, 0xaf, 0x60, 0x3d, 0x9f,
+	0xd2, 0xec, 0x12, 0x4f, 0x57, 0x81, 0x76, 0xa6, 0x80, 0x53, 0xc1, 0x2c, 0x48, 0x8a, 0x0d, 0x1b,
+	0x45, 0x1b, 0xf4, 0x04, 0x2a, 0x21, 0x1d, 0x16, 0x86, 0xff, 0x3f, 0x7a, 0xf8, 0x17, 0x76, 0x87,
+	0x0e, 0x89, 0x99, 0x1f, 0x40, 0xf7, 0x61, 0x6b, 0x3e, 0xb4, 0xdc, 0xd9, 0x7f, 0xe6, 0x22, 0xa1,
+	0x7c, 0x16, 0x61, 0x3d, 0xef, 0x89, 0xce, 0x60, 0xd3, 0x0d, 0xb8, 0x93, 0x24, 0x4e, 0x39, 0x34,
+	0xad, 0x6c, 0x52, 0xec, 0x24, 0x9e, 0xaf, 0x60, 0xd9, 0xa9, 0x41, 0xc3, 0xd8, 0xf1, 0xf8, 0x49,
+	0xc0, 0xf5, 0xe9, 0x31, 0x73, 0x0e, 0x40, 0xd6, 0x2f, 0xbb, 0xb6, 0x56, 0x97, 0x56, 0x1d, 0xea,
+	0x12, 0xe6, 0x64, 0x1d, 0x24, 0x96, 0x86, 0x8f, 0x3f, 0x8a, 0xb0, 0x59, 0xde, 0x11, 0xed, 0xc2,
+	0x8e, 0xd5, 0x6e, 0x75, 0xed, 0x4e, 0xaf, 0x69, 0xd8, 0x83, 0xae, 0xf5, 0xd2, 0x68, 0xb4, 0x9f,
+	0xb7, 0x8d, 0xa6, 0x2c, 0xa0, 0x2a, 0xc8, 0x8b, 0x52, 0xb3, 0x6d, 0x1a, 0x8d, 0xbe, 0x2c, 0xa2,
+	0x1d, 0xb8, 0xb3, 0xc8, 0xf6, 0x8d, 0x8b, 0xfe, 0x40, 0x3f, 0x97, 0xd7, 0x50, 0x0d, 0xaa, 0xbf,
+	0x8b, 0x6d, 0x7d, 0x70, 0x21, 0x4b, 0xe8, 0x01, 0xdc, 0x5b, 0x54, 0xce, 0x8d, 0x96, 0xde, 0x78,
+	0x6d, 0xeb, 0x9d, 0x76, 0xb7, 0x67, 0xbf, 0xb0, 0x7a, 0x5d, 0xf9, 0xc3, 0x49, 0xeb, 0xeb, 0x58,
+	0x15, 0x6f, 0xc6, 0xaa, 0xf8, 0x7d, 0xac, 0x8a, 0x9f, 0x26, 0xaa, 0x70, 0x33, 0x51, 0x85, 0x6f,
+	0x13, 0x55, 0x78, 0x73, 0xe0, 0x07, 0xfc, 0x6d, 0xea, 0x62, 0x8f, 0x86, 0x5a, 0xf9, 0xbc, 0xf3,
+	0xcf, 0x01, 0x1b, 0x5e, 0x69, 0x3c, 0x8b, 0xc9, 0xf2, 0x3f, 0xc3, 0xdd, 0xc8, 0x1f, 0xc7, 0xf1,
+	0xcf, 0x01, 0x00, 0xda, 0x51, 0x6b, 0x5b, 0x4f, 0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,11 @@
+package tx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TipTx defines the interface to be implemented by Txs that handle Tips.
+type TipTx interface {
+	sdk.FeeTx
+	GetTip() *Tip
+}
@@ -83,6 +83,12 @@ func (t *Tx) ValidateBasic() error {
 		}
 	}
 
+	if tip := authInfo.Tip; tip != nil {
+		if err := t.validateTip(tip); err != nil {
+			return err
+		}
+	}
+
 	sigs := t.Signatures
 
 	if len(sigs) == 0 {
@@ -99,6 +105,27 @@ func (t *Tx) ValidateBasic() error {
 	return nil
 }
 
+// validateTip checks that the tip is a valid amount of coins, paid by one of
+// the signers of the tx.
+func (t *Tx) validateTip(tip *Tip) error {
+	if tip.Amount.Empty() || !tip.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip provided: %s", tip.Amount)
+	}
+
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid tipper address (%s)", err)
+	}
+
+	for _, signer := range t.GetSigners() {
+		if signer.Equals(tipper) {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s must be a signer of the tx", tip.Tipper)
+}
+
 // GetSigners retrieves all the signers of a tx.
 // This includes all unique signers of the messages (in order),
 // as well as the FeePayer (if specified and not already included).
@@ -0,0 +1,51 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TipDecorator transfers the tip of the tx, if any, from the tipper to the fee
+// payer. Unlike the fee, the tip can be paid in any denom, which lets an
+// account holding no fee tokens get another account to pay the fee for it.
+// The tipper must be a signer of the tx, which is checked by tx.ValidateBasic.
+// Txs which do not implement TipTx are passed through.
+type TipDecorator struct {
+	bankKeeper types.BankKeeper
+}
+
+func NewTipDecorator(bk types.BankKeeper) TipDecorator {
+	return TipDecorator{
+		bankKeeper: bk,
+	}
+}
+
+func (td TipDecorator) AnteHandle(ctx sdk.Context, sdkTx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	tipTx, ok := sdkTx.(tx.TipTx)
+	if !ok || tipTx.GetTip() == nil {
+		return next(ctx, sdkTx, simulate)
+	}
+
+	tip := tipTx.GetTip()
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address: %s", err)
+	}
+
+	if !tip.Amount.IsValid() {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount: %s", tip.Amount)
+	}
+
+	if err := td.bankKeeper.SendCoins(ctx, tipper, tipTx.FeePayer(), tip.Amount); err != nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to pay tip: %s", err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyTip, tip.Amount.String()),
+		sdk.NewAttribute(sdk.AttributeKeyTipper, tip.Tipper),
+	))
+
+	return next(ctx, sdkTx, simulate)
+}
@@ -0,0 +1,91 @@
+package ante_test
+
+import (
+	"errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestTipDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	_, _, tipper := testdata.KeyTestPubAddr()
+	_, _, feePayer := testdata.KeyTestPubAddr()
+
+	// msg and fee payer
+	msg := testdata.NewTestMsg(tipper)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetFeePayer(feePayer)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTipDecorator(suite.app.BankKeeper))
+
+	// a tx without tip is passed through
+	_, err := antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, feePayer).IsZero())
+
+	// the tipper has insufficient funds
+	tip := sdk.NewCoins(sdk.NewInt64Coin("tipcoin", 100))
+	suite.txBuilder.SetTip(&txtypes.Tip{Amount: tip, Tipper: tipper.String()})
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, tipper, sdk.NewCoins(sdk.NewInt64Coin("tipcoin", 10)))
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrInsufficientFunds))
+
+	// the tip is transferred from the tipper to the fee payer
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, tipper, sdk.NewCoins(sdk.NewInt64Coin("tipcoin", 200)))
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(tip, suite.app.BankKeeper.GetAllBalances(suite.ctx, feePayer))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("tipcoin", 110)), suite.app.BankKeeper.GetAllBalances(suite.ctx, tipper))
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerTip() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// the tipper holds no fee coins, the fee payer holds no tip coins
+	accounts := suite.CreateTestAccounts(2)
+	tipper, feePayer := accounts[0].acc.GetAddress(), accounts[1].acc.GetAddress()
+	tip := sdk.NewCoins(sdk.NewInt64Coin("tipcoin", 100))
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, suite.ctx, tipper, tip))
+
+	msg := testdata.NewTestMsg(tipper)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetFeePayer(feePayer)
+
+	privs := []cryptotypes.PrivKey{accounts[0].priv, accounts[1].priv}
+	accNums, accSeqs := []uint64{0, 1}, []uint64{0, 0}
+
+	// the tipper must be a signer of the tx
+	_, _, other := testdata.KeyTestPubAddr()
+	suite.txBuilder.SetTip(&txtypes.Tip{Amount: tip, Tipper: other.String()})
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = suite.anteHandler(suite.ctx, tx, false)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrUnauthorized))
+
+	// the tip is paid once the signatures are verified
+	suite.txBuilder.SetTip(&txtypes.Tip{Amount: tip, Tipper: tipper.String()})
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = suite.anteHandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(tip.AmountOf("tipcoin"), suite.app.BankKeeper.GetBalance(suite.ctx, feePayer, "tipcoin").Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, tipper, "tipcoin").IsZero())
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// GetAuxToFeeCommand returns the tx aux-to-fee command.
+func GetAuxToFeeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aux-to-fee [aux_signer_data_file]...",
+		Short: "Assemble, sign as fee payer and broadcast a transaction signed by auxiliary signers",
+		Long: strings.TrimSpace(`Assemble a transaction from the aux signer data of its auxiliary
+signers, created with the --aux flag or with 'tx sign --sign-mode=direct-aux'. The
+--from key becomes the fee payer of the transaction, sets its fee and gas limit,
+signs it and broadcasts it. If you supply a dash (-) argument in place of an input
+filename, the command reads from standard input.
+
+With --generate-only, the unsigned transaction is printed instead, to be signed by
+the fee payer with the sign command. With --offline, the signed transaction is
+printed instead of being broadcasted.
+
+$ <appd> tx aux-to-fee ./aux_signer_data.json --from=feepayer --fees=10stake
+`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auxSignerData := make([]txtypes.AuxSignerData, len(args))
+			for i, arg := range args {
+				auxSignerData[i], err = authclient.ReadAuxSignerDataFromFile(clientCtx, arg)
+				if err != nil {
+					return err
+				}
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			txBuilder, err := tx.BuildAuxTx(txf, auxSignerData...)
+			if err != nil {
+				return err
+			}
+
+			txBuilder.SetFeePayer(clientCtx.GetFromAddress())
+			txBuilder.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+
+			if clientCtx.GenerateOnly {
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			}
+
+			err = authclient.SignTx(txf, clientCtx, clientCtx.GetFromName(), txBuilder, clientCtx.Offline, false)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Offline {
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
 
@@ -172,6 +173,10 @@ the transaction to fail.
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
+
+The --sign-mode=direct-aux flag (implied by --aux) signs the transaction body only, as
+an auxiliary signer, and prints the resulting aux signer data. The fee payer then
+assembles, signs and broadcasts the transaction with the 'aux-to-fee' command.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeSignCmd(),
@@ -232,7 +237,26 @@ func makeSignCmd() func(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("error getting account from keybase: %w", err)
 		}
 
+		var json []byte
 		overwrite, _ := f.GetBool(flagOverwrite)
+		if txF.SignMode() == signing.SignMode_SIGN_MODE_DIRECT_AUX {
+			if multisig != "" {
+				return fmt.Errorf("cannot sign with %s on behalf of a multisig account", signing.SignMode_SIGN_MODE_DIRECT_AUX)
+			}
+
+			auxSignerData, err := authclient.SignAuxTx(txF, clientCtx, fromName, txBuilder, clientCtx.Offline)
+			if err != nil {
+				return err
+			}
+
+			json, err = clientCtx.Codec.MarshalJSON(&auxSignerData)
+			if err != nil {
+				return err
+			}
+
+			return writeSignOutput(cmd, json)
+		}
+
 		if multisig != "" {
 			multisigAddr, _, _, err := client.GetFromFields(txFactory.Keybase(), multisig, clientCtx.GenerateOnly)
 			if err != nil {
@@ -256,7 +280,6 @@ func makeSignCmd() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		var json []byte
 		if aminoJSON {
 			stdTx, err := tx.ConvertTxToStdTx(clientCtx.LegacyAmino, txBuilder.GetTx())
 			if err != nil {
@@ -277,26 +300,32 @@ func makeSignCmd() func(cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
-		if outputDoc == "" {
-			cmd.Printf("%s\n", json)
-			return nil
-		}
+		return writeSignOutput(cmd, json)
+	}
+}
 
-		fp, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-		if err != nil {
-			return err
-		}
-		defer func() {
-			err2 := fp.Close()
-			if err == nil {
-				err = err2
-			}
-		}()
+// writeSignOutput writes the JSON output of the sign command to the
+// --output-document file, or to the command output if unset.
+func writeSignOutput(cmd *cobra.Command, json []byte) (err error) {
+	outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputDoc == "" {
+		cmd.Printf("%s\n", json)
+		return nil
+	}
 
-		_, err = fp.Write(append(json, '\n'))
+	fp, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
 		return err
 	}
+	defer func() {
+		err2 := fp.Close()
+		if err == nil {
+			err = err2
+		}
+	}()
+
+	_, err = fp.Write(append(json, '\n'))
+	return err
 }
 
 func marshalSignatureJSON(txConfig client.TxConfig, txBldr client.TxBuilder, signatureOnly bool) ([]byte, error) {
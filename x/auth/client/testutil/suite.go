@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -29,6 +30,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authcli "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -233,6 +236,114 @@ func (s *IntegrationTestSuite) TestCLISignAminoJSON() {
 	require.Equal(txAmino.Tx.Signatures[1].PubKey, valInfo.GetPubKey())
 }
 
+func (s *IntegrationTestSuite) TestCLISendSignModeTextual() {
+	val1 := s.network.Validators[0]
+
+	// the client renders coins with the metadata queried from the node
+	txCfg := authtx.NewTxConfigWithTextual(
+		codec.NewProtoCodec(val1.ClientCtx.InterfaceRegistry),
+		[]signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL},
+		textual.NewGRPCCoinMetadataQueryFn(val1.ClientCtx),
+	)
+	clientCtx := val1.ClientCtx.WithTxConfig(txCfg)
+
+	sendTokens := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+	out, err := bankcli.MsgSendExec(clientCtx, val1.Address, val1.Address, sendTokens,
+		fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeTextual),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	var txRes sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes), out.String())
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+}
+
+func (s *IntegrationTestSuite) TestCLISendAux() {
+	val1 := s.network.Validators[0]
+
+	// fund the auxiliary signer, which does not pay any fee afterwards, but
+	// tips the fee payer in another denom
+	kb := val1.ClientCtx.Keyring
+	auxSigner, _, err := kb.NewMnemonic("auxSigner", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	tipDenom := fmt.Sprintf("%stoken", val1.Moniker)
+	_, err = s.createBankMsg(val1, auxSigner.GetAddress(), sdk.NewCoins(
+		sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100)),
+		sdk.NewCoin(tipDenom, sdk.NewInt(50)),
+	))
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	sendTokens := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+	tipFlag := fmt.Sprintf("--%s=%s", flags.FlagTip, sdk.NewCoins(sdk.NewCoin(tipDenom, sdk.NewInt(5))).String())
+	feeFlags := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// the aux signer data is generated either with the --aux flag, or by
+	// signing a generated tx with SIGN_MODE_DIRECT_AUX
+	auxSignerDataFromAuxFlag := func() testutil.BufferWriter {
+		out, err := bankcli.MsgSendExec(val1.ClientCtx, auxSigner.GetAddress(), val1.Address, sendTokens,
+			fmt.Sprintf("--%s=true", flags.FlagAux),
+			tipFlag,
+		)
+		s.Require().NoError(err)
+		return out
+	}
+	auxSignerDataFromSignCmd := func() testutil.BufferWriter {
+		out, err := bankcli.MsgSendExec(val1.ClientCtx, auxSigner.GetAddress(), val1.Address, sendTokens,
+			fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		)
+		s.Require().NoError(err)
+		unsignedTx := testutil.WriteToNewTempFile(s.T(), out.String())
+
+		out, err = TxSignExec(val1.ClientCtx, auxSigner.GetAddress(), unsignedTx.Name(),
+			fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeDirectAux),
+			tipFlag,
+		)
+		s.Require().NoError(err)
+		return out
+	}
+
+	for i, makeAuxSignerData := range []func() testutil.BufferWriter{auxSignerDataFromAuxFlag, auxSignerDataFromSignCmd} {
+		out := makeAuxSignerData()
+
+		var auxSignerData tx.AuxSignerData
+		s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &auxSignerData), out.String())
+		s.Require().Equal(auxSigner.GetAddress().String(), auxSignerData.Address)
+		s.Require().Equal(signing.SignMode_SIGN_MODE_DIRECT_AUX, auxSignerData.Mode)
+		s.Require().Equal(uint64(i), auxSignerData.SignDoc.Sequence)
+		s.Require().Equal(auxSigner.GetAddress().String(), auxSignerData.SignDoc.Tip.Tipper)
+
+		// the fee payer assembles, signs and broadcasts the tx
+		auxSignerDataFile := testutil.WriteToNewTempFile(s.T(), out.String())
+		out, err = TxAuxToFeeExec(val1.ClientCtx, val1.Address, auxSignerDataFile.Name(), feeFlags...)
+		s.Require().NoError(err)
+
+		var txRes sdk.TxResponse
+		s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes), out.String())
+		s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+	}
+
+	// the aux signer only paid for the sends and the tips, the fees were paid
+	// by val1
+	out, err := bankcli.QueryBalancesExec(val1.ClientCtx, auxSigner.GetAddress())
+	s.Require().NoError(err)
+	var balRes banktypes.QueryAllBalancesResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &balRes))
+	s.Require().Equal(sdk.NewInt(80), balRes.Balances.AmountOf(s.cfg.BondDenom))
+	s.Require().Equal(sdk.NewInt(40), balRes.Balances.AmountOf(tipDenom))
+
+	// a tip can only be paid by an auxiliary signer
+	_, err = bankcli.MsgSendExec(val1.ClientCtx, auxSigner.GetAddress(), val1.Address, sendTokens, tipFlag)
+	s.Require().Error(err)
+}
+
 func checkSignatures(require *require.Assertions, txCfg client.TxConfig, output []byte, pks ...cryptotypes.PubKey) {
 	sigs, err := txCfg.UnmarshalSignatureJSON(output)
 	require.NoError(err, string(output))
@@ -1204,8 +1315,8 @@ func (s *IntegrationTestSuite) TestTxWithoutPublicKey() {
 	s.Require().NoError(err)
 	unsignedTxFile := testutil.WriteToNewTempFile(s.T(), string(txJSON))
 
-	// Sign the file with the unsignedTx.
-	signedTx, err := TxSignExec(val1.ClientCtx, val1.Address, unsignedTxFile.Name())
+	// Sign the file with the unsignedTx, overwriting its empty signature.
+	signedTx, err := TxSignExec(val1.ClientCtx, val1.Address, unsignedTxFile.Name(), "--overwrite")
 	s.Require().NoError(err)
 
 	// Remove the signerInfo's `public_key` field manually from the signedTx.
@@ -1252,7 +1363,7 @@ func (s *IntegrationTestSuite) TestSignWithMultiSignersAminoJSON() {
 		banktypes.NewMsgSend(val1.Address, addr1, sdk.NewCoins(val1Coin)),
 	)
 	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))))
-	txBuilder.SetGasLimit(testdata.NewTestGasLimit()) // min required is 101892
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit() * 2) // min required is 101892
 	require.Equal([]sdk.AccAddress{val0.Address, val1.Address}, txBuilder.GetTx().GetSigners())
 
 	// Write the unsigned tx into a file.
@@ -1285,7 +1396,7 @@ func (s *IntegrationTestSuite) TestSignWithMultiSignersAminoJSON() {
 	require.NoError(err)
 	var txRes sdk.TxResponse
 	require.NoError(val0.ClientCtx.Codec.UnmarshalJSON(res.Bytes(), &txRes))
-	require.Equal(uint32(0), txRes.Code)
+	require.Equal(uint32(0), txRes.Code, txRes.RawLog)
 
 	// Make sure the addr1's balance got funded.
 	queryResJSON, err := bankcli.QueryBalancesExec(val0.ClientCtx, addr1)
@@ -17,7 +17,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 )
 
@@ -65,6 +67,41 @@ func SignTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuild
 	return tx.Sign(txFactory, name, txBuilder, overwriteSig)
 }
 
+// SignAuxTx signs the body of a transaction managed by the TxBuilder with
+// SIGN_MODE_DIRECT_AUX using a `name` key stored in Keybase, and returns the
+// resulting AuxSignerData to be sent to the fee payer.
+func SignAuxTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuilder client.TxBuilder, offline bool) (txtypes.AuxSignerData, error) {
+	info, err := txFactory.Keybase().Key(name)
+	if err != nil {
+		return txtypes.AuxSignerData{}, err
+	}
+
+	addr := sdk.AccAddress(info.GetPubKey().Address())
+	if !isTxSigner(addr, txBuilder.GetTx().GetSigners()) {
+		return txtypes.AuxSignerData{}, fmt.Errorf("%s: %s", sdkerrors.ErrorInvalidSigner, name)
+	}
+	if !offline {
+		txFactory, err = populateAccountFromState(txFactory, clientCtx, addr)
+		if err != nil {
+			return txtypes.AuxSignerData{}, err
+		}
+	}
+
+	theTx := txBuilder.GetTx()
+	b := tx.NewAuxTxBuilder()
+	b.SetMemo(theTx.GetMemo())
+	b.SetTimeoutHeight(theTx.GetTimeoutHeight())
+	if err := b.SetMsgs(theTx.GetMsgs()...); err != nil {
+		return txtypes.AuxSignerData{}, err
+	}
+	if extTx, ok := theTx.(ante.HasExtensionOptionsTx); ok {
+		b.SetExtensionOptions(extTx.GetExtensionOptions()...)
+		b.SetNonCriticalExtensionOptions(extTx.GetNonCriticalExtensionOptions()...)
+	}
+
+	return tx.SignAux(txFactory, name, &b)
+}
+
 // SignTxWithSignerAddress attaches a signature to a transaction.
 // Don't perform online validation or lookups if offline is true, else
 // populate account and sequence numbers from a foreign account.
@@ -109,6 +146,25 @@ func ReadTxFromFile(ctx client.Context, filename string) (tx sdk.Tx, err error)
 	return ctx.TxConfig.TxJSONDecoder()(bytes)
 }
 
+// ReadAuxSignerDataFromFile reads the AuxSignerData of an auxiliary signer from
+// the given filename. Can pass "-" to read from stdin.
+func ReadAuxSignerDataFromFile(ctx client.Context, filename string) (data txtypes.AuxSignerData, err error) {
+	var bytes []byte
+
+	if filename == "-" {
+		bytes, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		bytes, err = ioutil.ReadFile(filename)
+	}
+
+	if err != nil {
+		return
+	}
+
+	err = ctx.Codec.UnmarshalJSON(bytes, &data)
+	return
+}
+
 // NewBatchScanner returns a new BatchScanner to read newline-delimited StdTx transactions from r.
 func NewBatchScanner(cfg client.TxConfig, r io.Reader) *BatchScanner {
 	return &BatchScanner{Scanner: bufio.NewScanner(r), cfg: cfg}
@@ -1,6 +1,9 @@
 package signing
 
 import (
+	"context"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -20,6 +23,27 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithContext is a SignModeHandler which may read the state,
+// such as the bank denomination metadata in SIGN_MODE_TEXTUAL, through the
+// given context when generating the sign bytes.
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the sign bytes for the provided SignMode,
+	// SignerData and Tx, or an error
+	GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes generated by the handler,
+// with the given context if the handler is a SignModeHandlerWithContext.
+func GetSignBytesWithContext(ctx context.Context, h SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if hc, ok := h.(SignModeHandlerWithContext); ok {
+		return hc.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return h.GetSignBytes(mode, data, tx)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
@@ -34,4 +58,12 @@ type SignerData struct {
 	// since in SIGN_MODE_DIRECT the account sequence is already in the signer
 	// info.
 	Sequence uint64
+
+	// Address is the bech32-encoded address of the signer. It is used by
+	// SIGN_MODE_DIRECT_AUX, whose sign doc does not include the signer infos.
+	Address string
+
+	// PubKey is the public key of the signer. It is used by
+	// SIGN_MODE_DIRECT_AUX, whose sign doc does not include the signer infos.
+	PubKey cryptotypes.PubKey
 }
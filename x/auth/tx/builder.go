@@ -1,9 +1,12 @@
 package tx
 
 import (
+	"bytes"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +20,8 @@ import (
 // wrapper is a wrapper around the tx.Tx proto.Message which retain the raw
 // body and auth_info bytes.
 type wrapper struct {
+	cdc codec.ProtoCodecMarshaler
+
 	tx *tx.Tx
 
 	// bodyBz represents the protobuf encoding of TxBody. This should be encoding
@@ -35,6 +40,7 @@ var (
 	_ client.TxBuilder           = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
+	_ tx.TipTx                   = &wrapper{}
 )
 
 // ExtensionOptionsTxBuilder defines a TxBuilder that can also set extensions.
@@ -45,8 +51,9 @@ type ExtensionOptionsTxBuilder interface {
 	SetNonCriticalExtensionOptions(...*codectypes.Any)
 }
 
-func newBuilder() *wrapper {
+func newBuilder(cdc codec.ProtoCodecMarshaler) *wrapper {
 	return &wrapper{
+		cdc: cdc,
 		tx: &tx.Tx{
 			Body: &tx.TxBody{},
 			AuthInfo: &tx.AuthInfo{
@@ -156,6 +163,11 @@ func (w *wrapper) FeeGranter() sdk.AccAddress {
 	return nil
 }
 
+// GetTip returns the tip of the transaction, if any.
+func (w *wrapper) GetTip() *tx.Tip {
+	return w.tx.AuthInfo.Tip
+}
+
 func (w *wrapper) GetMemo() string {
 	return w.tx.Body.Memo
 }
@@ -277,6 +289,15 @@ func (w *wrapper) SetFeeGranter(feeGranter sdk.AccAddress) {
 	w.authInfoBz = nil
 }
 
+// SetTip sets the tip of the transaction, transferred from the tipper to the
+// fee payer.
+func (w *wrapper) SetTip(tip *tx.Tip) {
+	w.tx.AuthInfo.Tip = tip
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	w.authInfoBz = nil
+}
+
 func (w *wrapper) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -350,3 +371,80 @@ func (w *wrapper) SetNonCriticalExtensionOptions(extOpts ...*codectypes.Any) {
 	w.tx.Body.NonCriticalExtensionOptions = extOpts
 	w.bodyBz = nil
 }
+
+// AddAuxSignerData adds a signer who signed with SIGN_MODE_DIRECT_AUX to the
+// transaction. The body of the transaction is set to the body signed by the
+// auxiliary signer, which must match the body of any other signer already
+// added, and the signer info and signature of the auxiliary signer are set at
+// its position in GetSigners.
+//
+// Once all auxiliary signers are added, the fee payer sets the fee and signs
+// the transaction. The body must not be modified anymore, as it would
+// invalidate the signatures of the auxiliary signers.
+func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if w.cdc == nil {
+		return sdkerrors.ErrLogic.Wrap("the transaction builder has no codec to decode the auxiliary signer body")
+	}
+
+	bodyBz := data.SignDoc.BodyBytes
+	if len(w.tx.Body.Messages) > 0 {
+		if !bytes.Equal(w.getBodyBytes(), bodyBz) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("auxiliary signer %s signed a different transaction body", data.Address)
+		}
+		if !proto.Equal(w.tx.AuthInfo.Tip, data.SignDoc.Tip) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("auxiliary signer %s signed a different tip", data.Address)
+		}
+	}
+
+	var body tx.TxBody
+	if err := w.cdc.Unmarshal(bodyBz, &body); err != nil {
+		return err
+	}
+
+	// Keep the exact bytes signed by the auxiliary signer.
+	w.tx.Body = &body
+	w.bodyBz = bodyBz
+	w.SetTip(data.SignDoc.Tip)
+
+	if err := w.cdc.UnpackAny(data.SignDoc.PublicKey, new(cryptotypes.PubKey)); err != nil {
+		return err
+	}
+
+	signerIndex := -1
+	for i, signer := range w.GetSigners() {
+		if signer.String() == data.Address {
+			signerIndex = i
+			break
+		}
+	}
+	if signerIndex < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("address %s is not a signer of the transaction", data.Address)
+	}
+
+	signerInfos := w.tx.AuthInfo.SignerInfos
+	sigs := w.tx.Signatures
+	for len(signerInfos) <= signerIndex {
+		signerInfos = append(signerInfos, &tx.SignerInfo{})
+		sigs = append(sigs, nil)
+	}
+
+	signerInfos[signerIndex] = &tx.SignerInfo{
+		PublicKey: data.SignDoc.PublicKey,
+		ModeInfo: &tx.ModeInfo{
+			Sum: &tx.ModeInfo_Single_{
+				Single: &tx.ModeInfo_Single{Mode: data.Mode},
+			},
+		},
+		Sequence: data.SignDoc.Sequence,
+	}
+	sigs[signerIndex] = data.Sig
+
+	w.setSignerInfos(signerInfos)
+	w.setSignatures(sigs)
+
+	return nil
+}
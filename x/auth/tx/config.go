@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 type config struct {
@@ -22,10 +23,19 @@ type config struct {
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
-// first enabled sign mode will become the default sign mode.
+// first enabled sign mode will become the default sign mode. If SIGN_MODE_TEXTUAL is enabled,
+// coins are rendered in their base denomination.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithTextual(protoCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig like NewTxConfig, rendering coins in
+// SIGN_MODE_TEXTUAL with the denomination metadata returned by coinMetadataQuerier.
+func NewTxConfigWithTextual(
+	protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, coinMetadataQuerier textual.CoinMetadataQueryFn,
+) client.TxConfig {
 	return &config{
-		handler:     makeSignModeHandler(enabledSignModes),
+		handler:     makeSignModeHandler(enabledSignModes, coinMetadataQuerier),
 		decoder:     DefaultTxDecoder(protoCodec),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
@@ -35,7 +45,7 @@ func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signin
 }
 
 func (g config) NewTxBuilder() client.TxBuilder {
-	return newBuilder()
+	return newBuilder(g.protoCodec)
 }
 
 // WrapTxBuilder returns a builder from provided transaction
@@ -0,0 +1,70 @@
+package tx
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	types "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// signModeDirectAuxHandler defines the SIGN_MODE_DIRECT_AUX SignModeHandler
+type signModeDirectAuxHandler struct{}
+
+var _ signing.SignModeHandler = signModeDirectAuxHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeDirectAuxHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_DIRECT_AUX
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeDirectAuxHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (signModeDirectAuxHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_DIRECT_AUX {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	if data.Address == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("got empty address in %s handler", signingtypes.SignMode_SIGN_MODE_DIRECT_AUX)
+	}
+
+	// The fee payer signs over the fee, which the sign doc does not include.
+	feePayer := protoTx.FeePayer().String()
+	if feePayer == data.Address {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("fee payer %s cannot sign with %s", feePayer, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX)
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(data.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return DirectAuxSignBytes(protoTx.getBodyBytes(), pkAny, data.ChainID, data.AccountNumber, data.Sequence, protoTx.GetTip())
+}
+
+// DirectAuxSignBytes returns the SIGN_MODE_DIRECT_AUX sign bytes for the provided TxBody bytes, public key, chain ID,
+// account number, sequence and tip.
+func DirectAuxSignBytes(bodyBytes []byte, pubKey *codectypes.Any, chainID string, accnum, sequence uint64, tip *types.Tip) ([]byte, error) {
+	signDoc := types.SignDocDirectAux{
+		BodyBytes:     bodyBytes,
+		PublicKey:     pubKey,
+		ChainId:       chainID,
+		AccountNumber: accnum,
+		Sequence:      sequence,
+		Tip:           tip,
+	}
+	return signDoc.Marshal()
+}
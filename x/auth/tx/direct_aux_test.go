@@ -0,0 +1,256 @@
+package tx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestDirectAuxModeHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	_, _, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX})
+	txBuilder := txConfig.NewTxBuilder()
+
+	memo := "sometestmemo"
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
+	accSeq := uint64(2) // Arbitrary account sequence
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+	}
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     sigData,
+		Sequence: accSeq,
+	}
+
+	fee := txtypes.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), GasLimit: 20000}
+
+	err := txBuilder.SetMsgs(msgs...)
+	require.NoError(t, err)
+	txBuilder.SetMemo(memo)
+	txBuilder.SetFeeAmount(fee.Amount)
+	txBuilder.SetGasLimit(fee.GasLimit)
+
+	err = txBuilder.SetSignatures(sig)
+	require.NoError(t, err)
+
+	t.Log("verify modes and default-mode")
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, modeHandler.DefaultMode(), signingtypes.SignMode_SIGN_MODE_DIRECT_AUX)
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+		PubKey:        pubkey,
+	}
+
+	t.Log("verify fee payer cannot use SIGN_MODE_DIRECT_AUX")
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.EqualError(t, err, fmt.Sprintf("fee payer %s cannot sign with %s: unauthorized", addr.String(), signingtypes.SignMode_SIGN_MODE_DIRECT_AUX))
+
+	t.Log("verify GetSignBytes with generating sign bytes by marshaling SignDocDirectAux")
+	txBuilder.SetFeePayer(feePayerAddr)
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotNil(t, signBytes)
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+	}
+
+	txBody := &txtypes.TxBody{
+		Memo:     memo,
+		Messages: anys,
+	}
+	bodyBytes := marshaler.MustMarshal(txBody)
+
+	any, err := codectypes.NewAnyWithValue(pubkey)
+	require.NoError(t, err)
+	signDocDirectAux := txtypes.SignDocDirectAux{
+		AccountNumber: 1,
+		BodyBytes:     bodyBytes,
+		ChainId:       "test-chain",
+		PublicKey:     any,
+		Sequence:      accSeq,
+	}
+	expectedSignBytes, err := signDocDirectAux.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	t.Log("verify that the sign bytes do not depend on the auth info")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 300)))
+	newSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, newSignBytes)
+
+	t.Log("verify the signature over the sign bytes")
+	sigBz, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	sig.Data = &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, Signature: sigBz}
+	require.NoError(t, signing.VerifySignature(pubkey, signingData, sig.Data, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify that the sign bytes change with the tip")
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tip-token", 10)), Tipper: addr.String()}
+	txBuilder.SetTip(tip)
+	require.Error(t, signing.VerifySignature(pubkey, signingData, sig.Data, modeHandler, txBuilder.GetTx()))
+	signBytes, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	signDocDirectAux.Tip = tip
+	expectedSignBytes, err = signDocDirectAux.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	t.Log("verify that the sign bytes change with the body")
+	txBuilder.SetMemo("othermemo")
+	require.Error(t, signing.VerifySignature(pubkey, signingData, sig.Data, modeHandler, txBuilder.GetTx()))
+}
+
+func TestDirectAuxModeHandler_nonDIRECT_MODE(t *testing.T) {
+	invalidModes := []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_UNSPECIFIED,
+	}
+	for _, invalidMode := range invalidModes {
+		t.Run(invalidMode.String(), func(t *testing.T) {
+			var dh signModeDirectAuxHandler
+			var signingData signing.SignerData
+			_, err := dh.GetSignBytes(invalidMode, signingData, nil)
+			require.Error(t, err)
+			wantErr := fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, invalidMode)
+			require.Equal(t, err, wantErr)
+		})
+	}
+}
+
+func TestDirectAuxModeHandler_nonProtoTx(t *testing.T) {
+	var dh signModeDirectAuxHandler
+	var signingData signing.SignerData
+	tx := legacytx.StdTx{}
+	_, err := dh.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, tx)
+	require.Error(t, err)
+	wantErr := fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	require.Equal(t, err, wantErr)
+}
+
+func TestAddAuxSignerData(t *testing.T) {
+	privKey1, pubKey1, addr1 := testdata.KeyTestPubAddr()
+	privKey2, pubKey2, addr2 := testdata.KeyTestPubAddr()
+	_, _, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	txConfig := NewTxConfig(marshaler, DefaultSignModes)
+
+	msg := testdata.NewTestMsg(addr1, addr2)
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	bodyBz := marshaler.MustMarshal(&txtypes.TxBody{Messages: []*codectypes.Any{msgAny}, Memo: "memo"})
+
+	// auxSignerData returns the AuxSignerData of the given signer over bodyBz.
+	auxSignerData := func(addr sdk.AccAddress, pubKey *codectypes.Any, sign func([]byte) ([]byte, error)) txtypes.AuxSignerData {
+		signDoc := &txtypes.SignDocDirectAux{BodyBytes: bodyBz, PublicKey: pubKey, ChainId: "test-chain", Sequence: 1}
+		signBz, err := signDoc.Marshal()
+		require.NoError(t, err)
+		sig, err := sign(signBz)
+		require.NoError(t, err)
+
+		return txtypes.AuxSignerData{
+			Address: addr.String(),
+			SignDoc: signDoc,
+			Mode:    signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+			Sig:     sig,
+		}
+	}
+	pkAny1, err := codectypes.NewAnyWithValue(pubKey1)
+	require.NoError(t, err)
+	pkAny2, err := codectypes.NewAnyWithValue(pubKey2)
+	require.NoError(t, err)
+	data1 := auxSignerData(addr1, pkAny1, privKey1.Sign)
+	data2 := auxSignerData(addr2, pkAny2, privKey2.Sign)
+
+	t.Log("verify that signers are added at their index, whatever the order")
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.AddAuxSignerData(data2))
+	require.NoError(t, txBuilder.AddAuxSignerData(data1))
+	txBuilder.SetFeePayer(feePayerAddr)
+
+	w := txBuilder.(*wrapper)
+	require.Equal(t, bodyBz, w.getBodyBytes())
+	require.Equal(t, "memo", txBuilder.GetTx().GetMemo())
+	require.Equal(t, []sdk.AccAddress{addr1, addr2, feePayerAddr}, txBuilder.GetTx().GetSigners())
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+	require.Equal(t, pubKey1, sigs[0].PubKey)
+	require.Equal(t, data1.Sig, sigs[0].Data.(*signingtypes.SingleSignatureData).Signature)
+	require.Equal(t, pubKey2, sigs[1].PubKey)
+	require.Equal(t, data2.Sig, sigs[1].Data.(*signingtypes.SingleSignatureData).Signature)
+
+	t.Log("verify the signatures of the auxiliary signers")
+	for i, data := range []txtypes.AuxSignerData{data1, data2} {
+		signerData := signing.SignerData{
+			ChainID:  "test-chain",
+			Sequence: 1,
+			Address:  data.Address,
+			PubKey:   sigs[i].PubKey,
+		}
+		require.NoError(t, signing.VerifySignature(sigs[i].PubKey, signerData, sigs[i].Data, txConfig.SignModeHandler(), txBuilder.GetTx()))
+	}
+
+	t.Log("verify that a different body is rejected")
+	otherBodyBz := marshaler.MustMarshal(&txtypes.TxBody{Messages: []*codectypes.Any{msgAny}, Memo: "other memo"})
+	otherData := data1
+	otherData.SignDoc = &txtypes.SignDocDirectAux{BodyBytes: otherBodyBz, PublicKey: pkAny1, ChainId: "test-chain"}
+	require.Error(t, txBuilder.AddAuxSignerData(otherData))
+
+	t.Log("verify that the tip signed by the auxiliary signers is set")
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tip-token", 10)), Tipper: addr1.String()}
+	tipData := data1
+	tipData.SignDoc = &txtypes.SignDocDirectAux{BodyBytes: bodyBz, PublicKey: pkAny1, ChainId: "test-chain", Tip: tip}
+	tipBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, tipBuilder.AddAuxSignerData(tipData))
+	require.Equal(t, tip, tipBuilder.GetTx().(txtypes.TipTx).GetTip())
+
+	t.Log("verify that a different tip is rejected")
+	require.Error(t, tipBuilder.AddAuxSignerData(data2))
+
+	t.Log("verify that a non signer is rejected")
+	_, pubKey3, addr3 := testdata.KeyTestPubAddr()
+	pkAny3, err := codectypes.NewAnyWithValue(pubKey3)
+	require.NoError(t, err)
+	data3 := auxSignerData(addr3, pkAny3, func(bz []byte) ([]byte, error) { return []byte("sig"), nil })
+	require.Error(t, txConfig.NewTxBuilder().AddAuxSignerData(data3))
+
+	t.Log("verify that invalid aux signer data is rejected")
+	invalidData := data1
+	invalidData.Mode = signingtypes.SignMode_SIGN_MODE_DIRECT
+	require.Error(t, txConfig.NewTxBuilder().AddAuxSignerData(invalidData))
+
+	t.Log("verify that a builder without codec rejects aux signer data")
+	require.Error(t, WrapTx(&txtypes.Tx{Body: &txtypes.TxBody{}, AuthInfo: &txtypes.AuthInfo{Fee: &txtypes.Fee{}}}).AddAuxSignerData(data1))
+}
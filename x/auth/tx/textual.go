@@ -0,0 +1,52 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+)
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler
+type signModeTextualHandler struct {
+	t *textual.Textual
+}
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	return h.t.GetSignBytes(ctx, data, textual.TxData{
+		Body:          protoTx.tx.Body,
+		AuthInfo:      protoTx.tx.AuthInfo,
+		BodyBytes:     protoTx.getBodyBytes(),
+		AuthInfoBytes: protoTx.getAuthInfoBytes(),
+	})
+}
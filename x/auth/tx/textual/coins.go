@@ -0,0 +1,121 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// coinsRenderer renders coins in the display denomination of their bank
+// metadata, e.g. 1.5 atom for 1500000uatom, sorted by display denomination and
+// separated by commas. Coins without metadata are rendered in their own
+// denomination.
+type coinsRenderer struct {
+	t *Textual
+}
+
+func (r coinsRenderer) Format(ctx context.Context, v reflect.Value) ([]Screen, error) {
+	var coins sdk.DecCoins
+	switch c := v.Interface().(type) {
+	case sdk.Coin:
+		coins = sdk.DecCoins{sdk.NewDecCoinFromCoin(c)}
+	case sdk.DecCoin:
+		coins = sdk.DecCoins{c}
+	case sdk.Coins:
+		coins = sdk.NewDecCoinsFromCoins(c...)
+	case []sdk.Coin:
+		coins = sdk.NewDecCoinsFromCoins(c...)
+	case sdk.DecCoins:
+		coins = c
+	case []sdk.DecCoin:
+		coins = c
+	default:
+		return nil, fmt.Errorf("textual: expected coins, got %s", v.Type())
+	}
+
+	if len(coins) == 0 {
+		return []Screen{{Content: "zero"}}, nil
+	}
+
+	type displayCoin struct {
+		amount string
+		denom  string
+	}
+
+	displayCoins := make([]displayCoin, len(coins))
+	for i, coin := range coins {
+		amount, denom, err := r.toDisplay(ctx, coin)
+		if err != nil {
+			return nil, err
+		}
+		displayCoins[i] = displayCoin{FormatDecimal(amount), denom}
+	}
+
+	sort.SliceStable(displayCoins, func(i, j int) bool {
+		return displayCoins[i].denom < displayCoins[j].denom
+	})
+
+	formatted := make([]string, len(displayCoins))
+	for i, coin := range displayCoins {
+		formatted[i] = fmt.Sprintf("%s %s", coin.amount, coin.denom)
+	}
+
+	return []Screen{{Content: strings.Join(formatted, ", ")}}, nil
+}
+
+// toDisplay converts the coin to the display denomination of its metadata.
+func (r coinsRenderer) toDisplay(ctx context.Context, coin sdk.DecCoin) (sdk.Dec, string, error) {
+	metadata, err := r.t.coinMetadata(ctx, coin.Denom)
+	if err != nil {
+		return sdk.Dec{}, "", err
+	}
+	if metadata == nil || metadata.Display == "" {
+		return coin.Amount, coin.Denom, nil
+	}
+
+	coinExp, found := denomUnitExponent(metadata, coin.Denom)
+	if !found {
+		return coin.Amount, coin.Denom, nil
+	}
+
+	displayExp, found := denomUnitExponent(metadata, metadata.Display)
+	if !found {
+		return coin.Amount, coin.Denom, nil
+	}
+
+	amount := coin.Amount
+	if coinExp > displayExp {
+		amount = amount.Mul(sdk.NewDecFromInt(tenPow(coinExp - displayExp)))
+	} else if coinExp < displayExp {
+		amount = amount.Quo(sdk.NewDecFromInt(tenPow(displayExp - coinExp)))
+	}
+
+	return amount, metadata.Display, nil
+}
+
+// denomUnitExponent returns the exponent of the given denomination unit, or of
+// the unit it is an alias of, in the metadata.
+func denomUnitExponent(metadata *banktypes.Metadata, denom string) (uint32, bool) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == denom {
+			return unit.Exponent, true
+		}
+
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return unit.Exponent, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func tenPow(exp uint32) sdk.Int {
+	return sdk.NewIntWithDecimal(1, int(exp))
+}
@@ -0,0 +1,198 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// messageRenderer renders protobuf messages as their full name, followed by
+// their fields which are not set to their default value, in field number order.
+type messageRenderer struct {
+	t *Textual
+}
+
+func (r messageRenderer) Format(ctx context.Context, v reflect.Value) ([]Screen, error) {
+	ptr := v
+	if v.Kind() != reflect.Ptr {
+		ptr = reflect.New(v.Type())
+		ptr.Elem().Set(v)
+	}
+
+	msg, ok := ptr.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("textual: expected a protobuf message, got %s", v.Type())
+	}
+
+	screens := []Screen{{Content: proto.MessageName(msg)}}
+	if ptr.IsNil() {
+		return screens, nil
+	}
+
+	fields, err := r.t.formatFields(ctx, ptr.Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	return append(screens, indent(fields, 1)...), nil
+}
+
+// anyRenderer renders Any values as their type URL, followed by the fields of
+// the message they hold.
+type anyRenderer struct {
+	t *Textual
+}
+
+func (r anyRenderer) Format(ctx context.Context, v reflect.Value) ([]Screen, error) {
+	anyValue, ok := v.Interface().(*codectypes.Any)
+	if !ok || anyValue == nil {
+		return nil, fmt.Errorf("textual: expected a non nil Any, got %s", v.Type())
+	}
+
+	msg := anyValue.GetCachedValue()
+	if msg == nil {
+		return nil, fmt.Errorf("textual: the value of the Any %s is not unpacked", anyValue.TypeUrl)
+	}
+
+	screens, err := messageRenderer{r.t}.Format(ctx, reflect.ValueOf(msg))
+	if err != nil {
+		return nil, err
+	}
+	screens[0].Content = anyValue.TypeUrl
+
+	return screens, nil
+}
+
+// protoField is a field of a protobuf message.
+type protoField struct {
+	number int
+	name   string
+	value  reflect.Value
+}
+
+// formatFields renders the fields of the given protobuf message struct which
+// are not set to their default value.
+func (t *Textual) formatFields(ctx context.Context, v reflect.Value) ([]Screen, error) {
+	var fields []protoField
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+
+		if tag, ok := structField.Tag.Lookup("protobuf"); ok {
+			number, name, err := parseProtobufTag(tag)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, protoField{number, name, v.Field(i)})
+			continue
+		}
+
+		// the value of a oneof is a pointer to a wrapper struct holding the
+		// field which is set
+		if _, ok := structField.Tag.Lookup("protobuf_oneof"); ok {
+			if v.Field(i).IsNil() {
+				continue
+			}
+
+			wrapper := v.Field(i).Elem().Elem()
+			number, name, err := parseProtobufTag(wrapper.Type().Field(0).Tag.Get("protobuf"))
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, protoField{number, name, wrapper.Field(0)})
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].number < fields[j].number
+	})
+
+	var screens []Screen
+	for _, field := range fields {
+		if isDefault(field.value) {
+			continue
+		}
+
+		fieldScreens, err := t.formatField(ctx, fieldTitle(field.name), field.value)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// formatField renders the value of a field with the given title. Repeated
+// fields are rendered as their number of elements, followed by each element.
+func (t *Textual) formatField(ctx context.Context, title string, v reflect.Value) ([]Screen, error) {
+	_, hasRenderer := t.renderers[v.Type()]
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 || hasRenderer {
+		screens, err := t.Format(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		screens[0].Title = title
+
+		return screens, nil
+	}
+
+	n := v.Len()
+	screens := []Screen{{Title: title, Content: pluralize(int64(n), "element")}}
+	for i := 0; i < n; i++ {
+		elem, err := t.Format(ctx, v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		elem[0].Title = fmt.Sprintf("%s (%d/%d)", title, i+1, n)
+
+		screens = append(screens, indent(elem, 1)...)
+	}
+
+	return screens, nil
+}
+
+// parseProtobufTag returns the field number and name of a protobuf struct tag.
+func parseProtobufTag(tag string) (int, string, error) {
+	parts := strings.Split(tag, ",")
+	if len(parts) < 2 {
+		return 0, "", fmt.Errorf("textual: invalid protobuf tag %q", tag)
+	}
+
+	number, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, "", fmt.Errorf("textual: invalid protobuf tag %q: %w", tag, err)
+	}
+
+	for _, part := range parts[2:] {
+		if strings.HasPrefix(part, "name=") {
+			return number, strings.TrimPrefix(part, "name="), nil
+		}
+	}
+
+	return 0, "", fmt.Errorf("textual: invalid protobuf tag %q: no field name", tag)
+}
+
+// isDefault returns true if the field is set to its default value, and thus
+// is not rendered.
+func isDefault(v reflect.Value) bool {
+	if v.Kind() == reflect.Slice {
+		return v.Len() == 0
+	}
+
+	return v.IsZero()
+}
+
+// fieldTitle returns the title of a field from its name, e.g. From address for
+// from_address.
+func fieldTitle(name string) string {
+	title := strings.ReplaceAll(name, "_", " ")
+
+	return strings.ToUpper(title[:1]) + title[1:]
+}
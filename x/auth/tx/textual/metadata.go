@@ -0,0 +1,53 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankKeeper defines the bank keeper methods used to render coins on chain.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn reading the
+// metadata from the bank keeper. It returns an error if it is not called with a
+// context wrapping a sdk.Context, such as the one passed to the ante handler.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil, fmt.Errorf("cannot query the metadata of %s without a sdk.Context", denom)
+		}
+
+		metadata, found := bk.GetDenomMetaData(sdkCtx, denom)
+		if !found {
+			return nil, nil
+		}
+
+		return &metadata, nil
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a CoinMetadataQueryFn querying the
+// metadata with the bank DenomMetadata gRPC query, for clients.
+func NewGRPCCoinMetadataQueryFn(grpcConn gogogrpc.ClientConn) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		res, err := banktypes.NewQueryClient(grpcConn).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &res.Metadata, nil
+	}
+}
@@ -0,0 +1,131 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxRenderedBytes is the maximum length of byte strings rendered in hex,
+// longer byte strings are rendered as their SHA-256 hash.
+const maxRenderedBytes = 32
+
+// stringRenderer renders strings as is.
+type stringRenderer struct{}
+
+func (stringRenderer) Format(_ context.Context, v reflect.Value) ([]Screen, error) {
+	return []Screen{{Content: v.String()}}, nil
+}
+
+// stringerRenderer renders values, such as addresses and enumerations, with
+// their String method.
+type stringerRenderer struct{}
+
+func (stringerRenderer) Format(_ context.Context, v reflect.Value) ([]Screen, error) {
+	stringer, ok := v.Interface().(fmt.Stringer)
+	if !ok {
+		return nil, fmt.Errorf("textual: expected a fmt.Stringer, got %s", v.Type())
+	}
+
+	return []Screen{{Content: stringer.String()}}, nil
+}
+
+// boolRenderer renders booleans as True or False.
+type boolRenderer struct{}
+
+func (boolRenderer) Format(_ context.Context, v reflect.Value) ([]Screen, error) {
+	if v.Bool() {
+		return []Screen{{Content: "True"}}, nil
+	}
+
+	return []Screen{{Content: "False"}}, nil
+}
+
+// intRenderer renders integers with thousands separators.
+type intRenderer struct{}
+
+func (intRenderer) Format(_ context.Context, v reflect.Value) ([]Screen, error) {
+	var s string
+	switch i := v.Interface().(type) {
+	case sdk.Int:
+		s = i.String()
+	default:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			s = fmt.Sprintf("%d", v.Int())
+		case reflect.Uint32, reflect.Uint64:
+			s = fmt.Sprintf("%d", v.Uint())
+		default:
+			return nil, fmt.Errorf("textual: expected an integer, got %s", v.Type())
+		}
+	}
+
+	return []Screen{{Content: FormatInteger(s)}}, nil
+}
+
+// decRenderer renders decimals with thousands separators and without trailing
+// zeros.
+type decRenderer struct{}
+
+func (decRenderer) Format(_ context.Context, v reflect.Value) ([]Screen, error) {
+	d, ok := v.Interface().(sdk.Dec)
+	if !ok {
+		return nil, fmt.Errorf("textual: expected a sdk.Dec, got %s", v.Type())
+	}
+
+	return []Screen{{Content: FormatDecimal(d)}}, nil
+}
+
+// bytesRenderer renders byte strings in upper case hex, or as their SHA-256
+// hash if they are longer than maxRenderedBytes.
+type bytesRenderer struct{}
+
+func (bytesRenderer) Format(_ context.Context, v reflect.Value) ([]Screen, error) {
+	bz := v.Bytes()
+	if len(bz) > maxRenderedBytes {
+		hash := sha256.Sum256(bz)
+		return []Screen{{Content: fmt.Sprintf("SHA-256=%X", hash[:])}}, nil
+	}
+
+	return []Screen{{Content: fmt.Sprintf("%X", bz)}}, nil
+}
+
+// FormatInteger formats the base 10 representation of an integer with a
+// thousands separator, e.g. 1'000'000.
+func FormatInteger(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	var sb strings.Builder
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			sb.WriteByte('\'')
+		}
+		sb.WriteRune(c)
+	}
+
+	return sign + sb.String()
+}
+
+// FormatDecimal formats a decimal with a thousands separator in its integral
+// part and without trailing zeros, e.g. 1'000.5.
+func FormatDecimal(d sdk.Dec) string {
+	s := d.String()
+
+	integral, fractional := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integral, fractional = s[:i], strings.TrimRight(s[i+1:], "0")
+	}
+
+	if fractional == "" {
+		return FormatInteger(integral)
+	}
+
+	return FormatInteger(integral) + "." + fractional
+}
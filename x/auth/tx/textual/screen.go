@@ -0,0 +1,133 @@
+package textual
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Screen is a single line of text displayed to the signer. The sign bytes of
+// SIGN_MODE_TEXTUAL are the CBOR encoding of the screens of a transaction.
+type Screen struct {
+	// Title is the name of the value displayed on the screen, it may be empty.
+	Title string
+
+	// Content is the rendered value.
+	Content string
+
+	// Indent is the nesting level of the screen, 0 for the top level.
+	Indent int
+
+	// Expert is set for screens which are only displayed to the signer in
+	// expert mode.
+	Expert bool
+}
+
+// CBOR keys of the fields of an encoded screen.
+const (
+	screenTitleKey   = 1
+	screenContentKey = 2
+	screenIndentKey  = 3
+	screenExpertKey  = 4
+)
+
+// CBOR major types.
+const (
+	cborUnsignedInt = 0
+	cborByteString  = 2
+	cborTextString  = 3
+	cborArray       = 4
+	cborMap         = 5
+
+	cborTrue = 0xf5
+)
+
+// EncodeScreens returns the deterministic CBOR encoding of the screens: an
+// array of maps holding, under integer keys in ascending order, the fields of
+// each screen which are not set to their zero value.
+func EncodeScreens(screens []Screen) []byte {
+	var buf bytes.Buffer
+
+	writeCBORHead(&buf, cborArray, uint64(len(screens)))
+	for _, s := range screens {
+		var n uint64
+		if s.Title != "" {
+			n++
+		}
+		if s.Content != "" {
+			n++
+		}
+		if s.Indent > 0 {
+			n++
+		}
+		if s.Expert {
+			n++
+		}
+
+		writeCBORHead(&buf, cborMap, n)
+		if s.Title != "" {
+			writeCBORHead(&buf, cborUnsignedInt, screenTitleKey)
+			writeCBORText(&buf, s.Title)
+		}
+		if s.Content != "" {
+			writeCBORHead(&buf, cborUnsignedInt, screenContentKey)
+			writeCBORText(&buf, s.Content)
+		}
+		if s.Indent > 0 {
+			writeCBORHead(&buf, cborUnsignedInt, screenIndentKey)
+			writeCBORHead(&buf, cborUnsignedInt, uint64(s.Indent))
+		}
+		if s.Expert {
+			writeCBORHead(&buf, cborUnsignedInt, screenExpertKey)
+			buf.WriteByte(cborTrue)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// encodeByteStrings returns the CBOR encoding of an array of byte strings.
+func encodeByteStrings(bzs ...[]byte) []byte {
+	var buf bytes.Buffer
+
+	writeCBORHead(&buf, cborArray, uint64(len(bzs)))
+	for _, bz := range bzs {
+		writeCBORHead(&buf, cborByteString, uint64(len(bz)))
+		buf.Write(bz)
+	}
+
+	return buf.Bytes()
+}
+
+func writeCBORText(buf *bytes.Buffer, s string) {
+	writeCBORHead(buf, cborTextString, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+// writeCBORHead writes the initial bytes of a data item of the given major
+// type, using the shortest encoding of its argument.
+func writeCBORHead(buf *bytes.Buffer, majorType byte, arg uint64) {
+	major := majorType << 5
+
+	switch {
+	case arg < 24:
+		buf.WriteByte(major | byte(arg))
+	case arg <= 0xff:
+		buf.WriteByte(major | 24)
+		buf.WriteByte(byte(arg))
+	case arg <= 0xffff:
+		buf.WriteByte(major | 25)
+		bz := make([]byte, 2)
+		binary.BigEndian.PutUint16(bz, uint16(arg))
+		buf.Write(bz)
+	case arg <= 0xffffffff:
+		buf.WriteByte(major | 26)
+		bz := make([]byte, 4)
+		binary.BigEndian.PutUint32(bz, uint32(arg))
+		buf.Write(bz)
+	default:
+		buf.WriteByte(major | 27)
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, arg)
+		buf.Write(bz)
+	}
+}
@@ -0,0 +1,168 @@
+// Package textual implements the rendering of transactions into the
+// human-readable screens signed in SIGN_MODE_TEXTUAL.
+//
+// Every value of a transaction is rendered by the ValueRenderer registered
+// for its Go type: integers and decimals with thousands separators, coins in
+// the display denomination of their bank metadata, timestamps in RFC 3339,
+// and protobuf messages as the list of their non-default fields.
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank metadata of the given denomination, or
+// nil if the denomination has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// ValueRenderer renders the values of a Go type into screens.
+type ValueRenderer interface {
+	// Format renders the value. The first screen holds the value itself and
+	// its title is set by the caller, the following screens hold the content
+	// of the value, indented relatively to the first one.
+	Format(ctx context.Context, v reflect.Value) ([]Screen, error)
+}
+
+// Textual renders transactions and their values into screens.
+type Textual struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+	renderers           map[reflect.Type]ValueRenderer
+}
+
+// NewTextual returns a Textual rendering coins with the metadata returned by
+// the given function. If it is nil, coins are rendered in their base
+// denomination.
+func NewTextual(coinMetadataQuerier CoinMetadataQueryFn) *Textual {
+	t := &Textual{
+		coinMetadataQuerier: coinMetadataQuerier,
+		renderers:           make(map[reflect.Type]ValueRenderer),
+	}
+
+	coins := coinsRenderer{t}
+	t.DefineValueRenderer(reflect.TypeOf(sdk.Coin{}), coins)
+	t.DefineValueRenderer(reflect.TypeOf(sdk.DecCoin{}), coins)
+	t.DefineValueRenderer(reflect.TypeOf(sdk.Coins{}), coins)
+	t.DefineValueRenderer(reflect.TypeOf([]sdk.Coin{}), coins)
+	t.DefineValueRenderer(reflect.TypeOf(sdk.DecCoins{}), coins)
+	t.DefineValueRenderer(reflect.TypeOf([]sdk.DecCoin{}), coins)
+
+	t.DefineValueRenderer(reflect.TypeOf(sdk.Int{}), intRenderer{})
+	t.DefineValueRenderer(reflect.TypeOf(sdk.Dec{}), decRenderer{})
+	t.DefineValueRenderer(reflect.TypeOf(time.Time{}), timestampRenderer{})
+	t.DefineValueRenderer(reflect.TypeOf(&gogotypes.Timestamp{}), timestampRenderer{})
+	t.DefineValueRenderer(reflect.TypeOf(time.Duration(0)), durationRenderer{})
+	t.DefineValueRenderer(reflect.TypeOf(&gogotypes.Duration{}), durationRenderer{})
+	t.DefineValueRenderer(reflect.TypeOf(&codectypes.Any{}), anyRenderer{t})
+
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf(sdk.AccAddress{}),
+		reflect.TypeOf(sdk.ValAddress{}),
+		reflect.TypeOf(sdk.ConsAddress{}),
+	} {
+		t.DefineValueRenderer(typ, stringerRenderer{})
+	}
+
+	return t
+}
+
+// DefineValueRenderer sets the ValueRenderer used for the values of the given
+// type, replacing the default one.
+func (t *Textual) DefineValueRenderer(typ reflect.Type, r ValueRenderer) {
+	t.renderers[typ] = r
+}
+
+// GetValueRenderer returns the ValueRenderer used for the values of the given
+// type.
+func (t *Textual) GetValueRenderer(typ reflect.Type) (ValueRenderer, error) {
+	if r, ok := t.renderers[typ]; ok {
+		return r, nil
+	}
+
+	protoMsgType := reflect.TypeOf((*proto.Message)(nil)).Elem()
+	stringerType := reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+	switch {
+	case typ.Kind() == reflect.Ptr && t.renderers[typ.Elem()] != nil:
+		// nullable fields of types with a renderer, such as *time.Time
+		return derefRenderer{t.renderers[typ.Elem()]}, nil
+
+	case typ.Implements(protoMsgType) && typ.Kind() == reflect.Ptr:
+		return messageRenderer{t}, nil
+
+	case typ.Kind() == reflect.Struct && reflect.PtrTo(typ).Implements(protoMsgType):
+		return messageRenderer{t}, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return stringRenderer{}, nil
+
+	case reflect.Bool:
+		return boolRenderer{}, nil
+
+	case reflect.Int32:
+		// protobuf enumerations are rendered by name
+		if typ.Implements(stringerType) {
+			return stringerRenderer{}, nil
+		}
+		return intRenderer{}, nil
+
+	case reflect.Int, reflect.Int64, reflect.Uint32, reflect.Uint64:
+		return intRenderer{}, nil
+
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return bytesRenderer{}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("textual: no value renderer for type %s", typ)
+}
+
+// Format renders the given value with the ValueRenderer of its type.
+func (t *Textual) Format(ctx context.Context, v reflect.Value) ([]Screen, error) {
+	r, err := t.GetValueRenderer(v.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Format(ctx, v)
+}
+
+// coinMetadata returns the metadata of the given denomination, or nil if it has
+// none.
+func (t *Textual) coinMetadata(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+	if t.coinMetadataQuerier == nil {
+		return nil, nil
+	}
+
+	return t.coinMetadataQuerier(ctx, denom)
+}
+
+// derefRenderer renders the value pointed to by a pointer.
+type derefRenderer struct {
+	r ValueRenderer
+}
+
+func (r derefRenderer) Format(ctx context.Context, v reflect.Value) ([]Screen, error) {
+	return r.r.Format(ctx, v.Elem())
+}
+
+// indent returns the screens indented by the given number of levels.
+func indent(screens []Screen, levels int) []Screen {
+	for i := range screens {
+		screens[i].Indent += levels
+	}
+
+	return screens
+}
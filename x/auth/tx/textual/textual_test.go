@@ -0,0 +1,246 @@
+package textual_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// loadTestVectors reads the JSON test vectors of the given file of the
+// testdata directory.
+func loadTestVectors(t *testing.T, file string, v interface{}) {
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", file))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, v))
+}
+
+// metadataQuerier returns a CoinMetadataQueryFn reading the given metadata,
+// indexed by base denomination as in the bank keeper.
+func metadataQuerier(metadata []banktypes.Metadata) textual.CoinMetadataQueryFn {
+	return func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		for i := range metadata {
+			if metadata[i].Base == denom {
+				return &metadata[i], nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// format renders the value and checks that it is rendered in one screen.
+func format(t *testing.T, tt *textual.Textual, v interface{}) string {
+	screens, err := tt.Format(context.Background(), reflect.ValueOf(v))
+	require.NoError(t, err)
+	require.Len(t, screens, 1)
+
+	return screens[0].Content
+}
+
+func TestFormatIntegers(t *testing.T) {
+	var vectors [][2]string
+	loadTestVectors(t, "integers.json", &vectors)
+
+	tt := textual.NewTextual(nil)
+	for _, tc := range vectors {
+		require.Equal(t, tc[1], textual.FormatInteger(tc[0]), tc[0])
+
+		i, ok := sdk.NewIntFromString(tc[0])
+		if ok {
+			require.Equal(t, tc[1], format(t, tt, i), tc[0])
+		}
+	}
+
+	require.Equal(t, "18'446'744'073'709'551'615", format(t, tt, uint64(18446744073709551615)))
+	require.Equal(t, "-1'234", format(t, tt, int64(-1234)))
+}
+
+func TestFormatDecimals(t *testing.T) {
+	var vectors [][2]string
+	loadTestVectors(t, "decimals.json", &vectors)
+
+	tt := textual.NewTextual(nil)
+	for _, tc := range vectors {
+		d, err := sdk.NewDecFromStr(tc[0])
+		require.NoError(t, err)
+		require.Equal(t, tc[1], textual.FormatDecimal(d), tc[0])
+		require.Equal(t, tc[1], format(t, tt, d), tc[0])
+	}
+}
+
+func TestFormatDurations(t *testing.T) {
+	var vectors [][2]string
+	loadTestVectors(t, "durations.json", &vectors)
+
+	tt := textual.NewTextual(nil)
+	for _, tc := range vectors {
+		d, err := time.ParseDuration(tc[0])
+		require.NoError(t, err)
+		require.Equal(t, tc[1], format(t, tt, d), tc[0])
+		require.Equal(t, tc[1], format(t, tt, gogotypes.DurationProto(d)), tc[0])
+	}
+}
+
+func TestFormatTimestamps(t *testing.T) {
+	var vectors [][2]string
+	loadTestVectors(t, "timestamps.json", &vectors)
+
+	tt := textual.NewTextual(nil)
+	for _, tc := range vectors {
+		ts, err := time.Parse(time.RFC3339Nano, tc[0])
+		require.NoError(t, err)
+		require.Equal(t, tc[1], format(t, tt, ts), tc[0])
+		require.Equal(t, tc[1], format(t, tt, &ts), tc[0])
+
+		protoTs, err := gogotypes.TimestampProto(ts)
+		require.NoError(t, err)
+		require.Equal(t, tc[1], format(t, tt, protoTs), tc[0])
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	var vectors [][2]string
+	loadTestVectors(t, "bytes.json", &vectors)
+
+	tt := textual.NewTextual(nil)
+	for _, tc := range vectors {
+		bz, err := hex.DecodeString(tc[0])
+		require.NoError(t, err)
+		require.Equal(t, tc[1], format(t, tt, bz), tc[0])
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	var vectors []struct {
+		Name     string               `json:"name"`
+		Metadata []banktypes.Metadata `json:"metadata"`
+		Coins    string               `json:"coins"`
+		DecCoins string               `json:"dec_coins"`
+		Text     string               `json:"text"`
+	}
+	loadTestVectors(t, "coins.json", &vectors)
+
+	for _, tc := range vectors {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			tt := textual.NewTextual(metadataQuerier(tc.Metadata))
+
+			if tc.DecCoins != "" {
+				decCoins, err := sdk.ParseDecCoins(tc.DecCoins)
+				require.NoError(t, err)
+				require.Equal(t, tc.Text, format(t, tt, decCoins))
+				return
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(tc.Coins)
+			require.NoError(t, err)
+			require.Equal(t, tc.Text, format(t, tt, coins))
+
+			if len(coins) == 1 {
+				require.Equal(t, tc.Text, format(t, tt, coins[0]))
+				require.Equal(t, tc.Text, format(t, tt, &coins[0]))
+			}
+		})
+	}
+}
+
+func TestRenderTx(t *testing.T) {
+	var vectors []struct {
+		Name       string `json:"name"`
+		SignerData struct {
+			ChainID       string `json:"chain_id"`
+			AccountNumber uint64 `json:"account_number"`
+			Sequence      uint64 `json:"sequence"`
+		} `json:"signer_data"`
+		Metadata []banktypes.Metadata `json:"metadata"`
+		Tx       json.RawMessage      `json:"tx"`
+		Screens  []struct {
+			Title   string `json:"title"`
+			Content string `json:"content"`
+			Indent  int    `json:"indent"`
+			Expert  bool   `json:"expert"`
+		} `json:"screens"`
+		CBOR string `json:"cbor"`
+	}
+	loadTestVectors(t, "tx.json", &vectors)
+
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	for _, tc := range vectors {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			var tx txtypes.Tx
+			require.NoError(t, cdc.UnmarshalJSON(tc.Tx, &tx))
+
+			bodyBz, err := tx.Body.Marshal()
+			require.NoError(t, err)
+			authInfoBz, err := tx.AuthInfo.Marshal()
+			require.NoError(t, err)
+			txData := textual.TxData{
+				Body:          tx.Body,
+				AuthInfo:      tx.AuthInfo,
+				BodyBytes:     bodyBz,
+				AuthInfoBytes: authInfoBz,
+			}
+
+			signerData := signing.SignerData{
+				ChainID:       tc.SignerData.ChainID,
+				AccountNumber: tc.SignerData.AccountNumber,
+				Sequence:      tc.SignerData.Sequence,
+			}
+
+			tt := textual.NewTextual(metadataQuerier(tc.Metadata))
+			screens, err := tt.RenderTx(context.Background(), signerData, txData)
+			require.NoError(t, err)
+
+			expected := make([]textual.Screen, len(tc.Screens))
+			for i, s := range tc.Screens {
+				expected[i] = textual.Screen{Title: s.Title, Content: s.Content, Indent: s.Indent, Expert: s.Expert}
+			}
+			require.Equal(t, expected, screens)
+
+			signBytes, err := tt.GetSignBytes(context.Background(), signerData, txData)
+			require.NoError(t, err)
+			require.Equal(t, tc.CBOR, hex.EncodeToString(signBytes))
+		})
+	}
+}
+
+func TestEncodeScreens(t *testing.T) {
+	testCases := []struct {
+		name    string
+		screens []textual.Screen
+		cbor    string
+	}{
+		{"no screens", nil, "80"},
+		{"empty screen", []textual.Screen{{}}, "81a0"},
+		{"content", []textual.Screen{{Content: "a"}}, "81a1026161"},
+		{"all fields", []textual.Screen{{Title: "a", Content: "b", Indent: 1, Expert: true}}, "81a4016161026162030104f5"},
+		{"large indent", []textual.Screen{{Indent: 24}}, "81a1031818"},
+		{
+			"long text",
+			[]textual.Screen{{Content: "0123456789012345678901234"}},
+			"81a1027819" + hex.EncodeToString([]byte("0123456789012345678901234")),
+		},
+		{"several screens", []textual.Screen{{Title: "a"}, {Content: "b"}}, "82a1016161a1026162"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.cbor, hex.EncodeToString(textual.EncodeScreens(tc.screens)), tc.name)
+	}
+}
@@ -0,0 +1,100 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+)
+
+// timestampRenderer renders timestamps in RFC 3339 format in UTC, without
+// trailing zeros in the fractional seconds.
+type timestampRenderer struct{}
+
+func (timestampRenderer) Format(_ context.Context, v reflect.Value) ([]Screen, error) {
+	var t time.Time
+	switch ts := v.Interface().(type) {
+	case time.Time:
+		t = ts
+	case *gogotypes.Timestamp:
+		var err error
+		if t, err = gogotypes.TimestampFromProto(ts); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("textual: expected a timestamp, got %s", v.Type())
+	}
+
+	return []Screen{{Content: t.UTC().Format(time.RFC3339Nano)}}, nil
+}
+
+// durationRenderer renders durations in days, hours, minutes and seconds, e.g.
+// 1 day, 2 hours, 3.5 seconds.
+type durationRenderer struct{}
+
+func (durationRenderer) Format(_ context.Context, v reflect.Value) ([]Screen, error) {
+	var d time.Duration
+	switch dur := v.Interface().(type) {
+	case time.Duration:
+		d = dur
+	case *gogotypes.Duration:
+		var err error
+		if d, err = gogotypes.DurationFromProto(dur); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("textual: expected a duration, got %s", v.Type())
+	}
+
+	return []Screen{{Content: FormatDuration(d)}}, nil
+}
+
+// FormatDuration formats a duration in days, hours, minutes and seconds,
+// omitting the zero components, e.g. 1 day, 2 hours, 3.5 seconds.
+func FormatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	seconds := d / time.Second
+	nanos := d - seconds*time.Second
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, pluralize(int64(days), "day"))
+	}
+	if hours > 0 {
+		parts = append(parts, pluralize(int64(hours), "hour"))
+	}
+	if minutes > 0 {
+		parts = append(parts, pluralize(int64(minutes), "minute"))
+	}
+	if nanos > 0 {
+		fractional := strings.TrimRight(fmt.Sprintf("%09d", int64(nanos)), "0")
+		parts = append(parts, fmt.Sprintf("%d.%s seconds", seconds, fractional))
+	} else if seconds > 0 || len(parts) == 0 {
+		parts = append(parts, pluralize(int64(seconds), "second"))
+	}
+
+	return sign + strings.Join(parts, ", ")
+}
+
+// pluralize returns the count followed by the noun, in the plural if the
+// count is not 1.
+func pluralize(n int64, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+
+	return fmt.Sprintf("%s %ss", FormatInteger(fmt.Sprintf("%d", n)), noun)
+}
@@ -0,0 +1,125 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// TxData is the transaction rendered in SIGN_MODE_TEXTUAL. The raw bytes of
+// its body and auth info are the ones included in the transaction, the hash of
+// which is signed along with its rendering.
+type TxData struct {
+	Body          *txtypes.TxBody
+	AuthInfo      *txtypes.AuthInfo
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// GetSignBytes returns the SIGN_MODE_TEXTUAL sign bytes of the transaction:
+// the CBOR encoding of its screens.
+func (t *Textual) GetSignBytes(ctx context.Context, signerData signing.SignerData, tx TxData) ([]byte, error) {
+	screens, err := t.RenderTx(ctx, signerData, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeScreens(screens), nil
+}
+
+// RenderTx renders the transaction signed by the signer into screens.
+func (t *Textual) RenderTx(ctx context.Context, signerData signing.SignerData, tx TxData) ([]Screen, error) {
+	if tx.Body == nil || tx.AuthInfo == nil {
+		return nil, fmt.Errorf("textual: the transaction has no body or auth info")
+	}
+
+	screens := []Screen{
+		{Title: "Chain id", Content: signerData.ChainID},
+		{Title: "Account number", Content: FormatInteger(fmt.Sprintf("%d", signerData.AccountNumber))},
+		{Title: "Sequence", Content: FormatInteger(fmt.Sprintf("%d", signerData.Sequence))},
+	}
+
+	n := len(tx.Body.Messages)
+	screens = append(screens, Screen{Content: fmt.Sprintf("This transaction has %s", pluralize(int64(n), "Message"))})
+	for i, msg := range tx.Body.Messages {
+		msgScreens, err := t.Format(ctx, reflect.ValueOf(msg))
+		if err != nil {
+			return nil, err
+		}
+		msgScreens[0].Title = fmt.Sprintf("Message (%d/%d)", i+1, n)
+
+		screens = append(screens, indent(msgScreens, 1)...)
+	}
+	screens = append(screens, Screen{Content: "End of Messages"})
+
+	if tx.Body.Memo != "" {
+		screens = append(screens, Screen{Title: "Memo", Content: tx.Body.Memo})
+	}
+
+	fee := tx.AuthInfo.Fee
+	if fee == nil {
+		fee = &txtypes.Fee{}
+	}
+
+	feeScreens, err := t.formatField(ctx, "Fees", reflect.ValueOf(fee.Amount))
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, feeScreens...)
+
+	if tip := tx.AuthInfo.Tip; tip != nil {
+		tipScreens, err := t.formatField(ctx, "Tip", reflect.ValueOf(tip.Amount))
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, tipScreens...)
+		screens = append(screens, Screen{Title: "Tipper", Content: tip.Tipper})
+	}
+
+	var expertScreens []Screen
+	if fee.Payer != "" {
+		expertScreens = append(expertScreens, Screen{Title: "Fee payer", Content: fee.Payer})
+	}
+	if fee.Granter != "" {
+		expertScreens = append(expertScreens, Screen{Title: "Fee granter", Content: fee.Granter})
+	}
+	expertScreens = append(expertScreens, Screen{Title: "Gas limit", Content: FormatInteger(fmt.Sprintf("%d", fee.GasLimit))})
+	if tx.Body.TimeoutHeight != 0 {
+		expertScreens = append(expertScreens, Screen{Title: "Timeout height", Content: FormatInteger(fmt.Sprintf("%d", tx.Body.TimeoutHeight))})
+	}
+
+	for _, opts := range []struct {
+		title   string
+		options interface{}
+	}{
+		{"Extension options", tx.Body.ExtensionOptions},
+		{"Non critical extension options", tx.Body.NonCriticalExtensionOptions},
+	} {
+		v := reflect.ValueOf(opts.options)
+		if isDefault(v) {
+			continue
+		}
+
+		optScreens, err := t.formatField(ctx, opts.title, v)
+		if err != nil {
+			return nil, err
+		}
+		expertScreens = append(expertScreens, optScreens...)
+	}
+
+	// the hash of the raw bytes binds the signature to the exact encoding of
+	// the transaction
+	hash := sha256.Sum256(encodeByteStrings(tx.BodyBytes, tx.AuthInfoBytes))
+	expertScreens = append(expertScreens, Screen{Title: "Hash of raw bytes", Content: hex.EncodeToString(hash[:])})
+
+	for i := range expertScreens {
+		expertScreens[i].Expert = true
+	}
+
+	return append(screens, expertScreens...), nil
+}
@@ -0,0 +1,170 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	metadata := banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	}
+	coinMetadataQuerier := func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		if denom == metadata.Base {
+			return &metadata, nil
+		}
+		return nil, nil
+	}
+
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, coinMetadataQuerier)
+	txBuilder := txConfig.NewTxBuilder()
+
+	accSeq := uint64(2)
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500)))
+	txBuilder.SetGasLimit(20000)
+
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		Sequence: accSeq,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	t.Log("verify modes and default-mode")
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+	}
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	t.Log("verify that the sign bytes are the encoding of the rendered transaction")
+	w := txBuilder.(*wrapper)
+	screens, err := textual.NewTextual(coinMetadataQuerier).RenderTx(context.Background(), signingData, textual.TxData{
+		Body:          w.tx.Body,
+		AuthInfo:      w.tx.AuthInfo,
+		BodyBytes:     w.getBodyBytes(),
+		AuthInfoBytes: w.getAuthInfoBytes(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, textual.EncodeScreens(screens), signBytes)
+	require.Contains(t, screens, textual.Screen{Title: "Memo", Content: "sometestmemo"})
+	require.Contains(t, screens, textual.Screen{Title: "Fees", Content: "0.0015 atom"})
+
+	t.Log("verify the signature over the sign bytes")
+	sigBz, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	sig.Data = &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL, Signature: sigBz}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	require.NoError(t, signing.VerifySignatureWithContext(context.Background(), pubkey, signingData, sig.Data, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify that the sign bytes change with the transaction")
+	txBuilder.SetMemo("othermemo")
+	newSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, newSignBytes)
+	require.Error(t, signing.VerifySignatureWithContext(context.Background(), pubkey, signingData, sig.Data, modeHandler, txBuilder.GetTx()))
+}
+
+func TestTextualModeHandler_nonTEXTUAL_MODE(t *testing.T) {
+	invalidModes := []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_UNSPECIFIED,
+	}
+	for _, invalidMode := range invalidModes {
+		t.Run(invalidMode.String(), func(t *testing.T) {
+			h := signModeTextualHandler{t: textual.NewTextual(nil)}
+			var signingData signing.SignerData
+			_, err := h.GetSignBytes(invalidMode, signingData, nil)
+			require.Error(t, err)
+			wantErr := fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, invalidMode)
+			require.Equal(t, err, wantErr)
+		})
+	}
+}
+
+func TestTextualModeHandler_nonProtoTx(t *testing.T) {
+	h := signModeTextualHandler{t: textual.NewTextual(nil)}
+	var signingData signing.SignerData
+	tx := legacytx.StdTx{}
+	_, err := h.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.Error(t, err)
+	wantErr := fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	require.Equal(t, err, wantErr)
+}
+
+// mockBankKeeper returns the metadata of a single denom.
+type mockBankKeeper struct {
+	metadata banktypes.Metadata
+}
+
+func (bk mockBankKeeper) GetDenomMetaData(_ sdk.Context, denom string) (banktypes.Metadata, bool) {
+	return bk.metadata, denom == bk.metadata.Base
+}
+
+func TestTextualModeHandler_bankKeeperWithoutSDKContext(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	bk := mockBankKeeper{metadata: banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	}}
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, textual.NewBankKeeperCoinMetadataQueryFn(bk))
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500)))
+
+	signingData := signing.SignerData{ChainID: "test-chain", AccountNumber: 1}
+	modeHandler := txConfig.SignModeHandler()
+
+	t.Log("verify the sign bytes cannot be rendered without a sdk.Context")
+	_, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	t.Log("verify the sign bytes are rendered with a sdk.Context")
+	handlerWithContext, ok := modeHandler.(signing.SignModeHandlerWithContext)
+	require.True(t, ok)
+	ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+	signBytes, err := handlerWithContext.GetSignBytesWithContext(ctx, signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), "0.0015 atom")
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -9,12 +10,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -29,6 +32,10 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewMsgCreateVestingAccountCmd(),
+		NewMsgCreatePermanentLockedAccountCmd(),
+		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -79,3 +86,203 @@ timestamp.`,
 
 	return cmd
 }
+
+// NewMsgCreatePermanentLockedAccountCmd returns a CLI command handler for creating a
+// MsgCreatePermanentLockedAccount transaction.
+func NewMsgCreatePermanentLockedAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-permanent-locked-account [to_address] [amount]",
+		Short: "Create a new permanently locked account funded with an allocation of tokens.",
+		Long: `Create a new account funded with an allocation of permanently locked tokens.
+These tokens may be used for staking but are non-transferable. Staking rewards
+will accrue as liquid and transferable tokens.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePermanentLockedAccount(clientCtx.GetFromAddress(), toAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreatePeriodicVestingAccount transaction.
+func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens.",
+		Long: fmt.Sprintf(`Create a new periodic vesting account funded with an allocation of tokens. The
+vesting schedule is read from a JSON file giving the start time of the schedule,
+as a UNIX epoch timestamp, and its consecutive periods, each releasing its coins
+after the given number of seconds since the end of the previous period. The
+account is funded with the sum of the coins of all the periods.
+
+Example:
+$ %s tx vesting create-periodic-vesting-account <to_address> periods.json --from <key>
+
+Where periods.json contains:
+
+{
+  "start_time": 1625204910,
+  "periods": [
+    {
+      "coins": "10stake",
+      "length_seconds": 2592000
+    },
+    {
+      "coins": "10stake",
+      "length_seconds": 2592000
+    }
+  ]
+}`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			data, err := parseVestingData(args[1])
+			if err != nil {
+				return err
+			}
+
+			periods, err := data.vestingPeriods()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, data.StartTime, periods)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new vesting account whose unvested tokens can be clawed back by the sender.",
+		Long: fmt.Sprintf(`Create a new clawback vesting account funded with an allocation of tokens. The
+account vests its tokens like a periodic vesting account, according to the vesting
+schedule read from a JSON periods file, and the sender, as the funder of the
+account, may claw back the tokens that are still vesting with the clawback command.
+
+Example:
+$ %s tx vesting create-clawback-vesting-account <to_address> periods.json --from <key>
+
+Where periods.json contains:
+
+{
+  "start_time": 1625204910,
+  "periods": [
+    {
+      "coins": "10stake",
+      "length_seconds": 2592000
+    },
+    {
+      "coins": "10stake",
+      "length_seconds": 2592000
+    }
+  ]
+}`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			data, err := parseVestingData(args[1])
+			if err != nil {
+				return err
+			}
+
+			periods, err := data.vestingPeriods()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, data.StartTime, periods)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back the tokens of a clawback vesting account that are still vesting,
+including the delegated ones. The sender must be the funder of the account. The
+tokens are sent to the address given by the '--dest' flag, or to the funder by
+default, and their delegations are transferred to it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destArg, _ := cmd.Flags().GetString(FlagDest); destArg != "" {
+				dest, err = sdk.AccAddressFromBech32(destArg)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back tokens, defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
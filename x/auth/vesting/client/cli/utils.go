@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// VestingData defines the JSON file passed to the create-periodic-vesting-account
+// command, which describes the start time and the periods of a vesting schedule.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a vesting period of a VestingData file, releasing the coins
+// after the given length in seconds.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// parseVestingData reads and parses the vesting schedule JSON file at the given
+// path.
+func parseVestingData(path string) (VestingData, error) {
+	var data VestingData
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return data, err
+	}
+
+	err = json.Unmarshal(contents, &data)
+	return data, err
+}
+
+// vestingPeriods converts the periods of a VestingData file to vesting periods.
+func (data VestingData) vestingPeriods() ([]types.Period, error) {
+	periods := make([]types.Period, 0, len(data.Periods))
+	for _, p := range data.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return periods, nil
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -130,3 +132,227 @@ func (s *IntegrationTestSuite) TestNewMsgCreateVestingAccountCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewMsgCreatePermanentLockedAccountCmd() {
+	val := s.network.Validators[0]
+
+	testCases := map[string]struct {
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		"create a permanent locked account": {
+			args: []string{
+				sdk.AccAddress("addr4_______________").String(),
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"invalid address": {
+			args: []string{
+				sdk.AccAddress("addr5").String(),
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"invalid coins": {
+			args: []string{
+				sdk.AccAddress("addr5_______________").String(),
+				"fooo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreatePermanentLockedAccountCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
+	val := s.network.Validators[0]
+
+	periodsFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`
+{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "10%[1]s", "length_seconds": 2592000},
+    {"coins": "20%[1]s", "length_seconds": 2592000}
+  ]
+}`, s.cfg.BondDenom))
+	invalidPeriodsFile := testutil.WriteToNewTempFile(s.T(), `{"start_time": 1625204910, "periods": [{"coins": "fooo", "length_seconds": 1}]}`)
+	emptyPeriodsFile := testutil.WriteToNewTempFile(s.T(), `{"start_time": 1625204910, "periods": []}`)
+
+	testCases := map[string]struct {
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		"create a periodic vesting account": {
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				periodsFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"missing periods file": {
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				"./missing.json",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"invalid period coins": {
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				invalidPeriodsFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"empty periods": {
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				emptyPeriodsFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreatePeriodicVestingAccountCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewMsgCreateClawbackVestingAccountAndClawbackCmds() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	addr := sdk.AccAddress("addr8_______________")
+
+	periodsFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`
+{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "10%[1]s", "length_seconds": 2592000},
+    {"coins": "20%[1]s", "length_seconds": 2592000}
+  ]
+}`, s.cfg.BondDenom))
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// The cases run in order: the clawback depends on the account created first.
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			name:         "create a clawback vesting account",
+			cmd:          cli.NewMsgCreateClawbackVestingAccountCmd(),
+			args:         append([]string{addr.String(), periodsFile.Name()}, txFlags...),
+			expectErr:    false,
+			expectedCode: 0,
+		},
+		{
+			name:      "create with missing periods file",
+			cmd:       cli.NewMsgCreateClawbackVestingAccountCmd(),
+			args:      append([]string{sdk.AccAddress("addr9_______________").String(), "./missing.json"}, txFlags...),
+			expectErr: true,
+		},
+		{
+			name:      "clawback with invalid destination",
+			cmd:       cli.NewMsgClawbackCmd(),
+			args:      append([]string{addr.String(), fmt.Sprintf("--%s=%s", cli.FlagDest, "invalid")}, txFlags...),
+			expectErr: true,
+		},
+		{
+			name:         "clawback the clawback vesting account",
+			cmd:          cli.NewMsgClawbackCmd(),
+			args:         append([]string{addr.String()}, txFlags...),
+			expectErr:    false,
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var txResp sdk.TxResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), &txResp), bw.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, txResp.RawLog)
+			}
+		})
+	}
+}
@@ -8,8 +8,8 @@ import (
 )
 
 // NewHandler returns a handler for x/auth message types.
-func NewHandler(ak keeper.AccountKeeper, bk types.BankKeeper) sdk.Handler {
-	msgServer := NewMsgServerImpl(ak, bk)
+func NewHandler(ak keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) sdk.Handler {
+	msgServer := NewMsgServerImpl(ak, bk, sk)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
@@ -19,6 +19,22 @@ func NewHandler(ak keeper.AccountKeeper, bk types.BankKeeper) sdk.Handler {
 			res, err := msgServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCreatePermanentLockedAccount:
+			res, err := msgServer.CreatePermanentLockedAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreatePeriodicVestingAccount:
+			res, err := msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateClawbackVestingAccount:
+			res, err := msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgClawback:
+			res, err := msgServer.Clawback(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -2,14 +2,18 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type HandlerTestSuite struct {
@@ -23,7 +27,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
 
-	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper)
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	suite.app = app
 }
 
@@ -91,6 +95,292 @@ func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgCreatePermanentLockedAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, addr1, balances))
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreatePermanentLockedAccount
+		expectErr bool
+	}{
+		{
+			name:      "create permanent locked account",
+			msg:       types.NewMsgCreatePermanentLockedAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("test", 100))),
+			expectErr: false,
+		},
+		{
+			name:      "permanent locked account already exists",
+			msg:       types.NewMsgCreatePermanentLockedAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("test", 100))),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr, err := sdk.AccAddressFromBech32(tc.msg.ToAddress)
+				suite.Require().NoError(err)
+				accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+				suite.Require().NotNil(accI)
+				acc, ok := accI.(*types.PermanentLockedAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(tc.msg.Amount, acc.GetVestingCoins(ctx.BlockTime().AddDate(100, 0, 0)))
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreatePeriodicVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create periodic vesting account",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, ctx.BlockTime().Unix(), periods),
+			expectErr: false,
+		},
+		{
+			name:      "periodic vesting account already exists",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, ctx.BlockTime().Unix(), periods),
+			expectErr: true,
+		},
+		{
+			name: "insufficient funds",
+			msg: types.NewMsgCreatePeriodicVestingAccount(addr1, addr3, ctx.BlockTime().Unix(), []types.Period{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 10000))},
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr, err := sdk.AccAddressFromBech32(tc.msg.ToAddress)
+				suite.Require().NoError(err)
+				accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+				suite.Require().NotNil(accI)
+				acc, ok := accI.(*types.PeriodicVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(tc.msg.TotalAmount(), acc.GetVestingCoins(ctx.BlockTime()))
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 200)), acc.GetVestingCoins(ctx.BlockTime().Add(100*time.Second)))
+				suite.Require().Equal(tc.msg.TotalAmount(), suite.app.BankKeeper.GetAllBalances(ctx, toAddr))
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))},
+	}
+
+	res, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix(), periods))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(addr1, acc.GetFunder())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 300)), acc.GetVestingCoins(ctx.BlockTime()))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 300)), suite.app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// the account already exists
+	_, err = suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix(), periods))
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.app.BaseApp.NewContext(false, tmproto.Header{}))
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200))},
+	}
+
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+	dest := sdk.AccAddress([]byte("dest________________"))
+
+	testCases := []struct {
+		name       string
+		delegate   int64
+		undelegate int64
+		msg        *types.MsgClawback
+		expectErr  bool
+		// expected amounts clawed back from the balance, the delegations and
+		// the unbonding delegations of the account
+		expBalance    int64
+		expDelegation int64
+		expUnbonding  int64
+	}{
+		{
+			name:       "clawback locked coins",
+			msg:        types.NewMsgClawback(funder, addr, dest),
+			expBalance: 200,
+		},
+		{
+			name:          "clawback delegated coins",
+			delegate:      250,
+			msg:           types.NewMsgClawback(funder, addr, dest),
+			expDelegation: 200,
+		},
+		{
+			name:          "clawback locked and delegated coins",
+			delegate:      150,
+			msg:           types.NewMsgClawback(funder, addr, dest),
+			expBalance:    50,
+			expDelegation: 150,
+		},
+		{
+			name:          "clawback delegated and unbonding coins",
+			delegate:      250,
+			undelegate:    100,
+			msg:           types.NewMsgClawback(funder, addr, dest),
+			expDelegation: 150,
+			expUnbonding:  50,
+		},
+		{
+			name:       "clawback to the funder by default",
+			msg:        types.NewMsgClawback(funder, addr, nil),
+			expBalance: 200,
+		},
+		{
+			name:      "not the funder",
+			msg:       types.NewMsgClawback(dest, addr, dest),
+			expectErr: true,
+		},
+		{
+			name:      "not a clawback vesting account",
+			msg:       types.NewMsgClawback(funder, funder, dest),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			startTime := time.Unix(1600000000, 0)
+			ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: startTime})
+			sk := suite.app.StakingKeeper
+
+			suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, funder))
+			suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, funder, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+			_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, startTime.Unix(), periods))
+			suite.Require().NoError(err)
+
+			valAddr := sdk.ValAddress([]byte("validator___________"))
+			suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))))
+			teststaking.NewHelper(suite.T(), ctx, sk).CreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt(100), true)
+			validator, _ := sk.GetValidator(ctx, valAddr)
+			if tc.delegate > 0 {
+				_, err = sk.Delegate(ctx, addr, sdk.NewInt(tc.delegate), stakingtypes.Unbonded, validator, true)
+				suite.Require().NoError(err)
+				validator, _ = sk.GetValidator(ctx, valAddr)
+			}
+			if tc.undelegate > 0 {
+				shares, err := validator.SharesFromTokens(sdk.NewInt(tc.undelegate))
+				suite.Require().NoError(err)
+				_, err = sk.Undelegate(ctx, addr, validator.GetOperator(), shares)
+				suite.Require().NoError(err)
+			}
+
+			// claw back in the middle of the second period
+			ctx = ctx.WithBlockTime(startTime.Add(150 * time.Second))
+			destAddr, err := tc.msg.GetDestination()
+			suite.Require().NoError(err)
+			destBalance := suite.app.BankKeeper.GetBalance(ctx, destAddr, bondDenom)
+			addrBalance := suite.app.BankKeeper.GetBalance(ctx, addr, bondDenom)
+
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+			suite.Require().True(ok)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.OriginalVesting)
+			suite.Require().Empty(acc.GetVestingCoins(ctx.BlockTime()))
+			suite.Require().Empty(acc.DelegatedVesting)
+			suite.Require().Empty(acc.LockedCoins(ctx.BlockTime()))
+
+			suite.Require().Equal(destBalance.AddAmount(sdk.NewInt(tc.expBalance)), suite.app.BankKeeper.GetBalance(ctx, destAddr, bondDenom))
+			suite.Require().Equal(addrBalance.SubAmount(sdk.NewInt(tc.expBalance)), suite.app.BankKeeper.GetBalance(ctx, addr, bondDenom))
+
+			delegated := sdk.ZeroInt()
+			if delegation, found := sk.GetDelegation(ctx, destAddr, validator.GetOperator()); found {
+				delegated = validator.TokensFromShares(delegation.Shares).TruncateInt()
+			}
+			suite.Require().Equal(sdk.NewInt(tc.expDelegation), delegated)
+
+			unbonding := sdk.ZeroInt()
+			if ubd, found := sk.GetUnbondingDelegation(ctx, destAddr, validator.GetOperator()); found {
+				for _, entry := range ubd.Entries {
+					unbonding = unbonding.Add(entry.Balance)
+				}
+			}
+			suite.Require().Equal(sdk.NewInt(tc.expUnbonding), unbonding)
+		})
+	}
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
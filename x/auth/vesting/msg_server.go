@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -17,12 +18,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -45,20 +47,12 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 		return nil, err
 	}
 
-	if bk.BlockedAddr(to) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
-	}
-
-	if acc := ak.GetAccount(ctx, to); acc != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
-	}
-
-	baseAccount := ak.NewAccountWithAddress(ctx, to)
-	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	baseAccount, err := s.newBaseAccount(ctx, to)
+	if err != nil {
+		return nil, err
 	}
 
-	baseVestingAccount := types.NewBaseVestingAccount(baseAccount.(*authtypes.BaseAccount), msg.Amount.Sort(), msg.EndTime)
+	baseVestingAccount := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
 
 	var acc authtypes.AccountI
 
@@ -98,3 +92,333 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 
 	return &types.MsgCreateVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreatePermanentLockedAccount(goCtx context.Context, msg *types.MsgCreatePermanentLockedAccount) (*types.MsgCreatePermanentLockedAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	if err := bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAccount, err := s.newBaseAccount(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewPermanentLockedAccount(baseAccount, msg.Amount.Sort())
+	ak.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range msg.Amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_permanent_locked_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreatePermanentLockedAccountResponse{}, nil
+}
+
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	totalCoins := msg.TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAccount, err := s.newBaseAccount(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewPeriodicVestingAccount(baseAccount, totalCoins, msg.StartTime, msg.VestingPeriods)
+	ak.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	totalCoins := msg.TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAccount, err := s.newBaseAccount(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount, from, totalCoins, msg.StartTime, msg.VestingPeriods)
+	ak.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := msg.GetDestination()
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	va, err := s.getClawbackVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !va.GetFunder().Equals(funder) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", funder, addr)
+	}
+
+	// The unvested coins are either delegated, tracked as delegated vesting
+	// coins, or locked in the account balance. The delegated ones are clawed
+	// back first by transferring the delegations, or the unbonding delegations,
+	// of the account to the destination.
+	blockTime := ctx.BlockTime()
+	unvested := va.GetVestingCoins(blockTime)
+	bondDenom := s.StakingKeeper.BondDenom(ctx)
+	delegated := sdk.MinInt(unvested.AmountOf(bondDenom), va.DelegatedVesting.AmountOf(bondDenom))
+	transferred := sdk.NewCoins(sdk.NewCoin(bondDenom, s.transferDelegations(ctx, addr, dest, delegated)))
+
+	// Transferring the delegations withdraws their rewards, which may update the
+	// account, so it is reloaded before clawing back the locked coins.
+	va, err = s.getClawbackVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := va.LockedCoins(blockTime)
+	va.Clawback(blockTime, transferred)
+	ak.SetAccount(ctx, va)
+
+	if err := bk.SendCoins(ctx, addr, dest, locked); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range locked.Add(transferred...) {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "clawback"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// getClawbackVestingAccount returns the clawback vesting account at the given
+// address.
+func (s msgServer) getClawbackVestingAccount(ctx sdk.Context, addr sdk.AccAddress) (*types.ClawbackVestingAccount, error) {
+	acc := s.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", addr)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", addr)
+	}
+
+	return va, nil
+}
+
+// transferDelegations transfers at most amount tokens of the delegations of
+// the account to the destination, and then of its unbonding delegations if the
+// delegations are not enough. It returns the amount of tokens transferred.
+func (s msgServer) transferDelegations(ctx sdk.Context, addr, dest sdk.AccAddress, amount sdk.Int) sdk.Int {
+	sk := s.StakingKeeper
+	transferred := sdk.ZeroInt()
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		want := amount.Sub(transferred)
+		if !want.IsPositive() {
+			return transferred
+		}
+
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found || validator.GetTokens().IsZero() {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			continue
+		}
+
+		// transfer the whole delegation when its tokens do not cover the wanted amount
+		if validator.TokensFromSharesTruncated(delegation.Shares).TruncateInt().LTE(want) {
+			wantShares = delegation.Shares
+		}
+
+		shares := sk.TransferDelegation(ctx, addr, dest, delegation.GetValidatorAddr(), wantShares)
+		transferred = transferred.Add(validator.TokensFromSharesTruncated(shares).TruncateInt())
+	}
+
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		want := amount.Sub(transferred)
+		if !want.IsPositive() {
+			return transferred
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		transferred = transferred.Add(sk.TransferUnbonding(ctx, addr, dest, valAddr, want))
+	}
+
+	return transferred
+}
+
+// newBaseAccount returns a new base account for the recipient of a vesting
+// account, which must be allowed to receive funds and must not exist yet.
+func (s msgServer) newBaseAccount(ctx sdk.Context, to sdk.AccAddress) (*authtypes.BaseAccount, error) {
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", to)
+	}
+
+	acc := s.AccountKeeper.NewAccountWithAddress(ctx, to)
+	baseAccount, ok := acc.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", acc)
+	}
+
+	return baseAccount, nil
+}
@@ -0,0 +1,286 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateVestingAccount         = "op_weight_msg_create_vesting_account"
+	OpWeightMsgCreatePermanentLockedAccount = "op_weight_msg_create_permanent_locked_account"
+	OpWeightMsgCreatePeriodicVestingAccount = "op_weight_msg_create_periodic_vesting_account"
+	OpWeightMsgCreateClawbackVestingAccount = "op_weight_msg_create_clawback_vesting_account"
+	OpWeightMsgClawback                     = "op_weight_msg_clawback"
+
+	// maxVestingDuration is the maximum duration, in seconds, of a simulated
+	// vesting schedule.
+	maxVestingDuration = 365 * 24 * 60 * 60
+)
+
+var (
+	TypeMsgCreateVestingAccount         = sdk.MsgTypeURL(&types.MsgCreateVestingAccount{})
+	TypeMsgCreatePermanentLockedAccount = sdk.MsgTypeURL(&types.MsgCreatePermanentLockedAccount{})
+	TypeMsgCreatePeriodicVestingAccount = sdk.MsgTypeURL(&types.MsgCreatePeriodicVestingAccount{})
+	TypeMsgCreateClawbackVestingAccount = sdk.MsgTypeURL(&types.MsgCreateClawbackVestingAccount{})
+	TypeMsgClawback                     = sdk.MsgTypeURL(&types.MsgClawback{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak keeper.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateVestingAccount         int
+		weightMsgCreatePermanentLockedAccount int
+		weightMsgCreatePeriodicVestingAccount int
+		weightMsgCreateClawbackVestingAccount int
+		weightMsgClawback                     int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = simappparams.DefaultWeightMsgCreateVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePermanentLockedAccount, &weightMsgCreatePermanentLockedAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePermanentLockedAccount = simappparams.DefaultWeightMsgCreatePermanentLockedAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePeriodicVestingAccount, &weightMsgCreatePeriodicVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePeriodicVestingAccount = simappparams.DefaultWeightMsgCreatePeriodicVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClawbackVestingAccount, &weightMsgCreateClawbackVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClawbackVestingAccount = simappparams.DefaultWeightMsgCreateClawbackVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClawback, &weightMsgClawback, nil,
+		func(_ *rand.Rand) {
+			weightMsgClawback = simappparams.DefaultWeightMsgClawback
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreatePermanentLockedAccount,
+			SimulateMsgCreatePermanentLockedAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreatePeriodicVestingAccount,
+			SimulateMsgCreatePeriodicVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClawbackVestingAccount,
+			SimulateMsgCreateClawbackVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawback,
+			SimulateMsgClawback(ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount with random values.
+func SimulateMsgCreateVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, to, amount, skipReason := randomVestingAccountFunding(r, ctx, accs, ak, bk)
+		if skipReason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateVestingAccount, skipReason), nil, nil
+		}
+
+		endTime := ctx.BlockTime().Unix() + int64(simtypes.RandIntBetween(r, 1, maxVestingDuration))
+		msg := types.NewMsgCreateVestingAccount(from.Address, to, amount, endTime, r.Intn(2) == 0)
+
+		return deliverVestingMsg(r, app, ctx, ak, bk, from, msg, TypeMsgCreateVestingAccount, amount)
+	}
+}
+
+// SimulateMsgCreatePermanentLockedAccount generates a MsgCreatePermanentLockedAccount
+// with random values.
+func SimulateMsgCreatePermanentLockedAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, to, amount, skipReason := randomVestingAccountFunding(r, ctx, accs, ak, bk)
+		if skipReason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreatePermanentLockedAccount, skipReason), nil, nil
+		}
+
+		msg := types.NewMsgCreatePermanentLockedAccount(from.Address, to, amount)
+
+		return deliverVestingMsg(r, app, ctx, ak, bk, from, msg, TypeMsgCreatePermanentLockedAccount, amount)
+	}
+}
+
+// SimulateMsgCreatePeriodicVestingAccount generates a MsgCreatePeriodicVestingAccount
+// with random values.
+func SimulateMsgCreatePeriodicVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, to, amount, skipReason := randomVestingAccountFunding(r, ctx, accs, ak, bk)
+		if skipReason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreatePeriodicVestingAccount, skipReason), nil, nil
+		}
+
+		startTime, periods := randomVestingSchedule(r, ctx, amount)
+		msg := types.NewMsgCreatePeriodicVestingAccount(from.Address, to, startTime, periods)
+
+		return deliverVestingMsg(r, app, ctx, ak, bk, from, msg, TypeMsgCreatePeriodicVestingAccount, amount)
+	}
+}
+
+// SimulateMsgCreateClawbackVestingAccount generates a MsgCreateClawbackVestingAccount
+// with random values.
+func SimulateMsgCreateClawbackVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, to, amount, skipReason := randomVestingAccountFunding(r, ctx, accs, ak, bk)
+		if skipReason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateClawbackVestingAccount, skipReason), nil, nil
+		}
+
+		startTime, periods := randomVestingSchedule(r, ctx, amount)
+		msg := types.NewMsgCreateClawbackVestingAccount(from.Address, to, startTime, periods)
+
+		return deliverVestingMsg(r, app, ctx, ak, bk, from, msg, TypeMsgCreateClawbackVestingAccount, amount)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback for a random clawback vesting
+// account funded by one of the simulation accounts.
+func SimulateMsgClawback(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var vestingAccs []*types.ClawbackVestingAccount
+		ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+			if va, ok := acc.(*types.ClawbackVestingAccount); ok {
+				vestingAccs = append(vestingAccs, va)
+			}
+			return false
+		})
+
+		if len(vestingAccs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgClawback, "no clawback vesting accounts"), nil, nil
+		}
+
+		va := vestingAccs[r.Intn(len(vestingAccs))]
+		funder, found := simtypes.FindAccount(accs, va.GetFunder())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgClawback, "funder is not a simulation account"), nil, nil
+		}
+
+		var dest sdk.AccAddress
+		if r.Intn(2) == 0 {
+			destAcc, _ := simtypes.RandomAcc(r, accs)
+			dest = destAcc.Address
+		}
+
+		msg := types.NewMsgClawback(funder.Address, va.GetAddress(), dest)
+
+		return deliverVestingMsg(r, app, ctx, ak, bk, funder, msg, TypeMsgClawback, nil)
+	}
+}
+
+// randomVestingSchedule returns a random start time and splits the given
+// amount over a random number of vesting periods.
+func randomVestingSchedule(r *rand.Rand, ctx sdk.Context, amount sdk.Coins) (startTime int64, periods []types.Period) {
+	remaining := amount
+	for i := r.Intn(5); i >= 0 && !remaining.Empty(); i-- {
+		periodAmount := remaining
+		if i > 0 {
+			periodAmount = simtypes.RandSubsetCoins(r, remaining)
+		}
+
+		periods = append(periods, types.Period{
+			Length: int64(simtypes.RandIntBetween(r, 1, maxVestingDuration/5)),
+			Amount: periodAmount,
+		})
+		remaining = remaining.Sub(periodAmount)
+	}
+
+	startTime = ctx.BlockTime().Add(time.Duration(r.Intn(maxVestingDuration)) * time.Second).Unix()
+
+	return startTime, periods
+}
+
+// randomVestingAccountFunding picks a random funder among the simulation accounts,
+// a random amount of its spendable coins and a new random address to create
+// the vesting account at. It returns a non empty reason if no vesting account
+// can be created.
+func randomVestingAccountFunding(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, ak keeper.AccountKeeper, bk types.BankKeeper,
+) (from simtypes.Account, to sdk.AccAddress, amount sdk.Coins, skipReason string) {
+	from, _ = simtypes.RandomAcc(r, accs)
+	to = simtypes.RandomAccounts(r, 1)[0].Address
+
+	if ak.GetAccount(ctx, to) != nil {
+		return from, to, nil, "account already exists"
+	}
+
+	if bk.BlockedAddr(to) {
+		return from, to, nil, "account is not allowed to receive funds"
+	}
+
+	spendable := bk.SpendableCoins(ctx, from.Address)
+	amount = simtypes.RandSubsetCoins(r, spendable)
+	if amount.Empty() {
+		return from, to, nil, "no coins to fund the account with"
+	}
+
+	if err := bk.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return from, to, nil, err.Error()
+	}
+
+	return from, to, amount, ""
+}
+
+// deliverVestingMsg signs the given message by the funder of the vesting account
+// and delivers it with random fees.
+func deliverVestingMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak keeper.AccountKeeper, bk types.BankKeeper,
+	from simtypes.Account, msg sdk.Msg, msgType string, amount sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      from,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: amount,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
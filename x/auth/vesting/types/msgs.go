@@ -1,14 +1,28 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// TypeMsgCreateVestingAccount defines the type value for a MsgCreateVestingAccount.
-const TypeMsgCreateVestingAccount = "msg_create_vesting_account"
+// Vesting message types
+const (
+	TypeMsgCreateVestingAccount         = "msg_create_vesting_account"
+	TypeMsgCreatePermanentLockedAccount = "msg_create_permanent_locked_account"
+	TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
+	TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+	TypeMsgClawback                     = "msg_clawback"
+)
 
-var _ sdk.Msg = &MsgCreateVestingAccount{}
+var (
+	_ sdk.Msg = &MsgCreateVestingAccount{}
+	_ sdk.Msg = &MsgCreatePermanentLockedAccount{}
+	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+)
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //nolint:interfacer
@@ -30,21 +44,9 @@ func (msg MsgCreateVestingAccount) Type() string { return TypeMsgCreateVestingAc
 
 // ValidateBasic Implements Msg.
 func (msg MsgCreateVestingAccount) ValidateBasic() error {
-	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
-	if err != nil {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
 		return err
 	}
-	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
-	if err != nil {
-		return err
-	}
-	if err := sdk.VerifyAddressFormat(from); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
-	}
-
-	if err := sdk.VerifyAddressFormat(to); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
-	}
 
 	if !msg.Amount.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
@@ -75,3 +77,273 @@ func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgCreatePermanentLockedAccount returns a reference to a new MsgCreatePermanentLockedAccount.
+//nolint:interfacer
+func NewMsgCreatePermanentLockedAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) *MsgCreatePermanentLockedAccount {
+	return &MsgCreatePermanentLockedAccount{
+		FromAddress: fromAddr.String(),
+		ToAddress:   toAddr.String(),
+		Amount:      amount,
+	}
+}
+
+// Route returns the message route for a MsgCreatePermanentLockedAccount.
+func (msg MsgCreatePermanentLockedAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreatePermanentLockedAccount.
+func (msg MsgCreatePermanentLockedAccount) Type() string {
+	return TypeMsgCreatePermanentLockedAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePermanentLockedAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreatePermanentLockedAccount.
+func (msg MsgCreatePermanentLockedAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreatePermanentLockedAccount.
+func (msg MsgCreatePermanentLockedAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgCreatePeriodicVestingAccount returns a reference to a new MsgCreatePeriodicVestingAccount.
+//nolint:interfacer
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreatePeriodicVestingAccount {
+	return &MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Type() string {
+	return TypeMsgCreatePeriodicVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+
+	return validateVestingSchedule(msg.StartTime, msg.VestingPeriods)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// TotalAmount returns the sum of the amounts of all the vesting periods.
+func (msg MsgCreatePeriodicVestingAccount) TotalAmount() sdk.Coins {
+	return periodsTotalAmount(msg.VestingPeriods)
+}
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return TypeMsgCreateClawbackVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+
+	return validateVestingSchedule(msg.StartTime, msg.VestingPeriods)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// TotalAmount returns the sum of the amounts of all the vesting periods.
+func (msg MsgCreateClawbackVestingAccount) TotalAmount() sdk.Coins {
+	return periodsTotalAmount(msg.VestingPeriods)
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. An empty destination
+// address sends the clawed back coins to the funder.
+//nolint:interfacer
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	var destAddress string
+	if !dest.Empty() {
+		destAddress = dest.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress: funder.String(),
+		Address:       addr.String(),
+		DestAddress:   destAddress,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if err := validateAddresses(msg.FunderAddress, msg.Address); err != nil {
+		return err
+	}
+
+	if msg.DestAddress != "" {
+		dest, err := sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := sdk.VerifyAddressFormat(dest); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{funder}
+}
+
+// GetDestination returns the address receiving the clawed back coins, which is
+// the funder when no destination address is set.
+func (msg MsgClawback) GetDestination() (sdk.AccAddress, error) {
+	if msg.DestAddress == "" {
+		return sdk.AccAddressFromBech32(msg.FunderAddress)
+	}
+
+	return sdk.AccAddressFromBech32(msg.DestAddress)
+}
+
+// validateVestingSchedule checks the start time and the periods of the vesting
+// schedule of a vesting account creation message.
+func validateVestingSchedule(startTime int64, periods []Period) error {
+	if startTime < 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time %d, must be positive", startTime)
+	}
+
+	if len(periods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vesting periods cannot be empty")
+	}
+
+	for i, period := range periods {
+		if period.Length < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid length %d of period %d, must be positive", period.Length, i)
+		}
+
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("period %d: %s", i, period.Amount))
+		}
+	}
+
+	return nil
+}
+
+// periodsTotalAmount returns the sum of the amounts of the given periods.
+func periodsTotalAmount(periods []Period) sdk.Coins {
+	var total sdk.Coins
+	for _, period := range periods {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
+// validateAddresses checks the sender and recipient addresses of a vesting
+// account creation message.
+func validateAddresses(fromAddress, toAddress string) error {
+	from, err := sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return err
+	}
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := sdk.VerifyAddressFormat(from); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(to); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	return nil
+}
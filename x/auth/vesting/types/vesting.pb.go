@@ -279,6 +279,48 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It vests
+// coins according to a schedule of periods, like a PeriodicVestingAccount,
+// and allows its funder to claw back the coins that are still vesting.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	FunderAddress       string   `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty" yaml:"funder_address"`
+	StartTime           int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	VestingPeriods      []Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
+func (*ClawbackVestingAccount) ProtoMessage() {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{6}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -286,6 +328,7 @@ func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -293,46 +336,49 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x4d, 0x08, 0xe5, 0x0a, 0xfd, 0x63, 0xda, 0x60, 0x3a, 0xd8, 0x91, 0xc5, 0x10,
-	0x21, 0xe1, 0xd0, 0xc2, 0xd4, 0x0d, 0x17, 0x21, 0x55, 0xed, 0x80, 0x2c, 0xc4, 0xc0, 0x12, 0x9d,
-	0xed, 0xc3, 0xb1, 0x1a, 0xdf, 0x45, 0xbe, 0x4b, 0x45, 0x3e, 0x00, 0x08, 0xa9, 0x0b, 0x48, 0x0c,
-	0x8c, 0x5d, 0x58, 0xf8, 0x10, 0xcc, 0x1d, 0x23, 0x26, 0xa6, 0x80, 0x92, 0x6f, 0x90, 0x4f, 0x80,
-	0x7c, 0x77, 0x76, 0x8a, 0x0b, 0x44, 0x65, 0x00, 0x31, 0x25, 0x77, 0xef, 0xfb, 0x3e, 0xf7, 0x7b,
-	0x5f, 0x3f, 0xa7, 0x83, 0xb7, 0x02, 0xca, 0x12, 0xca, 0x5a, 0x47, 0x98, 0xf1, 0x98, 0x44, 0xad,
-	0xa3, 0x2d, 0x1f, 0x73, 0xb4, 0x95, 0xaf, 0x9d, 0x5e, 0x4a, 0x39, 0xd5, 0xeb, 0x32, 0xcb, 0xc9,
-	0x77, 0x55, 0xd6, 0xe6, 0x7a, 0x44, 0x23, 0x2a, 0x52, 0x5a, 0xd9, 0x3f, 0x99, 0xbd, 0x69, 0x2a,
-	0x4d, 0x1f, 0x31, 0x5c, 0x08, 0x06, 0x34, 0x26, 0xa5, 0x38, 0xea, 0xf3, 0x4e, 0x11, 0xcf, 0x16,
-	0x32, 0x6e, 0x7f, 0xae, 0x42, 0xdd, 0x45, 0x0c, 0x3f, 0x95, 0xa7, 0x3d, 0x08, 0x02, 0xda, 0x27,
-	0x5c, 0xdf, 0x83, 0x57, 0x33, 0xc5, 0x36, 0x92, 0x6b, 0x03, 0x34, 0x40, 0x73, 0x69, 0xbb, 0xe1,
-	0x28, 0x36, 0x21, 0xa0, 0xd4, 0x9c, 0xac, 0x5c, 0xd5, 0xb9, 0xd5, 0xe1, 0xc8, 0x02, 0xde, 0x92,
-	0x3f, 0xdb, 0xd2, 0xdf, 0x02, 0xb8, 0x4a, 0xd3, 0x38, 0x8a, 0x09, 0xea, 0xb6, 0x55, 0x53, 0xc6,
-	0x42, 0xa3, 0xd2, 0x5c, 0xda, 0xbe, 0x99, 0xeb, 0x65, 0xf9, 0x85, 0xde, 0x2e, 0x8d, 0x89, 0xbb,
-	0x7f, 0x3a, 0xb2, 0xb4, 0xe9, 0xc8, 0xba, 0x31, 0x40, 0x49, 0x77, 0xc7, 0x2e, 0x0b, 0xd8, 0x1f,
-	0xbf, 0x5a, 0xcd, 0x28, 0xe6, 0x9d, 0xbe, 0xef, 0x04, 0x34, 0x69, 0xa9, 0x2e, 0xe5, 0xcf, 0x1d,
-	0x16, 0x1e, 0xb6, 0xf8, 0xa0, 0x87, 0x99, 0xd0, 0x62, 0xde, 0x4a, 0x5e, 0xae, 0xba, 0xd4, 0x8f,
-	0x01, 0x5c, 0x0e, 0x71, 0x17, 0x47, 0x88, 0xe3, 0xb0, 0xfd, 0x3c, 0xc5, 0xd8, 0xa8, 0xcc, 0x23,
-	0xda, 0x53, 0x44, 0x1b, 0x92, 0xe8, 0xc7, 0xf2, 0x8b, 0xf1, 0x5c, 0x2b, 0x8a, 0x1f, 0xa5, 0x18,
-	0xeb, 0xef, 0x00, 0x5c, 0x9b, 0xc9, 0xe5, 0x23, 0xaa, 0xce, 0x03, 0x3a, 0x50, 0x40, 0x46, 0x19,
-	0xe8, 0x8f, 0x66, 0xb4, 0x5a, 0xd4, 0xe7, 0x43, 0x72, 0xe0, 0x22, 0x26, 0x61, 0x9b, 0xc7, 0x09,
-	0x36, 0x2e, 0x35, 0x40, 0xb3, 0xe2, 0x5e, 0x9f, 0x8e, 0xac, 0x15, 0x79, 0x5a, 0x1e, 0xb1, 0xbd,
-	0xcb, 0x98, 0x84, 0x4f, 0xe2, 0x04, 0xef, 0x2c, 0xbe, 0x3e, 0xb1, 0xb4, 0xf7, 0x27, 0x96, 0x66,
-	0x7f, 0x02, 0xd0, 0xd8, 0xa5, 0x84, 0xc7, 0xa4, 0x4f, 0xfb, 0xac, 0x64, 0x2d, 0x1f, 0xae, 0x0b,
-	0x6b, 0x29, 0xca, 0x92, 0xc5, 0x6e, 0x3b, 0x3f, 0xb7, 0xbf, 0x73, 0xde, 0xa4, 0xca, 0x6c, 0xba,
-	0x7f, 0xde, 0xbe, 0xf7, 0x21, 0x64, 0x1c, 0xa5, 0x5c, 0xc2, 0x2f, 0x08, 0xf8, 0x8d, 0xe9, 0xc8,
-	0x5a, 0x93, 0xf0, 0xb3, 0x98, 0xed, 0x5d, 0x11, 0x8b, 0x52, 0x03, 0x2f, 0x01, 0xdc, 0x78, 0x88,
-	0xbb, 0x68, 0x50, 0x4c, 0xe3, 0x2f, 0xd2, 0x9f, 0xe1, 0x38, 0x06, 0xb0, 0xf6, 0x18, 0xa7, 0x31,
-	0x0d, 0xf5, 0x3a, 0xac, 0x75, 0x31, 0x89, 0x78, 0x47, 0x1c, 0x55, 0xf1, 0xd4, 0x4a, 0x0f, 0x60,
-	0x0d, 0x25, 0x02, 0x61, 0xee, 0x9d, 0xba, 0x9b, 0x19, 0xe6, 0x42, 0xa6, 0x50, 0xd2, 0x3b, 0x55,
-	0x41, 0xf3, 0x61, 0x01, 0xd6, 0x25, 0x4d, 0x1c, 0xfc, 0x2f, 0x1f, 0x55, 0x8f, 0xe0, 0x4a, 0x0e,
-	0xd5, 0x13, 0xec, 0x4c, 0x5d, 0x75, 0xf3, 0x57, 0x50, 0xb2, 0x45, 0xd7, 0x54, 0xd7, 0xab, 0x2e,
-	0xe5, 0x4b, 0x22, 0xb6, 0xb7, 0xac, 0x76, 0x64, 0x3a, 0x3b, 0xf3, 0xd5, 0x5e, 0x01, 0x31, 0xa7,
-	0x04, 0x11, 0x4c, 0xf8, 0x01, 0x0d, 0x0e, 0x71, 0xf8, 0x4f, 0xec, 0xe3, 0xee, 0x9f, 0x8e, 0x4d,
-	0x30, 0x1c, 0x9b, 0xe0, 0xdb, 0xd8, 0x04, 0x6f, 0x26, 0xa6, 0x36, 0x9c, 0x98, 0xda, 0x97, 0x89,
-	0xa9, 0x3d, 0xdb, 0xfa, 0xad, 0x05, 0x5e, 0xa8, 0xe7, 0x42, 0xbd, 0x53, 0xc2, 0x11, 0x7e, 0x4d,
-	0x3c, 0x18, 0xf7, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x80, 0x00, 0xd5, 0x07, 0xc6, 0x06, 0x00,
-	0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x49, 0x08, 0xed, 0x95, 0xfe, 0x32, 0x6d, 0x48, 0x3b, 0xd8, 0x91, 0xc5, 0x10,
+	0x21, 0xe1, 0xd0, 0xc2, 0xd4, 0x89, 0xba, 0x08, 0xa9, 0x6a, 0x07, 0x64, 0x21, 0x06, 0x96, 0xe8,
+	0x6c, 0xbf, 0xba, 0x56, 0xe3, 0xbb, 0xca, 0x77, 0x29, 0xf4, 0x0f, 0x00, 0x21, 0x75, 0x01, 0x89,
+	0x81, 0xb1, 0x0b, 0x0b, 0x7f, 0x04, 0x73, 0xc7, 0x8a, 0x89, 0x29, 0xa0, 0x76, 0x60, 0xef, 0x5f,
+	0x80, 0x72, 0x77, 0x4e, 0x5a, 0x17, 0x88, 0x5a, 0x09, 0x2a, 0xa6, 0xe4, 0xdd, 0x7b, 0xef, 0xeb,
+	0xcf, 0xdd, 0xfb, 0x5e, 0x1c, 0x7c, 0x3b, 0x60, 0x3c, 0x61, 0xbc, 0xb9, 0x03, 0x5c, 0xc4, 0x34,
+	0x6a, 0xee, 0x2c, 0xf8, 0x20, 0xc8, 0x42, 0x16, 0x3b, 0xdb, 0x29, 0x13, 0xcc, 0xa8, 0xaa, 0x2a,
+	0x27, 0x5b, 0xd5, 0x55, 0xf3, 0x33, 0x11, 0x8b, 0x98, 0x2c, 0x69, 0xf6, 0xbe, 0xa9, 0xea, 0x79,
+	0x53, 0x6b, 0xfa, 0x84, 0x43, 0x5f, 0x30, 0x60, 0x31, 0xcd, 0xe5, 0x49, 0x47, 0x6c, 0xf6, 0xf3,
+	0xbd, 0x40, 0xe5, 0xed, 0x2f, 0x65, 0x6c, 0xb8, 0x84, 0xc3, 0x33, 0xf5, 0xb4, 0xe5, 0x20, 0x60,
+	0x1d, 0x2a, 0x8c, 0x55, 0x7c, 0xa3, 0xa7, 0xd8, 0x22, 0x2a, 0xae, 0xa1, 0x3a, 0x6a, 0x8c, 0x2d,
+	0xd6, 0x1d, 0xcd, 0x26, 0x05, 0xb4, 0x9a, 0xd3, 0x6b, 0xd7, 0x7d, 0x6e, 0xf9, 0xb0, 0x6b, 0x21,
+	0x6f, 0xcc, 0x1f, 0x2c, 0x19, 0xef, 0x10, 0x9e, 0x62, 0x69, 0x1c, 0xc5, 0x94, 0xb4, 0x5b, 0x7a,
+	0x53, 0xb5, 0x62, 0xbd, 0xd4, 0x18, 0x5b, 0x9c, 0xcb, 0xf4, 0x7a, 0xf5, 0x7d, 0xbd, 0x15, 0x16,
+	0x53, 0x77, 0xed, 0xa0, 0x6b, 0x15, 0x4e, 0xba, 0xd6, 0xad, 0x5d, 0x92, 0xb4, 0x97, 0xec, 0xbc,
+	0x80, 0xfd, 0xe9, 0x9b, 0xd5, 0x88, 0x62, 0xb1, 0xd9, 0xf1, 0x9d, 0x80, 0x25, 0x4d, 0xbd, 0x4b,
+	0xf5, 0x71, 0x97, 0x87, 0x5b, 0x4d, 0xb1, 0xbb, 0x0d, 0x5c, 0x6a, 0x71, 0x6f, 0x32, 0x6b, 0xd7,
+	0xbb, 0x34, 0xf6, 0x10, 0x9e, 0x08, 0xa1, 0x0d, 0x11, 0x11, 0x10, 0xb6, 0x36, 0x52, 0x80, 0x5a,
+	0x69, 0x18, 0xd1, 0xaa, 0x26, 0x9a, 0x55, 0x44, 0x67, 0xdb, 0x2f, 0xc6, 0x33, 0xde, 0x6f, 0x7e,
+	0x9c, 0x02, 0x18, 0xef, 0x11, 0x9e, 0x1e, 0xc8, 0x65, 0x47, 0x54, 0x1e, 0x06, 0xb4, 0xae, 0x81,
+	0x6a, 0x79, 0xa0, 0x4b, 0x9d, 0xd1, 0x54, 0xbf, 0x3f, 0x3b, 0x24, 0x07, 0x8f, 0x00, 0x0d, 0x5b,
+	0x22, 0x4e, 0xa0, 0x76, 0xad, 0x8e, 0x1a, 0x25, 0xf7, 0xe6, 0x49, 0xd7, 0x9a, 0x54, 0x4f, 0xcb,
+	0x32, 0xb6, 0x77, 0x1d, 0x68, 0xf8, 0x34, 0x4e, 0x60, 0x69, 0xe4, 0xcd, 0xbe, 0x55, 0xf8, 0xb0,
+	0x6f, 0x15, 0xec, 0xcf, 0x08, 0xd7, 0x56, 0x18, 0x15, 0x31, 0xed, 0xb0, 0x0e, 0xcf, 0x59, 0xcb,
+	0xc7, 0x33, 0xd2, 0x5a, 0x9a, 0x32, 0x67, 0xb1, 0x3b, 0xce, 0xaf, 0xed, 0xef, 0x9c, 0x37, 0xa9,
+	0x36, 0x9b, 0xe1, 0x9f, 0xb7, 0xef, 0x03, 0x8c, 0xb9, 0x20, 0xa9, 0x50, 0xf0, 0x45, 0x09, 0x3f,
+	0x7b, 0xd2, 0xb5, 0xa6, 0x15, 0xfc, 0x20, 0x67, 0x7b, 0xa3, 0x32, 0xc8, 0x6d, 0xe0, 0x15, 0xc2,
+	0xb3, 0x8f, 0xa0, 0x4d, 0x76, 0x21, 0xcc, 0x29, 0xff, 0x03, 0xfa, 0x53, 0x1c, 0x7b, 0x08, 0x57,
+	0x9e, 0x40, 0x1a, 0xb3, 0xd0, 0xa8, 0xe2, 0x4a, 0x1b, 0x68, 0x24, 0x36, 0xe5, 0xa3, 0x4a, 0x9e,
+	0x8e, 0x8c, 0x00, 0x57, 0x48, 0x22, 0x11, 0x86, 0xde, 0xa9, 0x7b, 0x3d, 0xc3, 0x5c, 0xc8, 0x14,
+	0x5a, 0x7a, 0xa9, 0x2c, 0x69, 0x3e, 0x16, 0x71, 0x55, 0xd1, 0xc4, 0xc1, 0xff, 0x32, 0x54, 0x23,
+	0xc2, 0x93, 0x19, 0xd4, 0xb6, 0x64, 0xe7, 0xfa, 0xaa, 0x9b, 0xbf, 0x83, 0x52, 0x5b, 0x74, 0x4d,
+	0x7d, 0xbd, 0xaa, 0x4a, 0x3e, 0x27, 0x62, 0x7b, 0x13, 0x7a, 0x45, 0x95, 0xf3, 0x53, 0x53, 0x7b,
+	0x8d, 0xe4, 0x39, 0x25, 0x84, 0x02, 0x15, 0xeb, 0x2c, 0xd8, 0x82, 0xf0, 0x6a, 0xec, 0xf3, 0xa3,
+	0x88, 0xab, 0x2b, 0x6d, 0xf2, 0xc2, 0x27, 0xc1, 0xd6, 0x15, 0x0c, 0xec, 0x21, 0x9e, 0xd8, 0xe8,
+	0xd0, 0x10, 0xd2, 0x16, 0x09, 0xc3, 0x14, 0x38, 0x97, 0x43, 0x1b, 0x75, 0xe7, 0x06, 0xbf, 0xa2,
+	0x67, 0xf3, 0xb6, 0x37, 0xae, 0x16, 0x96, 0x55, 0x9c, 0x1b, 0x79, 0xe9, 0xf2, 0x23, 0x2f, 0xff,
+	0xdd, 0x91, 0xbb, 0x6b, 0x07, 0x47, 0x26, 0x3a, 0x3c, 0x32, 0xd1, 0xf7, 0x23, 0x13, 0xbd, 0x3d,
+	0x36, 0x0b, 0x87, 0xc7, 0x66, 0xe1, 0xeb, 0xb1, 0x59, 0x78, 0xbe, 0xf0, 0xc7, 0xcb, 0xf6, 0x52,
+	0xbf, 0x98, 0xf5, 0x3f, 0x02, 0x79, 0xf7, 0xfc, 0x8a, 0x7c, 0x35, 0xdf, 0xff, 0x39, 0x00, 0x40,
+	0xb1, 0xfd, 0x0c, 0x30, 0x08, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -623,6 +669,67 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -750,6 +857,32 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1482,6 +1615,177 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
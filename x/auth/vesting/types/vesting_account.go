@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -174,6 +176,7 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -644,6 +647,172 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, periods Periods,
+) *ClawbackVestingAccount {
+	endTime := startTime
+	for _, p := range periods {
+		endTime += p.Length
+	}
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// periodic returns the periodic vesting account following the same vesting
+// schedule as the clawback vesting account. It shares the base vesting account
+// and the vesting periods of the clawback vesting account.
+func (va ClawbackVestingAccount) periodic() *PeriodicVestingAccount {
+	return NewPeriodicVestingAccountRaw(va.BaseVestingAccount, va.StartTime, va.VestingPeriods)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return va.periodic().GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// AddOriginalVesting increases the original vesting spreading the amount according to the
+// vesting account strategy, which is the same as for the periodic vesting.
+func (va *ClawbackVestingAccount) AddOriginalVesting(amount sdk.Coins) {
+	pva := va.periodic()
+	pva.AddOriginalVesting(amount)
+	va.VestingPeriods = pva.VestingPeriods
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// GetFunder returns the address of the funder of the clawback vesting account.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(va.FunderAddress)
+	return funder
+}
+
+// Clawback removes from the account the coins that are still vesting at the
+// given block time by truncating its vesting schedule to the elapsed periods,
+// so that the account is fully vested afterwards. The delegated coins that
+// have been transferred away from the account with the clawback are removed
+// from the delegation tracking and the remaining delegated coins become free.
+func (va *ClawbackVestingAccount) Clawback(blockTime time.Time, transferred sdk.Coins) {
+	va.TrackUndelegation(transferred)
+	va.DelegatedFree = va.DelegatedFree.Add(va.DelegatedVesting...)
+	va.DelegatedVesting = sdk.NewCoins()
+
+	// keep the periods elapsed by the block time
+	endTime := va.StartTime
+	originalVesting := sdk.NewCoins()
+	var periods Periods
+	for _, period := range va.VestingPeriods {
+		if blockTime.Unix() < endTime+period.Length {
+			break
+		}
+
+		endTime += period.Length
+		originalVesting = originalVesting.Add(period.Amount...)
+		periods = append(periods, period)
+	}
+
+	va.OriginalVesting = originalVesting
+	va.EndTime = endTime
+	va.VestingPeriods = periods
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	endTime := va.StartTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range va.VestingPeriods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+	if endTime != va.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !originalVesting.IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+	}
+	return marshalYaml(out)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
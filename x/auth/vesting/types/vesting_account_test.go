@@ -750,6 +750,72 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require 50% of coins vested after period 1
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(15*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestingCoins(now.Add(15*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, va.GetVestedCoins(endTime))
+	require.Empty(t, va.GetVestingCoins(endTime))
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, funder, va.GetFunder())
+
+	// delegate 60stake, of which 50stake are vesting after the first period
+	blockTime := now.Add(15 * time.Hour)
+	va.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, va.LockedCoins(blockTime))
+
+	// claw back after the first period, 40stake of the delegations being transferred
+	va.Clawback(blockTime, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.OriginalVesting)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.EndTime)
+	require.Equal(t, []types.Period(periods[:1]), va.VestingPeriods)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, va.DelegatedFree)
+	require.Empty(t, va.DelegatedVesting)
+	require.Empty(t, va.GetVestingCoins(blockTime))
+	require.Empty(t, va.LockedCoins(blockTime))
+	require.NoError(t, va.Validate())
+
+	// clawing back before the start of the schedule removes all the coins
+	bacc, origCoins = initBaseAccount()
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	va.Clawback(now.Add(-time.Hour), nil)
+	require.Empty(t, va.OriginalVesting)
+	require.Empty(t, va.VestingPeriods)
+	require.Equal(t, now.Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -815,6 +881,24 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}},
+			},
+			true,
+		},
+		{
+			"invalid clawback vesting period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -894,6 +978,24 @@ func TestPermanentLockedAccountMarshal(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -6,6 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// GasCostPerIteration is the gas charged by the Accept method of an
+// Authorization for each item of its allow lists it checks.
+const GasCostPerIteration = uint64(10)
+
 // Authorization represents the interface of various Authorization types implemented
 // by other modules.
 type Authorization interface {
@@ -24,6 +28,18 @@ type Authorization interface {
 	ValidateBasic() error
 }
 
+// ExclusiveAuthorization is implemented by the authorizations which cannot be
+// granted to a grantee who is also granted some other message types by the
+// same granter, because these messages would let the grantee bypass the
+// restrictions of the authorization.
+type ExclusiveAuthorization interface {
+	Authorization
+
+	// ConflictingMsgTypeURLs returns the Msg service method URLs which cannot be
+	// granted to the grantee of the authorization.
+	ConflictingMsgTypeURLs() []string
+}
+
 // AcceptResponse instruments the controller of an authz message if the request is accepted
 // and if it should be updated or deleted.
 type AcceptResponse struct {
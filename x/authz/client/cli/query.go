@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 
 	authorizationQueryCmd.AddCommand(
 		GetCmdQueryGrants(),
+		GetCmdQueryGranterGrants(),
+		GetCmdQueryGranteeGrants(),
 	)
 
 	return authorizationQueryCmd
@@ -91,3 +93,103 @@ $ %s query %s grants cosmos1skjw.. cosmos1skjwj.. %s
 	flags.AddPaginationFlagsToCmd(cmd, "grants")
 	return cmd
 }
+
+// GetCmdQueryGranterGrants returns cmd to query for all grants for a granter.
+func GetCmdQueryGranterGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grants-by-granter [granter-addr]",
+		Aliases: []string{"granter-grants"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query authorization grants granted by granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants granted by granter.
+Examples:
+$ %s q %s grants-by-granter cosmos1skj..
+`,
+				version.AppName, authz.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.GranterGrants(
+				cmd.Context(),
+				&authz.QueryGranterGrantsRequest{
+					Granter:    granter.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "granter-grants")
+	return cmd
+}
+
+// GetCmdQueryGranteeGrants returns cmd to query for all grants for a grantee.
+func GetCmdQueryGranteeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grants-by-grantee [grantee-addr]",
+		Aliases: []string{"grantee-grants"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "query authorization grants granted to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants granted to a grantee.
+Examples:
+$ %s q %s grants-by-grantee cosmos1skj..
+`,
+				version.AppName, authz.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.GranteeGrants(
+				cmd.Context(),
+				&authz.QueryGranteeGrantsRequest{
+					Grantee:    grantee.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
+	return cmd
+}
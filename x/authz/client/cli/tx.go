@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +17,9 @@ import (
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distribution "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -26,6 +30,14 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagPeriod            = "period"
+	FlagPeriodLimit       = "period-limit"
+	FlagAllowList         = "allow-list"
+	FlagProposalIDs       = "proposal-ids"
+	FlagVoteOptions       = "vote-options"
+	periodicSend          = "periodic-send"
+	vote                  = "vote"
+	withdrawRewards       = "withdraw-rewards"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -53,15 +65,19 @@ func GetTxCmd() *cobra.Command {
 
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"periodic-send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"|\"vote\"|\"withdraw-rewards\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`grant authorization to an address to execute a transaction on your behalf:
 
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. periodic-send --period=86400 --period-limit=100stake --allow-list=cosmos1v9x.. --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. vote --proposal-ids=1,2 --vote-options=yes,abstain --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. withdraw-rewards --allowed-validators=cosmosvaloper1.. --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -98,6 +114,54 @@ Examples:
 				}
 
 				authorization = bank.NewSendAuthorization(spendLimit)
+			case periodicSend:
+				period, err := cmd.Flags().GetInt64(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				if period <= 0 {
+					return fmt.Errorf("period should be greater than zero")
+				}
+
+				periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+				if err != nil {
+					return err
+				}
+
+				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+				if err != nil {
+					return err
+				}
+
+				if !periodLimit.IsAllPositive() {
+					return fmt.Errorf("period-limit should be greater than zero")
+				}
+
+				limit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				spendLimit, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
+				if err != nil {
+					return err
+				}
+
+				allowed := make([]sdk.AccAddress, len(allowList))
+				for i, addr := range allowList {
+					allowed[i], err = sdk.AccAddressFromBech32(addr)
+					if err != nil {
+						return err
+					}
+				}
+
+				authorization = bank.NewPeriodicSendAuthorization(time.Duration(period)*time.Second, periodLimit, spendLimit, allowed)
 			case "generic":
 				msgType, err := cmd.Flags().GetString(FlagMsgType)
 				if err != nil {
@@ -105,6 +169,46 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case vote:
+				proposalIDsVal, err := cmd.Flags().GetStringSlice(FlagProposalIDs)
+				if err != nil {
+					return err
+				}
+
+				proposalIDs := make([]uint64, len(proposalIDsVal))
+				for i, id := range proposalIDsVal {
+					proposalIDs[i], err = strconv.ParseUint(id, 10, 64)
+					if err != nil {
+						return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", id)
+					}
+				}
+
+				optionsVal, err := cmd.Flags().GetStringSlice(FlagVoteOptions)
+				if err != nil {
+					return err
+				}
+
+				options := make([]gov.VoteOption, len(optionsVal))
+				for i, option := range optionsVal {
+					options[i], err = gov.VoteOptionFromString(govutils.NormalizeVoteOption(option))
+					if err != nil {
+						return err
+					}
+				}
+
+				authorization = gov.NewVoteAuthorization(proposalIDs, options)
+			case withdrawRewards:
+				allowValidators, err := cmd.Flags().GetStringSlice(FlagAllowedValidators)
+				if err != nil {
+					return err
+				}
+
+				allowed, err := bech32toValidatorAddresses(allowValidators)
+				if err != nil {
+					return err
+				}
+
+				authorization = distribution.NewWithdrawRewardsAuthorization(allowed)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -171,6 +275,11 @@ Examples:
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period in seconds for Periodic Send Authorization, after which the period limit is reset")
+	cmd.Flags().String(FlagPeriodLimit, "", "Period limit for Periodic Send Authorization, an array of Coins allowed spend in every period")
+	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed recipient addresses for Periodic Send Authorization separated by ,")
+	cmd.Flags().StringSlice(FlagProposalIDs, []string{}, "Proposal IDs for Vote Authorization separated by ,")
+	cmd.Flags().StringSlice(FlagVoteOptions, []string{}, "Vote options for Vote Authorization separated by ,")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/spf13/cobra"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -180,3 +181,103 @@ func (s *IntegrationTestSuite) TestQueryAuthorization() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryGranterAndGranteeGrants() {
+	val := s.network.Validators[0]
+	grantee := s.grantee
+	twoHours := time.Now().Add(time.Minute * time.Duration(120)).Unix()
+
+	_, err := ExecGrant(
+		val,
+		[]string{
+			grantee.String(),
+			"generic",
+			fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgVote),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		},
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		cmd       func() *cobra.Command
+		args      []string
+		expectErr bool
+		expErrMsg string
+		check     func(grant *authz.GrantAuthorization) bool
+	}{
+		{
+			"Error: Invalid granter",
+			cli.GetCmdQueryGranterGrants,
+			[]string{
+				"invalid granter",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"decoding bech32 failed: invalid character in string: ' '",
+			nil,
+		},
+		{
+			"Valid granter query",
+			cli.GetCmdQueryGranterGrants,
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			func(grant *authz.GrantAuthorization) bool {
+				return grant.Granter == val.Address.String()
+			},
+		},
+		{
+			"Error: Invalid grantee",
+			cli.GetCmdQueryGranteeGrants,
+			[]string{
+				"invalid grantee",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"decoding bech32 failed: invalid character in string: ' '",
+			nil,
+		},
+		{
+			"Valid grantee query",
+			cli.GetCmdQueryGranteeGrants,
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			func(grant *authz.GrantAuthorization) bool {
+				return grant.Grantee == grantee.String()
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+			resp, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				var grants authz.QueryGranterGrantsResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(resp.Bytes(), &grants)
+				s.Require().NoError(err)
+				s.Require().NotEmpty(grants.Grants)
+				for _, grant := range grants.Grants {
+					s.Require().True(tc.check(grant))
+				}
+			}
+		})
+	}
+}
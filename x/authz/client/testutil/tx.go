@@ -229,6 +229,82 @@ func (s *IntegrationTestSuite) TestCLITxGrantAuthorization() {
 			0,
 			false,
 		},
+		{
+			"invalid periodic send authorization period",
+			[]string{
+				grantee.String(),
+				"periodic-send",
+				fmt.Sprintf("--%s=100stake", cli.FlagPeriodLimit),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			},
+			0,
+			true,
+		},
+		{
+			"Valid tx periodic send authorization",
+			[]string{
+				grantee.String(),
+				"periodic-send",
+				fmt.Sprintf("--%s=3600", cli.FlagPeriod),
+				fmt.Sprintf("--%s=100stake", cli.FlagPeriodLimit),
+				fmt.Sprintf("--%s=1000stake", cli.FlagSpendLimit),
+				fmt.Sprintf("--%s=%s", cli.FlagAllowList, val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			0,
+			false,
+		},
+		{
+			"invalid vote authorization option",
+			[]string{
+				grantee.String(),
+				"vote",
+				fmt.Sprintf("--%s=1", cli.FlagProposalIDs),
+				fmt.Sprintf("--%s=invalid", cli.FlagVoteOptions),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			},
+			0,
+			true,
+		},
+		{
+			"Valid tx vote authorization",
+			[]string{
+				grantee.String(),
+				"vote",
+				fmt.Sprintf("--%s=1,2", cli.FlagProposalIDs),
+				fmt.Sprintf("--%s=yes,abstain", cli.FlagVoteOptions),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			0,
+			false,
+		},
+		{
+			"Valid tx withdraw rewards authorization",
+			[]string{
+				grantee.String(),
+				"withdraw-rewards",
+				fmt.Sprintf("--%s=%s", cli.FlagAllowedValidators, val.ValAddress.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			0,
+			false,
+		},
 		{
 			"Valid tx send authorization",
 			[]string{
@@ -90,6 +90,113 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 	}, nil
 }
 
+// GranterGrants implements the Query/GranterGrants gRPC method.
+func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	authzStore := prefix.NewStore(store, granterStoreKey(granter))
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.Paginate(authzStore, req.Pagination, func(key []byte, value []byte) error {
+		grant, err := unmarshalAuthorization(k.cdc, value)
+		if err != nil {
+			return err
+		}
+
+		authorizationAny, err := grantAuthorizationAny(grant)
+		if err != nil {
+			return err
+		}
+
+		grantee := firstAddressFromGrantStoreKey(key)
+		grants = append(grants, &authz.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Authorization: authorizationAny,
+			Expiration:    grant.Expiration,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranterGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GranteeGrants implements the Query/GranteeGrants gRPC method.
+// Grants are keyed by granter first, so all grants are iterated and filtered
+// by grantee.
+func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRequest) (*authz.QueryGranteeGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), granteeIndexPrefix(grantee))
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		granter, msgType := granterAndMsgTypeFromGranteeIndexKey(key)
+		grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+		if !found {
+			return status.Errorf(codes.Internal, "grant of %s to %s for %s not found", granter, grantee, msgType)
+		}
+
+		authorizationAny, err := grantAuthorizationAny(grant)
+		if err != nil {
+			return err
+		}
+
+		grants = append(grants, &authz.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Authorization: authorizationAny,
+			Expiration:    grant.Expiration,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranteeGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// grantAuthorizationAny packs the authorization of a grant into an Any.
+func grantAuthorizationAny(grant authz.Grant) (*codectypes.Any, error) {
+	msg, ok := grant.GetAuthorization().(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "can't protomarshal %T", grant.GetAuthorization())
+	}
+
+	authorizationAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return authorizationAny, nil
+}
+
 // unmarshal an authorization from a store value
 func unmarshalAuthorization(cdc codec.BinaryCodec, value []byte) (v authz.Grant, err error) {
 	err = cdc.Unmarshal(value, &v)
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -162,3 +163,145 @@ func (suite *TestSuite) TestGRPCQueryAuthorizations() {
 		})
 	}
 }
+
+func (suite *TestSuite) TestGRPCQueryGranterGrants() {
+	require := suite.Require()
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	now := ctx.BlockHeader().Time
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	authorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], authorization, now.Add(time.Hour)))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[1], authorization, now.Add(time.Hour)))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, now.Add(time.Hour)))
+
+	testCases := []struct {
+		msg      string
+		req      *authz.QueryGranterGrantsRequest
+		expError bool
+		numItems int
+	}{
+		{
+			"fail invalid granter addr",
+			&authz.QueryGranterGrantsRequest{},
+			true,
+			0,
+		},
+		{
+			"valid case, no grants",
+			&authz.QueryGranterGrantsRequest{Granter: addrs[2].String()},
+			false,
+			0,
+		},
+		{
+			"valid case, multiple grants",
+			&authz.QueryGranterGrantsRequest{Granter: addrs[1].String()},
+			false,
+			2,
+		},
+		{
+			"valid case, pagination",
+			&authz.QueryGranterGrantsRequest{
+				Granter:    addrs[1].String(),
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			false,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.GranterGrants(gocontext.Background(), tc.req)
+			if tc.expError {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Len(res.Grants, tc.numItems)
+			for _, grant := range res.Grants {
+				require.Equal(tc.req.Granter, grant.Granter)
+				var auth authz.Authorization
+				require.NoError(app.InterfaceRegistry().UnpackAny(grant.Authorization, &auth))
+				require.Equal(authorization.String(), auth.String())
+			}
+		})
+	}
+}
+
+func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
+	require := suite.Require()
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	now := ctx.BlockHeader().Time
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	authorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], authorization, now.Add(time.Hour)))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[2], authorization, now.Add(time.Hour)))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, now.Add(time.Hour)))
+
+	testCases := []struct {
+		msg      string
+		req      *authz.QueryGranteeGrantsRequest
+		expError bool
+		numItems int
+	}{
+		{
+			"fail invalid grantee addr",
+			&authz.QueryGranteeGrantsRequest{},
+			true,
+			0,
+		},
+		{
+			"valid case, no grants",
+			&authz.QueryGranteeGrantsRequest{Grantee: addrs[2].String()},
+			false,
+			0,
+		},
+		{
+			"valid case, multiple grants",
+			&authz.QueryGranteeGrantsRequest{Grantee: addrs[0].String()},
+			false,
+			2,
+		},
+		{
+			"valid case, pagination",
+			&authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			false,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.GranteeGrants(gocontext.Background(), tc.req)
+			if tc.expError {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Len(res.Grants, tc.numItems)
+			for _, grant := range res.Grants {
+				require.Equal(tc.req.Grantee, grant.Grantee)
+				var auth authz.Authorization
+				require.NoError(app.InterfaceRegistry().UnpackAny(grant.Authorization, &auth))
+				require.Equal(authorization.String(), auth.String())
+			}
+		})
+	}
+
+	// revoked and pruned grants are removed from the grantee index
+	require.NoError(app.AuthzKeeper.DeleteGrant(ctx, addrs[0], addrs[1], authorization.MsgTypeURL()))
+	res, err := queryClient.GranteeGrants(gocontext.Background(), &authz.QueryGranteeGrantsRequest{Grantee: addrs[0].String()})
+	require.NoError(err)
+	require.Len(res.Grants, 1)
+	require.Equal(addrs[2].String(), res.Grants[0].Granter)
+
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(now.Add(2*time.Hour)), 10))
+	res, err = queryClient.GranteeGrants(gocontext.Background(), &authz.QueryGranteeGrantsRequest{Grantee: addrs[0].String()})
+	require.NoError(err)
+	require.Empty(res.Grants)
+}
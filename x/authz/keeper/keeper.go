@@ -137,9 +137,20 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 		return err
 	}
 
+	if err := k.checkConflictingGrants(ctx, grantee, granter, authorization); err != nil {
+		return err
+	}
+
 	bz := k.cdc.MustMarshal(&grant)
-	skey := grantStoreKey(grantee, granter, authorization.MsgTypeURL())
+	msgType := authorization.MsgTypeURL()
+	skey := grantStoreKey(grantee, granter, msgType)
+	if oldGrant, found := k.getGrant(ctx, skey); found {
+		store.Delete(grantQueueKey(oldGrant.Expiration, grantee, granter, msgType))
+	}
+
 	store.Set(skey, bz)
+	store.Set(grantQueueKey(expiration, grantee, granter, msgType), []byte{0})
+	store.Set(granteeIndexKey(grantee, granter, msgType), []byte{0})
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
 		Granter:    granter.String(),
@@ -147,16 +158,59 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 	})
 }
 
+// checkConflictingGrants returns an error if the authorization conflicts with
+// one of the unexpired grants of the granter to the grantee, either because it
+// is an ExclusiveAuthorization of which the grant is a conflicting message type
+// or because the grant is an ExclusiveAuthorization of which the authorization
+// is a conflicting message type.
+func (k Keeper) checkConflictingGrants(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization) error {
+	msgType := authorization.MsgTypeURL()
+	conflicting := make(map[string]bool)
+	if exclusive, ok := authorization.(authz.ExclusiveAuthorization); ok {
+		for _, conflictingMsgType := range exclusive.ConflictingMsgTypeURLs() {
+			conflicting[conflictingMsgType] = true
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, grantStoreKey(grantee, granter, ""))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant authz.Grant
+		k.cdc.MustUnmarshal(iter.Value(), &grant)
+		if grant.Expiration.Before(ctx.BlockTime()) {
+			continue
+		}
+
+		existing := grant.GetAuthorization()
+		if conflicting[existing.MsgTypeURL()] {
+			return sdkerrors.Wrapf(authz.ErrConflictingGrant, "%s cannot be granted along with %s", msgType, existing.MsgTypeURL())
+		}
+
+		if exclusive, ok := existing.(authz.ExclusiveAuthorization); ok {
+			for _, conflictingMsgType := range exclusive.ConflictingMsgTypeURLs() {
+				if conflictingMsgType == msgType {
+					return sdkerrors.Wrapf(authz.ErrConflictingGrant, "%s cannot be granted along with %s", msgType, existing.MsgTypeURL())
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 // DeleteGrant revokes any authorization for the provided message type granted to the grantee
 // by the granter.
 func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
 	store := ctx.KVStore(k.storeKey)
 	skey := grantStoreKey(grantee, granter, msgType)
-	_, found := k.getGrant(ctx, skey)
+	grant, found := k.getGrant(ctx, skey)
 	if !found {
 		return sdkerrors.ErrNotFound.Wrap("authorization not found")
 	}
 	store.Delete(skey)
+	store.Delete(grantQueueKey(grant.Expiration, grantee, granter, msgType))
+	store.Delete(granteeIndexKey(grantee, granter, msgType))
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
@@ -164,6 +218,41 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	})
 }
 
+// DequeueAndDeleteExpiredGrants deletes the grants expired before the block
+// time, up to limit grants in the order of their expiration, and emits an
+// EventPruneGrant for each of them. The remaining expired grants are left in
+// the queue for the next calls.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context, limit int) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(GrantQueuePrefix, grantQueueTimePrefix(ctx.BlockHeader().Time))
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		skey, msgType := grantStoreKeyFromQueueKey(key)
+		granter, grantee := addressesFromGrantStoreKey(skey)
+
+		store.Delete(skey)
+		store.Delete(key)
+		store.Delete(granteeIndexKey(grantee, granter, msgType))
+
+		err := ctx.EventManager().EmitTypedEvent(&authz.EventPruneGrant{
+			MsgTypeUrl: msgType,
+			Granter:    granter.String(),
+			Grantee:    grantee.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) (authorizations []authz.Authorization) {
 	store := ctx.KVStore(k.storeKey)
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
@@ -13,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 var bankSendAuthMsgType = banktypes.SendAuthorization{}.MsgTypeURL()
@@ -247,6 +249,96 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDequeueAndDeleteExpiredGrants() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	now := s.ctx.BlockHeader().Time
+
+	sendAuthz := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+	msgTypes := []string{
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+		sdk.MsgTypeURL(&authz.MsgGrant{}),
+		sdk.MsgTypeURL(&authz.MsgRevoke{}),
+	}
+	for i, msgType := range msgTypes {
+		err := app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authz.NewGenericAuthorization(msgType), now.Add(time.Duration(i+1)*time.Hour))
+		require.NoError(err)
+	}
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, sendAuthz, now.Add(time.Hour)))
+
+	// extending a grant moves it in the queue
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, sendAuthz, now.Add(10*time.Hour)))
+	// a revoked grant is removed from the queue
+	require.NoError(app.AuthzKeeper.DeleteGrant(s.ctx, granteeAddr, granterAddr, msgTypes[2]))
+
+	// grants expiring at the block time are not pruned
+	ctx := s.ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 10))
+	require.Len(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr), 3)
+	require.Empty(ctx.EventManager().Events())
+
+	// the work is bounded by the limit
+	ctx = s.ctx.WithBlockTime(now.Add(5 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 1))
+	require.Len(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr), 2)
+
+	events := ctx.EventManager().Events()
+	require.Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(err)
+	require.Equal(&authz.EventPruneGrant{
+		MsgTypeUrl: msgTypes[0],
+		Granter:    granterAddr.String(),
+		Grantee:    granteeAddr.String(),
+	}, event)
+
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 1))
+	authorizations := app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	require.Len(authorizations, 1)
+	require.Equal(bankSendAuthMsgType, authorizations[0].MsgTypeURL())
+
+	// the queue holds no other grant expired before the block time
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 10))
+	require.Len(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr), 1)
+	require.Len(ctx.EventManager().Events(), 2)
+}
+
+func (s *TestSuite) TestConflictingGrants() {
+	app, addrs, require := s.app, s.addrs, s.Require()
+	granterAddr := addrs[0]
+	now := s.ctx.BlockHeader().Time
+
+	withdrawAuthz := distrtypes.NewWithdrawRewardsAuthorization(nil)
+	setWithdrawAddrAuthz := authz.NewGenericAuthorization(sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}))
+
+	// MsgSetWithdrawAddress cannot be granted along with WithdrawRewardsAuthorization
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[1], granterAddr, withdrawAuthz, now.Add(time.Hour)))
+	err := app.AuthzKeeper.SaveGrant(s.ctx, addrs[1], granterAddr, setWithdrawAddrAuthz, now.Add(time.Hour))
+	require.ErrorIs(err, authz.ErrConflictingGrant)
+
+	// and the other way around
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[2], granterAddr, setWithdrawAddrAuthz, now.Add(time.Hour)))
+	err = app.AuthzKeeper.SaveGrant(s.ctx, addrs[2], granterAddr, withdrawAuthz, now.Add(time.Hour))
+	require.ErrorIs(err, authz.ErrConflictingGrant)
+
+	// the grants can be updated
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[1], granterAddr, withdrawAuthz, now.Add(2*time.Hour)))
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[2], granterAddr, setWithdrawAddrAuthz, now.Add(2*time.Hour)))
+
+	// the grants of other granters do not conflict
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[1], addrs[2], setWithdrawAddrAuthz, now.Add(time.Hour)))
+
+	// expired grants do not conflict
+	ctx := s.ctx.WithBlockTime(now.Add(3 * time.Hour))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], granterAddr, setWithdrawAddrAuthz, now.Add(4*time.Hour)))
+
+	// nor revoked ones
+	require.NoError(app.AuthzKeeper.DeleteGrant(ctx, addrs[2], granterAddr, setWithdrawAddrAuthz.MsgTypeURL()))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[2], granterAddr, withdrawAuthz, now.Add(4*time.Hour)))
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -9,7 +11,9 @@ import (
 
 // Keys for store prefixes
 var (
-	GrantKey = []byte{0x01} // prefix for each key
+	GrantKey         = []byte{0x01} // prefix for each key
+	GrantQueuePrefix = []byte{0x02} // prefix for the grant expiration queue
+	GranteeKeyPrefix = []byte{0x03} // prefix for the grantee index of grants
 )
 
 // StoreKey is the store key string for authz
@@ -34,6 +38,21 @@ func grantStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType strin
 	return key
 }
 
+// granterStoreKey - return the prefix of all grants given by the granter
+//
+// - 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes>
+func granterStoreKey(granter sdk.AccAddress) []byte {
+	return append(append([]byte{}, GrantKey...), address.MustLengthPrefix(granter)...)
+}
+
+// firstAddressFromGrantStoreKey - parse the first length prefixed address from
+// a grant store key without its prefix, e.g. the grantee of a key
+// iterated under granterStoreKey.
+func firstAddressFromGrantStoreKey(key []byte) sdk.AccAddress {
+	addrLen := key[0]
+	return sdk.AccAddress(key[1 : 1+addrLen])
+}
+
 // addressesFromGrantStoreKey - split granter & grantee address from the authorization key
 func addressesFromGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress) {
 	// key is of format:
@@ -45,3 +64,58 @@ func addressesFromGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAdd
 
 	return granterAddr, granteeAddr
 }
+
+// granteeIndexKey - return the key of a grant in the grantee index
+// Items are stored with the following key: values
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: 0x00
+func granteeIndexKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	// a grant store key with the addresses swapped has the layout of the index key
+	return append(append([]byte{}, GranteeKeyPrefix...), grantStoreKey(granter, grantee, msgType)[len(GrantKey):]...)
+}
+
+// granteeIndexPrefix - return the prefix of the grantee index entries of all
+// grants given to the grantee
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes>
+func granteeIndexPrefix(grantee sdk.AccAddress) []byte {
+	return append(append([]byte{}, GranteeKeyPrefix...), address.MustLengthPrefix(grantee)...)
+}
+
+// granterAndMsgTypeFromGranteeIndexKey - parse the granter and message type of
+// a grantee index key without its prefix, as iterated under granteeIndexPrefix.
+func granterAndMsgTypeFromGranteeIndexKey(key []byte) (granter sdk.AccAddress, msgType string) {
+	granterAddrLen := int(key[0])
+	granter = sdk.AccAddress(key[1 : 1+granterAddrLen])
+	msgType = string(key[1+granterAddrLen:])
+
+	return granter, msgType
+}
+
+// grantQueueKey - return the key of a grant in the expiration queue
+// Items are stored with the following key: values
+//
+// - 0x02<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: 0x00
+func grantQueueKey(expiration time.Time, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	return append(grantQueueTimePrefix(expiration), grantStoreKey(grantee, granter, msgType)[len(GrantKey):]...)
+}
+
+// grantQueueTimePrefix - return the prefix of the grants expiring at the given time
+//
+// - 0x02<expiration_Bytes>
+func grantQueueTimePrefix(expiration time.Time) []byte {
+	return append(append([]byte{}, GrantQueuePrefix...), sdk.FormatTimeBytes(expiration)...)
+}
+
+// grantStoreKeyFromQueueKey - return the grant store key of a grant expiration
+// queue key, along with the message type of the grant
+func grantStoreKeyFromQueueKey(key []byte) (skey []byte, msgType string) {
+	lenTime := len(sdk.FormatTimeBytes(time.Time{}))
+	skey = append(append([]byte{}, GrantKey...), key[len(GrantQueuePrefix)+lenTime:]...)
+
+	granterAddrLen := int(skey[1])
+	granteeAddrLen := int(skey[2+granterAddrLen])
+	msgType = string(skey[3+granterAddrLen+granteeAddrLen:])
+
+	return skey, msgType
+}
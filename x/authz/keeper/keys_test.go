@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -24,3 +25,24 @@ func TestGrantkey(t *testing.T) {
 	require.Equal(granter, granter1)
 	require.Equal(grantee, grantee1)
 }
+
+func TestGrantQueueKey(t *testing.T) {
+	require := require.New(t)
+	expiration := time.Now().UTC()
+	key := grantQueueKey(expiration, grantee, granter, msgType)
+	require.Equal(grantQueueTimePrefix(expiration), key[:len(grantQueueTimePrefix(expiration))])
+
+	skey, msgType1 := grantStoreKeyFromQueueKey(key)
+	require.Equal(grantStoreKey(grantee, granter, msgType), skey)
+	require.Equal(msgType, msgType1)
+}
+
+func TestGranteeIndexKey(t *testing.T) {
+	require := require.New(t)
+	key := granteeIndexKey(grantee, granter, msgType)
+	require.Equal(granteeIndexPrefix(grantee), key[:len(granteeIndexPrefix(grantee))])
+
+	granter1, msgType1 := granterAndMsgTypeFromGranteeIndexKey(key[len(granteeIndexPrefix(grantee)):])
+	require.Equal(granter, granter1)
+	require.Equal(msgType, msgType1)
+}
@@ -0,0 +1,41 @@
+package v045
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// GrantPrefix is the prefix of the grants in the authz store
+	GrantPrefix = []byte{0x01}
+
+	// GrantQueuePrefix is the prefix of the grant expiration queue
+	GrantQueuePrefix = []byte{0x02}
+
+	// GranteeIndexPrefix is the prefix of the grantee index of the grants
+	GranteeIndexPrefix = []byte{0x03}
+)
+
+// GrantQueueKey returns the key of a grant in the expiration queue, from the
+// store key of the grant stripped of its GrantPrefix:
+//
+// - 0x02<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>
+func GrantQueueKey(expiration time.Time, grantKey []byte) []byte {
+	key := append(append([]byte{}, GrantQueuePrefix...), sdk.FormatTimeBytes(expiration)...)
+	return append(key, grantKey...)
+}
+
+// GranteeIndexKey returns the key of a grant in the grantee index, from the
+// store key of the grant stripped of its GrantPrefix:
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>
+func GranteeIndexKey(grantKey []byte) []byte {
+	granterEnd := 1 + int(grantKey[0])
+	granteeEnd := granterEnd + 1 + int(grantKey[granterEnd])
+
+	key := append([]byte{}, GranteeIndexPrefix...)
+	key = append(key, grantKey[granterEnd:granteeEnd]...)
+	key = append(key, grantKey[:granterEnd]...)
+	return append(key, grantKey[granteeEnd:]...)
+}
@@ -0,0 +1,45 @@
+package v045
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Add the grants to the expiration queue, from which the expired grants are
+// pruned at the end of each block.
+// - Add the grants to the grantee index, used to query the grants given to a
+// grantee.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return addGrantQueueAndGranteeIndex(store, cdc)
+}
+
+// addGrantQueueAndGranteeIndex adds an entry to the expiration queue and to the
+// grantee index for every grant of the store.
+func addGrantQueueAndGranteeIndex(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	grantsStore := prefix.NewStore(store, GrantPrefix)
+
+	var keys [][]byte
+	iterator := grantsStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var grant authz.Grant
+		if err := cdc.Unmarshal(iterator.Value(), &grant); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		keys = append(keys, GrantQueueKey(grant.Expiration, iterator.Key()), GranteeIndexKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Set(key, []byte{0})
+	}
+
+	return nil
+}
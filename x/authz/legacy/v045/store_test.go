@@ -0,0 +1,76 @@
+package v045_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	v045authz "github.com/cosmos/cosmos-sdk/x/authz/legacy/v045"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	authzKey := app.GetKey(keeper.StoreKey)
+
+	sendAuthz := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	genericAuthz := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], sendAuthz, now.Add(time.Hour)))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], genericAuthz, now.Add(2*time.Hour)))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[2], sendAuthz, now.Add(2*time.Hour)))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[0], sendAuthz, now.Add(3*time.Hour)))
+
+	// remove the queue and the grantee index to get the store of a chain on the
+	// previous version
+	store := ctx.KVStore(authzKey)
+	queueKeys := deletePrefix(store, keeper.GrantQueuePrefix)
+	require.Len(t, queueKeys, 4)
+	indexKeys := deletePrefix(store, keeper.GranteeKeyPrefix)
+	require.Len(t, indexKeys, 4)
+
+	require.NoError(t, v045authz.MigrateStore(ctx, authzKey, app.AppCodec()))
+
+	require.Equal(t, queueKeys, deletePrefix(store, keeper.GrantQueuePrefix))
+	require.Equal(t, indexKeys, deletePrefix(store, keeper.GranteeKeyPrefix))
+	require.NoError(t, v045authz.MigrateStore(ctx, authzKey, app.AppCodec()))
+
+	// the migrated index is used to query the grants of a grantee
+	res, err := app.AuthzKeeper.GranteeGrants(sdk.WrapSDKContext(ctx), &authz.QueryGranteeGrantsRequest{Grantee: addrs[1].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Grants, 3)
+
+	// the migrated queue is used to prune the expired grants
+	ctx = ctx.WithBlockTime(now.Add(150 * time.Minute))
+	require.NoError(t, app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 10))
+	require.Empty(t, app.AuthzKeeper.GetAuthorizations(ctx, addrs[1], addrs[0]))
+	require.Len(t, app.AuthzKeeper.GetAuthorizations(ctx, addrs[2], addrs[0]), 1)
+
+	res, err = app.AuthzKeeper.GranteeGrants(sdk.WrapSDKContext(ctx), &authz.QueryGranteeGrantsRequest{Grantee: addrs[1].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Grants)
+}
+
+// deletePrefix deletes the keys of the store with the given prefix and returns
+// them in order.
+func deletePrefix(store sdk.KVStore, prefix []byte) [][]byte {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return keys
+}
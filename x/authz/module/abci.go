@@ -0,0 +1,24 @@
+package authz
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+)
+
+// MaxPrunedGrantsPerBlock is the maximum number of expired grants deleted at
+// the end of a block, bounding the work of the EndBlocker when many grants
+// expire at once.
+const MaxPrunedGrantsPerBlock = 200
+
+// EndBlocker is called at the end of every block, deletes the expired grants.
+func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(authz.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := keeper.DequeueAndDeleteExpiredGrants(ctx, MaxPrunedGrantsPerBlock); err != nil {
+		panic(err)
+	}
+}
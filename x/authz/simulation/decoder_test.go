@@ -26,6 +26,8 @@ func TestDecodeStore(t *testing.T) {
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(keeper.GrantKey), Value: grantBz},
+			{Key: []byte(keeper.GrantQueuePrefix), Value: []byte{0}},
+			{Key: []byte(keeper.GranteeKeyPrefix), Value: []byte{0}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -36,6 +38,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", false, fmt.Sprintf("%v\n%v", grant, grant)},
+		{"GrantQueue", false, fmt.Sprintf("%v\n%v", []byte{0}, []byte{0})},
+		{"GranteeIndex", false, fmt.Sprintf("%v\n%v", []byte{0}, []byte{0})},
 		{"other", true, ""},
 	}
 
@@ -0,0 +1,23 @@
+package bank
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MaxPrunedSupplyHistoryEntriesPerBlock is the maximum number of supply
+// history entries deleted at the end of a block, bounding the work of the
+// EndBlocker when the supply history window is lowered.
+const MaxPrunedSupplyHistoryEntriesPerBlock = 200
+
+// EndBlocker prunes the supply history entries that fall out of the supply
+// history window.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.PruneSupplyHistory(ctx, MaxPrunedSupplyHistoryEntriesPerBlock)
+}
@@ -14,7 +14,9 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom      = "denom"
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -31,8 +33,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetSpendableBalancesCmd(),
 		GetCmdQueryTotalSupply(),
+		GetCmdQuerySupplyHistory(),
 		GetCmdDenomsMetadata(),
+		GetCmdDenomOwners(),
 	)
 
 	return cmd
@@ -101,6 +106,167 @@ Example:
 	return cmd
 }
 
+// GetSpendableBalancesCmd defines the cobra command to query the spendable
+// balances of an account.
+func GetSpendableBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balances [address]",
+		Short: "Query for account spendable balances by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the spendable balances of an account, i.e. its balances minus the
+coins locked by vesting.
+
+Example:
+  $ %s query %s spendable-balances [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SpendableBalances(cmd.Context(), types.NewQuerySpendableBalancesRequest(addr, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "spendable balances")
+
+	return cmd
+}
+
+// GetCmdQuerySupplyHistory defines the cobra command to query the recorded
+// supply changes of a denomination.
+func GetCmdQuerySupplyHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-history [denom]",
+		Short: "Query the recorded supply changes of a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amounts of a coin denomination minted and burned at each height,
+together with the resulting supply. Only the heights within the supply history
+window of the bank params are recorded.
+
+Example:
+  $ %s query %s supply-history [denom]
+  $ %s query %s supply-history [denom] --from-height=100 --to-height=200
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyHistory(cmd.Context(), &types.QuerySupplyHistoryRequest{
+				Denom:      args[0],
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "The first height of the supply history to query for")
+	cmd.Flags().Int64(FlagToHeight, 0, "The last height of the supply history to query for, 0 for the latest")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "supply history")
+
+	return cmd
+}
+
+// GetCmdDenomOwners defines the cobra command to query all the accounts
+// holding a given denomination.
+func GetCmdDenomOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Short: "Query for all account addresses that own a particular token denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all account addresses that own a particular token denomination,
+together with their balance of that denomination.
+
+Example:
+  $ %s query %s denom-owners [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomOwners(cmd.Context(), &types.QueryDenomOwnersRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom owners")
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
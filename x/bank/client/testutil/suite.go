@@ -162,6 +162,113 @@ func (s *IntegrationTestSuite) TestGetBalancesCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetSpendableBalancesCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{"no address provided", []string{}, true, nil, nil},
+		{
+			"spendable account balances",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&types.QuerySpendableBalancesResponse{},
+			&types.QuerySpendableBalancesResponse{
+				Balances: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				),
+				Pagination: &query.PageResponse{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetSpendableBalancesCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdDenomOwners() {
+	val := s.network.Validators[0]
+	denom := fmt.Sprintf("%stoken", val.Moniker)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{"no denom provided", []string{}, true, nil, nil},
+		{
+			"invalid denom",
+			[]string{
+				"1invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true, nil, nil,
+		},
+		{
+			"owners of a denom",
+			[]string{
+				denom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&types.QueryDenomOwnersResponse{},
+			&types.QueryDenomOwnersResponse{
+				DenomOwners: []*types.DenomOwner{
+					{
+						Address: val.Address.String(),
+						Balance: sdk.NewCoin(denom, s.cfg.AccountTokens),
+					},
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdDenomOwners()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	val := s.network.Validators[0]
 
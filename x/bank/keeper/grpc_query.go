@@ -76,6 +76,41 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
 }
 
+// SpendableBalances implements a gRPC query handler for retrieving an account's
+// spendable balances.
+func (k BaseKeeper) SpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var denoms []string
+	accountStore := k.getAccountStore(sdkCtx, addr)
+
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key, _ []byte) error {
+		denoms = append(denoms, string(key))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	spendable := k.SpendableCoins(sdkCtx, addr)
+	balances := make(sdk.Coins, 0, len(denoms))
+	for _, denom := range denoms {
+		balances = append(balances, sdk.NewCoin(denom, spendable.AmountOf(denom)))
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
 // TotalSupply implements the Query/TotalSupply gRPC method
 func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -103,6 +138,45 @@ func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// SupplyHistory implements the Query/SupplyHistory gRPC method
+func (k BaseKeeper) SupplyHistory(c context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+
+	if req.ToHeight != 0 && req.ToHeight < req.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "to height %d is lower than from height %d", req.ToHeight, req.FromHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateSupplyHistoryPrefix(req.Denom))
+	rangeStore := newHeightRangeStore(historyStore, req.FromHeight, req.ToHeight)
+
+	var entries []types.SupplyHistoryEntry
+	pageRes, err := query.Paginate(rangeStore, req.Pagination, func(_, value []byte) error {
+		var entry types.SupplyHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySupplyHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -164,3 +238,38 @@ func (k BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetada
 		Metadata: metadata,
 	}, nil
 }
+
+// DenomOwners implements the Query/DenomOwners gRPC method, returning all the
+// accounts holding a non-zero balance of the given denomination.
+func (k BaseKeeper) DenomOwners(c context.Context, req *types.QueryDenomOwnersRequest) (*types.QueryDenomOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomPrefixStore := k.getDenomAddressPrefixStore(ctx, req.Denom)
+
+	var denomOwners []*types.DenomOwner
+	pageRes, err := query.Paginate(denomPrefixStore, req.Pagination, func(key, _ []byte) error {
+		addr, err := types.AddressFromBalancesStore(key)
+		if err != nil {
+			return err
+		}
+
+		denomOwners = append(denomOwners, &types.DenomOwner{
+			Address: addr.String(),
+			Balance: k.GetBalance(ctx, addr, req.Denom),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
+}
@@ -3,7 +3,9 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -11,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -86,6 +90,112 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *IntegrationTestSuite) TestSpendableBalances() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	ctx = ctx.WithBlockTime(time.Now())
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.SpendableBalances(sdk.WrapSDKContext(ctx), &types.QuerySpendableBalancesRequest{})
+	suite.Require().Error(err)
+
+	pageReq := &query.PageRequest{
+		Key:        nil,
+		Limit:      2,
+		CountTotal: false,
+	}
+	req := types.NewQuerySpendableBalancesRequest(addr, pageReq)
+
+	res, err := queryClient.SpendableBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.True(res.Balances.IsZero())
+
+	fooCoins := newFooCoin(50)
+	barCoins := newBarCoin(30)
+
+	origCoins := sdk.NewCoins(fooCoins, barCoins)
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	acc = vesting.NewContinuousVestingAccount(
+		acc.(*authtypes.BaseAccount),
+		sdk.NewCoins(fooCoins),
+		ctx.BlockTime().Unix(),
+		ctx.BlockTime().Add(time.Hour).Unix(),
+	)
+
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, acc.GetAddress(), origCoins))
+
+	// move time forward for some tokens to vest
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	res, err = queryClient.SpendableBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Equal(2, res.Balances.Len())
+	suite.Nil(res.Pagination.NextKey)
+	suite.EqualValues(30, res.Balances[0].Amount.Int64())
+	suite.EqualValues(25, res.Balances[1].Amount.Int64())
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomOwners() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{})
+	suite.Require().Error(err)
+
+	addrs := make([]sdk.AccAddress, 4)
+	for i := range addrs {
+		_, _, addrs[i] = testdata.KeyTestPubAddr()
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addrs[i])
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	// three owners of foo, one of bar
+	for _, addr := range addrs[:3] {
+		suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(newFooCoin(50))))
+	}
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addrs[3], sdk.NewCoins(newBarCoin(30))))
+
+	// the last owner spends all its foo and is no longer an owner
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addrs[2], addrs[3], sdk.NewCoins(newFooCoin(50))))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addrs[3], addrs[1], sdk.NewCoins(newFooCoin(50))))
+
+	res, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DenomOwners, 2)
+
+	expOwners := map[string]sdk.Coin{
+		addrs[0].String(): newFooCoin(50),
+		addrs[1].String(): newFooCoin(100),
+	}
+	for _, owner := range res.DenomOwners {
+		exp, ok := expOwners[owner.Address]
+		suite.Require().True(ok, owner.Address)
+		suite.Require().Equal(exp, owner.Balance)
+	}
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DenomOwners, 1)
+	suite.Require().NotNil(res.Pagination.NextKey)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DenomOwners, 1)
+	suite.Require().Equal(addrs[3].String(), res.DenomOwners[0].Address)
+	suite.Require().Equal(newBarCoin(30), res.DenomOwners[0].Balance)
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	expectedTotalSupply := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000))
@@ -120,6 +230,75 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(test1Supply, res.Amount)
 }
 
+func (suite *IntegrationTestSuite) TestQuerySupplyHistory() {
+	app, queryClient := suite.app, suite.queryClient
+
+	params := app.BankKeeper.GetParams(suite.ctx)
+	params.SupplyHistoryWindow = 100
+	app.BankKeeper.SetParams(suite.ctx, params)
+
+	for height := int64(1); height <= 5; height++ {
+		ctx := suite.ctx.WithBlockHeight(height)
+		suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(10))))
+	}
+
+	_, err := queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{
+		Denom:      fooDenom,
+		FromHeight: 3,
+		ToHeight:   2,
+	})
+	suite.Require().Error(err)
+
+	res, err := queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 5)
+	for i, entry := range res.Entries {
+		suite.Require().Equal(int64(i+1), entry.Height)
+		suite.Require().Equal(sdk.NewInt(10), entry.Minted)
+		suite.Require().Equal(sdk.ZeroInt(), entry.Burned)
+		suite.Require().Equal(sdk.NewInt(int64(10*(i+1))), entry.Supply)
+	}
+
+	res, err = queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{
+		Denom:      fooDenom,
+		FromHeight: 2,
+		ToHeight:   4,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 2)
+	suite.Require().Equal(int64(2), res.Entries[0].Height)
+	suite.Require().Equal(int64(3), res.Entries[1].Height)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{
+		Denom:      fooDenom,
+		FromHeight: 2,
+		ToHeight:   4,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Equal(int64(4), res.Entries[0].Height)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	res, err = queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{
+		Denom:      fooDenom,
+		FromHeight: 4,
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Equal(int64(5), res.Entries[0].Height)
+
+	res, err = queryClient.SupplyHistory(gocontext.Background(), &types.QuerySupplyHistoryRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Entries)
+}
+
 func (suite *IntegrationTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
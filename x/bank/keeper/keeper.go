@@ -31,6 +31,11 @@ type Keeper interface {
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
 	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
 
+	GetSupplyHistoryWindow(ctx sdk.Context) uint64
+	GetSupplyHistoryEntry(ctx sdk.Context, denom string, height int64) (types.SupplyHistoryEntry, bool)
+	IterateSupplyHistory(ctx sdk.Context, denom string, cb func(types.SupplyHistoryEntry) bool)
+	PruneSupplyHistory(ctx sdk.Context, limit int)
+
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -382,6 +387,7 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Add(amount)
 		k.setSupply(ctx, supply)
+		k.trackSupplyChange(ctx, supply, amount.Amount, sdk.ZeroInt())
 	}
 
 	logger := k.Logger(ctx)
@@ -416,6 +422,7 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Sub(amount)
 		k.setSupply(ctx, supply)
+		k.trackSupplyChange(ctx, supply, sdk.ZeroInt(), amount.Amount)
 	}
 
 	logger := k.Logger(ctx)
@@ -1163,3 +1163,80 @@ func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func (suite *IntegrationTestSuite) TestSupplyHistory() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	require := suite.Require()
+
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+
+	// disabled by default
+	require.Equal(uint64(0), keeper.GetSupplyHistoryWindow(ctx))
+	require.NoError(keeper.MintCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(100))))
+	_, found := keeper.GetSupplyHistoryEntry(ctx, fooDenom, 10)
+	require.False(found)
+
+	params := keeper.GetParams(ctx)
+	params.SupplyHistoryWindow = 2
+	keeper.SetParams(ctx, params)
+	require.Equal(uint64(2), keeper.GetSupplyHistoryWindow(ctx))
+
+	// changes of the same block are accumulated
+	require.NoError(keeper.MintCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(50), newBarCoin(30))))
+	require.NoError(keeper.BurnCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(20))))
+
+	entry, found := keeper.GetSupplyHistoryEntry(ctx, fooDenom, 10)
+	require.True(found)
+	require.Equal(types.SupplyHistoryEntry{
+		Height: 10,
+		Minted: sdk.NewInt(50),
+		Burned: sdk.NewInt(20),
+		Supply: sdk.NewInt(130),
+	}, entry)
+
+	entry, found = keeper.GetSupplyHistoryEntry(ctx, barDenom, 10)
+	require.True(found)
+	require.Equal(sdk.NewInt(30), entry.Supply)
+
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(keeper.BurnCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(30))))
+
+	var heights []int64
+	keeper.IterateSupplyHistory(ctx, fooDenom, func(entry types.SupplyHistoryEntry) bool {
+		heights = append(heights, entry.Height)
+		return false
+	})
+	require.Equal([]int64{10, 11}, heights)
+
+	// entries are kept for the window
+	keeper.PruneSupplyHistory(ctx, 10)
+	_, found = keeper.GetSupplyHistoryEntry(ctx, fooDenom, 10)
+	require.True(found)
+
+	// and pruned once out of it
+	ctx = ctx.WithBlockHeight(12)
+	keeper.PruneSupplyHistory(ctx, 10)
+	_, found = keeper.GetSupplyHistoryEntry(ctx, fooDenom, 10)
+	require.False(found)
+	_, found = keeper.GetSupplyHistoryEntry(ctx, barDenom, 10)
+	require.False(found)
+	entry, found = keeper.GetSupplyHistoryEntry(ctx, fooDenom, 11)
+	require.True(found)
+	require.Equal(sdk.NewInt(100), entry.Supply)
+
+	require.NoError(keeper.MintCoins(ctx, multiPerm, sdk.NewCoins(newBarCoin(10))))
+
+	// disabling the history prunes all the entries, up to limit entries per call
+	params.SupplyHistoryWindow = 0
+	keeper.SetParams(ctx, params)
+	keeper.PruneSupplyHistory(ctx, 1)
+	_, found = keeper.GetSupplyHistoryEntry(ctx, fooDenom, 11)
+	require.False(found)
+	_, found = keeper.GetSupplyHistoryEntry(ctx, barDenom, 12)
+	require.True(found)
+
+	keeper.PruneSupplyHistory(ctx, 1)
+	_, found = keeper.GetSupplyHistoryEntry(ctx, barDenom, 12)
+	require.False(found)
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetSupplyHistoryWindow returns the number of blocks for which the supply
+// changes are recorded. Zero means the supply history is disabled.
+func (k BaseKeeper) GetSupplyHistoryWindow(ctx sdk.Context) (window uint64) {
+	k.paramSpace.GetIfExists(ctx, types.KeySupplyHistoryWindow, &window)
+	return window
+}
+
+// GetSupplyHistoryEntry returns the supply history entry of a denomination at
+// the given height, if any.
+func (k BaseKeeper) GetSupplyHistoryEntry(ctx sdk.Context, denom string, height int64) (types.SupplyHistoryEntry, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SupplyHistoryKey(denom, height))
+	if bz == nil {
+		return types.SupplyHistoryEntry{}, false
+	}
+
+	var entry types.SupplyHistoryEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+
+	return entry, true
+}
+
+// IterateSupplyHistory iterates over the supply history entries of a
+// denomination by ascending height. If true is returned from the callback,
+// iteration is halted.
+func (k BaseKeeper) IterateSupplyHistory(ctx sdk.Context, denom string, cb func(types.SupplyHistoryEntry) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateSupplyHistoryPrefix(denom))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.SupplyHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// PruneSupplyHistory deletes the supply history entries that are out of the
+// supply history window at the current height, up to limit entries in the
+// order of their height. The remaining entries out of the window are deleted
+// by the next calls. It is called by the bank EndBlocker.
+func (k BaseKeeper) PruneSupplyHistory(ctx sdk.Context, limit int) {
+	// entries at heights lower than or equal to cutoff are out of the window.
+	cutoff := ctx.BlockHeight() - int64(k.GetSupplyHistoryWindow(ctx))
+	if cutoff < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.SupplyHistoryByHeightPrefix,
+		types.SupplyHistoryByHeightKey(cutoff+1, ""),
+	)
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		height, denom := types.SplitSupplyHistoryByHeightKey(key)
+		store.Delete(types.SupplyHistoryKey(denom, height))
+		store.Delete(key)
+	}
+}
+
+// trackSupplyChange records the minted and burned amounts of a denomination in
+// the supply history entry of the current block, together with the resulting
+// supply. It is a no-op if the supply history is disabled.
+func (k BaseKeeper) trackSupplyChange(ctx sdk.Context, supply sdk.Coin, minted, burned sdk.Int) {
+	if k.GetSupplyHistoryWindow(ctx) == 0 {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	entry, found := k.GetSupplyHistoryEntry(ctx, supply.Denom, height)
+	if !found {
+		entry = types.SupplyHistoryEntry{
+			Height: height,
+			Minted: sdk.ZeroInt(),
+			Burned: sdk.ZeroInt(),
+		}
+	}
+
+	entry.Minted = entry.Minted.Add(minted)
+	entry.Burned = entry.Burned.Add(burned)
+	entry.Supply = supply.Amount
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SupplyHistoryKey(supply.Denom, height), k.cdc.MustMarshal(&entry))
+	store.Set(types.SupplyHistoryByHeightKey(height, supply.Denom), []byte{0})
+}
+
+// heightRangeStore restricts the iteration of the supply history entries of a
+// denomination to the entries from the start key and before the end key, if
+// any, so that the entries out of the range are not iterated over.
+type heightRangeStore struct {
+	sdk.KVStore
+
+	start, end []byte
+}
+
+// newHeightRangeStore returns a store of the supply history entries of a
+// denomination whose iterators are restricted to the heights from fromHeight
+// to toHeight. A zero toHeight sets no upper bound.
+func newHeightRangeStore(historyStore sdk.KVStore, fromHeight, toHeight int64) heightRangeStore {
+	s := heightRangeStore{
+		KVStore: historyStore,
+		start:   sdk.Uint64ToBigEndian(uint64(fromHeight)),
+	}
+	if toHeight != 0 {
+		s.end = sdk.Uint64ToBigEndian(uint64(toHeight) + 1)
+	}
+
+	return s
+}
+
+// Iterator implements KVStore.Iterator, restricting the domain to the range of
+// the store.
+func (s heightRangeStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.ReverseIterator, restricting the domain
+// to the range of the store.
+func (s heightRangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s heightRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+
+	return start, end
+}
@@ -75,7 +75,8 @@ func TestMigrateJSON(t *testing.T) {
 	"denom_metadata": [],
 	"params": {
 		"default_send_enabled": false,
-		"send_enabled": []
+		"send_enabled": [],
+		"supply_history_window": "0"
 	},
 	"supply": [
 		{
@@ -0,0 +1,57 @@
+package v045
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	v043 "github.com/cosmos/cosmos-sdk/x/bank/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Add an index of the account balances by denomination, used by the
+// DenomOwners query.
+// - Add the SupplyHistoryWindow param, with the supply history disabled.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	if err := addDenomReverseIndex(store, cdc); err != nil {
+		return err
+	}
+
+	paramSpace.Set(ctx, types.KeySupplyHistoryWindow, uint64(0))
+	return nil
+}
+
+// addDenomReverseIndex indexes every non-zero balance of the balances store
+// under DenomAddressPrefix || denom || 0x00 || addrLen (1 byte) || addrBytes.
+func addDenomReverseIndex(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	balancesStore := prefix.NewStore(store, v043.BalancesPrefix)
+
+	iterator := balancesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var balance sdk.Coin
+		if err := cdc.Unmarshal(iterator.Value(), &balance); err != nil {
+			return err
+		}
+
+		if balance.IsZero() {
+			continue
+		}
+
+		addr, err := types.AddressFromBalancesStore(iterator.Key())
+		if err != nil {
+			return err
+		}
+
+		denomPrefixStore := prefix.NewStore(store, types.CreateDenomAddressPrefix(balance.Denom))
+		denomPrefixStore.Set(address.MustLengthPrefix(addr), []byte{0})
+	}
+
+	return nil
+}
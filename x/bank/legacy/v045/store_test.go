@@ -0,0 +1,71 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	v045bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	store := ctx.KVStore(bankKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, bankKey, tBankKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	balances := map[string]sdk.Coins{
+		addr1.String(): sdk.NewCoins(sdk.NewInt64Coin("foo", 10), sdk.NewInt64Coin("foobar", 20)),
+		addr2.String(): sdk.NewCoins(sdk.NewInt64Coin("foo", 30)),
+	}
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		accountStore := prefix.NewStore(store, types.CreateAccountBalancesPrefix(addr))
+		for _, coin := range balances[addr.String()] {
+			bz, err := encCfg.Marshaler.Marshal(&coin)
+			require.NoError(t, err)
+			accountStore.Set([]byte(coin.Denom), bz)
+		}
+	}
+
+	require.NoError(t, v045bank.MigrateStore(ctx, bankKey, paramSpace, encCfg.Marshaler))
+
+	owners := func(denom string) []sdk.AccAddress {
+		denomStore := prefix.NewStore(store, types.CreateDenomAddressPrefix(denom))
+		iterator := denomStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		var addrs []sdk.AccAddress
+		for ; iterator.Valid(); iterator.Next() {
+			addr, err := types.AddressFromBalancesStore(iterator.Key())
+			require.NoError(t, err)
+			require.Equal(t, []byte{0}, iterator.Value())
+			addrs = append(addrs, addr)
+		}
+		return addrs
+	}
+
+	require.ElementsMatch(t, []sdk.AccAddress{addr1, addr2}, owners("foo"))
+	require.Equal(t, []sdk.AccAddress{addr1}, owners("foobar"))
+	require.Empty(t, owners("bar"))
+
+	denomStore := prefix.NewStore(store, types.CreateDenomAddressPrefix("foo"))
+	require.True(t, denomStore.Has(address.MustLengthPrefix(addr1)))
+
+	var window uint64 = 1
+	paramSpace.Get(ctx, types.KeySupplyHistoryWindow, &window)
+	require.Equal(t, uint64(0), window)
+}
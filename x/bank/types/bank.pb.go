@@ -30,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty" yaml:"default_send_enabled,omitempty"`
+	// supply_history_window is the number of blocks for which the changes of the
+	// supply of each denomination are recorded. Zero disables the supply history.
+	SupplyHistoryWindow uint64 `protobuf:"varint,3,opt,name=supply_history_window,json=supplyHistoryWindow,proto3" json:"supply_history_window,omitempty" yaml:"supply_history_window,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +81,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetSupplyHistoryWindow() uint64 {
+	if m != nil {
+		return m.SupplyHistoryWindow
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -417,6 +427,59 @@ func (m *Metadata) GetSymbol() string {
 	return ""
 }
 
+// SupplyHistoryEntry records the changes of the supply of a denomination
+// during a block.
+type SupplyHistoryEntry struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// minted is the amount of coins of the denomination minted during the block.
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+	// burned is the amount of coins of the denomination burned during the block.
+	Burned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=burned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burned"`
+	// supply is the total supply of the denomination at the end of the block.
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+}
+
+func (m *SupplyHistoryEntry) Reset()         { *m = SupplyHistoryEntry{} }
+func (m *SupplyHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*SupplyHistoryEntry) ProtoMessage()    {}
+func (*SupplyHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *SupplyHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyHistoryEntry.Merge(m, src)
+}
+func (m *SupplyHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyHistoryEntry proto.InternalMessageInfo
+
+func (m *SupplyHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -425,49 +488,57 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*SupplyHistoryEntry)(nil), "cosmos.bank.v1beta1.SupplyHistoryEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0x35, 0x8d, 0x49, 0x2f, 0xb0, 0x1c, 0x15, 0x72, 0x23, 0x61, 0x1b, 0x4b, 0x48, 0x29,
-	0xa2, 0x4e, 0x0a, 0x0c, 0x28, 0x0b, 0x52, 0xca, 0x0f, 0x75, 0x40, 0x20, 0x57, 0x08, 0x09, 0x86,
-	0xe8, 0x9c, 0xbb, 0x06, 0xab, 0xf6, 0x9d, 0x95, 0x3b, 0x57, 0xf5, 0x7f, 0xc0, 0x04, 0x8c, 0x8c,
-	0x9d, 0x59, 0xe1, 0x7f, 0xa0, 0x63, 0x05, 0x0b, 0x53, 0x40, 0xc9, 0xc2, 0xdc, 0xbf, 0x00, 0xf9,
-	0xce, 0xf9, 0x51, 0x29, 0x20, 0x06, 0x06, 0xa6, 0xbc, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0xe9, 0xbb,
-	0xe7, 0x40, 0xbb, 0xcf, 0x45, 0xc2, 0x45, 0x2b, 0xc4, 0xec, 0xa0, 0x75, 0xb8, 0x1d, 0x52, 0x89,
-	0xb7, 0x15, 0xf0, 0xd3, 0x21, 0x97, 0x1c, 0x5d, 0xd6, 0x75, 0x5f, 0xa5, 0xca, 0x7a, 0x63, 0x7d,
-	0xc0, 0x07, 0x5c, 0xd5, 0x5b, 0x45, 0xa4, 0xa9, 0x8d, 0x0d, 0x4d, 0xed, 0xe9, 0x42, 0xd9, 0xa7,
-	0x4b, 0xf3, 0x29, 0x82, 0xce, 0xa6, 0xf4, 0x79, 0xc4, 0x74, 0xdd, 0xfb, 0x0a, 0xa0, 0xf9, 0x14,
-	0x0f, 0x71, 0x22, 0xd0, 0x3e, 0xbc, 0x28, 0x28, 0x23, 0x3d, 0xca, 0x70, 0x18, 0x53, 0x62, 0x01,
-	0xb7, 0xd2, 0xac, 0xdf, 0x72, 0xfd, 0x25, 0x7b, 0xf8, 0x7b, 0x94, 0x91, 0x07, 0x9a, 0xd7, 0xbd,
-	0x76, 0x36, 0x72, 0xae, 0xe6, 0x38, 0x89, 0x3b, 0xde, 0x62, 0xff, 0x4d, 0x9e, 0x44, 0x92, 0x26,
-	0xa9, 0xcc, 0xbd, 0xa0, 0x2e, 0xe6, 0x7c, 0xf4, 0x12, 0xae, 0x13, 0xba, 0x8f, 0xb3, 0x58, 0xf6,
-	0xce, 0xcd, 0x5b, 0x71, 0x41, 0xb3, 0xd6, 0xdd, 0x3c, 0x1b, 0x39, 0xd7, 0xb5, 0xda, 0x32, 0xd6,
-	0xa2, 0x2a, 0x2a, 0x09, 0x0b, 0xcb, 0x74, 0x56, 0xdf, 0x1f, 0x3b, 0x86, 0xf7, 0x08, 0xd6, 0x17,
-	0x92, 0x68, 0x1d, 0x56, 0x09, 0x65, 0x3c, 0xb1, 0x80, 0x0b, 0x9a, 0x6b, 0x81, 0x06, 0xc8, 0x82,
-	0x17, 0xce, 0x8d, 0x0e, 0xa6, 0xb0, 0x53, 0x2b, 0x44, 0x7e, 0x1e, 0x3b, 0xc0, 0x7b, 0x03, 0x60,
-	0x75, 0x97, 0xa5, 0x99, 0x2c, 0xd8, 0x98, 0x90, 0x21, 0x15, 0xa2, 0x54, 0x99, 0x42, 0x84, 0x61,
-	0xb5, 0x30, 0x54, 0x58, 0x2b, 0xca, 0xb0, 0x8d, 0xb9, 0x61, 0x82, 0xce, 0x0c, 0xdb, 0xe1, 0x11,
-	0xeb, 0xb6, 0x4f, 0x46, 0x8e, 0xf1, 0xe1, 0xbb, 0xd3, 0x1c, 0x44, 0xf2, 0x55, 0x16, 0xfa, 0x7d,
-	0x9e, 0x94, 0xaf, 0x55, 0xfe, 0x6c, 0x09, 0x72, 0xd0, 0x92, 0x79, 0x4a, 0x85, 0x6a, 0x10, 0x81,
-	0x56, 0xee, 0xd4, 0x5e, 0xeb, 0x85, 0x0c, 0xef, 0x2d, 0x80, 0xe6, 0x93, 0x4c, 0xfe, 0x47, 0x1b,
-	0x7d, 0x04, 0xd0, 0xdc, 0xcb, 0xd2, 0x34, 0xce, 0x8b, 0xb9, 0x92, 0x4b, 0x1c, 0x97, 0xa7, 0xf3,
-	0x6f, 0xe7, 0x2a, 0xe5, 0xce, 0xc3, 0x72, 0x2e, 0xf8, 0xf2, 0x69, 0xeb, 0xee, 0x8d, 0x3f, 0x76,
-	0x1f, 0xe9, 0x4f, 0x2b, 0xa6, 0x03, 0xdc, 0xcf, 0x5b, 0x87, 0xed, 0x3b, 0x6d, 0x5f, 0xef, 0xb9,
-	0x6b, 0x01, 0xef, 0x39, 0x5c, 0xbb, 0x5f, 0x5c, 0xc1, 0x33, 0x16, 0xc9, 0xdf, 0xdc, 0x47, 0x03,
-	0xd6, 0xe8, 0x51, 0xca, 0x19, 0x65, 0x52, 0x1d, 0xc8, 0xa5, 0x60, 0x86, 0x95, 0xf7, 0x71, 0x84,
-	0x05, 0x15, 0x56, 0xc5, 0xad, 0x28, 0xef, 0x35, 0xf4, 0x3e, 0x03, 0x58, 0x7b, 0x4c, 0x25, 0x26,
-	0x58, 0x62, 0xe4, 0xc2, 0x3a, 0xa1, 0xa2, 0x3f, 0x8c, 0x52, 0x19, 0x71, 0x56, 0xca, 0x2f, 0xa6,
-	0xd0, 0xbd, 0x82, 0xc1, 0x78, 0xd2, 0xcb, 0x58, 0x24, 0xa7, 0x0f, 0x66, 0x2f, 0xfd, 0xe6, 0x66,
-	0xfb, 0x06, 0x90, 0x4c, 0x43, 0x81, 0x10, 0x5c, 0x2d, 0xec, 0xb5, 0x2a, 0x4a, 0x5b, 0xc5, 0xc5,
-	0x76, 0x24, 0x12, 0x69, 0x8c, 0x73, 0x6b, 0x55, 0x5f, 0x46, 0x09, 0x0b, 0x36, 0xc3, 0x09, 0xb5,
-	0xaa, 0x9a, 0x5d, 0xc4, 0xe8, 0x0a, 0x34, 0x45, 0x9e, 0x84, 0x3c, 0xb6, 0x4c, 0x95, 0x2d, 0x51,
-	0x77, 0xe7, 0x64, 0x6c, 0x83, 0xd3, 0xb1, 0x0d, 0x7e, 0x8c, 0x6d, 0xf0, 0x6e, 0x62, 0x1b, 0xa7,
-	0x13, 0xdb, 0xf8, 0x36, 0xb1, 0x8d, 0x17, 0x9b, 0x7f, 0xe3, 0xbb, 0x7a, 0xbc, 0xd0, 0x54, 0x7f,
-	0x33, 0xb7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x03, 0xbf, 0xe9, 0xee, 0x04, 0x00, 0x00,
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xe4, 0x97, 0xc9, 0x44, 0x2f, 0xdb, 0x2a, 0xdb, 0x82, 0xbb, 0x71, 0xc1, 0x92, 0x16,
+	0x9b, 0xb4, 0xea, 0x41, 0x72, 0x11, 0x52, 0x5b, 0xed, 0x41, 0x94, 0x2d, 0x52, 0x50, 0x30, 0x4c,
+	0xb2, 0xd3, 0x64, 0xe8, 0xee, 0xcc, 0x92, 0x99, 0xb4, 0xdd, 0xbb, 0x07, 0x4f, 0xea, 0xd1, 0x63,
+	0xcf, 0x5e, 0xeb, 0xff, 0x60, 0x8f, 0xc5, 0x93, 0x78, 0x88, 0xd2, 0x5e, 0x3c, 0xf7, 0x2f, 0x90,
+	0xf9, 0x91, 0x34, 0x85, 0x28, 0xa5, 0x20, 0x78, 0xda, 0xf7, 0xcd, 0xfb, 0xde, 0xf7, 0xde, 0xce,
+	0x7b, 0x6f, 0xa0, 0xd3, 0x66, 0x3c, 0x62, 0xbc, 0xd6, 0x42, 0x74, 0xbb, 0xb6, 0xb3, 0xdc, 0xc2,
+	0x02, 0x2d, 0x2b, 0x50, 0x8d, 0x7b, 0x4c, 0x30, 0x6b, 0x4a, 0xfb, 0xab, 0xea, 0xc8, 0xf8, 0x67,
+	0xa7, 0x3b, 0xac, 0xc3, 0x94, 0xbf, 0x26, 0x2d, 0x4d, 0x9d, 0x9d, 0xd1, 0xd4, 0xa6, 0x76, 0x98,
+	0x38, 0xed, 0x3a, 0xcb, 0xc2, 0xf1, 0x28, 0x4b, 0x9b, 0x11, 0xaa, 0xfd, 0xde, 0x41, 0x1a, 0xe6,
+	0x9f, 0xa3, 0x1e, 0x8a, 0xb8, 0xb5, 0x05, 0xaf, 0x72, 0x4c, 0x83, 0x26, 0xa6, 0xa8, 0x15, 0xe2,
+	0xc0, 0x06, 0xe5, 0x4c, 0xa5, 0x74, 0xb7, 0x5c, 0x9d, 0x50, 0x47, 0x75, 0x03, 0xd3, 0x60, 0x55,
+	0xf3, 0x1a, 0xb7, 0x4e, 0x07, 0xee, 0xcd, 0x04, 0x45, 0x61, 0xdd, 0x1b, 0x8f, 0xbf, 0xc3, 0x22,
+	0x22, 0x70, 0x14, 0x8b, 0xc4, 0xf3, 0x4b, 0xfc, 0x8c, 0x6f, 0xbd, 0x82, 0xd3, 0x01, 0xde, 0x42,
+	0xfd, 0x50, 0x34, 0xcf, 0xe5, 0x4b, 0x97, 0x41, 0xa5, 0xd0, 0x98, 0x3f, 0x1d, 0xb8, 0xb7, 0xb5,
+	0xda, 0x24, 0xd6, 0xb8, 0xaa, 0x65, 0x08, 0x63, 0xc5, 0x58, 0xaf, 0xe1, 0x75, 0xde, 0x8f, 0xe3,
+	0x30, 0x69, 0x76, 0x09, 0x17, 0xac, 0x97, 0x34, 0x77, 0x09, 0x0d, 0xd8, 0xae, 0x9d, 0x29, 0x83,
+	0x4a, 0xb6, 0xb1, 0x70, 0x3a, 0x70, 0xe7, 0x4c, 0xad, 0x93, 0x68, 0xe3, 0xf2, 0x53, 0x9a, 0xf1,
+	0x44, 0x13, 0x36, 0x95, 0xbf, 0x9e, 0xfd, 0xb8, 0xef, 0xa6, 0xbc, 0xc7, 0xb0, 0x34, 0x9e, 0x74,
+	0x1a, 0xe6, 0x02, 0x4c, 0x59, 0x64, 0x83, 0x32, 0xa8, 0x14, 0x7d, 0x0d, 0x2c, 0x1b, 0x5e, 0x39,
+	0xf7, 0x6b, 0xfe, 0x10, 0xd6, 0x0b, 0x52, 0xe4, 0xd7, 0xbe, 0x0b, 0xbc, 0x77, 0x00, 0xe6, 0xd6,
+	0x69, 0xdc, 0x17, 0x92, 0x8d, 0x82, 0xa0, 0x87, 0x39, 0x37, 0x2a, 0x43, 0x68, 0x21, 0x98, 0x93,
+	0x0d, 0xe3, 0x76, 0x5a, 0x35, 0x64, 0xe6, 0xac, 0x21, 0x1c, 0x8f, 0x1a, 0xb2, 0xc2, 0x08, 0x6d,
+	0x2c, 0x1d, 0x0e, 0xdc, 0xd4, 0xa7, 0x1f, 0x6e, 0xa5, 0x43, 0x44, 0xb7, 0xdf, 0xaa, 0xb6, 0x59,
+	0x64, 0xa6, 0xc1, 0x7c, 0x16, 0x79, 0xb0, 0x5d, 0x13, 0x49, 0x8c, 0xb9, 0x0a, 0xe0, 0xbe, 0x56,
+	0xae, 0x17, 0xde, 0xea, 0x82, 0x52, 0xde, 0x7b, 0x00, 0xf3, 0xcf, 0xfa, 0xe2, 0x3f, 0xaa, 0xe8,
+	0x00, 0xc0, 0xfc, 0x86, 0xea, 0x84, 0xcc, 0x2b, 0x98, 0x40, 0xa1, 0x0d, 0xfe, 0x41, 0x5e, 0xa5,
+	0x5c, 0x5f, 0x33, 0x79, 0xc1, 0xd7, 0xcf, 0x8b, 0x0f, 0x16, 0xfe, 0x1a, 0xbd, 0xa7, 0x57, 0x37,
+	0xc4, 0x1d, 0xd4, 0x4e, 0x6a, 0x3b, 0x4b, 0xf7, 0x97, 0xaa, 0xba, 0xce, 0x75, 0x1b, 0x78, 0x9b,
+	0xb0, 0xf8, 0x48, 0x4e, 0xc1, 0x0b, 0x4a, 0xc4, 0x1f, 0xe6, 0x63, 0x16, 0x16, 0xf0, 0x5e, 0xcc,
+	0x28, 0xa6, 0x42, 0x0d, 0xc8, 0x35, 0x7f, 0x84, 0xd5, 0xdd, 0x87, 0x04, 0x71, 0xcc, 0xed, 0x4c,
+	0x39, 0xa3, 0xee, 0x5e, 0x43, 0xef, 0x0b, 0x80, 0x85, 0xa7, 0x58, 0xa0, 0x00, 0x09, 0x64, 0x95,
+	0x61, 0x29, 0xc0, 0xbc, 0xdd, 0x23, 0xb1, 0x20, 0x8c, 0x1a, 0xf9, 0xf1, 0x23, 0xeb, 0xa1, 0x64,
+	0x50, 0x16, 0x35, 0xfb, 0x94, 0x88, 0x61, 0xc3, 0x9c, 0x89, 0x3b, 0x3d, 0xaa, 0xd7, 0x87, 0xc1,
+	0xd0, 0xe4, 0x96, 0x05, 0xb3, 0xf2, 0x7a, 0xd5, 0xfe, 0x14, 0x7d, 0x65, 0xcb, 0xea, 0x02, 0xc2,
+	0xe3, 0x10, 0x25, 0x76, 0x56, 0x4f, 0x86, 0x81, 0x92, 0x4d, 0x51, 0x84, 0xed, 0x9c, 0x66, 0x4b,
+	0xdb, 0xba, 0x01, 0xf3, 0x3c, 0x89, 0x5a, 0x2c, 0xb4, 0xf3, 0xea, 0xd4, 0x20, 0xef, 0x4d, 0x1a,
+	0x5a, 0x1b, 0xe3, 0x2b, 0xb6, 0x4a, 0x45, 0x2f, 0x91, 0xf4, 0x2e, 0x26, 0x9d, 0xae, 0x50, 0xbf,
+	0x93, 0xf1, 0x0d, 0xb2, 0xd6, 0x60, 0x3e, 0x22, 0x54, 0x98, 0x6d, 0x2a, 0x36, 0xaa, 0xb2, 0xc5,
+	0xdf, 0x07, 0xee, 0xdc, 0x05, 0x5a, 0xbc, 0x4e, 0x85, 0x6f, 0xa2, 0xa5, 0x4e, 0xab, 0xdf, 0xa3,
+	0x38, 0xb0, 0x33, 0x97, 0xd3, 0xd1, 0xd1, 0x52, 0x47, 0x3f, 0x10, 0x76, 0xf6, 0x72, 0x3a, 0x3a,
+	0xba, 0xb1, 0x72, 0x78, 0xec, 0x80, 0xa3, 0x63, 0x07, 0xfc, 0x3c, 0x76, 0xc0, 0x87, 0x13, 0x27,
+	0x75, 0x74, 0xe2, 0xa4, 0xbe, 0x9d, 0x38, 0xa9, 0x97, 0xf3, 0x17, 0x19, 0x3f, 0x25, 0xd8, 0xca,
+	0xab, 0xd7, 0xfc, 0xde, 0xef, 0x01, 0x00, 0x83, 0xe1, 0x49, 0x7b, 0x55, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -546,6 +617,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SupplyHistoryWindow != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.SupplyHistoryWindow))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -854,6 +930,64 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -880,6 +1014,9 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if m.SupplyHistoryWindow != 0 {
+		n += 1 + sovBank(uint64(m.SupplyHistoryWindow))
+	}
 	return n
 }
 
@@ -1009,6 +1146,24 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SupplyHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1098,6 +1253,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistoryWindow", wireType)
+			}
+			m.SupplyHistoryWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyHistoryWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1914,6 +2088,177 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SupplyHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
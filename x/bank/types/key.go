@@ -26,6 +26,13 @@ var (
 	BalancesPrefix      = []byte{0x02}
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
+	DenomAddressPrefix  = []byte{0x03}
+
+	// SupplyHistoryPrefix is the prefix of the supply history entries, keyed by
+	// denom and height. SupplyHistoryByHeightPrefix indexes the same entries by
+	// height, to prune the entries that fall out of the supply history window.
+	SupplyHistoryPrefix         = []byte{0x04}
+	SupplyHistoryByHeightPrefix = []byte{0x05}
 )
 
 // DenomMetadataKey returns the denomination metadata key.
@@ -55,3 +62,43 @@ func AddressFromBalancesStore(key []byte) (sdk.AccAddress, error) {
 func CreateAccountBalancesPrefix(addr []byte) []byte {
 	return append(BalancesPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// CreateDenomAddressPrefix creates a prefix for a reverse index of denomination
+// to account balance for that denomination.
+func CreateDenomAddressPrefix(denom string) []byte {
+	// The denom is terminated by a zero byte, so that iterating the prefix of one
+	// denom does not include denoms that it is a prefix of (e.g. "foo" and "foobar").
+	key := make([]byte, len(DenomAddressPrefix)+len(denom)+1)
+	copy(key, DenomAddressPrefix)
+	copy(key[len(DenomAddressPrefix):], denom)
+	return key
+}
+
+// CreateSupplyHistoryPrefix creates the prefix of the supply history entries of
+// a denomination. Like in CreateDenomAddressPrefix, the denom is terminated by a
+// zero byte.
+func CreateSupplyHistoryPrefix(denom string) []byte {
+	key := make([]byte, len(SupplyHistoryPrefix)+len(denom)+1)
+	copy(key, SupplyHistoryPrefix)
+	copy(key[len(SupplyHistoryPrefix):], denom)
+	return key
+}
+
+// SupplyHistoryKey returns the key of the supply history entry of a
+// denomination at the given height.
+func SupplyHistoryKey(denom string, height int64) []byte {
+	return append(CreateSupplyHistoryPrefix(denom), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// SupplyHistoryByHeightKey returns the key of the height index entry of the
+// supply history entry of a denomination at the given height.
+func SupplyHistoryByHeightKey(height int64, denom string) []byte {
+	key := append(append([]byte{}, SupplyHistoryByHeightPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, denom...)
+}
+
+// SplitSupplyHistoryByHeightKey returns the height and denomination of a key of
+// the supply history height index.
+func SplitSupplyHistoryByHeightKey(key []byte) (height int64, denom string) {
+	return int64(sdk.BigEndianToUint64(key[1:9])), string(key[9:])
+}
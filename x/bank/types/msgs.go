@@ -62,6 +62,11 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// GetTargets Implements feegrant.TargetedMsg, returning the recipient.
+func (msg MsgSend) GetTargets() []string {
+	return []string{msg.ToAddress}
+}
+
 var _ sdk.Msg = &MsgMultiSend{}
 
 // NewMsgMultiSend - construct arbitrary multi-in, multi-out send msg.
@@ -106,6 +111,16 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// GetTargets Implements feegrant.TargetedMsg, returning the recipients.
+func (msg MsgMultiSend) GetTargets() []string {
+	targets := make([]string, len(msg.Outputs))
+	for i, out := range msg.Outputs {
+		targets[i] = out.Address
+	}
+
+	return targets
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(in.Address)
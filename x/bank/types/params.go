@@ -19,6 +19,8 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeySupplyHistoryWindow is store's key for the SupplyHistoryWindow option
+	KeySupplyHistoryWindow = []byte("SupplyHistoryWindow")
 )
 
 // ParamKeyTable for bank module.
@@ -48,7 +50,10 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	return validateSupplyHistoryWindow(p.SupplyHistoryWindow)
 }
 
 // String implements the Stringer interface.
@@ -77,7 +82,9 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
+	params := NewParams(p.DefaultSendEnabled, sendParams)
+	params.SupplyHistoryWindow = p.SupplyHistoryWindow
+	return params
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -85,6 +92,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeySupplyHistoryWindow, &p.SupplyHistoryWindow, validateSupplyHistoryWindow),
 	}
 }
 
@@ -140,3 +148,11 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateSupplyHistoryWindow(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
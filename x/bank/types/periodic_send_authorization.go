@@ -0,0 +1,154 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &PeriodicSendAuthorization{}
+)
+
+// NewPeriodicSendAuthorization creates a new PeriodicSendAuthorization object.
+// The first period begins with the first send. An empty spendLimit sets no
+// lifetime limit and an empty allowList allows any recipient.
+func NewPeriodicSendAuthorization(
+	period time.Duration, periodSpendLimit, spendLimit sdk.Coins, allowList []sdk.AccAddress,
+) *PeriodicSendAuthorization {
+	allowed := make([]string, len(allowList))
+	for i, addr := range allowList {
+		allowed[i] = addr.String()
+	}
+
+	return &PeriodicSendAuthorization{
+		SpendLimit:       spendLimit,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		AllowList:        allowed,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. The amount sent is deducted from
+// both the coins left in the current period and the lifetime spend limit, and
+// the authorization is deleted once the spend limit is used up.
+func (a PeriodicSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.AllowList) > 0 && !a.isAllowed(mSend.ToAddress) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s", mSend.ToAddress)
+	}
+
+	a.tryResetPeriod(ctx.BlockTime())
+
+	var isNegative bool
+	a.PeriodCanSpend, isNegative = a.PeriodCanSpend.SafeSub(mSend.Amount)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than period spend limit")
+	}
+
+	if !a.SpendLimit.Empty() {
+		a.SpendLimit, isNegative = a.SpendLimit.SafeSub(mSend.Amount)
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+		}
+		if a.SpendLimit.IsZero() {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+}
+
+// tryResetPeriod tops up PeriodCanSpend to the lesser of PeriodSpendLimit and
+// SpendLimit and moves PeriodReset one period forward once the current period
+// ended. If no coins were sent during more than one period, the new period
+// starts at the block time.
+func (a *PeriodicSendAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	if a.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	} else {
+		a.PeriodCanSpend = minCoins(a.PeriodSpendLimit, a.SpendLimit)
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// minCoins returns the lesser amount of coins and limit in each denom of
+// coins, leaving out the denoms limit has none of.
+func minCoins(coins, limit sdk.Coins) sdk.Coins {
+	min := make([]sdk.Coin, 0, len(coins))
+	for _, coin := range coins {
+		min = append(min, sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, limit.AmountOf(coin.Denom))))
+	}
+	return sdk.NewCoins(min...)
+}
+
+// isAllowed returns true if the coins can be sent to the address.
+func (a PeriodicSendAuthorization) isAllowed(toAddr string) bool {
+	for _, addr := range a.AllowList {
+		if addr == toAddr {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicSendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.Empty() {
+		if !a.SpendLimit.IsValid() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("spend limit is invalid: %s", a.SpendLimit)
+		}
+		if !a.SpendLimit.IsAllPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrap("spend limit must be positive")
+		}
+		if !a.PeriodSpendLimit.DenomsSubsetOf(a.SpendLimit) {
+			return sdkerrors.ErrInvalidCoins.Wrap("period spend limit has different denoms than spend limit")
+		}
+	}
+
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	allowed := make(map[string]bool, len(a.AllowList))
+	for _, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allow list address %s: %s", addr, err)
+		}
+		if allowed[addr] {
+			return sdkerrors.ErrInvalidAddress.Wrapf("duplicate allow list address %s", addr)
+		}
+		allowed[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPeriodicSendAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	coins300 := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(300)))
+	authorization := types.NewPeriodicSendAuthorization(time.Hour, coins500, coins1000, []sdk.AccAddress{toAddr})
+
+	t.Log("verify authorization returns valid method name")
+	require.Equal(t, authorization.MsgTypeURL(), "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify sending to an address out of the allow list is rejected")
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, fromAddr, coins300))
+	require.Error(t, err)
+
+	t.Log("verify sending another denom is rejected")
+	_, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.Error(t, err)
+
+	t.Log("verify the first send starts the period")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), updated.PeriodCanSpend)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 700)), updated.SpendLimit)
+	require.Equal(t, now.Add(time.Hour), updated.PeriodReset)
+
+	t.Log("verify sending more than the coins left in the period is rejected")
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.Error(t, err)
+
+	t.Log("verify the period limit is reset after the period")
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.True(t, updated.PeriodCanSpend.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), updated.SpendLimit)
+	require.Equal(t, now.Add(2*time.Hour), updated.PeriodReset)
+
+	t.Log("verify the period limit is capped by the spend limit left and starts at the block time after inactivity")
+	ctx = ctx.WithBlockTime(now.Add(5 * time.Hour))
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.Error(t, err)
+
+	t.Log("expect authorization deleted after spending the spend limit")
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify an authorization without spend limit nor allow list is never used up")
+	authorization = types.NewPeriodicSendAuthorization(time.Hour, coins500, nil, nil)
+	require.NoError(t, authorization.ValidateBasic())
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(now.Add(time.Duration(10+i) * time.Hour))
+		resp, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, fromAddr, coins500))
+		require.NoError(t, err)
+		require.False(t, resp.Delete)
+		authorization = resp.Updated.(*types.PeriodicSendAuthorization)
+	}
+}
+
+func TestPeriodicSendAuthorizationMultipleDenoms(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("btok", 100))
+	periodSpendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 20), sdk.NewInt64Coin("btok", 5))
+	authorization := types.NewPeriodicSendAuthorization(time.Hour, periodSpendLimit, spendLimit, nil)
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify the period limit is capped by the spend limit in each denom")
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("btok", 100))))
+	require.Error(t, err)
+	_, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("btok", 6))))
+	require.Error(t, err)
+	_, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 11))))
+	require.Error(t, err)
+
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 4), sdk.NewInt64Coin("btok", 5))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	updated := resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 6)), updated.PeriodCanSpend)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 6), sdk.NewInt64Coin("btok", 95)), updated.SpendLimit)
+}
+
+func TestPeriodicSendAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authorization *types.PeriodicSendAuthorization
+		expErr        bool
+	}{
+		{"valid", types.NewPeriodicSendAuthorization(time.Hour, coins500, coins1000, []sdk.AccAddress{toAddr}), false},
+		{"no spend limit", types.NewPeriodicSendAuthorization(time.Hour, coins500, nil, nil), false},
+		{"no period limit", types.NewPeriodicSendAuthorization(time.Hour, nil, coins1000, nil), true},
+		{"zero period", types.NewPeriodicSendAuthorization(0, coins500, coins1000, nil), true},
+		{
+			"period limit denom not in spend limit",
+			types.NewPeriodicSendAuthorization(time.Hour, coins500.Add(sdk.NewInt64Coin("atom", 1)), coins1000, nil),
+			true,
+		},
+		{"duplicate allow list address", types.NewPeriodicSendAuthorization(time.Hour, coins500, nil, []sdk.AccAddress{toAddr, toAddr}), true},
+		{
+			"invalid allow list address",
+			&types.PeriodicSendAuthorization{Period: time.Hour, PeriodSpendLimit: coins500, AllowList: []string{"invalid"}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -25,6 +25,13 @@ func NewQueryAllBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *Qu
 	return &QueryAllBalancesRequest{Address: addr.String(), Pagination: req}
 }
 
+// NewQuerySpendableBalancesRequest creates a new instance of a
+// QuerySpendableBalancesRequest.
+//nolint:interfacer
+func NewQuerySpendableBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *QuerySpendableBalancesRequest {
+	return &QuerySpendableBalancesRequest{Address: addr.String(), Pagination: req}
+}
+
 // QueryTotalSupplyParams defines the params for the following queries:
 //
 // - 'custom/bank/totalSupply'
@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.ExclusiveAuthorization = &WithdrawRewardsAuthorization{}
+)
+
+// NewWithdrawRewardsAuthorization creates a new WithdrawRewardsAuthorization
+// object. Empty validators allow withdrawing the rewards of any validator.
+func NewWithdrawRewardsAuthorization(validators []sdk.ValAddress) *WithdrawRewardsAuthorization {
+	allowed := make([]string, len(validators))
+	for i, valAddr := range validators {
+		allowed[i] = valAddr.String()
+	}
+
+	return &WithdrawRewardsAuthorization{
+		Validators: allowed,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a WithdrawRewardsAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgWithdrawDelegatorReward{})
+}
+
+// ConflictingMsgTypeURLs implements ExclusiveAuthorization.ConflictingMsgTypeURLs.
+// A grantee also granted MsgSetWithdrawAddress could redirect the rewards to
+// its own address, so the two cannot be granted together.
+func (a WithdrawRewardsAuthorization) ConflictingMsgTypeURLs() []string {
+	return []string{sdk.MsgTypeURL(&MsgSetWithdrawAddress{})}
+}
+
+// Accept implements Authorization.Accept. The rewards are sent to the withdraw
+// address of the delegator by MsgWithdrawDelegatorReward.
+func (a WithdrawRewardsAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mWithdraw, ok := msg.(*MsgWithdrawDelegatorReward)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.Validators) > 0 {
+		allowed := false
+		for _, valAddr := range a.Validators {
+			ctx.GasMeter().ConsumeGas(authz.GasCostPerIteration, "withdraw rewards authorization")
+			if valAddr == mWithdraw.ValidatorAddress {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot withdraw the rewards of validator %s", mWithdraw.ValidatorAddress)
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a WithdrawRewardsAuthorization) ValidateBasic() error {
+	validators := make(map[string]bool, len(a.Validators))
+	for _, valAddr := range a.Validators {
+		if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address %s: %s", valAddr, err)
+		}
+		if validators[valAddr] {
+			return sdkerrors.ErrInvalidAddress.Wrapf("duplicate validator address %s", valAddr)
+		}
+		validators[valAddr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var (
+	delAddr  = sdk.AccAddress("_____delegator _____")
+	valAddr1 = sdk.ValAddress("_____validator1_____")
+	valAddr2 = sdk.ValAddress("_____validator2_____")
+)
+
+func TestWithdrawRewardsAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	authorization := types.NewWithdrawRewardsAuthorization([]sdk.ValAddress{valAddr1})
+	require.Equal(t, "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward", authorization.MsgTypeURL())
+	require.Equal(t, []string{"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress"}, authorization.ConflictingMsgTypeURLs())
+	require.NoError(t, authorization.ValidateBasic())
+
+	resp, err := authorization.Accept(ctx, types.NewMsgWithdrawDelegatorReward(delAddr, valAddr1))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	_, err = authorization.Accept(ctx, types.NewMsgWithdrawDelegatorReward(delAddr, valAddr2))
+	require.Error(t, err)
+
+	_, err = authorization.Accept(ctx, types.NewMsgSetWithdrawAddress(delAddr, delAddr))
+	require.Error(t, err)
+
+	// an authorization without validators accepts the rewards of any validator
+	resp, err = types.NewWithdrawRewardsAuthorization(nil).Accept(ctx, types.NewMsgWithdrawDelegatorReward(delAddr, valAddr2))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	require.Error(t, types.NewWithdrawRewardsAuthorization([]sdk.ValAddress{valAddr1, valAddr1}).ValidateBasic())
+	require.Error(t, (&types.WithdrawRewardsAuthorization{Validators: []string{delAddr.String()}}).ValidateBasic())
+}
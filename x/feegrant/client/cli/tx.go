@@ -22,6 +22,10 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+
+	FlagAllowedTargets = "allowed-targets"
+	FlagMaxGasPerTx    = "max-gas-per-tx"
+	FlagMaxFeePerTx    = "max-fee-per-tx"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +42,7 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdPruneAllowances(),
 	)
 
 	return feegrantTxCmd
@@ -57,8 +62,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 36000 or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --allowed-targets cosmos1skjw...,cosmosvaloper1skjw...
+	--max-gas-per-tx 200000 --max-fee-per-tx 5stake
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -161,6 +169,38 @@ Examples:
 				}
 			}
 
+			allowedTargets, err := cmd.Flags().GetStringSlice(FlagAllowedTargets)
+			if err != nil {
+				return err
+			}
+
+			maxGasPerTx, err := cmd.Flags().GetUint64(FlagMaxGasPerTx)
+			if err != nil {
+				return err
+			}
+
+			maxFeePerTxVal, err := cmd.Flags().GetString(FlagMaxFeePerTx)
+			if err != nil {
+				return err
+			}
+
+			// Check any of the target flags set, If set consider it as allowed target fee allowance.
+			if len(allowedTargets) > 0 || maxGasPerTx > 0 || maxFeePerTxVal != "" {
+				if len(allowedTargets) == 0 {
+					return fmt.Errorf("allowed targets were not set")
+				}
+
+				maxFeePerTx, err := sdk.ParseCoinsNormalized(maxFeePerTxVal)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewAllowedTargetAllowance(grant, allowedTargets, maxGasPerTx, maxFeePerTx)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
@@ -176,6 +216,9 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration in which period_spend_limit coins can be spent before that allowance is reset")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringSlice(FlagAllowedTargets, []string{}, "Set of account and validator addresses the messages of the transactions paid by the fee allowance must target")
+	cmd.Flags().Uint64(FlagMaxGasPerTx, 0, "Maximum gas limit of a transaction paid by the fee allowance, requires allowed targets")
+	cmd.Flags().String(FlagMaxFeePerTx, "", "Maximum fee of a transaction paid by the fee allowance, requires allowed targets")
 
 	return cmd
 }
@@ -216,6 +259,36 @@ Example:
 	return cmd
 }
 
+// NewCmdPruneAllowances returns a CLI command handler for creating a MsgPruneAllowances transaction.
+func NewCmdPruneAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune expired fee allowances",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove expired fee allowances from the store. A fixed amount of gas
+is refunded per removed allowance, up to a cap.
+
+Example:
+ $ %s tx %s prune --from=mykey
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgPruneAllowances(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
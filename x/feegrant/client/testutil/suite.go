@@ -251,6 +251,62 @@ func (s *IntegrationTestSuite) TestCmdGetFeeGrants() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdGetFeeGrantsByGranter() {
+	val := s.network.Validators[0]
+	granter := s.addedGranter
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		resp         *feegrant.QueryAllowancesByGranterResponse
+		expectLength int
+	}{
+		{
+			"wrong granter",
+			[]string{
+				"wrong_granter",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true, nil, 0,
+		},
+		{
+			"non existed granter",
+			[]string{
+				"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false, &feegrant.QueryAllowancesByGranterResponse{}, 0,
+		},
+		{
+			"valid req",
+			[]string{
+				granter.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false, &feegrant.QueryAllowancesByGranterResponse{}, 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFeeGrantsByGranter()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.resp), out.String())
+				s.Require().Len(tc.resp.Allowances, tc.expectLength)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
 	val := s.network.Validators[0]
 	granter := val.Address
@@ -509,6 +565,62 @@ func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
 			),
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid allowed target fee grant",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1guc4svnq9qncss000jsa48puvtfqxky3yw6qvl",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s,%s", cli.FlagAllowedTargets, granter, val.ValAddress),
+					fmt.Sprintf("--%s=%d", cli.FlagMaxGasPerTx, 200000),
+					fmt.Sprintf("--%s=%s", cli.FlagMaxFeePerTx, "5stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"valid allowed target fee grant with amino",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1hsdskjhvgef8qmcyxg02uaqlhhe39dhxjsfq6g",
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedTargets, val.ValAddress),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+					fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid max gas per tx without allowed targets",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1xfvgc5z089265gfemvuj7xn57j4dfh470k6sep",
+					fmt.Sprintf("--%s=%d", cli.FlagMaxGasPerTx, 200000),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"invalid allowed target",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1xfvgc5z089265gfemvuj7xn57j4dfh470k6sep",
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedTargets, "invalid"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
 		{
 			"invalid expiration",
 			append(
@@ -653,6 +765,77 @@ func (s *IntegrationTestSuite) TestNewCmdRevokeFeegrant() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCmdPruneAllowances() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"unexpected argument",
+			append(
+				[]string{
+					val.Address.String(),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"Valid prune",
+			append(
+				[]string{
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"Valid prune with amino",
+			append(
+				[]string{
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+					fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdPruneAllowances()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestTxWithFeeGrant() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -22,4 +22,8 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrNoTargets error if there is no target
+	ErrNoTargets = sdkerrors.Register(DefaultCodespace, 8, "allowed targets are empty")
+	// ErrGasLimitExceeded error if the gas limit of the transaction is too high
+	ErrGasLimitExceeded = sdkerrors.Register(DefaultCodespace, 9, "gas limit exceeded")
 )
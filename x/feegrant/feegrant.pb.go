@@ -218,6 +218,54 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// AllowedTargetAllowance creates allowance only for the messages targeting the
+// specified addresses, with a ceiling on the gas and fee of each transaction.
+type AllowedTargetAllowance struct {
+	// allowance can be any of basic, periodic and filtered fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_targets are the account and validator addresses the messages of a
+	// transaction must target, e.g. the recipient of a MsgSend or the validator of
+	// a MsgDelegate.
+	AllowedTargets []string `protobuf:"bytes,2,rep,name=allowed_targets,json=allowedTargets,proto3" json:"allowed_targets,omitempty"`
+	// max_gas_per_tx is the maximum gas limit of a transaction, zero for no limit.
+	MaxGasPerTx uint64 `protobuf:"varint,3,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// max_fee_per_tx is the maximum fee of a transaction, empty for no limit.
+	MaxFeePerTx github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_fee_per_tx,json=maxFeePerTx,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee_per_tx"`
+}
+
+func (m *AllowedTargetAllowance) Reset()         { *m = AllowedTargetAllowance{} }
+func (m *AllowedTargetAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedTargetAllowance) ProtoMessage()    {}
+func (*AllowedTargetAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *AllowedTargetAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedTargetAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedTargetAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedTargetAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedTargetAllowance.Merge(m, src)
+}
+func (m *AllowedTargetAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedTargetAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedTargetAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedTargetAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -232,7 +280,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,6 +334,7 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*AllowedTargetAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedTargetAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -294,43 +343,47 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa4, 0x90, 0x0b, 0x94, 0xc6, 0x14, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb5, 0x69, 0xd9, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x32, 0x4c, 0x2c, 0xd1, 0xd9, 0x79,
-	0x35, 0x27, 0x6c, 0x9f, 0xe5, 0xbb, 0x40, 0xb3, 0x32, 0x31, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x7c,
-	0x88, 0x8a, 0xa9, 0x82, 0x85, 0x89, 0xa2, 0xe4, 0x8b, 0x20, 0xdf, 0x9d, 0x9d, 0x90, 0xf0, 0x47,
-	0xaa, 0x3a, 0xc5, 0x77, 0xef, 0xfd, 0xfe, 0xbd, 0x77, 0x0a, 0xba, 0xe5, 0x53, 0x16, 0x51, 0x66,
-	0x1f, 0x02, 0x04, 0x29, 0x8e, 0xb9, 0xfd, 0x7a, 0xc7, 0x03, 0x8e, 0x77, 0x8a, 0x0b, 0x2b, 0x49,
-	0x29, 0xa7, 0xfa, 0x4d, 0xd9, 0x67, 0x15, 0xd7, 0xaa, 0xaf, 0xb5, 0x11, 0xd0, 0x80, 0x8a, 0x1e,
-	0x3b, 0xfb, 0x92, 0xed, 0xad, 0x66, 0x40, 0x69, 0x10, 0x82, 0x2d, 0x4e, 0xde, 0xe8, 0xd0, 0xc6,
-	0xf1, 0x38, 0x2f, 0x49, 0xa6, 0x81, 0xc4, 0x28, 0x5a, 0x59, 0x32, 0x95, 0x19, 0x0f, 0x33, 0x28,
-	0x8c, 0xf8, 0x94, 0xc4, 0xaa, 0xde, 0x5e, 0x64, 0xe5, 0x24, 0x02, 0xc6, 0x71, 0x94, 0xe4, 0x04,
-	0x8b, 0x0d, 0xc3, 0x51, 0x8a, 0x39, 0xa1, 0x8a, 0x60, 0xf3, 0x9b, 0x86, 0xd6, 0x1c, 0xcc, 0x88,
-	0xdf, 0x0b, 0x43, 0xfa, 0x06, 0xc7, 0x3e, 0xe8, 0x21, 0xaa, 0xb3, 0x04, 0xe2, 0xe1, 0x20, 0x24,
-	0x11, 0xe1, 0x86, 0xd6, 0x29, 0x77, 0xeb, 0xbb, 0x4d, 0x4b, 0xf9, 0xca, 0x9c, 0xe4, 0x51, 0xad,
-	0x3e, 0x25, 0xb1, 0x73, 0xe7, 0xe4, 0x47, 0xbb, 0xf4, 0xe9, 0xac, 0xdd, 0x0d, 0x08, 0x7f, 0x39,
-	0xf2, 0x2c, 0x9f, 0x46, 0x2a, 0x84, 0xfa, 0xd9, 0x66, 0xc3, 0x57, 0x36, 0x1f, 0x27, 0xc0, 0x04,
-	0x80, 0xb9, 0x48, 0xf0, 0x3f, 0xc9, 0xe8, 0xf5, 0xfb, 0x08, 0xc1, 0x51, 0x42, 0xa4, 0x29, 0x63,
-	0xa5, 0xa3, 0x75, 0xeb, 0xbb, 0x2d, 0x4b, 0xba, 0xb6, 0x72, 0xd7, 0xd6, 0xf3, 0x3c, 0x96, 0x53,
-	0x39, 0x3e, 0x6b, 0x6b, 0xee, 0x1c, 0x66, 0xaf, 0xf1, 0xf5, 0xf3, 0xf6, 0xd5, 0x47, 0x00, 0x45,
-	0x82, 0xc7, 0x9b, 0xd3, 0x32, 0x6a, 0x3c, 0x85, 0x94, 0xd0, 0xe1, 0x7c, 0xb0, 0x3e, 0xaa, 0x7a,
-	0x59, 0x54, 0x43, 0x13, 0x2a, 0x5b, 0xd6, 0x5f, 0x36, 0x68, 0xfd, 0x3e, 0x10, 0xa7, 0x92, 0x05,
-	0x74, 0x25, 0x56, 0xbf, 0x87, 0x56, 0x13, 0xc1, 0xac, 0xbc, 0x36, 0x97, 0xbc, 0x3e, 0x50, 0x13,
-	0x76, 0x2e, 0x67, 0xb8, 0xf7, 0x99, 0x5d, 0x05, 0xd1, 0xc7, 0x48, 0x97, 0x5f, 0x83, 0xf9, 0x09,
-	0x97, 0x2f, 0x7e, 0xc2, 0xeb, 0x52, 0xe6, 0xd9, 0x6c, 0xce, 0x23, 0xa4, 0xee, 0x06, 0x3e, 0x8e,
-	0xa5, 0xbc, 0x51, 0xb9, 0x78, 0xe1, 0x35, 0x29, 0xd2, 0xc7, 0xb1, 0xd0, 0xd6, 0xf7, 0xd1, 0x15,
-	0x25, 0x9b, 0x02, 0x03, 0x6e, 0x54, 0xff, 0xbb, 0x60, 0x31, 0x35, 0xb1, 0xe4, 0xba, 0x44, 0xba,
-	0x19, 0xf0, 0x4f, 0x5b, 0xfe, 0xa0, 0xa1, 0xeb, 0xe2, 0x08, 0xc3, 0x03, 0x16, 0xcc, 0xf6, 0xfc,
-	0x10, 0xd5, 0x70, 0x7e, 0x50, 0xbb, 0xde, 0x58, 0x12, 0xec, 0xc5, 0x63, 0xa7, 0xf1, 0x65, 0x91,
-	0xd3, 0x9d, 0x21, 0xf5, 0xdb, 0x68, 0x1d, 0x4b, 0xf6, 0x41, 0x04, 0x8c, 0xe1, 0x00, 0x98, 0xb1,
-	0xd2, 0x29, 0x77, 0x6b, 0xee, 0x35, 0x75, 0x7f, 0xa0, 0xae, 0xf7, 0x6e, 0xbc, 0xfb, 0xd8, 0x2e,
-	0x2d, 0x1b, 0x7c, 0xab, 0xa1, 0xea, 0x7e, 0xf6, 0xb2, 0x74, 0x03, 0x5d, 0x12, 0x4f, 0x0c, 0x52,
-	0x61, 0xa8, 0xe6, 0xe6, 0xc7, 0x59, 0x05, 0xc4, 0x83, 0x2a, 0x2a, 0x0b, 0x31, 0xca, 0xe7, 0x8d,
-	0xe1, 0xf4, 0x4e, 0x26, 0xa6, 0x76, 0x3a, 0x31, 0xb5, 0x9f, 0x13, 0x53, 0x3b, 0x9e, 0x9a, 0xa5,
-	0xd3, 0xa9, 0x59, 0xfa, 0x3e, 0x35, 0x4b, 0x2f, 0xb6, 0xfe, 0xb9, 0xd5, 0xa3, 0xe2, 0x0f, 0xcf,
-	0x5b, 0x15, 0x72, 0x77, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x08, 0x9a, 0x3d, 0x1b, 0x05,
-	0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0x8f, 0xe3, 0xb4, 0xdf, 0x6f, 0x2f, 0x10, 0x5a, 0x53, 0xc0, 0xed, 0xe0, 0x44, 0x45, 0xa2,
+	0x61, 0xa8, 0x4d, 0xcb, 0x56, 0x16, 0xea, 0x42, 0x2b, 0x24, 0x2a, 0x55, 0x26, 0x13, 0x8b, 0x75,
+	0x76, 0x5e, 0x8c, 0x45, 0xec, 0xb3, 0x7c, 0x17, 0x70, 0x56, 0x26, 0xc6, 0x8e, 0x4c, 0x88, 0x99,
+	0x19, 0xf1, 0x37, 0x54, 0x4c, 0x15, 0x2c, 0x4c, 0x14, 0x25, 0xff, 0x08, 0xf2, 0xdd, 0x39, 0x09,
+	0x09, 0x05, 0x09, 0x85, 0x29, 0xbe, 0x7b, 0xef, 0xf3, 0xe3, 0x7d, 0x5e, 0x2c, 0xa3, 0x5b, 0x3e,
+	0xa1, 0x11, 0xa1, 0x56, 0x07, 0x20, 0x48, 0x71, 0xcc, 0xac, 0x17, 0xdb, 0x1e, 0x30, 0xbc, 0x3d,
+	0xba, 0x30, 0x93, 0x94, 0x30, 0xa2, 0xdd, 0x10, 0x7d, 0xe6, 0xe8, 0x5a, 0xf6, 0xad, 0xaf, 0x06,
+	0x24, 0x20, 0xbc, 0xc7, 0xca, 0x9f, 0x44, 0xfb, 0xfa, 0x5a, 0x40, 0x48, 0xd0, 0x05, 0x8b, 0x9f,
+	0xbc, 0x5e, 0xc7, 0xc2, 0x71, 0xbf, 0x28, 0x09, 0x26, 0x57, 0x60, 0x24, 0xad, 0x28, 0x19, 0xd2,
+	0x8c, 0x87, 0x29, 0x8c, 0x8c, 0xf8, 0x24, 0x8c, 0x65, 0xbd, 0x3e, 0xcd, 0xca, 0xc2, 0x08, 0x28,
+	0xc3, 0x51, 0x52, 0x10, 0x4c, 0x37, 0xb4, 0x7b, 0x29, 0x66, 0x21, 0x91, 0x04, 0x1b, 0x5f, 0x14,
+	0x54, 0xb3, 0x31, 0x0d, 0xfd, 0xbd, 0x6e, 0x97, 0xbc, 0xc4, 0xb1, 0x0f, 0x5a, 0x17, 0x55, 0x69,
+	0x02, 0x71, 0xdb, 0xed, 0x86, 0x51, 0xc8, 0x74, 0xa5, 0xa1, 0x36, 0xab, 0x3b, 0x6b, 0xa6, 0xf4,
+	0x95, 0x3b, 0x29, 0x46, 0x35, 0xf7, 0x49, 0x18, 0xdb, 0x77, 0x4e, 0xbf, 0xd5, 0x4b, 0xef, 0xcf,
+	0xeb, 0xcd, 0x20, 0x64, 0xcf, 0x7a, 0x9e, 0xe9, 0x93, 0x48, 0x0e, 0x21, 0x7f, 0xb6, 0x68, 0xfb,
+	0xb9, 0xc5, 0xfa, 0x09, 0x50, 0x0e, 0xa0, 0x0e, 0xe2, 0xfc, 0x8f, 0x73, 0x7a, 0xed, 0x3e, 0x42,
+	0x90, 0x25, 0xa1, 0x30, 0xa5, 0x97, 0x1b, 0x4a, 0xb3, 0xba, 0xb3, 0x6e, 0x0a, 0xd7, 0x66, 0xe1,
+	0xda, 0x6c, 0x15, 0x63, 0xd9, 0x95, 0x93, 0xf3, 0xba, 0xe2, 0x4c, 0x60, 0x76, 0x57, 0x3e, 0x7f,
+	0xd8, 0xba, 0x7c, 0x00, 0x30, 0x9a, 0xe0, 0xd1, 0xc6, 0x50, 0x45, 0x2b, 0xc7, 0x90, 0x86, 0xa4,
+	0x3d, 0x39, 0xd8, 0x3e, 0x5a, 0xf0, 0xf2, 0x51, 0x75, 0x85, 0xab, 0x6c, 0x9a, 0x17, 0x6c, 0xd0,
+	0xfc, 0x39, 0x10, 0xbb, 0x92, 0x0f, 0xe8, 0x08, 0xac, 0x76, 0x0f, 0x2d, 0x26, 0x9c, 0x59, 0x7a,
+	0x5d, 0x9b, 0xf1, 0xfa, 0x40, 0x26, 0x6c, 0xff, 0x9f, 0xe3, 0xde, 0xe4, 0x76, 0x25, 0x44, 0xeb,
+	0x23, 0x4d, 0x3c, 0xb9, 0x93, 0x09, 0xab, 0xf3, 0x4f, 0x78, 0x59, 0xc8, 0x3c, 0x19, 0xe7, 0xdc,
+	0x43, 0xf2, 0xce, 0xf5, 0x71, 0x2c, 0xe4, 0xf5, 0xca, 0xfc, 0x85, 0x6b, 0x42, 0x64, 0x1f, 0xc7,
+	0x5c, 0x5b, 0x3b, 0x44, 0x97, 0xa4, 0x6c, 0x0a, 0x14, 0x98, 0xbe, 0xf0, 0xc7, 0x05, 0xf3, 0xd4,
+	0xf8, 0x92, 0xab, 0x02, 0xe9, 0xe4, 0xc0, 0x5f, 0x6d, 0xf9, 0xad, 0x82, 0xae, 0xf2, 0x23, 0xb4,
+	0x8f, 0x68, 0x30, 0xde, 0xf3, 0x43, 0xb4, 0x84, 0x8b, 0x83, 0xdc, 0xf5, 0xea, 0x8c, 0xe0, 0x5e,
+	0xdc, 0xb7, 0x57, 0x3e, 0x4d, 0x73, 0x3a, 0x63, 0xa4, 0x76, 0x1b, 0x2d, 0x63, 0xc1, 0xee, 0x46,
+	0x40, 0x29, 0x0e, 0x80, 0xea, 0xe5, 0x86, 0xda, 0x5c, 0x72, 0xae, 0xc8, 0xfb, 0x23, 0x79, 0xbd,
+	0x7b, 0xed, 0xf5, 0xbb, 0x7a, 0x69, 0xd6, 0xe0, 0xc7, 0x32, 0xba, 0x2e, 0x0d, 0xb6, 0x70, 0x1a,
+	0x00, 0x9b, 0xbb, 0xc7, 0x4d, 0x54, 0x78, 0x71, 0x19, 0x57, 0x28, 0x2c, 0xd6, 0xf0, 0xa4, 0x2e,
+	0xd5, 0x6e, 0xa2, 0x5a, 0x84, 0x33, 0x37, 0xc0, 0xd4, 0x4d, 0x20, 0x75, 0x59, 0xa6, 0xab, 0x0d,
+	0xa5, 0x59, 0x71, 0xaa, 0x11, 0xce, 0x0e, 0x31, 0x3d, 0x86, 0xb4, 0x95, 0x69, 0x89, 0x68, 0xea,
+	0x00, 0x14, 0x4d, 0xff, 0xe0, 0x1f, 0x92, 0x2b, 0x1e, 0x00, 0x70, 0xc5, 0x8b, 0x82, 0x7b, 0xa5,
+	0xa0, 0x85, 0xc3, 0xfc, 0x95, 0xd4, 0x74, 0xf4, 0x1f, 0x7f, 0x37, 0x21, 0xe5, 0x29, 0x2d, 0x39,
+	0xc5, 0x71, 0x5c, 0x01, 0xbd, 0x3c, 0x59, 0x99, 0xca, 0x56, 0xfd, 0xdb, 0x6c, 0xed, 0xbd, 0xd3,
+	0x81, 0xa1, 0x9c, 0x0d, 0x0c, 0xe5, 0xfb, 0xc0, 0x50, 0x4e, 0x86, 0x46, 0xe9, 0x6c, 0x68, 0x94,
+	0xbe, 0x0e, 0x8d, 0xd2, 0xd3, 0xcd, 0xdf, 0x0e, 0x9b, 0x8d, 0xbe, 0x14, 0xde, 0x22, 0x97, 0xbb,
+	0xfb, 0x63, 0x00, 0x4b, 0x41, 0x0b, 0x25, 0x54, 0x06, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -501,6 +554,69 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowedTargetAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedTargetAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedTargetAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFeePerTx) > 0 {
+		for iNdEx := len(m.MaxFeePerTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFeePerTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedTargets) > 0 {
+		for iNdEx := len(m.AllowedTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedTargets[iNdEx])
+			copy(dAtA[i:], m.AllowedTargets[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedTargets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -626,6 +742,34 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *AllowedTargetAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedTargets) > 0 {
+		for _, s := range m.AllowedTargets {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGasPerTx))
+	}
+	if len(m.MaxFeePerTx) > 0 {
+		for _, e := range m.MaxFeePerTx {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1108,6 +1252,177 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AllowedTargetAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedTargetAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedTargetAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedTargets = append(m.AllowedTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFeePerTx = append(m.MaxFeePerTx, types.Coin{})
+			if err := m.MaxFeePerTx[len(m.MaxFeePerTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -103,3 +105,14 @@ func (a *AllowedMsgAllowance) ValidateBasic() error {
 
 	return allowance.ValidateBasic()
 }
+
+// ExpiresAt implements FeeAllowance.ExpiresAt, returning the expiry time of the
+// wrapped allowance
+func (a *AllowedMsgAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
+}
@@ -93,3 +93,39 @@ func (q Keeper) Allowances(c context.Context, req *feegrant.QueryAllowancesReque
 
 	return &feegrant.QueryAllowancesResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowancesByGranter queries all the allowances granted by the given granter.
+func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowancesByGranterRequest) (*feegrant.QueryAllowancesByGranterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*feegrant.Grant
+
+	store := ctx.KVStore(q.storeKey)
+	granterStore := prefix.NewStore(store, feegrant.FeeAllowancePrefixByGranter(granterAddr))
+
+	pageRes, err := query.Paginate(granterStore, req.Pagination, func(key []byte, _ []byte) error {
+		granteeAddr := feegrant.GranteeFromFeeAllowanceByGranterStore(key)
+		grant, err := q.getGrant(ctx, granterAddr, granteeAddr)
+		if err != nil {
+			return err
+		}
+
+		grants = append(grants, grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
+}
@@ -148,6 +148,89 @@ func (suite *KeeperTestSuite) TestFeeAllowances() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
+	testCases := []struct {
+		name      string
+		req       *feegrant.QueryAllowancesByGranterRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *feegrant.QueryAllowancesByGranterResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			func() {},
+			func(*feegrant.QueryAllowancesByGranterResponse) {},
+		},
+		{
+			"fail: invalid granter",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter: "invalid_granter",
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowancesByGranterResponse) {},
+		},
+		{
+			"no grants",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter: suite.addrs[0].String(),
+			},
+			false,
+			func() {},
+			func(resp *feegrant.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 0)
+			},
+		},
+		{
+			"valid query: expect single grant",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter: suite.addrs[0].String(),
+			},
+			false,
+			func() {
+				grantFeeAllowance(suite)
+			},
+			func(resp *feegrant.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 1)
+				suite.Require().Equal(resp.Allowances[0].Granter, suite.addrs[0].String())
+				suite.Require().Equal(resp.Allowances[0].Grantee, suite.addrs[1].String())
+			},
+		},
+		{
+			"valid query: revoked grant is not returned",
+			&feegrant.QueryAllowancesByGranterRequest{
+				Granter: suite.addrs[0].String(),
+			},
+			false,
+			func() {
+				_, err := suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{
+					Granter: suite.addrs[0].String(),
+					Grantee: suite.addrs[1].String(),
+				})
+				suite.Require().NoError(err)
+			},
+			func(resp *feegrant.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.keeper.AllowancesByGranter(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
 func grantFeeAllowance(suite *KeeperTestSuite) {
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
 	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{
@@ -48,6 +48,15 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 
 	store := ctx.KVStore(k.storeKey)
 	key := feegrant.FeeAllowanceKey(granter, grantee)
+
+	// remove the existing grant from the expiration queue, it is queued again
+	// below with the expiration of the new allowance
+	if store.Has(key) {
+		if err := k.dequeueAllowance(ctx, granter, grantee); err != nil {
+			return err
+		}
+	}
+
 	grant, err := feegrant.NewGrant(granter, grantee, feeAllowance)
 	if err != nil {
 		return err
@@ -58,7 +67,16 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 		return err
 	}
 
+	exp, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
 	store.Set(key, bz)
+	store.Set(feegrant.FeeAllowanceByGranterKey(granter, grantee), []byte{0})
+	if exp != nil {
+		store.Set(feegrant.FeeAllowanceQueueKey(*exp, granter, grantee), []byte{0})
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -73,14 +91,14 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 
 // revokeAllowance removes an existing grant
 func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
-	_, err := k.getGrant(ctx, granter, grantee)
-	if err != nil {
+	if err := k.dequeueAllowance(ctx, granter, grantee); err != nil {
 		return err
 	}
 
 	store := ctx.KVStore(k.storeKey)
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 	store.Delete(key)
+	store.Delete(feegrant.FeeAllowanceByGranterKey(granter, grantee))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -92,6 +110,57 @@ func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	return nil
 }
 
+// dequeueAllowance removes an existing grant from the expiration queue
+func (k Keeper) dequeueAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	allowance, err := k.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	exp, err := allowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	if exp != nil {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(feegrant.FeeAllowanceQueueKey(*exp, granter, grantee))
+	}
+
+	return nil
+}
+
+// RemoveExpiredAllowances removes at most limit fee allowances expired before
+// the block time, along with their granter index and expiration queue entries,
+// and returns the number of allowances removed.
+func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(feegrant.FeeAllowanceQueueKeyPrefix, feegrant.FeeAllowancePrefixQueue(ctx.BlockTime()))
+
+	var queueKeys [][]byte
+	for ; iterator.Valid() && len(queueKeys) < limit; iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, queueKey := range queueKeys {
+		granter, grantee := feegrant.ParseAddressesFromFeeAllowanceQueueKey(queueKey)
+		store.Delete(queueKey)
+		store.Delete(feegrant.FeeAllowanceKey(granter, grantee))
+		store.Delete(feegrant.FeeAllowanceByGranterKey(granter, grantee))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				feegrant.EventTypePruneFeeGrant,
+				sdk.NewAttribute(feegrant.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee.String()),
+			),
+		)
+	}
+
+	return len(queueKeys)
+}
+
 // GetAllowance returns the allowance between the granter and grantee.
 // If there is none, it returns nil, nil.
 // Returns an error on parsing issues
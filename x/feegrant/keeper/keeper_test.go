@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 )
@@ -259,3 +261,78 @@ func (suite *KeeperTestSuite) TestIterateGrants() {
 	})
 
 }
+
+func (suite *KeeperTestSuite) TestRemoveExpiredAllowances() {
+	now := time.Now().UTC()
+	oneHour := now.Add(time.Hour)
+	threeHours := now.Add(3 * time.Hour)
+	ctx := suite.sdkCtx.WithBlockTime(now)
+
+	expiring := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneHour}
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], expiring))
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], expiring))
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[3], &feegrant.BasicAllowance{SpendLimit: suite.atom}))
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[1], suite.addrs[3], expiring))
+
+	// updating an allowance moves it in the expiration queue
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[1], suite.addrs[2], expiring))
+	extended, err := feegrant.NewAllowedMsgAllowance(
+		&feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &threeHours}, []string{"/cosmos.bank.v1beta1.MsgSend"},
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[1], suite.addrs[2], extended))
+
+	// revoking an allowance removes it from the expiration queue
+	revoke := feegrant.NewMsgRevokeAllowance(suite.addrs[1], suite.addrs[3])
+	_, err = suite.msgSrvr.RevokeAllowance(sdk.WrapSDKContext(ctx), &revoke)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(0, suite.keeper.RemoveExpiredAllowances(ctx.WithBlockTime(oneHour), 10))
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	suite.Require().Equal(1, suite.keeper.RemoveExpiredAllowances(ctx, 1))
+	suite.Require().Equal(1, suite.keeper.RemoveExpiredAllowances(ctx, 10))
+	suite.Require().Equal(0, suite.keeper.RemoveExpiredAllowances(ctx, 10))
+
+	for _, grantee := range []sdk.AccAddress{suite.addrs[1], suite.addrs[2]} {
+		_, err = suite.keeper.GetAllowance(ctx, suite.addrs[0], grantee)
+		suite.Require().Error(err)
+	}
+	_, err = suite.keeper.GetAllowance(ctx, suite.addrs[0], suite.addrs[3])
+	suite.Require().NoError(err)
+	_, err = suite.keeper.GetAllowance(ctx, suite.addrs[1], suite.addrs[2])
+	suite.Require().NoError(err)
+
+	res, err := suite.keeper.AllowancesByGranter(sdk.WrapSDKContext(ctx), &feegrant.QueryAllowancesByGranterRequest{Granter: suite.addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Allowances, 1)
+	suite.Require().Equal(suite.addrs[3].String(), res.Allowances[0].Grantee)
+
+	suite.Require().Equal(0, suite.keeper.RemoveExpiredAllowances(ctx.WithBlockTime(threeHours), 10))
+	suite.Require().Equal(1, suite.keeper.RemoveExpiredAllowances(ctx.WithBlockTime(threeHours.Add(time.Nanosecond)), 10))
+	_, err = suite.keeper.GetAllowance(ctx, suite.addrs[1], suite.addrs[2])
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeeAllowedTargets() {
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	allowance, err := feegrant.NewAllowedTargetAllowance(
+		&feegrant.BasicAllowance{SpendLimit: suite.atom}, []string{suite.addrs[2].String()}, 0, nil,
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], allowance))
+
+	// sending to an address out of the allowed targets is rejected
+	msgs := []sdk.Msg{banktypes.NewMsgSend(suite.addrs[1], suite.addrs[3], smallAtom)}
+	suite.Require().Error(suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[1], smallAtom, msgs))
+
+	msgs = []sdk.Msg{banktypes.NewMsgSend(suite.addrs[1], suite.addrs[2], smallAtom)}
+	suite.Require().NoError(suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[1], smallAtom, msgs))
+
+	// the fee is deducted from the stored wrapped allowance
+	loaded, err := suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	basic, err := loaded.(*feegrant.AllowedTargetAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom.Sub(smallAtom), basic.(*feegrant.BasicAllowance).SpendLimit)
+}
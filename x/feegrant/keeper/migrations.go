@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	v045 "github.com/cosmos/cosmos-sdk/x/feegrant/legacy/v045"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v045.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates from version 2 to 3, adding the fee allowances with an
+// expiration to the expiration queue.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var grants []feegrant.Grant
+	err := m.keeper.IterateAllFeeAllowances(ctx, func(grant feegrant.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, grant := range grants {
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			return err
+		}
+
+		allowance, err := grant.GetGrant()
+		if err != nil {
+			return err
+		}
+
+		exp, err := allowance.ExpiresAt()
+		if err != nil {
+			return err
+		}
+
+		if exp != nil {
+			store.Set(feegrant.FeeAllowanceQueueKey(*exp, granter, grantee), []byte{0})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	now := time.Now().UTC()
+	oneHour := now.Add(time.Hour)
+	ctx := suite.sdkCtx.WithBlockTime(now)
+
+	expiring := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneHour}
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *expiring,
+		Period:           time.Minute,
+		PeriodSpendLimit: suite.atom,
+	}
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], expiring))
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], periodic))
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[3], &feegrant.BasicAllowance{SpendLimit: suite.atom}))
+
+	// remove the expiration queue, as in a store of version 2
+	store := ctx.KVStore(suite.app.GetKey(feegrant.StoreKey))
+	iterator := sdk.KVStorePrefixIterator(store, feegrant.FeeAllowanceQueueKeyPrefix)
+	var queueKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+	suite.Require().Len(queueKeys, 2)
+	for _, key := range queueKeys {
+		store.Delete(key)
+	}
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	suite.Require().Equal(0, suite.keeper.RemoveExpiredAllowances(ctx, 10))
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate2to3(ctx))
+	suite.Require().Equal(2, suite.keeper.RemoveExpiredAllowances(ctx, 10))
+
+	_, err := suite.keeper.GetAllowance(ctx, suite.addrs[0], suite.addrs[3])
+	suite.Require().NoError(err)
+}
@@ -9,6 +9,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
+// MaxPrunedAllowancesPerMsg is the maximum number of expired fee allowances
+// removed by a MsgPruneAllowances.
+const MaxPrunedAllowancesPerMsg = 75
+
+// PruneAllowanceRefundGas is the gas refunded for each expired fee allowance
+// removed by a MsgPruneAllowances.
+const PruneAllowanceRefundGas = 1000
+
+// MaxPruneAllowancesRefundGas caps the gas refunded for a MsgPruneAllowances.
+const MaxPruneAllowancesRefundGas = 50000
+
 type msgServer struct {
 	Keeper
 }
@@ -76,3 +87,25 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// PruneAllowances removes expired allowances. A fixed amount of gas is refunded
+// per removed allowance, up to MaxPruneAllowancesRefundGas and to the gas spent
+// removing them.
+func (k msgServer) PruneAllowances(goCtx context.Context, msg *feegrant.MsgPruneAllowances) (*feegrant.MsgPruneAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	removed := k.Keeper.RemoveExpiredAllowances(ctx, MaxPrunedAllowancesPerMsg)
+
+	// the queue iterator is closed once RemoveExpiredAllowances returns
+	refund := uint64(removed) * PruneAllowanceRefundGas
+	if refund > MaxPruneAllowancesRefundGas {
+		refund = MaxPruneAllowancesRefundGas
+	}
+	if spent := ctx.GasMeter().GasConsumed() - gasBefore; refund > spent {
+		refund = spent
+	}
+	ctx.GasMeter().RefundGas(refund, "prune fee allowances")
+
+	return &feegrant.MsgPruneAllowancesResponse{}, nil
+}
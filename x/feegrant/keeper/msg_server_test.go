@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"fmt"
+	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 )
 
 func (suite *KeeperTestSuite) TestGrantAllowance() {
@@ -221,3 +225,65 @@ func (suite *KeeperTestSuite) TestRevokeAllowance() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestPruneAllowances() {
+	now := time.Now().UTC()
+	oneHour := now.Add(time.Hour)
+	ctx := suite.sdkCtx.WithBlockTime(now)
+
+	expiring := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneHour}
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], expiring))
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], expiring))
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[3], &feegrant.BasicAllowance{SpendLimit: suite.atom}))
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	msg := feegrant.NewMsgPruneAllowances(suite.addrs[3])
+
+	// removing the allowances from the keeper directly gives the gas spent
+	keeperCtx, _ := ctx.CacheContext()
+	keeperCtx = keeperCtx.WithGasMeter(sdk.NewGasMeter(1000000))
+	suite.Require().Equal(2, suite.keeper.RemoveExpiredAllowances(keeperCtx, 10))
+
+	// a fixed amount of gas is refunded per removed allowance
+	msgCtx := ctx.WithGasMeter(sdk.NewGasMeter(1000000))
+	_, err := suite.msgSrvr.PruneAllowances(sdk.WrapSDKContext(msgCtx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(keeperCtx.GasMeter().GasConsumed()-2*keeper.PruneAllowanceRefundGas, msgCtx.GasMeter().GasConsumed())
+
+	for _, grantee := range []sdk.AccAddress{suite.addrs[1], suite.addrs[2]} {
+		_, err = suite.keeper.GetAllowance(ctx, suite.addrs[0], grantee)
+		suite.Require().Error(err)
+	}
+	_, err = suite.keeper.GetAllowance(ctx, suite.addrs[0], suite.addrs[3])
+	suite.Require().NoError(err)
+
+	// pruning without expired allowances succeeds
+	_, err = suite.msgSrvr.PruneAllowances(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPruneAllowancesRefundCap() {
+	now := time.Now().UTC()
+	oneHour := now.Add(time.Hour)
+	ctx := suite.sdkCtx.WithBlockTime(now)
+
+	expiring := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneHour}
+	for i := 0; i < keeper.MaxPrunedAllowancesPerMsg; i++ {
+		grantee := sdk.AccAddress(fmt.Sprintf("prune-grantee-%d", i))
+		suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], grantee, expiring))
+	}
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	msg := feegrant.NewMsgPruneAllowances(suite.addrs[3])
+
+	keeperCtx, _ := ctx.CacheContext()
+	keeperCtx = keeperCtx.WithGasMeter(sdk.NewGasMeter(10000000))
+	suite.Require().Equal(keeper.MaxPrunedAllowancesPerMsg, suite.keeper.RemoveExpiredAllowances(keeperCtx, keeper.MaxPrunedAllowancesPerMsg))
+
+	// the refund is capped
+	suite.Require().Greater(keeper.MaxPrunedAllowancesPerMsg*keeper.PruneAllowanceRefundGas, keeper.MaxPruneAllowancesRefundGas)
+	msgCtx := ctx.WithGasMeter(sdk.NewGasMeter(10000000))
+	_, err := suite.msgSrvr.PruneAllowances(sdk.WrapSDKContext(msgCtx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(keeperCtx.GasMeter().GasConsumed()-keeper.MaxPruneAllowancesRefundGas, msgCtx.GasMeter().GasConsumed())
+}
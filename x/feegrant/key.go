@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -22,6 +24,14 @@ const (
 var (
 	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
 	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// FeeAllowanceByGranterKeyPrefix is the prefix of the index of the fee
+	// allowances by granter
+	FeeAllowanceByGranterKeyPrefix = []byte{0x01}
+
+	// FeeAllowanceQueueKeyPrefix is the prefix of the queue of the fee
+	// allowances by expiration time
+	FeeAllowanceQueueKeyPrefix = []byte{0x02}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -34,3 +44,51 @@ func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
 func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
 }
+
+// FeeAllowanceByGranterKey is the key of the index entry of a grant from granter
+// to grantee, used to search for all grants given by a granter
+func FeeAllowanceByGranterKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGranter(granter), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// FeeAllowancePrefixByGranter returns a prefix to scan for all grants given by this address.
+func FeeAllowancePrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(FeeAllowanceByGranterKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
+
+// GranteeFromFeeAllowanceByGranterStore returns the grantee address from a key of
+// the granter index, stripped of its FeeAllowancePrefixByGranter prefix, as
+// iterated by a granter prefix store.
+func GranteeFromFeeAllowanceByGranterStore(key []byte) sdk.AccAddress {
+	addrLen := key[0]
+	return sdk.AccAddress(key[1 : 1+addrLen])
+}
+
+// FeeAllowanceQueueKey is the key of the expiration queue entry of a grant from
+// granter to grantee expiring at the given time
+func FeeAllowanceQueueKey(exp time.Time, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixQueue(exp), FeeAllowanceKey(granter, grantee)[len(FeeAllowanceKeyPrefix):]...)
+}
+
+// FeeAllowancePrefixQueue returns the prefix of the expiration queue entries of
+// the grants expiring at the given time. Iterating up to this prefix yields the
+// grants expired before that time.
+func FeeAllowancePrefixQueue(exp time.Time) []byte {
+	return append(append([]byte{}, FeeAllowanceQueueKeyPrefix...), sdk.FormatTimeBytes(exp)...)
+}
+
+// ParseAddressesFromFeeAllowanceQueueKey returns the granter and grantee
+// addresses from a key of the expiration queue.
+func ParseAddressesFromFeeAllowanceQueueKey(key []byte) (granter, grantee sdk.AccAddress) {
+	// key is of format:
+	// 0x02<expiration><granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes>
+	granteeAddrLenIndex := len(FeeAllowanceQueueKeyPrefix) + len(sdk.FormatTimeBytes(time.Time{}))
+	granteeAddrLen := int(key[granteeAddrLenIndex])
+	grantee = sdk.AccAddress(key[granteeAddrLenIndex+1 : granteeAddrLenIndex+1+granteeAddrLen])
+
+	granterAddrLenIndex := granteeAddrLenIndex + 1 + granteeAddrLen
+	granterAddrLen := int(key[granterAddrLenIndex])
+	granter = sdk.AccAddress(key[granterAddrLenIndex+1 : granterAddrLenIndex+1+granterAddrLen])
+
+	return granter, grantee
+}
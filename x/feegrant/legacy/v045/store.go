@@ -0,0 +1,48 @@
+package v045
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Add an index of the fee allowances by granter, used by the
+// AllowancesByGranter query.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return addGranterIndex(store, cdc)
+}
+
+// addGranterIndex adds an entry to the granter index for every fee allowance
+// of the store.
+func addGranterIndex(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	grantsStore := prefix.NewStore(store, feegrant.FeeAllowanceKeyPrefix)
+
+	iterator := grantsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant feegrant.Grant
+		if err := cdc.Unmarshal(iterator.Value(), &grant); err != nil {
+			return err
+		}
+
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			return err
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			return err
+		}
+
+		store.Set(feegrant.FeeAllowanceByGranterKey(granter, grantee), []byte{0})
+	}
+
+	return nil
+}
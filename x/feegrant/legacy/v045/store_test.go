@@ -0,0 +1,66 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	v045feegrant "github.com/cosmos/cosmos-sdk/x/feegrant/legacy/v045"
+)
+
+func TestGranterIndexMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	feegrantKey := sdk.NewKVStoreKey(feegrant.StoreKey)
+	ctx := testutil.DefaultContext(feegrantKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(feegrantKey)
+
+	_, _, granter1 := testdata.KeyTestPubAddr()
+	_, _, granter2 := testdata.KeyTestPubAddr()
+	_, _, grantee1 := testdata.KeyTestPubAddr()
+	_, _, grantee2 := testdata.KeyTestPubAddr()
+
+	allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))}
+	grants := []struct {
+		granter, grantee sdk.AccAddress
+	}{
+		{granter1, grantee1},
+		{granter1, grantee2},
+		{granter2, grantee1},
+	}
+	for _, g := range grants {
+		grant, err := feegrant.NewGrant(g.granter, g.grantee, allowance)
+		require.NoError(t, err)
+		bz, err := encCfg.Marshaler.Marshal(&grant)
+		require.NoError(t, err)
+		store.Set(feegrant.FeeAllowanceKey(g.granter, g.grantee), bz)
+	}
+
+	require.NoError(t, v045feegrant.MigrateStore(ctx, feegrantKey, encCfg.Marshaler))
+
+	grantees := func(granter sdk.AccAddress) []sdk.AccAddress {
+		granterStore := prefix.NewStore(store, feegrant.FeeAllowancePrefixByGranter(granter))
+		iterator := granterStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		var addrs []sdk.AccAddress
+		for ; iterator.Valid(); iterator.Next() {
+			addrs = append(addrs, feegrant.GranteeFromFeeAllowanceByGranterStore(iterator.Key()))
+		}
+		return addrs
+	}
+
+	require.ElementsMatch(t, []sdk.AccAddress{grantee1, grantee2}, grantees(granter1))
+	require.Equal(t, []sdk.AccAddress{grantee1}, grantees(granter2))
+	require.Empty(t, grantees(grantee1))
+
+	// grants are left untouched
+	for _, g := range grants {
+		require.NotNil(t, store.Get(feegrant.FeeAllowanceKey(g.granter, g.grantee)))
+	}
+}
@@ -0,0 +1,23 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+// MaxPrunedAllowancesPerBlock is the maximum number of expired fee allowances
+// removed at the end of a block, bounding the work of the EndBlocker when many
+// allowances expire at once.
+const MaxPrunedAllowancesPerBlock = 200
+
+// EndBlocker is called at the end of every block, removes the expired fee
+// allowances.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(feegrant.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.RemoveExpiredAllowances(ctx, MaxPrunedAllowancesPerBlock)
+}
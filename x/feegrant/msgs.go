@@ -11,8 +11,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
+	_, _, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgPruneAllowances{}
+	_, _, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgPruneAllowances{} // For amino support.
 
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
@@ -142,3 +142,42 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgPruneAllowances returns a message to remove the expired fee allowances
+//nolint:interfacer
+func NewMsgPruneAllowances(pruner sdk.AccAddress) *MsgPruneAllowances {
+	return &MsgPruneAllowances{Pruner: pruner.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgPruneAllowances) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Pruner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pruner address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners gets the pruner address.
+func (msg MsgPruneAllowances) GetSigners() []sdk.AccAddress {
+	pruner, err := sdk.AccAddressFromBech32(msg.Pruner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{pruner}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgPruneAllowances) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgPruneAllowances) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgPruneAllowances) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
@@ -132,3 +132,27 @@ func TestMsgRevokeAllowance(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgPruneAllowances(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+
+	cases := map[string]struct {
+		pruner string
+		valid  bool
+	}{
+		"valid":          {pruner: addr.String(), valid: true},
+		"no pruner":      {pruner: "", valid: false},
+		"invalid pruner": {pruner: "invalid", valid: false},
+	}
+
+	for _, tc := range cases {
+		msg := feegrant.MsgPruneAllowances{Pruner: tc.pruner}
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
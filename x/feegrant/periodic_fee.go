@@ -105,3 +105,8 @@ func (a PeriodicAllowance) ValidateBasic() error {
 
 	return nil
 }
+
+// ExpiresAt implements FeeAllowance.ExpiresAt
+func (a *PeriodicAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Basic.Expiration, nil
+}
@@ -19,6 +19,9 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], feegrant.FeeAllowanceByGranterKeyPrefix),
+			bytes.Equal(kvA.Key[:1], feegrant.FeeAllowanceQueueKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid feegrant key %X", kvA.Key))
 		}
@@ -0,0 +1,173 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TargetedMsg is implemented by the messages acting on addresses other than
+// their signers, e.g. the recipient of a MsgSend or the validator of a
+// MsgDelegate. Only such messages can be paid for by an AllowedTargetAllowance.
+type TargetedMsg interface {
+	sdk.Msg
+
+	// GetTargets returns the bech32 account or validator addresses targeted by
+	// the message.
+	GetTargets() []string
+}
+
+var _ FeeAllowanceI = (*AllowedTargetAllowance)(nil)
+var _ types.UnpackInterfacesMessage = (*AllowedTargetAllowance)(nil)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedTargetAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewAllowedTargetAllowance creates a new allowance paying only for the
+// transactions whose messages all target allowedTargets. A zero maxGasPerTx or
+// empty maxFeePerTx sets no ceiling on the gas limit or fee of a transaction.
+func NewAllowedTargetAllowance(
+	allowance FeeAllowanceI, allowedTargets []string, maxGasPerTx uint64, maxFeePerTx sdk.Coins,
+) (*AllowedTargetAllowance, error) {
+	a := &AllowedTargetAllowance{
+		AllowedTargets: allowedTargets,
+		MaxGasPerTx:    maxGasPerTx,
+		MaxFeePerTx:    maxFeePerTx,
+	}
+	if err := a.SetAllowance(allowance); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *AllowedTargetAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *AllowedTargetAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	a.Allowance = any
+	return nil
+}
+
+// Accept checks the gas limit and fee of the transaction against the ceilings
+// of the allowance and the targets of its messages against the allowed
+// targets, before deducting the fee from the wrapped allowance.
+func (a *AllowedTargetAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if a.MaxGasPerTx > 0 && ctx.GasMeter().Limit() > a.MaxGasPerTx {
+		return false, sdkerrors.Wrapf(ErrGasLimitExceeded, "gas limit %d is more than %d", ctx.GasMeter().Limit(), a.MaxGasPerTx)
+	}
+
+	if !a.MaxFeePerTx.Empty() && !fee.IsAllLTE(a.MaxFeePerTx) {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "fee %s is more than %s", fee, a.MaxFeePerTx)
+	}
+
+	if err := a.allMsgTargetsAllowed(ctx, msgs); err != nil {
+		return false, err
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil || remove {
+		return remove, err
+	}
+
+	// store the updated state of the wrapped allowance
+	return false, a.SetAllowance(allowance)
+}
+
+func (a *AllowedTargetAllowance) allMsgTargetsAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	targets := make(map[string]bool, len(a.AllowedTargets))
+	for _, target := range a.AllowedTargets {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check target")
+		targets[target] = true
+	}
+
+	for _, msg := range msgs {
+		targetedMsg, ok := msg.(TargetedMsg)
+		if !ok {
+			return sdkerrors.Wrapf(ErrMessageNotAllowed, "message %s has no target", sdk.MsgTypeURL(msg))
+		}
+
+		for _, target := range targetedMsg.GetTargets() {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check target")
+			if !targets[target] {
+				return sdkerrors.Wrapf(ErrMessageNotAllowed, "target %s is not allowed", target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedTargetAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedTargets) == 0 {
+		return sdkerrors.Wrap(ErrNoTargets, "allowed targets shouldn't be empty")
+	}
+
+	targets := make(map[string]bool, len(a.AllowedTargets))
+	for _, target := range a.AllowedTargets {
+		if _, err := sdk.AccAddressFromBech32(target); err != nil {
+			if _, err := sdk.ValAddressFromBech32(target); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid target address %s", target)
+			}
+		}
+		if targets[target] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate target address %s", target)
+		}
+		targets[target] = true
+	}
+
+	if !a.MaxFeePerTx.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max fee per tx is invalid: %s", a.MaxFeePerTx)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt implements FeeAllowance.ExpiresAt, returning the expiry time of the
+// wrapped allowance
+func (a *AllowedTargetAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
+}
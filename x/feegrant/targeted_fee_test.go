@@ -0,0 +1,217 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ feegrant.TargetedMsg = &banktypes.MsgSend{}
+	_ feegrant.TargetedMsg = &banktypes.MsgMultiSend{}
+	_ feegrant.TargetedMsg = &stakingtypes.MsgDelegate{}
+	_ feegrant.TargetedMsg = &stakingtypes.MsgUndelegate{}
+	_ feegrant.TargetedMsg = &stakingtypes.MsgBeginRedelegate{}
+	_ feegrant.TargetedMsg = &stakingtypes.MsgCancelUnbondingDelegation{}
+)
+
+func TestAllowedTargetAllowance(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now}).WithGasMeter(sdk.NewGasMeter(100000))
+
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, contract := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	validator := sdk.ValAddress(contract)
+	otherValidator := sdk.ValAddress(other)
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	stake := sdk.NewInt64Coin("stake", 10)
+	oneHour := now.Add(time.Hour)
+
+	send := banktypes.NewMsgSend(sender, contract, atom)
+	delegate := stakingtypes.NewMsgDelegate(sender, validator, stake)
+
+	cases := map[string]struct {
+		targets  []string
+		maxGas   uint64
+		maxFee   sdk.Coins
+		gasLimit uint64
+		fee      sdk.Coins
+		msgs     []sdk.Msg
+		accept   bool
+	}{
+		"allowed targets": {
+			targets: []string{contract.String(), validator.String()},
+			fee:     smallAtom,
+			msgs:    []sdk.Msg{send, delegate},
+			accept:  true,
+		},
+		"target not allowed": {
+			targets: []string{contract.String()},
+			fee:     smallAtom,
+			msgs:    []sdk.Msg{send, delegate},
+			accept:  false,
+		},
+		"one of the targets not allowed": {
+			targets: []string{validator.String()},
+			fee:     smallAtom,
+			msgs:    []sdk.Msg{stakingtypes.NewMsgBeginRedelegate(sender, validator, otherValidator, stake)},
+			accept:  false,
+		},
+		"message without target": {
+			targets: []string{contract.String()},
+			fee:     smallAtom,
+			msgs:    []sdk.Msg{testdata.NewTestMsg(sender)},
+			accept:  false,
+		},
+		"gas limit under ceiling": {
+			targets:  []string{contract.String()},
+			maxGas:   200000,
+			gasLimit: 200000,
+			fee:      smallAtom,
+			msgs:     []sdk.Msg{send},
+			accept:   true,
+		},
+		"gas limit over ceiling": {
+			targets:  []string{contract.String()},
+			maxGas:   200000,
+			gasLimit: 200001,
+			fee:      smallAtom,
+			msgs:     []sdk.Msg{send},
+			accept:   false,
+		},
+		"fee under ceiling": {
+			targets: []string{contract.String()},
+			maxFee:  smallAtom,
+			fee:     smallAtom,
+			msgs:    []sdk.Msg{send},
+			accept:  true,
+		},
+		"fee over ceiling": {
+			targets: []string{contract.String()},
+			maxFee:  smallAtom,
+			fee:     smallAtom.Add(sdk.NewInt64Coin("atom", 1)),
+			msgs:    []sdk.Msg{send},
+			accept:  false,
+		},
+		"fee denom not in ceiling": {
+			targets: []string{contract.String()},
+			maxFee:  smallAtom,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			msgs:    []sdk.Msg{send},
+			accept:  false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			basic := &feegrant.BasicAllowance{SpendLimit: atom, Expiration: &oneHour}
+			allowance, err := feegrant.NewAllowedTargetAllowance(basic, tc.targets, tc.maxGas, tc.maxFee)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			exp, err := allowance.ExpiresAt()
+			require.NoError(t, err)
+			require.Equal(t, &oneHour, exp)
+
+			ctx := ctx
+			if tc.gasLimit > 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+			}
+
+			removed, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+
+			// the wrapped allowance is updated
+			updated, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, leftAtom, updated.(*feegrant.BasicAllowance).SpendLimit)
+
+			bz, err := app.AppCodec().MarshalInterface(allowance)
+			require.NoError(t, err)
+			var decoded feegrant.FeeAllowanceI
+			require.NoError(t, app.AppCodec().UnmarshalInterface(bz, &decoded))
+			decodedAllowance, err := decoded.(*feegrant.AllowedTargetAllowance).GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, leftAtom, decodedAllowance.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestAllowedTargetAllowanceValidateBasic(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555))}
+
+	cases := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		targets   []string
+		maxFee    sdk.Coins
+		valid     bool
+	}{
+		"valid": {
+			allowance: basic,
+			targets:   []string{addr.String(), sdk.ValAddress(addr).String()},
+			maxFee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			valid:     true,
+		},
+		"no targets": {
+			allowance: basic,
+			valid:     false,
+		},
+		"invalid target": {
+			allowance: basic,
+			targets:   []string{"invalid"},
+			valid:     false,
+		},
+		"duplicate target": {
+			allowance: basic,
+			targets:   []string{addr.String(), addr.String()},
+			valid:     false,
+		},
+		"invalid max fee": {
+			allowance: basic,
+			targets:   []string{addr.String()},
+			maxFee:    sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}},
+			valid:     false,
+		},
+		"invalid allowance": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(0)}}},
+			targets:   []string{addr.String()},
+			valid:     false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedTargetAllowance(tc.allowance, tc.targets, 0, tc.maxFee)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
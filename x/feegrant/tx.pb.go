@@ -224,39 +224,126 @@ func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
 
+// MsgPruneAllowances removes expired fee allowances.
+type MsgPruneAllowances struct {
+	// pruner is the address of the user pruning the expired allowances.
+	Pruner string `protobuf:"bytes,1,opt,name=pruner,proto3" json:"pruner,omitempty"`
+}
+
+func (m *MsgPruneAllowances) Reset()         { *m = MsgPruneAllowances{} }
+func (m *MsgPruneAllowances) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneAllowances) ProtoMessage()    {}
+func (*MsgPruneAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{4}
+}
+func (m *MsgPruneAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneAllowances.Merge(m, src)
+}
+func (m *MsgPruneAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneAllowances proto.InternalMessageInfo
+
+func (m *MsgPruneAllowances) GetPruner() string {
+	if m != nil {
+		return m.Pruner
+	}
+	return ""
+}
+
+// MsgPruneAllowancesResponse defines the Msg/PruneAllowancesResponse response type.
+type MsgPruneAllowancesResponse struct {
+}
+
+func (m *MsgPruneAllowancesResponse) Reset()         { *m = MsgPruneAllowancesResponse{} }
+func (m *MsgPruneAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneAllowancesResponse) ProtoMessage()    {}
+func (*MsgPruneAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{5}
+}
+func (m *MsgPruneAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneAllowancesResponse.Merge(m, src)
+}
+func (m *MsgPruneAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneAllowancesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
 	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgPruneAllowances)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowances")
+	proto.RegisterType((*MsgPruneAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowancesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x60, 0x8e,
-	0x52, 0x1f, 0x23, 0x97, 0xa0, 0x6f, 0x71, 0xba, 0x3b, 0xc8, 0x02, 0xc7, 0x9c, 0x9c, 0xfc, 0xf2,
-	0xc4, 0xbc, 0xe4, 0x54, 0x21, 0x09, 0x2e, 0x76, 0xb0, 0x95, 0xa9, 0x45, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0x9c, 0x41, 0x30, 0x2e, 0x42, 0x26, 0x55, 0x82, 0x09, 0x59, 0x26, 0x55, 0xc8, 0x95, 0x8b,
-	0x33, 0x11, 0x66, 0x80, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e, 0xc4, 0x4d, 0x7a,
-	0x30, 0x37, 0xe9, 0x39, 0xe6, 0x55, 0x3a, 0x09, 0x9e, 0xda, 0xa2, 0xcb, 0xeb, 0x96, 0x9a, 0x0a,
-	0xb7, 0xce, 0x33, 0x08, 0xa1, 0x53, 0x49, 0x9a, 0x4b, 0x12, 0xc3, 0x3d, 0x41, 0xa9, 0xc5, 0x05,
-	0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0x1e, 0x5c, 0x42, 0xbe, 0xc5, 0xe9, 0x41, 0xa9, 0x65, 0xf9, 0xd9,
-	0xa9, 0x14, 0xb9, 0x56, 0x49, 0x86, 0x4b, 0x0a, 0xd3, 0x24, 0x98, 0x3d, 0x46, 0x6f, 0x18, 0xb9,
-	0x98, 0x7d, 0x8b, 0xd3, 0x85, 0x0a, 0xb8, 0xf8, 0xd0, 0x42, 0x46, 0x4b, 0x0f, 0x47, 0xac, 0xe8,
-	0x61, 0xb8, 0x5a, 0xca, 0x88, 0x78, 0xb5, 0x30, 0x9b, 0x85, 0x8a, 0xb9, 0xf8, 0xd1, 0xbd, 0xa7,
-	0x8d, 0xcf, 0x18, 0x34, 0xc5, 0x52, 0xc6, 0x24, 0x28, 0x86, 0x59, 0xea, 0xe4, 0x78, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xea, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xd0, 0x74, 0x03, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b, 0xe0, 0xa9, 0x37,
-	0x89, 0x0d, 0x1c, 0xc5, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0x47, 0xc8, 0xf2, 0xd7,
-	0x02, 0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0x87, 0x19, 0x48, 0xb8, 0x61, 0x6e, 0xd4, 0x30, 0x21, 0x5a, 0xaa, 0x69, 0x48, 0x37, 0x12,
+	0x95, 0x99, 0x00, 0x4f, 0x00, 0x89, 0xff, 0x16, 0x24, 0xa6, 0x4b, 0x37, 0xa6, 0xc5, 0xc3, 0x68,
+	0x80, 0x4e, 0xd3, 0x29, 0x08, 0x2f, 0x61, 0x7c, 0x18, 0x1f, 0xc2, 0xb8, 0x62, 0xe9, 0x52, 0xe1,
+	0x45, 0x0c, 0x6d, 0x07, 0x48, 0x89, 0x44, 0xe2, 0x0a, 0x4e, 0xcf, 0x37, 0xbf, 0xef, 0xe4, 0x74,
+	0x8a, 0x4b, 0x6d, 0x21, 0xfb, 0x42, 0xb2, 0x0e, 0x00, 0xf7, 0x6d, 0x37, 0x60, 0xc3, 0xaa, 0x03,
+	0x81, 0x5d, 0x65, 0xc1, 0x88, 0x7a, 0xbe, 0x08, 0x04, 0x39, 0x88, 0x08, 0xaa, 0x08, 0x1a, 0x13,
+	0x7a, 0x81, 0x0b, 0x2e, 0x42, 0x86, 0xcd, 0xff, 0x45, 0xb8, 0x5e, 0xe4, 0x42, 0xf0, 0x1e, 0xb0,
+	0xb0, 0x72, 0x06, 0x1d, 0x66, 0xbb, 0x63, 0xd5, 0x8a, 0x92, 0xee, 0xa2, 0x33, 0x71, 0x6c, 0x58,
+	0x98, 0xcf, 0x08, 0xe7, 0x5b, 0x92, 0x5f, 0xce, 0x05, 0x8d, 0x5e, 0x4f, 0x3c, 0xd9, 0x6e, 0x1b,
+	0x88, 0x86, 0xff, 0x85, 0x4a, 0xf0, 0x35, 0x54, 0x42, 0xe5, 0x9c, 0xa5, 0xca, 0x65, 0x07, 0xb4,
+	0xf4, 0x6a, 0x07, 0xc8, 0x39, 0xce, 0xd9, 0x2a, 0x40, 0xcb, 0x94, 0x50, 0xf9, 0x7f, 0xad, 0x40,
+	0xa3, 0x99, 0xa8, 0x9a, 0x89, 0x36, 0xdc, 0x71, 0x33, 0xff, 0xfe, 0x5a, 0xd9, 0xb9, 0x00, 0x58,
+	0xe8, 0xae, 0xad, 0xe5, 0x49, 0xf3, 0x10, 0x17, 0xd7, 0xe6, 0xb1, 0x40, 0x7a, 0xc2, 0x95, 0x60,
+	0x5e, 0x61, 0xd2, 0x92, 0xdc, 0x82, 0xa1, 0xe8, 0xc2, 0x9f, 0xa6, 0x35, 0x8f, 0xb0, 0xbe, 0x9e,
+	0xb4, 0xf0, 0x9c, 0x85, 0x9e, 0x1b, 0x7f, 0xe0, 0x2e, 0x9b, 0x92, 0xec, 0xe3, 0xac, 0x37, 0x7f,
+	0xa4, 0x34, 0x71, 0x15, 0x67, 0x25, 0x68, 0x95, 0x55, 0xfb, 0x4a, 0xe3, 0x4c, 0x4b, 0x72, 0xe2,
+	0xe1, 0xdd, 0xc4, 0x96, 0x4f, 0xe8, 0x0f, 0x6f, 0x98, 0xae, 0x6d, 0x40, 0xaf, 0xfd, 0x9e, 0x55,
+	0x66, 0x22, 0xf1, 0x5e, 0x72, 0x55, 0xa7, 0x9b, 0x62, 0x12, 0xb0, 0x5e, 0xdf, 0x02, 0x5e, 0x95,
+	0x26, 0xf7, 0xb6, 0x51, 0x9a, 0x80, 0xf5, 0xfa, 0x16, 0xb0, 0x92, 0x36, 0x1b, 0x6f, 0x53, 0x03,
+	0x4d, 0xa6, 0x06, 0xfa, 0x9c, 0x1a, 0xe8, 0x65, 0x66, 0xa4, 0x26, 0x33, 0x23, 0xf5, 0x31, 0x33,
+	0x52, 0xb7, 0xc7, 0xfc, 0x31, 0x78, 0x18, 0x38, 0xb4, 0x2d, 0xfa, 0xf1, 0xc5, 0x8f, 0x7f, 0x2a,
+	0xf2, 0xbe, 0xcb, 0x46, 0x8b, 0xcf, 0xcf, 0xc9, 0x86, 0x77, 0xb4, 0xfe, 0x3d, 0x00, 0x1a, 0x44,
+	0xe9, 0x26, 0x98, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -277,6 +364,10 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// PruneAllowances removes expired fee allowances from the store. Anyone can
+	// send it, a fixed amount of gas is refunded per removed allowance, up to a
+	// cap.
+	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
 }
 
 type msgClient struct {
@@ -305,6 +396,15 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error) {
+	out := new(MsgPruneAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/PruneAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -313,6 +413,10 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// PruneAllowances removes expired fee allowances from the store. Anyone can
+	// send it, a fixed amount of gas is refunded per removed allowance, up to a
+	// cap.
+	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -325,6 +429,9 @@ func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrant
 func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (*UnimplementedMsgServer) PruneAllowances(ctx context.Context, req *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -366,6 +473,24 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/PruneAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneAllowances(ctx, req.(*MsgPruneAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -378,6 +503,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "PruneAllowances",
+			Handler:    _Msg_PruneAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -515,6 +644,59 @@ func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPruneAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pruner) > 0 {
+		i -= len(m.Pruner)
+		copy(dAtA[i:], m.Pruner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pruner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -582,6 +764,28 @@ func (m *MsgRevokeAllowanceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPruneAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pruner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -952,6 +1156,138 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPruneAllowances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAllowances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pruner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
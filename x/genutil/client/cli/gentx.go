@@ -27,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // GenTxCmd builds the application's gentx command.
@@ -153,6 +154,11 @@ $ %s gentx my-key-name 1000000stake --home=/path/to/home/dir --keyring-backend=o
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
+			commissionRate := msg.(*stakingtypes.MsgCreateValidator).Commission.Rate
+			if err = genutil.ValidateCommissionInGenesis(genesisState, commissionRate, cdc); err != nil {
+				return errors.Wrap(err, "failed to validate commission in genesis")
+			}
+
 			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti {
 				cmd.PrintErrln("Offline key passed in. Use `tx sign` command to sign.")
 				return authclient.PrintUnsignedStdTx(txBldr, clientCtx, []sdk.Msg{msg})
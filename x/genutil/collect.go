@@ -138,6 +138,11 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 		// TODO abstract out staking message validation back to staking
 		msg := msgs[0].(*stakingtypes.MsgCreateValidator)
 
+		// validate the commission rate against the staking params in the state
+		if err := ValidateCommissionInGenesis(appState, msg.Commission.Rate, cdc); err != nil {
+			return appGenTxs, persistentPeers, fmt.Errorf("invalid genesis transaction %s: %w", fo.Name(), err)
+		}
+
 		// validate delegator and validator addresses and funds against the accounts in the state
 		delAddr := msg.DelegatorAddress
 		valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
@@ -86,6 +86,25 @@ func ValidateAccountInGenesis(
 	return nil
 }
 
+// ValidateCommissionInGenesis checks that the provided validator commission
+// rate is not lower than the minimum commission rate of the staking params in
+// the genesis state.
+func ValidateCommissionInGenesis(
+	appGenesisState map[string]json.RawMessage, rate sdk.Dec, cdc codec.JSONCodec,
+) error {
+	var stakingData stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenesisState[stakingtypes.ModuleName], &stakingData)
+	minCommissionRate := stakingData.Params.MinCommissionRate
+
+	if !minCommissionRate.IsNil() && rate.LT(minCommissionRate) {
+		return fmt.Errorf(
+			"validator commission rate %s is lower than the minimum commission rate %s", rate, minCommissionRate,
+		)
+	}
+
+	return nil
+}
+
 type deliverTxfn func(abci.RequestDeliverTx) abci.ResponseDeliverTx
 
 // DeliverGenTxs iterates over all genesis txs, decodes each into a Tx and
@@ -202,6 +202,39 @@ func (suite *GenTxTestSuite) TestValidateAccountInGenesis() {
 	}
 }
 
+func (suite *GenTxTestSuite) TestValidateCommissionInGenesis() {
+	minCommissionRate := sdk.NewDecWithPrec(5, 2)
+
+	testCases := []struct {
+		msg     string
+		rate    sdk.Dec
+		expPass bool
+	}{
+		{"commission below the minimum", sdk.NewDecWithPrec(4, 2), false},
+		{"commission equal to the minimum", minCommissionRate, true},
+		{"commission above the minimum", sdk.NewDecWithPrec(10, 2), true},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			cdc := suite.encodingConfig.Marshaler
+
+			params := stakingtypes.DefaultParams()
+			params.MinCommissionRate = minCommissionRate
+			stakingGenesis, err := cdc.MarshalJSON(stakingtypes.NewGenesisState(params, nil, nil))
+			suite.Require().NoError(err)
+			appGenesisState := map[string]json.RawMessage{stakingtypes.ModuleName: stakingGenesis}
+
+			err = genutil.ValidateCommissionInGenesis(appGenesisState, tc.rate, cdc)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *GenTxTestSuite) TestDeliverGenTxs() {
 	var (
 		genTxs    []json.RawMessage
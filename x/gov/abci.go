@@ -47,7 +47,46 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		passes, burnDeposits, tallyResults, breakdowns, overrides := keeper.TallyWithBreakdown(ctx, proposal)
+
+		// An expedited proposal that fails is converted to a regular one: its
+		// voting period is extended to the regular one and the votes cast so far
+		// are kept, so it is tallied again once the regular voting period ends.
+		// Its deposits are left untouched until then.
+		if !passes && proposal.Expedited {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			logger.Info(
+				"expedited proposal rejected; converted to a regular proposal",
+				"proposal", proposal.ProposalId,
+				"title", proposal.GetTitle(),
+				"voting_end_time", proposal.VotingEndTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		// keep the breakdown of the final tally once the votes are deleted
+		for _, breakdown := range breakdowns {
+			keeper.SetTallyBreakdown(ctx, breakdown)
+		}
+		for _, override := range overrides {
+			keeper.SetDelegatorOverride(ctx, override)
+		}
+		keeper.DeleteVotes(ctx, proposal.ProposalId)
 
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalId)
@@ -56,13 +95,20 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			// The proposal handler, or the messages of an execution proposal,
+			// may execute state mutating logic depending on the proposal
+			// content. If the execution fails, no state mutation is written and
+			// the error message is logged.
+			var err error
+			if ep, ok := proposal.GetContent().(*types.ExecutionProposal); ok {
+				proposal.MsgResults, err = keeper.ExecuteProposalMsgs(cacheCtx, ep)
+			} else {
+				handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+				err = handler(cacheCtx, proposal.GetContent())
+			}
+
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -11,9 +11,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestTickExpiredDepositPeriod(t *testing.T) {
@@ -292,7 +294,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -307,7 +309,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -340,7 +342,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -348,7 +350,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 
 	handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, newDepositMsg)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -362,3 +364,235 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestExpeditedProposal(t *testing.T) {
+	testCases := []struct {
+		name       string
+		voteOption types.VoteOption
+		expPass    bool
+	}{
+		{"expedited proposal passes", types.OptionYes, true},
+		{"expedited proposal fails and is converted to a regular one", types.OptionNo, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			macc := app.GovKeeper.GetGovernanceAccount(ctx)
+			initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], "", true)
+			require.NoError(t, err)
+			require.True(t, proposal.Expedited)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(tc.voteOption), "")
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+
+			if tc.expPass {
+				require.Equal(t, types.StatusPassed, proposal.Status)
+				require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+				return
+			}
+
+			// the proposal is still in its voting period, as a regular proposal
+			// which keeps its votes and deposits
+			require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 1)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins.Add(proposalCoins...)))
+
+			activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+			require.False(t, activeQueue.Valid())
+			activeQueue.Close()
+
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, types.StatusRejected, proposal.Status)
+			require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+		})
+	}
+}
+
+func TestExecutionProposal(t *testing.T) {
+	testCases := []struct {
+		name      string
+		sendCoins sdk.Coins
+		expPass   bool
+	}{
+		{"messages executed", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), true},
+		{"message failed on execution", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001)), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			// fund the governance module account, besides the deposits
+			funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, funds))
+
+			govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			recipientBalance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+
+			content, err := types.NewExecutionProposal("Test", "description", []sdk.Msg{
+				banktypes.NewMsgSend(govAddr, addrs[1], tc.sendCoins),
+			})
+			require.NoError(t, err)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, content, addrs[0], "", false)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
+			require.NoError(t, err)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+
+			if tc.expPass {
+				require.Equal(t, types.StatusPassed, proposal.Status)
+				require.Len(t, proposal.MsgResults, 1)
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, govAddr).IsEqual(funds.Sub(tc.sendCoins)))
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, addrs[1]).IsEqual(recipientBalance.Add(tc.sendCoins...)))
+
+				// the events of the executed message are emitted
+				var transferred bool
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type != banktypes.EventTypeTransfer {
+						continue
+					}
+					for _, attr := range event.Attributes {
+						if string(attr.Key) == banktypes.AttributeKeyRecipient && string(attr.Value) == addrs[1].String() {
+							transferred = true
+						}
+					}
+				}
+				require.True(t, transferred)
+				return
+			}
+
+			// no state mutation of the failed message is written, while the
+			// deposits are refunded
+			require.Equal(t, types.StatusFailed, proposal.Status)
+			require.Empty(t, proposal.MsgResults)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, govAddr).IsEqual(funds))
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, addrs[1]).IsEqual(recipientBalance))
+		})
+	}
+}
+
+func TestTallyBreakdownEndblocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	handleAndCheck(t, stakingHandler, ctx, stakingtypes.NewMsgDelegate(addrs[1], valAddr, sdk.NewCoin(sdk.DefaultBondDenom, delTokens)))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	yes := types.NewNonSplitVoteOption(types.OptionYes)
+	no := types.NewNonSplitVoteOption(types.OptionNo)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], yes, ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], no, ""))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// the votes are deleted but the breakdown of the tally is kept
+	require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+
+	breakdown, found := app.GovKeeper.GetTallyBreakdown(ctx, proposal.ProposalId, valAddr)
+	require.True(t, found)
+	require.Equal(t, types.ValidatorTallyBreakdown{
+		ProposalId:          proposal.ProposalId,
+		ValidatorAddress:    valAddr.String(),
+		Options:             yes,
+		VotingPower:         app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
+		DeductedVotingPower: delTokens,
+	}, breakdown)
+	require.Len(t, app.GovKeeper.GetAllTallyBreakdowns(ctx), 1)
+
+	override, found := app.GovKeeper.GetDelegatorOverride(ctx, proposal.ProposalId, valAddr, addrs[1])
+	require.True(t, found)
+	require.Equal(t, types.NewDelegatorOverride(proposal.ProposalId, valAddr, addrs[1], no, delTokens), override)
+	require.Len(t, app.GovKeeper.GetAllDelegatorOverrides(ctx), 1)
+}
@@ -7,7 +7,10 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
@@ -42,3 +45,40 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseExecutionProposal reads and parses an execution proposal JSON file,
+// returning its content and initial deposit.
+func parseExecutionProposal(cdc codec.JSONCodec, path string) (*types.ExecutionProposal, sdk.Coins, error) {
+	var proposal executionProposal
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return nil, nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, anyJSON := range proposal.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg); err != nil {
+			return nil, nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+
+		msgs[i] = msg
+	}
+
+	content, err := types.NewExecutionProposal(proposal.Title, proposal.Description, msgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return content, deposit, nil
+}
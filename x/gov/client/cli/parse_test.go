@@ -5,7 +5,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -67,3 +71,61 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseExecutionProposal(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+      "to_address": "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ],
+  "deposit": "1000test"
+}
+`)
+	unknownMsgJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "messages": [{"@type": "/cosmos.unknown.v1beta1.MsgUnknown"}],
+  "deposit": "1000test"
+}
+`)
+	badJSON := testutil.WriteToNewTempFile(t, "bad json")
+
+	// nonexistent json
+	_, _, err := parseExecutionProposal(cdc, "fileDoesNotExist")
+	require.Error(t, err)
+
+	// invalid json
+	_, _, err = parseExecutionProposal(cdc, badJSON.Name())
+	require.Error(t, err)
+
+	// unknown message type
+	_, _, err = parseExecutionProposal(cdc, unknownMsgJSON.Name())
+	require.Error(t, err)
+
+	// ok json
+	content, deposit, err := parseExecutionProposal(cdc, okJSON.Name())
+	require.NoError(t, err)
+	require.Equal(t, "Test Proposal", content.Title)
+	require.Equal(t, "My awesome proposal", content.Description)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 1000)), deposit)
+
+	msgs, err := content.GetMessages()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	msgSend, ok := msgs[0].(*banktypes.MsgSend)
+	require.True(t, ok)
+	require.Equal(t, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", msgSend.FromAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), msgSend.Amount)
+}
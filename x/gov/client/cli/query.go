@@ -37,6 +37,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryRunningTally(),
+		GetCmdQueryTallyBreakdown(),
+		GetCmdQueryDelegatorOverrides(),
 	)
 
 	return govQueryCmd
@@ -544,6 +547,109 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryRunningTally implements the command to query for the running
+// tally of a proposal in voting period.
+func GetCmdQueryRunningTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "running-tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the running tally of a proposal in voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current tally of votes on a proposal in voting period,
+along with its turnout, whether it reaches the quorum and the status the proposal
+would have if its voting period ended now.
+
+Example:
+$ %s query gov running-tally 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.RunningTally(
+				cmd.Context(),
+				&types.QueryRunningTallyRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTallyBreakdown implements the command to query for the breakdown
+// of the final tally of a proposal per validator.
+func GetCmdQueryTallyBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-breakdown [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the breakdown of the final tally of a proposal per validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the breakdown of the final tally of a tallied proposal: for
+each validator, its vote, the voting power carried by its vote after the deduction
+of the delegators who voted, and the voting power deducted. The delegators who
+overrode the vote of their validator are returned by the delegator-overrides query.
+
+Example:
+$ %s query gov tally-breakdown 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorTallyBreakdown(
+				cmd.Context(),
+				&types.QueryValidatorTallyBreakdownRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "tally breakdowns")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -701,3 +807,69 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQueryDelegatorOverrides implements the command to query for the
+// delegators who overrode the vote of their validator in the final tally of a
+// proposal.
+func GetCmdQueryDelegatorOverrides() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegator-overrides [proposal-id] [validator-addr]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Get the delegators who overrode the vote of their validator in the final tally of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegators who overrode the vote of their validator with
+their own vote in the final tally of a tallied proposal, with their vote and voting
+power. The overrides can be restricted to the delegators of a single validator.
+
+Example:
+$ %s query gov delegator-overrides 1
+$ %s query gov delegator-overrides 1 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			var valAddr string
+			if len(args) > 1 {
+				if _, err := sdk.ValAddressFromBech32(args[1]); err != nil {
+					return err
+				}
+				valAddr = args[1]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorOverrides(
+				cmd.Context(),
+				&types.QueryDelegatorOverridesRequest{ProposalId: proposalID, ValidatorAddress: valAddr, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "delegator overrides")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
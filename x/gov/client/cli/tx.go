@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -26,6 +27,8 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
+	FlagMetadata     = "metadata"
 )
 
 type proposal struct {
@@ -35,6 +38,13 @@ type proposal struct {
 	Deposit     string
 }
 
+type executionProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Messages    []json.RawMessage `json:"messages"`
+	Deposit     string            `json:"deposit"`
+}
+
 // ProposalFlags defines the core required fields of a proposal. It is used to
 // verify that these values are not provided in conjunction with a JSON proposal
 // file.
@@ -60,6 +70,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	}
 
 	cmdSubmitProp := NewCmdSubmitProposal()
+	cmdSubmitProp.AddCommand(NewCmdSubmitExecutionProposal())
 	for _, propCmd := range propCmds {
 		flags.AddTxFlagsToCmd(propCmd)
 		cmdSubmitProp.AddCommand(propCmd)
@@ -69,6 +80,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		NewCmdDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdCancelProposal(),
 		cmdSubmitProp,
 	)
 
@@ -99,8 +111,14 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+The proposal can be submitted as expedited, in which case it goes through a shorter voting
+period with a higher threshold and falls back to a regular proposal if it does not pass, and
+can carry free-form metadata such as an IPFS link:
+
+$ %s tx gov submit-proposal --proposal="path/to/proposal.json" --expedited --metadata="ipfs://CID" --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -126,6 +144,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			msg.Expedited, _ = cmd.Flags().GetBool(FlagExpedited)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -135,6 +156,71 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as expedited")
+	cmd.Flags().String(FlagMetadata, "", "The proposal metadata, e.g. an IPFS link to its full text")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitExecutionProposal implements submitting an execution proposal
+// transaction command.
+func NewCmdSubmitExecutionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execution [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages signed by the governance account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal executing a list of messages, along with an initial deposit.
+The messages are executed in order once the proposal passes, and the governance module
+account must be their only signer. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal execution path/to/proposal.json --from mykey
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Funding",
+  "description": "Fund the community pool from the governance account",
+  "messages": [
+    {
+      "@type": "/cosmos.distribution.v1beta1.MsgFundCommunityPool",
+      "amount": [{"denom": "stake", "amount": "10"}],
+      "depositor": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+    }
+  ],
+  "deposit": "10stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, deposit, err := parseExecutionProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			msg.Expedited, _ = cmd.Flags().GetBool(FlagExpedited)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as expedited")
+	cmd.Flags().String(FlagMetadata, "", "The proposal metadata, e.g. an IPFS link to its full text")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -178,11 +264,13 @@ $ %s tx gov deposit 1 10stake --from mykey
 			}
 
 			msg := types.NewMsgDeposit(from, proposalID, amount)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMetadata, "", "The deposit metadata")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -226,11 +314,13 @@ $ %s tx gov vote 1 yes --from mykey
 
 			// Build vote message and run basic validation
 			msg := types.NewMsgVote(from, proposalID, byteVoteOption)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMetadata, "", "The vote metadata, e.g. a rationale for the vote")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -275,6 +365,7 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 			// Build vote message and run basic validation
 			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -284,6 +375,47 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 		},
 	}
 
+	cmd.Flags().String(FlagMetadata, "", "The vote metadata, e.g. a rationale for the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelProposal implements cancelling a proposal by its proposer.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal before its voting period ends",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal before its voting period ends. Only the
+proposer can cancel a proposal. A share of the proposal deposits, set by the
+proposal_cancel_ratio deposit param, is burned and the remainder is refunded.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -88,7 +88,7 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"voting_params":{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","proposal_cancel_ratio":"0.500000000000000000"}}`,
 		},
 		{
 			"text output",
@@ -99,11 +99,14 @@ deposit_params:
   min_deposit:
   - amount: "10000000"
     denom: stake
+  proposal_cancel_ratio: "0.500000000000000000"
 tally_params:
+  expedited_threshold: "0.667000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
 voting_params:
+  expedited_voting_period: "86400000000000"
   voting_period: "172800000000000"
 	`,
 		},
@@ -137,7 +140,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"voting",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"voting_period":"172800000000000"}`,
+			`{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"}`,
 		},
 		{
 			"tally params",
@@ -145,7 +148,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"tallying",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"}`,
+			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"}`,
 		},
 		{
 			"deposit params",
@@ -153,7 +156,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"deposit",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}`,
+			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","proposal_cancel_ratio":"0.500000000000000000"}`,
 		},
 	}
 
@@ -329,6 +332,30 @@ func (s *IntegrationTestSuite) TestNewCmdSubmitProposal() {
 			},
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"metadata too long",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagProposal, validPropFile.Name()),
+				fmt.Sprintf("--%s=%s", cli.FlagMetadata, strings.Repeat("a", types.MaxMetadataLength+1)),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"valid expedited transaction with metadata",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagProposal, validPropFile.Name()),
+				fmt.Sprintf("--%s=true", cli.FlagExpedited),
+				fmt.Sprintf("--%s=ipfs://CID", cli.FlagMetadata),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
 		{
 			"valid transaction",
 			[]string{
@@ -912,3 +939,98 @@ func (s *IntegrationTestSuite) TestNewCmdWeightedVote() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewCmdCancelProposal() {
+	val := s.network.Validators[0]
+
+	// create a proposal with metadata to be cancelled
+	out, err := MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+		"Text Proposal 4", "Where is the title!?", types.ProposalTypeText,
+		fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)).String()),
+		fmt.Sprintf("--%s=ipfs://CID", cli.FlagMetadata))
+	s.Require().NoError(err)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	var proposalID string
+	for _, event := range txResp.Logs[0].GetEvents() {
+		if event.GetType() == types.EventTypeSubmitProposal {
+			for _, attr := range event.GetAttributes() {
+				if attr.Key == types.AttributeKeyProposalID {
+					proposalID = attr.Value
+				}
+			}
+		}
+	}
+	s.Require().NotEmpty(proposalID)
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryProposal(), []string{proposalID, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var proposal types.Proposal
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &proposal), out.String())
+	s.Require().Equal("ipfs://CID", proposal.Metadata)
+	s.Require().Equal(val.Address.String(), proposal.Proposer)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"invalid proposal id",
+			[]string{
+				"abc",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true, 0,
+		},
+		{
+			"cancel non existing proposal",
+			[]string{
+				"100",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, types.ErrUnknownProposal.ABCICode(),
+		},
+		{
+			"valid cancel",
+			[]string{
+				proposalID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdCancelProposal()
+			clientCtx := val.ClientCtx
+			var txResp sdk.TxResponse
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	// the cancelled proposal is removed from the store
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryProposal(), []string{proposalID, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().Error(err)
+}
@@ -41,6 +41,20 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetProposal(ctx, proposal)
 	}
 
+	for _, breakdown := range data.TallyBreakdowns {
+		k.SetTallyBreakdown(ctx, breakdown)
+	}
+	for _, override := range data.DelegatorOverrides {
+		k.SetDelegatorOverride(ctx, override)
+	}
+
+	// count the votes of the proposals in voting period in their running tally
+	for _, proposal := range data.Proposals {
+		if proposal.Status == types.StatusVotingPeriod {
+			k.RebuildRunningTally(ctx, proposal.ProposalId)
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -79,5 +93,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		TallyBreakdowns:    k.GetAllTallyBreakdowns(ctx),
+		DelegatorOverrides: k.GetAllDelegatorOverrides(ctx),
 	}
 }
@@ -33,15 +33,15 @@ func TestImportExportQueues(t *testing.T) {
 
 	// Create two proposals, put the second into the voting period
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, nil, "", false)
 	require.NoError(t, err)
 	proposalID1 := proposal1.ProposalId
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, nil, "", false)
 	require.NoError(t, err)
 	proposalID2 := proposal2.ProposalId
 
-	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID2, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID2, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit, "")
 	require.NoError(t, err)
 	require.True(t, votingStarted)
 
@@ -52,6 +52,19 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	breakdown := types.ValidatorTallyBreakdown{
+		ProposalId:          proposalID1,
+		ValidatorAddress:    sdk.ValAddress(addrs[0]).String(),
+		Options:             types.NewNonSplitVoteOption(types.OptionYes),
+		VotingPower:         sdk.NewInt(10),
+		DeductedVotingPower: sdk.NewInt(5),
+	}
+	app.GovKeeper.SetTallyBreakdown(ctx, breakdown)
+	override := types.NewDelegatorOverride(
+		proposalID1, sdk.ValAddress(addrs[0]), addrs[1], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewInt(5),
+	)
+	app.GovKeeper.SetDelegatorOverride(ctx, override)
+
 	authGenState := auth.ExportGenesis(ctx, app.AccountKeeper)
 	bankGenState := app.BankKeeper.ExportGenesis(ctx)
 
@@ -98,6 +111,9 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	require.Equal(t, []types.ValidatorTallyBreakdown{breakdown}, app2.GovKeeper.GetAllTallyBreakdowns(ctx2))
+	require.Equal(t, []types.DelegatorOverride{override}, app2.GovKeeper.GetAllDelegatorOverrides(ctx2))
+
 	macc := app2.GovKeeper.GetGovernanceAccount(ctx2)
 	require.Equal(t, app2.GovKeeper.GetDepositParams(ctx2).MinDeposit, app2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
@@ -145,10 +161,10 @@ func TestEqualProposals(t *testing.T) {
 
 	// Submit two proposals
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, nil, "", false)
 	require.NoError(t, err)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, nil, "", false)
 	require.NoError(t, err)
 
 	// They are similar but their IDs should be different
@@ -31,6 +31,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.VoteWeighted(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCancelProposal:
+			res, err := msgServer.CancelProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
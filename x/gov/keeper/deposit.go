@@ -110,7 +110,13 @@ func (keeper Keeper) IterateDeposits(ctx sdk.Context, proposalID uint64, cb func
 
 // AddDeposit adds or updates a deposit of a specific depositor on a specific proposal
 // Activates voting period when appropriate
-func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (bool, error) {
+func (keeper Keeper) AddDeposit(
+	ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins, metadata string,
+) (bool, error) {
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return false, err
+	}
+
 	// Checks to see if proposal exists
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
@@ -149,6 +155,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	} else {
 		deposit = types.NewDeposit(proposalID, depositorAddr, depositAmount)
 	}
+	deposit.Metadata = metadata
 
 	// called when deposit has been added to a proposal, however the proposal may not be active
 	keeper.AfterProposalDeposit(ctx, proposalID, depositorAddr)
@@ -185,3 +192,38 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 		return false
 	})
 }
+
+// CancelDeposits burns the given ratio of every deposit on a specific proposal,
+// refunds the remainder to the depositors and deletes the deposits
+func (keeper Keeper) CancelDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			panic(err)
+		}
+
+		var burnAmount sdk.Coins
+		for _, coin := range deposit.Amount {
+			burnAmount = burnAmount.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(burnRatio).TruncateInt()))
+		}
+
+		if !burnAmount.IsZero() {
+			if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burnAmount); err != nil {
+				panic(err)
+			}
+		}
+
+		refundAmount := deposit.Amount.Sub(burnAmount)
+		if !refundAmount.IsZero() {
+			err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refundAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+}
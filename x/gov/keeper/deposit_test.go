@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -18,7 +21,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, "", false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 
@@ -38,7 +41,7 @@ func TestDeposits(t *testing.T) {
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
 
 	// Check first deposit
-	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake, "")
 	require.NoError(t, err)
 	require.False(t, votingStarted)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
@@ -51,7 +54,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 
 	// Check a second deposit from same address
-	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fiveStake)
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fiveStake, "")
 	require.NoError(t, err)
 	require.False(t, votingStarted)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
@@ -63,12 +66,15 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, fourStake.Add(fiveStake...), proposal.TotalDeposit)
 	require.Equal(t, addr0Initial.Sub(fourStake).Sub(fiveStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 
-	// Check third deposit from a new address
-	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake)
+	// Check third deposit from a new address, with metadata
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake, strings.Repeat("a", types.MaxMetadataLength+1))
+	require.True(t, errors.Is(err, types.ErrMetadataTooLong))
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake, "metadata")
 	require.NoError(t, err)
 	require.True(t, votingStarted)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.True(t, found)
+	require.Equal(t, "metadata", deposit.Metadata)
 	require.Equal(t, TestAddrs[1].String(), deposit.Depositor)
 	require.Equal(t, fourStake, deposit.Amount)
 	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
@@ -102,7 +108,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete deposits
-	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake, "")
 	require.NoError(t, err)
 	app.GovKeeper.DeleteDeposits(ctx, proposalID)
 	deposits = app.GovKeeper.GetDeposits(ctx, proposalID)
@@ -275,5 +275,149 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 		_, _, tallyResult = q.Tally(ctx, proposal)
 	}
 
-	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
+	return &types.QueryTallyResultResponse{
+		Tally:       tallyResult,
+		TallyParams: q.GetProposalTallyParams(ctx, proposal),
+	}, nil
+}
+
+// RunningTally queries the current tally of a proposal in voting period
+func (q Keeper) RunningTally(c context.Context, req *types.QueryRunningTallyRequest) (*types.QueryRunningTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	results, votingPower, err := q.GetRunningTally(ctx, proposal)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	bondedTokens := q.sk.TotalBondedTokens(ctx)
+	turnout := sdk.ZeroDec()
+	if !bondedTokens.IsZero() {
+		turnout = votingPower.QuoInt(bondedTokens)
+	}
+
+	projectedStatus := types.StatusRejected
+	if passes, _ := q.tallyOutcome(ctx, proposal, results, votingPower); passes {
+		projectedStatus = types.StatusPassed
+	}
+
+	return &types.QueryRunningTallyResponse{
+		Tally:           types.NewTallyResultFromMap(results),
+		VotingPower:     votingPower.TruncateInt(),
+		BondedTokens:    bondedTokens,
+		Turnout:         turnout,
+		QuorumReached:   q.QuorumReached(ctx, proposal, votingPower),
+		ProjectedStatus: projectedStatus,
+	}, nil
+}
+
+// ValidatorTallyBreakdown queries the breakdown of the final tally of a proposal per validator
+func (q Keeper) ValidatorTallyBreakdown(
+	c context.Context, req *types.QueryValidatorTallyBreakdownRequest,
+) (*types.QueryValidatorTallyBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if proposal.Status == types.StatusDepositPeriod || proposal.Status == types.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d has not been tallied yet", req.ProposalId)
+	}
+
+	var breakdowns []types.ValidatorTallyBreakdown
+	store := ctx.KVStore(q.storeKey)
+	breakdownsStore := prefix.NewStore(store, types.TallyBreakdownsKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(breakdownsStore, req.Pagination, func(key []byte, value []byte) error {
+		var breakdown types.ValidatorTallyBreakdown
+		if err := q.cdc.Unmarshal(value, &breakdown); err != nil {
+			return err
+		}
+
+		breakdowns = append(breakdowns, breakdown)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorTallyBreakdownResponse{Breakdowns: breakdowns, Pagination: pageRes}, nil
+}
+
+// DelegatorOverrides queries the delegators who overrode the vote of their validator in the final
+// tally of a proposal
+func (q Keeper) DelegatorOverrides(
+	c context.Context, req *types.QueryDelegatorOverridesRequest,
+) (*types.QueryDelegatorOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	keyPrefix := types.DelegatorOverridesKey(req.ProposalId)
+	if req.ValidatorAddress != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		keyPrefix = types.ValidatorDelegatorOverridesKey(req.ProposalId, valAddr)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if proposal.Status == types.StatusDepositPeriod || proposal.Status == types.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d has not been tallied yet", req.ProposalId)
+	}
+
+	var overrides []types.DelegatorOverride
+	store := ctx.KVStore(q.storeKey)
+	overridesStore := prefix.NewStore(store, keyPrefix)
+
+	pageRes, err := query.Paginate(overridesStore, req.Pagination, func(key []byte, value []byte) error {
+		var override types.DelegatorOverride
+		if err := q.cdc.Unmarshal(value, &override); err != nil {
+			return err
+		}
+
+		overrides = append(overrides, override)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorOverridesResponse{Overrides: overrides, Pagination: pageRes}, nil
 }
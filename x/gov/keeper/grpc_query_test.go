@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"bytes"
 	gocontext "context"
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -50,7 +52,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 			func() {
 				req = &types.QueryProposalRequest{ProposalId: 1}
 				testProposal := types.NewTextProposal("Proposal", "testing proposal")
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil, "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -106,7 +108,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 				for i := 0; i < 5; i++ {
 					num := strconv.Itoa(i + 1)
 					testProposal := types.NewTextProposal("Proposal"+num, "testing proposal "+num)
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil, "", false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, proposal)
@@ -183,7 +185,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 			func() {
 				testProposals[1].Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, testProposals[1])
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, testProposals[1].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, testProposals[1].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
 
 				req = &types.QueryProposalsRequest{
 					Voter: addrs[0].String(),
@@ -274,7 +276,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVoteRequest{
@@ -291,7 +293,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			func() {
 				proposal.Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
 
 				req = &types.QueryVoteRequest{
 					ProposalId: proposal.ProposalId,
@@ -379,7 +381,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVotesRequest{
@@ -402,8 +404,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 				accAddr2, err2 := sdk.AccAddressFromBech32(votes[1].Voter)
 				suite.Require().NoError(err1)
 				suite.Require().NoError(err2)
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr1, votes[0].Options))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr2, votes[1].Options))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr1, votes[0].Options, ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr2, votes[1].Options, ""))
 
 				req = &types.QueryVotesRequest{
 					ProposalId: proposal.ProposalId,
@@ -460,7 +462,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamDeposit}
 				expRes = &types.QueryParamsResponse{
 					DepositParams: types.DefaultDepositParams(),
-					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
 				}
 			},
 			true,
@@ -470,8 +472,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
 				expRes = &types.QueryParamsResponse{
-					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					DepositParams: types.NewDepositParams(nil, 0, sdk.NewDec(0)),
+					VotingParams:  types.DefaultVotingParams(),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
 				}
 			},
 			true,
@@ -481,7 +484,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamTallying}
 				expRes = &types.QueryParamsResponse{
-					TallyParams: types.DefaultTallyParams(),
+					DepositParams: types.NewDepositParams(nil, 0, sdk.NewDec(0)),
+					TallyParams:   types.DefaultTallyParams(),
 				}
 			},
 			true,
@@ -570,7 +574,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -659,7 +663,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryDepositsRequest{
@@ -751,14 +755,15 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
 				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
 
 				expRes = &types.QueryTallyResultResponse{
-					Tally: types.EmptyTallyResult(),
+					Tally:       types.EmptyTallyResult(),
+					TallyParams: types.DefaultTallyParams(),
 				}
 			},
 			true,
@@ -769,9 +774,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 				proposal.Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
 
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
 
 				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
 
@@ -779,6 +784,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 					Tally: types.TallyResult{
 						Yes: sdk.NewInt(3 * 5 * 1000000),
 					},
+					TallyParams: types.DefaultTallyParams(),
 				}
 			},
 			true,
@@ -793,7 +799,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
 
 				expRes = &types.QueryTallyResultResponse{
-					Tally: proposal.FinalTallyResult,
+					Tally:       proposal.FinalTallyResult,
+					TallyParams: types.DefaultTallyParams(),
 				}
 			},
 			true,
@@ -816,3 +823,317 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryRunningTally() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs, _ := createValidators(suite.T(), ctx, app, []int64{5, 5, 5})
+
+	var (
+		req      *types.QueryRunningTallyRequest
+		expRes   *types.QueryRunningTallyResponse
+		proposal types.Proposal
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryRunningTallyRequest{}
+			},
+			false,
+		},
+		{
+			"query non existed proposal",
+			func() {
+				req = &types.QueryRunningTallyRequest{ProposalId: 1}
+			},
+			false,
+		},
+		{
+			"proposal in deposit period",
+			func() {
+				var err error
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
+				suite.Require().NoError(err)
+
+				req = &types.QueryRunningTallyRequest{ProposalId: proposal.ProposalId}
+			},
+			false,
+		},
+		{
+			"request running tally without quorum",
+			func() {
+				app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+
+				bondedTokens := app.StakingKeeper.TotalBondedTokens(ctx)
+				expRes = &types.QueryRunningTallyResponse{
+					Tally:           types.TallyResult{Yes: sdk.NewInt(5 * 1000000)},
+					VotingPower:     sdk.NewInt(5 * 1000000),
+					BondedTokens:    bondedTokens,
+					Turnout:         sdk.NewDec(5 * 1000000).QuoInt(bondedTokens),
+					QuorumReached:   false,
+					ProjectedStatus: types.StatusRejected,
+				}
+			},
+			true,
+		},
+		{
+			"request running tally after few votes",
+			func() {
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionNo), ""))
+
+				bondedTokens := app.StakingKeeper.TotalBondedTokens(ctx)
+				expRes = &types.QueryRunningTallyResponse{
+					Tally: types.TallyResult{
+						Yes: sdk.NewInt(2 * 5 * 1000000),
+						No:  sdk.NewInt(5 * 1000000),
+					},
+					VotingPower:     sdk.NewInt(3 * 5 * 1000000),
+					BondedTokens:    bondedTokens,
+					Turnout:         sdk.NewDec(3 * 5 * 1000000).QuoInt(bondedTokens),
+					QuorumReached:   true,
+					ProjectedStatus: types.StatusPassed,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.RunningTally(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.String(), res.String())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorTallyBreakdown() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	var (
+		req      *types.QueryValidatorTallyBreakdownRequest
+		expRes   *types.QueryValidatorTallyBreakdownResponse
+		proposal types.Proposal
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryValidatorTallyBreakdownRequest{}
+			},
+			false,
+		},
+		{
+			"query non existed proposal",
+			func() {
+				req = &types.QueryValidatorTallyBreakdownRequest{ProposalId: 1}
+			},
+			false,
+		},
+		{
+			"proposal not tallied yet",
+			func() {
+				var err error
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
+				suite.Require().NoError(err)
+
+				req = &types.QueryValidatorTallyBreakdownRequest{ProposalId: proposal.ProposalId}
+			},
+			false,
+		},
+		{
+			"request breakdowns with pagination",
+			func() {
+				proposal.Status = types.StatusPassed
+				app.GovKeeper.SetProposal(ctx, proposal)
+
+				var breakdowns []types.ValidatorTallyBreakdown
+				for i := 0; i < 2; i++ {
+					breakdown := types.ValidatorTallyBreakdown{
+						ProposalId:          proposal.ProposalId,
+						ValidatorAddress:    sdk.ValAddress(addrs[i]).String(),
+						Options:             types.NewNonSplitVoteOption(types.OptionYes),
+						VotingPower:         sdk.NewInt(10),
+						DeductedVotingPower: sdk.NewInt(5),
+					}
+					app.GovKeeper.SetTallyBreakdown(ctx, breakdown)
+					breakdowns = append(breakdowns, breakdown)
+				}
+				sort.Slice(breakdowns, func(i, j int) bool {
+					valI, _ := sdk.ValAddressFromBech32(breakdowns[i].ValidatorAddress)
+					valJ, _ := sdk.ValAddressFromBech32(breakdowns[j].ValidatorAddress)
+					return bytes.Compare(valI, valJ) < 0
+				})
+
+				req = &types.QueryValidatorTallyBreakdownRequest{
+					ProposalId: proposal.ProposalId,
+					Pagination: &query.PageRequest{Limit: 1},
+				}
+
+				expRes = &types.QueryValidatorTallyBreakdownResponse{
+					Breakdowns: breakdowns[:1],
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.ValidatorTallyBreakdown(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.GetBreakdowns(), res.GetBreakdowns())
+				suite.Require().NotNil(res.Pagination.NextKey)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryDelegatorOverrides() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	var (
+		req       *types.QueryDelegatorOverridesRequest
+		expRes    *types.QueryDelegatorOverridesResponse
+		proposal  types.Proposal
+		overrides []types.DelegatorOverride
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryDelegatorOverridesRequest{}
+			},
+			false,
+		},
+		{
+			"query non existed proposal",
+			func() {
+				req = &types.QueryDelegatorOverridesRequest{ProposalId: 1}
+			},
+			false,
+		},
+		{
+			"proposal not tallied yet",
+			func() {
+				var err error
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
+				suite.Require().NoError(err)
+
+				req = &types.QueryDelegatorOverridesRequest{ProposalId: proposal.ProposalId}
+			},
+			false,
+		},
+		{
+			"invalid validator address",
+			func() {
+				proposal.Status = types.StatusPassed
+				app.GovKeeper.SetProposal(ctx, proposal)
+
+				req = &types.QueryDelegatorOverridesRequest{ProposalId: proposal.ProposalId, ValidatorAddress: "invalid"}
+			},
+			false,
+		},
+		{
+			"request overrides with pagination",
+			func() {
+				// two validators, each overridden by two delegators
+				for i := 0; i < 2; i++ {
+					for j := 0; j < 2; j++ {
+						override := types.NewDelegatorOverride(
+							proposal.ProposalId, sdk.ValAddress(addrs[i]), addrs[j], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewInt(5),
+						)
+						app.GovKeeper.SetDelegatorOverride(ctx, override)
+						overrides = append(overrides, override)
+					}
+				}
+				sort.Slice(overrides, func(i, j int) bool {
+					valI, _ := sdk.ValAddressFromBech32(overrides[i].ValidatorAddress)
+					valJ, _ := sdk.ValAddressFromBech32(overrides[j].ValidatorAddress)
+					if !bytes.Equal(valI, valJ) {
+						return bytes.Compare(valI, valJ) < 0
+					}
+					delI, _ := sdk.AccAddressFromBech32(overrides[i].DelegatorAddress)
+					delJ, _ := sdk.AccAddressFromBech32(overrides[j].DelegatorAddress)
+					return bytes.Compare(delI, delJ) < 0
+				})
+
+				req = &types.QueryDelegatorOverridesRequest{
+					ProposalId: proposal.ProposalId,
+					Pagination: &query.PageRequest{Limit: 3},
+				}
+
+				expRes = &types.QueryDelegatorOverridesResponse{
+					Overrides: overrides[:3],
+				}
+			},
+			true,
+		},
+		{
+			"request overrides of a validator",
+			func() {
+				req = &types.QueryDelegatorOverridesRequest{
+					ProposalId:       proposal.ProposalId,
+					ValidatorAddress: overrides[2].ValidatorAddress,
+					Pagination:       &query.PageRequest{Limit: 1},
+				}
+
+				expRes = &types.QueryDelegatorOverridesResponse{
+					Overrides: overrides[2:3],
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.DelegatorOverrides(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.GetOverrides(), res.GetOverrides())
+				suite.Require().NotNil(res.Pagination.NextKey)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
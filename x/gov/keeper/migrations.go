@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v043"
+	v045 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v045"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,17 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v045.MigrateStore(ctx, m.keeper.paramSpace)
+}
+
+// Migrate3to4 migrates from version 3 to 4, counting the votes of the
+// proposals in voting period in their running tally.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.iterateVotingProposalIDs(ctx, func(proposalID uint64) {
+		m.keeper.RebuildRunningTally(ctx, proposalID)
+	})
+	return nil
+}
@@ -9,19 +9,41 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content, its proposer, an
+// optional metadata and whether it should go through the expedited voting
+// period
+func (keeper Keeper) SubmitProposal(
+	ctx sdk.Context, content types.Content, proposer sdk.AccAddress, metadata string, expedited bool,
+) (types.Proposal, error) {
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return types.Proposal{}, err
+	}
+
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
-	// Execute the proposal content in a new context branch (with branched store)
-	// to validate the actual parameter changes before the proposal proceeds
-	// through the governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	if ep, ok := content.(*types.ExecutionProposal); ok {
+		// The messages of an execution proposal are not executed beforehand, as
+		// they may depend on the state at the end of the voting period, but they
+		// must be signed by the governance module account and routable.
+		msgs, err := ep.GetMessages()
+		if err != nil {
+			return types.Proposal{}, err
+		}
+
+		if err := keeper.validateProposalMsgs(msgs); err != nil {
+			return types.Proposal{}, err
+		}
+	} else {
+		// Execute the proposal content in a new context branch (with branched store)
+		// to validate the actual parameter changes before the proposal proceeds
+		// through the governance process. State is not persisted.
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -37,6 +59,12 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		return types.Proposal{}, err
 	}
 
+	if proposer != nil {
+		proposal.Proposer = proposer.String()
+	}
+	proposal.Metadata = metadata
+	proposal.Expedited = expedited
+
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
@@ -54,6 +82,61 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// ExecuteProposalMsgs executes the messages of an execution proposal, signed
+// by the governance module account, through the msg service router. It emits
+// the events of the messages and returns their encoded responses, in order. It
+// stops at the first message failing.
+func (keeper Keeper) ExecuteProposalMsgs(ctx sdk.Context, ep *types.ExecutionProposal) ([][]byte, error) {
+	msgs, err := ep.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := keeper.validateProposalMsgs(msgs); err != nil {
+		return nil, err
+	}
+
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		handler := keeper.msgServiceRouter.Handler(msg)
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %d (%s) failed on execution", i, sdk.MsgTypeURL(msg))
+		}
+		results[i] = res.Data
+
+		// emit the events of the executed message
+		events := make(sdk.Events, len(res.Events))
+		for j, event := range res.Events {
+			events[j] = sdk.Event(event)
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	return results, nil
+}
+
+// validateProposalMsgs checks that the governance module account is the only
+// signer of each of the given messages, and that they can be routed by the msg
+// service router.
+func (keeper Keeper) validateProposalMsgs(msgs []sdk.Msg) error {
+	govAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return sdkerrors.Wrapf(types.ErrInvalidSigner, "message %d (%s)", i, sdk.MsgTypeURL(msg))
+		}
+
+		if keeper.msgServiceRouter.Handler(msg) == nil {
+			return sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -183,9 +266,50 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 	store.Set(types.ProposalIDKey, types.GetProposalIDBytes(proposalID))
 }
 
+// CancelProposal cancels a proposal on behalf of its proposer. The configured
+// ProposalCancelRatio of every deposit is burned and the remainder is refunded
+// to the depositors. The proposal and its votes are then removed from the store.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	switch proposal.Status {
+	case types.StatusDepositPeriod:
+	case types.StatusVotingPeriod:
+		if !ctx.BlockHeader().Time.Before(proposal.VotingEndTime) {
+			return sdkerrors.Wrapf(types.ErrInactiveProposal, "voting period of proposal %d has already ended", proposalID)
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	keeper.CancelDeposits(ctx, proposalID, keeper.GetDepositParams(ctx).ProposalCancelRatio)
+	keeper.DeleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer),
+		),
+	)
+
+	return nil
+}
+
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
 	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = keeper.GetVotingParams(ctx).ExpeditedVotingPeriod
+	}
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -6,13 +6,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, "", false)
 	suite.Require().NoError(err)
 	proposalID := proposal.ProposalId
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -24,7 +26,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, "", false)
 	suite.Require().NoError(err)
 
 	suite.Require().True(proposal.VotingStartTime.Equal(time.Time{}))
@@ -64,11 +66,51 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 
 	for i, tc := range testCases {
-		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content)
+		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content, nil, "", false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitExecutionProposal() {
+	govAddr := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{"signed by the governance account", []sdk.Msg{banktypes.NewMsgSend(govAddr, suite.addrs[0], coins)}, nil},
+		{"signed by another account", []sdk.Msg{banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], coins)}, types.ErrInvalidSigner},
+		{"multiple signers", []sdk.Msg{testdata.NewTestMsg(govAddr, suite.addrs[0])}, types.ErrInvalidSigner},
+		{"unroutable message", []sdk.Msg{testdata.NewTestMsg(govAddr)}, types.ErrUnroutableProposalMsg},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			content, err := types.NewExecutionProposal("title", "description", tc.msgs)
+			suite.Require().NoError(err)
+
+			proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content, suite.addrs[0], "", false)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			// no message is executed on submission
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, govAddr).IsZero())
+			suite.Require().Empty(proposal.MsgResults)
+
+			proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+			suite.Require().True(ok)
+			suite.Require().Equal(content, proposal.GetContent())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []types.ProposalStatus{types.StatusDepositPeriod, types.StatusVotingPeriod}
@@ -125,3 +167,116 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSubmitProposalMetadata() {
+	proposer := suite.addrs[0]
+
+	_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, proposer, strings.Repeat("a", types.MaxMetadataLength+1), false)
+	suite.Require().True(errors.Is(err, types.ErrMetadataTooLong))
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, proposer, "ipfs://CID", true)
+	suite.Require().NoError(err)
+
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().Equal(proposer.String(), proposal.Proposer)
+	suite.Require().Equal("ipfs://CID", proposal.Metadata)
+	suite.Require().True(proposal.Expedited)
+}
+
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	proposer, depositor := suite.addrs[0], suite.addrs[1]
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1001)))
+
+	submitProposal := func(ctx sdk.Context) uint64 {
+		proposal, err := suite.app.GovKeeper.SubmitProposal(ctx, TestProposal, proposer, "", false)
+		suite.Require().NoError(err)
+		_, err = suite.app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, proposer, deposit, "")
+		suite.Require().NoError(err)
+		_, err = suite.app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, depositor, deposit, "")
+		suite.Require().NoError(err)
+		return proposal.ProposalId
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) (sdk.Context, uint64, string)
+		expectedErr error
+	}{
+		{
+			"unknown proposal",
+			func(ctx sdk.Context) (sdk.Context, uint64, string) {
+				return ctx, 100, proposer.String()
+			},
+			types.ErrUnknownProposal,
+		},
+		{
+			"not the proposer",
+			func(ctx sdk.Context) (sdk.Context, uint64, string) {
+				return ctx, submitProposal(ctx), depositor.String()
+			},
+			types.ErrInvalidProposer,
+		},
+		{
+			"voting period ended",
+			func(ctx sdk.Context) (sdk.Context, uint64, string) {
+				proposalID := submitProposal(ctx)
+				proposal, ok := suite.app.GovKeeper.GetProposal(ctx, proposalID)
+				suite.Require().True(ok)
+				suite.app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+				proposal, _ = suite.app.GovKeeper.GetProposal(ctx, proposalID)
+				return ctx.WithBlockTime(proposal.VotingEndTime), proposalID, proposer.String()
+			},
+			types.ErrInactiveProposal,
+		},
+		{
+			"deposit period",
+			func(ctx sdk.Context) (sdk.Context, uint64, string) {
+				return ctx, submitProposal(ctx), proposer.String()
+			},
+			nil,
+		},
+		{
+			"voting period",
+			func(ctx sdk.Context) (sdk.Context, uint64, string) {
+				proposalID := submitProposal(ctx)
+				proposal, ok := suite.app.GovKeeper.GetProposal(ctx, proposalID)
+				suite.Require().True(ok)
+				suite.app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+				suite.Require().NoError(suite.app.GovKeeper.AddVote(ctx, proposalID, depositor, types.NewNonSplitVoteOption(types.OptionYes), ""))
+				return ctx, proposalID, proposer.String()
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx, proposalID, sender := tc.malleate(ctx)
+
+			proposerBalance := suite.app.BankKeeper.GetAllBalances(ctx, proposer)
+			depositorBalance := suite.app.BankKeeper.GetAllBalances(ctx, depositor)
+			supply := suite.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+			err := suite.app.GovKeeper.CancelProposal(ctx, proposalID, sender)
+			if tc.expectedErr != nil {
+				suite.Require().True(errors.Is(err, tc.expectedErr), err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, ok := suite.app.GovKeeper.GetProposal(ctx, proposalID)
+			suite.Require().False(ok)
+			suite.Require().Empty(suite.app.GovKeeper.GetDeposits(ctx, proposalID))
+			suite.Require().Empty(suite.app.GovKeeper.GetVotes(ctx, proposalID))
+
+			// with the default cancel ratio of 0.5, 500 tokens of each 1001 deposit
+			// are burned (truncated) and 501 are refunded
+			refund := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(501)))
+			suite.Require().Equal(proposerBalance.Add(refund...), suite.app.BankKeeper.GetAllBalances(ctx, proposer))
+			suite.Require().Equal(depositorBalance.Add(refund...), suite.app.BankKeeper.GetAllBalances(ctx, depositor))
+			suite.Require().Equal(supply.SubAmount(sdk.NewInt(1000)), suite.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The running tally of a proposal in voting period keeps, for each validator,
+// the delegator shares voted on each option by the delegators who voted
+// themselves, along with the total of these shares which are deducted from the
+// validator vote. It is updated on every vote and delegation change so that the
+// current tally can be computed without iterating the votes.
+
+// GetValidatorRunningTally gets the running tally of the delegators of a
+// validator on a proposal, with no shares if none of them voted.
+func (keeper Keeper) GetValidatorRunningTally(
+	ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress,
+) types.ValidatorRunningTally {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ValidatorRunningTallyKey(proposalID, valAddr))
+	if bz == nil {
+		return types.NewValidatorRunningTally(valAddr)
+	}
+
+	var vrt types.ValidatorRunningTally
+	keeper.cdc.MustUnmarshal(bz, &vrt)
+	return vrt
+}
+
+// SetValidatorRunningTally sets the running tally of the delegators of a
+// validator on a proposal, deleting it when no shares are left.
+func (keeper Keeper) SetValidatorRunningTally(ctx sdk.Context, proposalID uint64, vrt types.ValidatorRunningTally) {
+	valAddr, err := sdk.ValAddressFromBech32(vrt.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	if vrt.IsEmpty() {
+		store.Delete(types.ValidatorRunningTallyKey(proposalID, valAddr))
+		return
+	}
+
+	store.Set(types.ValidatorRunningTallyKey(proposalID, valAddr), keeper.cdc.MustMarshal(&vrt))
+}
+
+// IterateValidatorRunningTallies iterates over the validator running tallies
+// of a proposal and performs a callback function
+func (keeper Keeper) IterateValidatorRunningTallies(
+	ctx sdk.Context, proposalID uint64, cb func(vrt types.ValidatorRunningTally) (stop bool),
+) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorRunningTalliesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vrt types.ValidatorRunningTally
+		keeper.cdc.MustUnmarshal(iterator.Value(), &vrt)
+
+		if cb(vrt) {
+			break
+		}
+	}
+}
+
+// GetVotedShares gets the delegator shares of a voter on a validator counted
+// in the running tally of a proposal
+func (keeper Keeper) GetVotedShares(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) sdk.Dec {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VotedShareKey(proposalID, voterAddr, valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var shares sdk.DecProto
+	keeper.cdc.MustUnmarshal(bz, &shares)
+	return shares.Dec
+}
+
+// setVotedShares sets the delegator shares of a voter on a validator counted
+// in the running tally of a proposal, deleting them when zero.
+func (keeper Keeper) setVotedShares(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) {
+	store := ctx.KVStore(keeper.storeKey)
+	if shares.IsZero() {
+		store.Delete(types.VotedShareKey(proposalID, voterAddr, valAddr))
+		return
+	}
+
+	store.Set(types.VotedShareKey(proposalID, voterAddr, valAddr), keeper.cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+}
+
+// updateVotedShares updates the running tally of a proposal with the current
+// delegator shares of a voter on a validator, counted for the given options.
+func (keeper Keeper) updateVotedShares(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	shares sdk.Dec, options types.WeightedVoteOptions,
+) {
+	diff := shares.Sub(keeper.GetVotedShares(ctx, proposalID, voterAddr, valAddr))
+	if diff.IsZero() {
+		return
+	}
+
+	vrt := keeper.GetValidatorRunningTally(ctx, proposalID, valAddr)
+	vrt.AddOptionShares(diff, options)
+	vrt.DeductedShares = vrt.DeductedShares.Add(diff)
+
+	keeper.SetValidatorRunningTally(ctx, proposalID, vrt)
+	keeper.setVotedShares(ctx, proposalID, voterAddr, valAddr, shares)
+}
+
+// addVoteToRunningTally counts the current delegations of a voter for the
+// given options in the running tally of a proposal.
+func (keeper Keeper) addVoteToRunningTally(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions,
+) {
+	keeper.sk.IterateDelegations(ctx, voterAddr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		keeper.updateVotedShares(ctx, proposalID, voterAddr, delegation.GetValidatorAddr(), delegation.GetShares(), options)
+		return false
+	})
+}
+
+// removeVoteFromRunningTally removes the delegator shares of a voter counted
+// for the given options from the running tally of a proposal.
+func (keeper Keeper) removeVoteFromRunningTally(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions,
+) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VotedSharesKey(proposalID, voterAddr))
+
+	var valAddrs []sdk.ValAddress
+	for ; iterator.Valid(); iterator.Next() {
+		_, _, valAddr := types.SplitVotedShareKey(iterator.Key())
+		valAddrs = append(valAddrs, valAddr)
+	}
+	iterator.Close()
+
+	for _, valAddr := range valAddrs {
+		keeper.updateVotedShares(ctx, proposalID, voterAddr, valAddr, sdk.ZeroDec(), options)
+	}
+}
+
+// deleteRunningTally deletes the running tally of a proposal from the store
+func (keeper Keeper) deleteRunningTally(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	for _, prefix := range [][]byte{
+		types.ValidatorRunningTalliesKey(proposalID),
+		append(types.VotedSharesKeyPrefix, types.GetProposalIDBytes(proposalID)...),
+	} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// RebuildRunningTally deletes the running tally of a proposal and counts all
+// its votes again with the current delegations of the voters.
+func (keeper Keeper) RebuildRunningTally(ctx sdk.Context, proposalID uint64) {
+	keeper.deleteRunningTally(ctx, proposalID)
+
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.addVoteToRunningTally(ctx, proposalID, voter, vote.Options)
+		return false
+	})
+}
+
+// iterateVotingProposalIDs iterates over the IDs of the proposals in voting
+// period and performs a callback function
+func (keeper Keeper) iterateVotingProposalIDs(ctx sdk.Context, cb func(proposalID uint64)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ActiveProposalQueuePrefix)
+
+	var proposalIDs []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitActiveProposalQueueKey(iterator.Key())
+		proposalIDs = append(proposalIDs, proposalID)
+	}
+	iterator.Close()
+
+	for _, proposalID := range proposalIDs {
+		cb(proposalID)
+	}
+}
+
+// GetRunningTally computes the current tally of a proposal in voting period from
+// its running tally, along with the total voting power which voted. The result
+// matches the one of Tally, up to the rounding of the voting power which is
+// computed per validator rather than per delegation.
+func (keeper Keeper) GetRunningTally(
+	ctx sdk.Context, proposal types.Proposal,
+) (results map[types.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, err error) {
+	if proposal.Status != types.StatusVotingPeriod {
+		return nil, sdk.Dec{}, fmt.Errorf("proposal %d is not in voting period", proposal.ProposalId)
+	}
+
+	results = make(map[types.VoteOption]sdk.Dec)
+	results[types.OptionYes] = sdk.ZeroDec()
+	results[types.OptionAbstain] = sdk.ZeroDec()
+	results[types.OptionNo] = sdk.ZeroDec()
+	results[types.OptionNoWithVeto] = sdk.ZeroDec()
+
+	totalVotingPower = sdk.ZeroDec()
+
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valAddr := validator.GetOperator()
+		delegatorShares := validator.GetDelegatorShares()
+		if delegatorShares.IsZero() {
+			return false
+		}
+
+		// shares * bonded / total shares
+		votingPower := func(shares sdk.Dec) sdk.Dec {
+			return shares.MulInt(validator.GetBondedTokens()).Quo(delegatorShares)
+		}
+
+		vrt := keeper.GetValidatorRunningTally(ctx, proposal.ProposalId, valAddr)
+		for option, shares := range vrt.OptionShares() {
+			results[option] = results[option].Add(votingPower(shares))
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower(vrt.DeductedShares))
+
+		// the validator votes with the shares of the delegators which did not vote
+		vote, found := keeper.GetVote(ctx, proposal.ProposalId, sdk.AccAddress(valAddr))
+		if !found {
+			return false
+		}
+
+		sharesAfterDeductions := votingPower(delegatorShares.Sub(vrt.DeductedShares))
+		for _, option := range vote.Options {
+			results[option.Option] = results[option.Option].Add(sharesAfterDeductions.Mul(option.Weight))
+		}
+		totalVotingPower = totalVotingPower.Add(sharesAfterDeductions)
+
+		return false
+	})
+
+	return results, totalVotingPower, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func requireRunningTallyMatches(t *testing.T, ctx sdk.Context, app *simapp.SimApp, proposalID uint64) {
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+
+	_, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	results, _, err := app.GovKeeper.GetRunningTally(ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, tallyResults, types.NewTallyResultFromMap(results))
+}
+
+func TestRunningTally(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, vals := createValidators(t, ctx, app, []int64{5, 6, 7})
+	// createValidators replaces the staking keeper by one without hooks
+	app.StakingKeeper.SetHooks(app.GovKeeper.StakingHooks())
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	val1, found := app.StakingKeeper.GetValidator(ctx, vals[0])
+	require.True(t, found)
+	val2, found := app.StakingKeeper.GetValidator(ctx, vals[1])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val2, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, "", false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+
+	// the running tally is only available in voting period
+	_, _, err = app.GovKeeper.GetRunningTally(ctx, proposal)
+	require.Error(t, err)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	requireRunningTallyMatches(t, ctx, app, proposalID)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
+	requireRunningTallyMatches(t, ctx, app, proposalID)
+
+	// the shares of the voting delegators, including the self delegation of
+	// the validator, are deducted from the validators
+	selfDelegation := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	vrt := app.GovKeeper.GetValidatorRunningTally(ctx, proposalID, vals[0])
+	require.Equal(t, selfDelegation.ToDec(), vrt.YesShares)
+	require.Equal(t, delTokens.ToDec(), vrt.AbstainShares)
+	require.Equal(t, delTokens.Add(selfDelegation).ToDec(), vrt.DeductedShares)
+
+	// the delegator changes its vote
+	options := types.WeightedVoteOptions{
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(25, 2)},
+		{Option: types.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(75, 2)},
+	}
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], options, ""))
+	requireRunningTallyMatches(t, ctx, app, proposalID)
+
+	vrt = app.GovKeeper.GetValidatorRunningTally(ctx, proposalID, vals[0])
+	require.True(t, vrt.AbstainShares.IsZero())
+	require.Equal(t, delTokens.Add(selfDelegation).ToDec(), vrt.DeductedShares)
+
+	// the delegator delegates to a new validator
+	val3, found := app.StakingKeeper.GetValidator(ctx, vals[2])
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val3, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+	requireRunningTallyMatches(t, ctx, app, proposalID)
+
+	// the delegator undelegates part of a delegation
+	_, err = app.StakingKeeper.Undelegate(ctx, addrs[3], vals[0], delTokens.QuoRaw(2).ToDec())
+	require.NoError(t, err)
+	requireRunningTallyMatches(t, ctx, app, proposalID)
+
+	// the delegator redelegates all of a delegation
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrs[3], vals[1], vals[0], delTokens.ToDec())
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+	requireRunningTallyMatches(t, ctx, app, proposalID)
+	require.True(t, app.GovKeeper.GetVotedShares(ctx, proposalID, addrs[3], vals[1]).IsZero())
+
+	// rebuilding the running tally leads to the same state
+	var vrts []types.ValidatorRunningTally
+	app.GovKeeper.IterateValidatorRunningTallies(ctx, proposalID, func(vrt types.ValidatorRunningTally) bool {
+		vrts = append(vrts, vrt)
+		return false
+	})
+	app.GovKeeper.RebuildRunningTally(ctx, proposalID)
+
+	var rebuilt []types.ValidatorRunningTally
+	app.GovKeeper.IterateValidatorRunningTallies(ctx, proposalID, func(vrt types.ValidatorRunningTally) bool {
+		rebuilt = append(rebuilt, vrt)
+		return false
+	})
+	require.Equal(t, vrts, rebuilt)
+
+	// deleting the votes deletes the running tally
+	app.GovKeeper.DeleteVotes(ctx, proposalID)
+	app.GovKeeper.IterateValidatorRunningTallies(ctx, proposalID, func(vrt types.ValidatorRunningTally) bool {
+		require.Fail(t, "unexpected running tally", vrt)
+		return true
+	})
+	require.True(t, app.GovKeeper.GetVotedShares(ctx, proposalID, addrs[3], vals[0]).IsZero())
+}
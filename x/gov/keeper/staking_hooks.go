@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks wrapper struct for the staking hooks keeping the running tally
+// of the proposals in voting period up to date with the delegations
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the governance keeper
+func (keeper Keeper) StakingHooks() StakingHooks { return StakingHooks{keeper} }
+
+// AfterDelegationModified updates the shares of the delegation counted in the
+// running tally of the proposals the delegator voted on
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	shares := sdk.ZeroDec()
+	if delegation := h.k.sk.Delegation(ctx, delAddr, valAddr); delegation != nil {
+		shares = delegation.GetShares()
+	}
+
+	h.updateDelegation(ctx, delAddr, valAddr, shares)
+}
+
+// BeforeDelegationRemoved removes the shares of the delegation counted in the
+// running tally of the proposals the delegator voted on
+func (h StakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.updateDelegation(ctx, delAddr, valAddr, sdk.ZeroDec())
+}
+
+func (h StakingHooks) updateDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	h.k.iterateVotingProposalIDs(ctx, func(proposalID uint64) {
+		vote, found := h.k.GetVote(ctx, proposalID, delAddr)
+		if !found {
+			return
+		}
+
+		h.k.updateVotedShares(ctx, proposalID, delAddr, valAddr, shares, vote.Options)
+	})
+}
+
+func (StakingHooks) AfterValidatorCreated(sdk.Context, sdk.ValAddress)                          {}
+func (StakingHooks) BeforeValidatorModified(sdk.Context, sdk.ValAddress)                        {}
+func (StakingHooks) AfterValidatorRemoved(sdk.Context, sdk.ConsAddress, sdk.ValAddress)         {}
+func (StakingHooks) AfterValidatorBonded(sdk.Context, sdk.ConsAddress, sdk.ValAddress)          {}
+func (StakingHooks) AfterValidatorBeginUnbonding(sdk.Context, sdk.ConsAddress, sdk.ValAddress)  {}
+func (StakingHooks) BeforeDelegationCreated(sdk.Context, sdk.AccAddress, sdk.ValAddress)        {}
+func (StakingHooks) BeforeDelegationSharesModified(sdk.Context, sdk.AccAddress, sdk.ValAddress) {}
+func (StakingHooks) BeforeValidatorSlashed(sdk.Context, sdk.ValAddress, sdk.Dec)                {}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -11,6 +13,19 @@ import (
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
 // voters
 func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
+	passes, burnDeposits, tallyResults, _, _ = keeper.TallyWithBreakdown(ctx, proposal)
+	return passes, burnDeposits, tallyResults
+}
+
+// TallyWithBreakdown tallies a proposal as Tally does, and also returns the breakdown of the tally
+// for each bonded validator which voted or whose delegators voted, sorted by validator address, and
+// the delegators who overrode the vote of these validators, in the same order.
+func (keeper Keeper) TallyWithBreakdown(
+	ctx sdk.Context, proposal types.Proposal,
+) (
+	passes bool, burnDeposits bool, tallyResults types.TallyResult,
+	breakdowns []types.ValidatorTallyBreakdown, delegatorOverrides []types.DelegatorOverride,
+) {
 	results := make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
 	results[types.OptionAbstain] = sdk.ZeroDec()
@@ -20,6 +35,12 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	totalVotingPower := sdk.ZeroDec()
 	currValidators := make(map[string]types.ValidatorGovInfo)
 
+	// voting power of the self delegation of the validators which voted, and
+	// of the delegators which overrode the vote of their validator
+	selfVotingPower := make(map[string]sdk.Dec)
+	overrides := make(map[string][]types.DelegatorOverride)
+	deductedVotingPower := make(map[string]sdk.Dec)
+
 	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
 		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
@@ -65,12 +86,24 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 					results[option.Option] = results[option.Option].Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
+
+				if valAddrStr == sdk.ValAddress(voter).String() {
+					selfVotingPower[valAddrStr] = votingPower
+				} else {
+					overrides[valAddrStr] = append(overrides[valAddrStr], types.NewDelegatorOverride(
+						proposal.ProposalId, delegation.GetValidatorAddr(), voter, vote.Options, votingPower.TruncateInt(),
+					))
+					deducted, ok := deductedVotingPower[valAddrStr]
+					if !ok {
+						deducted = sdk.ZeroDec()
+					}
+					deductedVotingPower[valAddrStr] = deducted.Add(votingPower)
+				}
 			}
 
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
@@ -90,36 +123,118 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
-	tallyResults = types.NewTallyResultFromMap(results)
+	// build the breakdown of the validators in a deterministic order
+	valAddrStrs := make([]string, 0, len(currValidators))
+	for valAddrStr := range currValidators {
+		valAddrStrs = append(valAddrStrs, valAddrStr)
+	}
+	sort.Strings(valAddrStrs)
+
+	for _, valAddrStr := range valAddrStrs {
+		val := currValidators[valAddrStr]
+		if len(val.Vote) == 0 && len(overrides[valAddrStr]) == 0 {
+			continue
+		}
+
+		breakdown := types.ValidatorTallyBreakdown{
+			ProposalId:          proposal.ProposalId,
+			ValidatorAddress:    valAddrStr,
+			VotingPower:         sdk.ZeroInt(),
+			DeductedVotingPower: sdk.ZeroInt(),
+		}
+
+		// the vote of the validator carries its self delegation along with the
+		// shares of the delegators which did not vote
+		if len(val.Vote) > 0 {
+			breakdown.Options = val.Vote
+			votingPower := val.DelegatorShares.Sub(val.DelegatorDeductions).MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			if self, ok := selfVotingPower[valAddrStr]; ok {
+				votingPower = votingPower.Add(self)
+			}
+			breakdown.VotingPower = votingPower.TruncateInt()
+		}
+		if deducted, ok := deductedVotingPower[valAddrStr]; ok {
+			breakdown.DeductedVotingPower = deducted.TruncateInt()
+		}
+
+		breakdowns = append(breakdowns, breakdown)
+		delegatorOverrides = append(delegatorOverrides, overrides[valAddrStr]...)
+	}
+
+	passes, burnDeposits = keeper.tallyOutcome(ctx, proposal, results, totalVotingPower)
+	return passes, burnDeposits, types.NewTallyResultFromMap(results), breakdowns, delegatorOverrides
+}
+
+// QuorumReached returns true if the given voting power reaches the quorum of
+// a proposal
+func (keeper Keeper) QuorumReached(ctx sdk.Context, proposal types.Proposal, totalVotingPower sdk.Dec) bool {
+	// If there is no staked coins, the quorum can't be reached
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return false
+	}
+
+	percentVoting := totalVotingPower.Quo(totalBonded.ToDec())
+	return percentVoting.GTE(keeper.GetProposalTallyParams(ctx, proposal).Quorum)
+}
+
+// tallyOutcome returns whether a proposal passes, and whether its deposits are
+// burned, given the voting power voting for each option
+func (keeper Keeper) tallyOutcome(
+	ctx sdk.Context, proposal types.Proposal, results map[types.VoteOption]sdk.Dec, totalVotingPower sdk.Dec,
+) (passes bool, burnDeposits bool) {
+	tallyParams := keeper.GetProposalTallyParams(ctx, proposal)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
 	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
+		return false, false
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
+	if !keeper.QuorumReached(ctx, proposal, totalVotingPower) {
+		return false, true
 	}
 
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, false, tallyResults
+		return false, false
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
-		return false, true, tallyResults
+		return false, true
+	}
+
+	// Expedited proposals require a higher share of Yes votes to pass
+	threshold := tallyParams.Threshold
+	if proposal.Expedited {
+		threshold = sdk.MaxDec(threshold, tallyParams.ExpeditedThreshold)
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
-		return true, false, tallyResults
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
+		return true, false
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return false, false
+}
+
+// GetProposalTallyParams returns the tally params applying to a proposal, with
+// the quorum, threshold and veto threshold of the tally overrides of its content
+// type, or of the types of its messages for an execution proposal.
+func (keeper Keeper) GetProposalTallyParams(ctx sdk.Context, proposal types.Proposal) types.TallyParams {
+	var typeURLs []string
+	if proposal.Content != nil {
+		typeURLs = append(typeURLs, proposal.Content.TypeUrl)
+	}
+
+	if ep, ok := proposal.GetContent().(*types.ExecutionProposal); ok {
+		for _, msg := range ep.Messages {
+			typeURLs = append(typeURLs, msg.TypeUrl)
+		}
+	}
+
+	return keeper.GetTallyParams(ctx).ForTypes(typeURLs...)
 }
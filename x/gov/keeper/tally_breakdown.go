@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// SetTallyBreakdown sets the tally breakdown of a validator on a tallied proposal
+func (keeper Keeper) SetTallyBreakdown(ctx sdk.Context, breakdown types.ValidatorTallyBreakdown) {
+	valAddr, err := sdk.ValAddressFromBech32(breakdown.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.TallyBreakdownKey(breakdown.ProposalId, valAddr), keeper.cdc.MustMarshal(&breakdown))
+}
+
+// GetTallyBreakdown gets the tally breakdown of a validator on a tallied proposal
+func (keeper Keeper) GetTallyBreakdown(
+	ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress,
+) (breakdown types.ValidatorTallyBreakdown, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.TallyBreakdownKey(proposalID, valAddr))
+	if bz == nil {
+		return breakdown, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &breakdown)
+	return breakdown, true
+}
+
+// IterateAllTallyBreakdowns iterates over all the stored tally breakdowns and
+// performs a callback function
+func (keeper Keeper) IterateAllTallyBreakdowns(ctx sdk.Context, cb func(breakdown types.ValidatorTallyBreakdown) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TallyBreakdownsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var breakdown types.ValidatorTallyBreakdown
+		keeper.cdc.MustUnmarshal(iterator.Value(), &breakdown)
+
+		if cb(breakdown) {
+			break
+		}
+	}
+}
+
+// GetAllTallyBreakdowns returns all the stored tally breakdowns
+func (keeper Keeper) GetAllTallyBreakdowns(ctx sdk.Context) (breakdowns []types.ValidatorTallyBreakdown) {
+	keeper.IterateAllTallyBreakdowns(ctx, func(breakdown types.ValidatorTallyBreakdown) bool {
+		breakdowns = append(breakdowns, breakdown)
+		return false
+	})
+	return
+}
+
+// SetDelegatorOverride sets the override of the vote of a validator by one of
+// its delegators on a tallied proposal
+func (keeper Keeper) SetDelegatorOverride(ctx sdk.Context, override types.DelegatorOverride) {
+	valAddr, err := sdk.ValAddressFromBech32(override.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	delAddr, err := sdk.AccAddressFromBech32(override.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.DelegatorOverrideKey(override.ProposalId, valAddr, delAddr), keeper.cdc.MustMarshal(&override))
+}
+
+// GetDelegatorOverride gets the override of the vote of a validator by one of
+// its delegators on a tallied proposal
+func (keeper Keeper) GetDelegatorOverride(
+	ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress, delAddr sdk.AccAddress,
+) (override types.DelegatorOverride, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DelegatorOverrideKey(proposalID, valAddr, delAddr))
+	if bz == nil {
+		return override, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+// IterateAllDelegatorOverrides iterates over all the stored delegator overrides
+// and performs a callback function
+func (keeper Keeper) IterateAllDelegatorOverrides(ctx sdk.Context, cb func(override types.DelegatorOverride) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegatorOverridesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var override types.DelegatorOverride
+		keeper.cdc.MustUnmarshal(iterator.Value(), &override)
+
+		if cb(override) {
+			break
+		}
+	}
+}
+
+// GetAllDelegatorOverrides returns all the stored delegator overrides
+func (keeper Keeper) GetAllDelegatorOverrides(ctx sdk.Context) (overrides []types.DelegatorOverride) {
+	keeper.IterateAllDelegatorOverrides(ctx, func(override types.DelegatorOverride) bool {
+		overrides = append(overrides, override)
+		return false
+	})
+	return
+}
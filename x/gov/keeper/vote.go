@@ -9,7 +9,9 @@ import (
 )
 
 // AddVote adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
+func (keeper Keeper) AddVote(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions, metadata string,
+) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -24,7 +26,18 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		}
 	}
 
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return err
+	}
+
+	// count the delegations of the voter for its new options in the running tally
+	if oldVote, found := keeper.GetVote(ctx, proposalID, voterAddr); found {
+		keeper.removeVoteFromRunningTally(ctx, proposalID, voterAddr, oldVote.Options)
+	}
+	keeper.addVoteToRunningTally(ctx, proposalID, voterAddr, options)
+
 	vote := types.NewVote(proposalID, voterAddr, options)
+	vote.Metadata = metadata
 	keeper.SetVote(ctx, vote)
 
 	// called after a vote on a proposal is cast
@@ -125,10 +138,23 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
-// deleteVote deletes a vote from a given proposalID and voter from the store
-func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+// DeleteVotes deletes all the votes cast on a given proposal, along with its
+// running tally, from the store
+func (keeper Keeper) DeleteVotes(ctx sdk.Context, proposalID uint64) {
+	keeper.deleteRunningTally(ctx, proposalID)
+
 	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(types.VoteKey(proposalID, voterAddr))
+	iterator := sdk.KVStorePrefixIterator(store, types.VotesKey(proposalID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
 }
 
 // populateLegacyOption adds graceful fallback of deprecated `Option` field, in case
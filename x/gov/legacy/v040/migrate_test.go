@@ -95,9 +95,11 @@ func TestMigrate(t *testing.T) {
 	// - SoftwareUpgradeProposal has correct JSON.
 	// - ParameterChangeProposal has correct JSON.
 	expected := `{
+	"delegator_overrides": [],
 	"deposit_params": {
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"min_deposit": [],
+		"proposal_cancel_ratio": "0"
 	},
 	"deposits": [],
 	"proposals": [
@@ -108,13 +110,17 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_text"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"msg_results": [],
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -135,13 +141,17 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_community"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"msg_results": [],
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -155,13 +165,17 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_cancel_upgrade"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"msg_results": [],
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -182,13 +196,17 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_software_upgrade"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"msg_results": [],
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -209,13 +227,17 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_param_change"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"metadata": "",
+			"msg_results": [],
 			"proposal_id": "0",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -224,13 +246,17 @@ func TestMigrate(t *testing.T) {
 		}
 	],
 	"starting_proposal_id": "0",
+	"tally_breakdowns": [],
 	"tally_params": {
+		"expedited_threshold": "0",
+		"overrides": [],
 		"quorum": "0",
 		"threshold": "0",
 		"veto_threshold": "0"
 	},
 	"votes": [],
 	"voting_params": {
+		"expedited_voting_period": "0s",
 		"voting_period": "0s"
 	}
 }`
@@ -48,20 +48,26 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Votes are all ADR-037 weighted votes with weight 1.
 	expected := `{
+	"delegator_overrides": [],
 	"deposit_params": {
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"min_deposit": [],
+		"proposal_cancel_ratio": "0"
 	},
 	"deposits": [],
 	"proposals": [],
 	"starting_proposal_id": "0",
+	"tally_breakdowns": [],
 	"tally_params": {
+		"expedited_threshold": "0",
+		"overrides": [],
 		"quorum": "0",
 		"threshold": "0",
 		"veto_threshold": "0"
 	},
 	"votes": [
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -73,6 +79,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -84,6 +91,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -95,6 +103,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -106,6 +115,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -118,6 +128,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": "0s",
 		"voting_period": "0s"
 	}
 }`
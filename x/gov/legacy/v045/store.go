@@ -0,0 +1,39 @@
+package v045
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Add the ProposalCancelRatio deposit param, set to its default value.
+// - Add the ExpeditedVotingPeriod voting param, set to its default value or to
+// half of the voting period if the latter is not longer than the default.
+// - Add the ExpeditedThreshold tally param, set to its default value or halfway
+// between the threshold and one if the threshold is not lower than the default.
+func MigrateStore(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	depositParams.ProposalCancelRatio = types.DefaultProposalCancelRatio
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, depositParams)
+
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	votingParams.ExpeditedVotingPeriod = types.DefaultExpeditedPeriod
+	if votingParams.ExpeditedVotingPeriod >= votingParams.VotingPeriod {
+		votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+	}
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, votingParams)
+
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	tallyParams.ExpeditedThreshold = types.DefaultExpeditedThreshold
+	if tallyParams.ExpeditedThreshold.LTE(tallyParams.Threshold) {
+		tallyParams.ExpeditedThreshold = tallyParams.Threshold.Add(sdk.OneDec().Sub(tallyParams.Threshold).QuoInt64(2))
+	}
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, tallyParams)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package v045_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v045gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+
+	testCases := []struct {
+		name                  string
+		votingPeriod          time.Duration
+		threshold             sdk.Dec
+		expeditedVotingPeriod time.Duration
+		expeditedThreshold    sdk.Dec
+	}{
+		{
+			"default params",
+			types.DefaultPeriod, types.DefaultThreshold,
+			types.DefaultExpeditedPeriod, types.DefaultExpeditedThreshold,
+		},
+		{
+			"short voting period and high threshold",
+			time.Hour, sdk.NewDecWithPrec(8, 1),
+			30 * time.Minute, sdk.NewDecWithPrec(9, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(govKey, tGovKey)
+			paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, govKey, tGovKey, types.ModuleName).
+				WithKeyTable(types.ParamKeyTable())
+
+			// v0.43 params don't have the new fields set.
+			minDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.DefaultMinDepositTokens))
+			paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, types.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: types.DefaultPeriod})
+			paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, types.VotingParams{VotingPeriod: tc.votingPeriod})
+			paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, types.TallyParams{
+				Quorum: types.DefaultQuorum, Threshold: tc.threshold, VetoThreshold: types.DefaultVetoThreshold,
+			})
+
+			require.NoError(t, v045gov.MigrateStore(ctx, paramSpace))
+
+			var depositParams types.DepositParams
+			paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+			require.Equal(t, types.NewDepositParams(minDeposit, types.DefaultPeriod, types.DefaultProposalCancelRatio), depositParams)
+
+			var votingParams types.VotingParams
+			paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+			require.Equal(t, types.NewVotingParams(tc.votingPeriod, tc.expeditedVotingPeriod), votingParams)
+
+			var tallyParams types.TallyParams
+			paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+			require.True(t, tc.expeditedThreshold.Equal(tallyParams.ExpeditedThreshold), tallyParams.ExpeditedThreshold.String())
+
+			genState := types.NewGenesisState(types.DefaultStartingProposalID, depositParams, votingParams, tallyParams)
+			require.NoError(t, types.ValidateGenesis(genState))
+		})
+	}
+}
@@ -16,12 +16,15 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit          = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod       = "deposit_params_deposit_period"
+	DepositParamsProposalCancelRatio = "deposit_params_proposal_cancel_ratio"
+	VotingParamsVotingPeriod         = "voting_params_voting_period"
+	VotingParamsExpeditedPeriod      = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                = "tally_params_quorum"
+	TallyParamsThreshold             = "tally_params_threshold"
+	TallyParamsVeto                  = "tally_params_veto"
+	TallyParamsExpeditedThreshold    = "tally_params_expedited_threshold"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -34,11 +37,22 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsProposalCancelRatio randomized DepositParamsProposalCancelRatio
+func GenDepositParamsProposalCancelRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 101)), 2)
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedVotingPeriod,
+// always shorter than the given voting period
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return time.Duration(r.Int63n(int64(votingPeriod)-1) + 1)
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -54,6 +68,12 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold,
+// always greater than the threshold generated by GenTallyParamsThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 667, 1001)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -94,11 +114,29 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var proposalCancelRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsProposalCancelRatio, &proposalCancelRatio, simState.Rand,
+		func(r *rand.Rand) { proposalCancelRatio = GenDepositParamsProposalCancelRatio(r) },
+	)
+
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r, votingPeriod) },
+	)
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
-		types.NewVotingParams(votingPeriod),
-		types.NewTallyParams(quorum, threshold, veto),
+		types.NewDepositParams(minDeposit, depositPeriod, proposalCancelRatio),
+		types.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		types.NewTallyParams(quorum, threshold, veto, expeditedThreshold),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
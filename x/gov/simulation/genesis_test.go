@@ -43,13 +43,18 @@ func TestRandomizedGenState(t *testing.T) {
 	dec1, _ := sdk.NewDecFromStr("0.361000000000000000")
 	dec2, _ := sdk.NewDecFromStr("0.512000000000000000")
 	dec3, _ := sdk.NewDecFromStr("0.267000000000000000")
+	dec4, _ := sdk.NewDecFromStr("0.682000000000000000")
+	dec5, _ := sdk.NewDecFromStr("0.070000000000000000")
 
 	require.Equal(t, "905stake", govGenesis.DepositParams.MinDeposit.String())
 	require.Equal(t, "77h26m10s", govGenesis.DepositParams.MaxDepositPeriod.String())
+	require.Equal(t, dec5, govGenesis.DepositParams.ProposalCancelRatio)
 	require.Equal(t, float64(148296), govGenesis.VotingParams.VotingPeriod.Seconds())
+	require.Equal(t, int64(137531838212036), int64(govGenesis.VotingParams.ExpeditedVotingPeriod))
 	require.Equal(t, dec1, govGenesis.TallyParams.Quorum)
 	require.Equal(t, dec2, govGenesis.TallyParams.Threshold)
 	require.Equal(t, dec3, govGenesis.TallyParams.VetoThreshold)
+	require.Equal(t, dec4, govGenesis.TallyParams.ExpeditedThreshold)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, types.Deposits{}, govGenesis.Deposits)
 	require.Equal(t, types.Votes{}, govGenesis.Votes)
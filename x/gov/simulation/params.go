@@ -20,6 +20,8 @@ const (
 	subkeyQuorum     = "quorum"
 	subkeyThreshold  = "threshold"
 	subkeyVeto       = "veto"
+
+	subkeyExpeditedThreshold = "expedited_threshold"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -28,12 +30,19 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keyVotingParams,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf(`{"voting_period": "%d"}`, GenVotingParamsVotingPeriod(r))
+				votingPeriod := GenVotingParamsVotingPeriod(r)
+				return fmt.Sprintf(
+					`{"voting_period": "%d", "expedited_voting_period": "%d"}`,
+					votingPeriod, GenVotingParamsExpeditedVotingPeriod(r, votingPeriod),
+				)
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyDepositParams,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf(`{"max_deposit_period": "%d"}`, GenDepositParamsDepositPeriod(r))
+				return fmt.Sprintf(
+					`{"max_deposit_period": "%d", "proposal_cancel_ratio": "%s"}`,
+					GenDepositParamsDepositPeriod(r), GenDepositParamsProposalCancelRatio(r),
+				)
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyTallyParams,
@@ -45,6 +54,7 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 					{subkeyQuorum, GenTallyParamsQuorum(r)},
 					{subkeyThreshold, GenTallyParamsThreshold(r)},
 					{subkeyVeto, GenTallyParamsVeto(r)},
+					{subkeyExpeditedThreshold, GenTallyParamsExpeditedThreshold(r)},
 				}
 
 				pc := make(map[string]string)
@@ -19,9 +19,9 @@ func TestParamChanges(t *testing.T) {
 		simValue    string
 		subspace    string
 	}{
-		{"gov/votingparams", "votingparams", "{\"voting_period\": \"82639000000000\"}", "gov"},
-		{"gov/depositparams", "depositparams", "{\"max_deposit_period\": \"47332000000000\"}", "gov"},
-		{"gov/tallyparams", "tallyparams", "{\"threshold\":\"0.509000000000000000\"}", "gov"},
+		{"gov/votingparams", "votingparams", "{\"voting_period\": \"82639000000000\", \"expedited_voting_period\": \"49393082258522\"}", "gov"},
+		{"gov/depositparams", "depositparams", "{\"max_deposit_period\": \"153577000000000\", \"proposal_cancel_ratio\": \"0.870000000000000000\"}", "gov"},
+		{"gov/tallyparams", "tallyparams", "{\"quorum\":\"0.339000000000000000\",\"veto\":\"0.323000000000000000\"}", "gov"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
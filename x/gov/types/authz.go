@@ -0,0 +1,87 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &VoteAuthorization{}
+)
+
+// NewVoteAuthorization creates a new VoteAuthorization object. Empty
+// proposalIDs or options allow voting on any proposal or any option.
+func NewVoteAuthorization(proposalIDs []uint64, options []VoteOption) *VoteAuthorization {
+	return &VoteAuthorization{
+		ProposalIds: proposalIDs,
+		Options:     options,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a VoteAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgVote{})
+}
+
+// Accept implements Authorization.Accept.
+func (a VoteAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mVote, ok := msg.(*MsgVote)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.ProposalIds) > 0 {
+		allowed := false
+		for _, proposalID := range a.ProposalIds {
+			ctx.GasMeter().ConsumeGas(authz.GasCostPerIteration, "vote authorization")
+			if proposalID == mVote.ProposalId {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot vote on proposal %d", mVote.ProposalId)
+		}
+	}
+
+	if len(a.Options) > 0 {
+		allowed := false
+		for _, option := range a.Options {
+			ctx.GasMeter().ConsumeGas(authz.GasCostPerIteration, "vote authorization")
+			if option == mVote.Option {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot vote %s", mVote.Option)
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a VoteAuthorization) ValidateBasic() error {
+	proposalIDs := make(map[uint64]bool, len(a.ProposalIds))
+	for _, proposalID := range a.ProposalIds {
+		if proposalIDs[proposalID] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate proposal ID %d", proposalID)
+		}
+		proposalIDs[proposalID] = true
+	}
+
+	options := make(map[VoteOption]bool, len(a.Options))
+	for _, option := range a.Options {
+		if !ValidVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		if options[option] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate vote option %s", option)
+		}
+		options[option] = true
+	}
+
+	return nil
+}
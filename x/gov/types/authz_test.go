@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestVoteAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	voter := sdk.AccAddress("_______voter________")
+
+	authorization := types.NewVoteAuthorization([]uint64{1, 2}, []types.VoteOption{types.OptionYes, types.OptionAbstain})
+	require.Equal(t, "/cosmos.gov.v1beta1.MsgVote", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expAccept bool
+	}{
+		{"allowed proposal and option", types.NewMsgVote(voter, 1, types.OptionYes), true},
+		{"other allowed proposal and option", types.NewMsgVote(voter, 2, types.OptionAbstain), true},
+		{"proposal not allowed", types.NewMsgVote(voter, 3, types.OptionYes), false},
+		{"option not allowed", types.NewMsgVote(voter, 1, types.OptionNo), false},
+		{"other msg type", types.NewMsgDeposit(voter, 1, nil), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := authorization.Accept(ctx, tc.msg)
+			if tc.expAccept {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				require.False(t, resp.Delete)
+				require.Nil(t, resp.Updated)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// an authorization without restrictions accepts any vote
+	resp, err := types.NewVoteAuthorization(nil, nil).Accept(ctx, types.NewMsgVote(voter, 10, types.OptionNoWithVeto))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+}
+
+func TestVoteAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authorization *types.VoteAuthorization
+		expErr        bool
+	}{
+		{"valid", types.NewVoteAuthorization([]uint64{1, 2}, []types.VoteOption{types.OptionYes}), false},
+		{"no restrictions", types.NewVoteAuthorization(nil, nil), false},
+		{"duplicate proposal ID", types.NewVoteAuthorization([]uint64{1, 1}, nil), true},
+		{"duplicate option", types.NewVoteAuthorization(nil, []types.VoteOption{types.OptionYes, types.OptionYes}), true},
+		{"empty option", types.NewVoteAuthorization(nil, []types.VoteOption{types.OptionEmpty}), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -11,7 +11,7 @@ import (
 // NewDeposit creates a new Deposit instance
 //nolint:interfacer
 func NewDeposit(proposalID uint64, depositor sdk.AccAddress, amount sdk.Coins) Deposit {
-	return Deposit{proposalID, depositor.String(), amount}
+	return Deposit{ProposalId: proposalID, Depositor: depositor.String(), Amount: amount}
 }
 
 func (d Deposit) String() string {
@@ -14,4 +14,8 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 10, "metadata too long")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 11, "invalid proposer")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 12, "expected gov account as only signer for proposal message")
+	ErrUnroutableProposalMsg   = sdkerrors.Register(ModuleName, 13, "proposal message not recognized by router")
 )
@@ -24,6 +24,7 @@ type StakingKeeper interface {
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+	Delegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) stakingtypes.DelegationI
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
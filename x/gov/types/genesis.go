@@ -61,6 +61,51 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.IsNil() || expeditedThreshold.LTE(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold)
+	}
+
+	votingPeriod, expeditedVotingPeriod := data.VotingParams.VotingPeriod, data.VotingParams.ExpeditedVotingPeriod
+	if expeditedVotingPeriod <= 0 || expeditedVotingPeriod >= votingPeriod {
+		return fmt.Errorf("governance expedited voting period should be positive and shorter than the voting period %s, is %s",
+			votingPeriod, expeditedVotingPeriod)
+	}
+
+	typeURLs := make(map[string]bool, len(data.TallyParams.Overrides))
+	for _, override := range data.TallyParams.Overrides {
+		if err := validateTallyOverride(override); err != nil {
+			return fmt.Errorf("governance tally override is invalid: %w", err)
+		}
+		if typeURLs[override.TypeUrl] {
+			return fmt.Errorf("governance tally override for type %s is duplicated", override.TypeUrl)
+		}
+		typeURLs[override.TypeUrl] = true
+	}
+
+	cancelRatio := data.DepositParams.ProposalCancelRatio
+	if cancelRatio.IsNil() || cancelRatio.IsNegative() || cancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance proposal cancel ratio should be positive and less or equal to one, is %s",
+			cancelRatio)
+	}
+
+	for _, proposal := range data.Proposals {
+		if err := ValidateMetadata(proposal.Metadata); err != nil {
+			return err
+		}
+	}
+	for _, vote := range data.Votes {
+		if err := ValidateMetadata(vote.Metadata); err != nil {
+			return err
+		}
+	}
+	for _, deposit := range data.Deposits {
+		if err := ValidateMetadata(deposit.Metadata); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
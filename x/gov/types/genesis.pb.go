@@ -39,6 +39,12 @@ type GenesisState struct {
 	VotingParams VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	// tally_breakdowns defines the tally breakdowns of the tallied proposals
+	// present at genesis.
+	TallyBreakdowns []ValidatorTallyBreakdown `protobuf:"bytes,8,rep,name=tally_breakdowns,json=tallyBreakdowns,proto3" json:"tally_breakdowns" yaml:"tally_breakdowns"`
+	// delegator_overrides defines the delegator overrides of the tallied
+	// proposals present at genesis.
+	DelegatorOverrides []DelegatorOverride `protobuf:"bytes,9,rep,name=delegator_overrides,json=delegatorOverrides,proto3" json:"delegator_overrides" yaml:"delegator_overrides"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +129,20 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetTallyBreakdowns() []ValidatorTallyBreakdown {
+	if m != nil {
+		return m.TallyBreakdowns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorOverrides() []DelegatorOverride {
+	if m != nil {
+		return m.DelegatorOverrides
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1beta1.GenesisState")
 }
@@ -130,34 +150,40 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/genesis.proto", fileDescriptor_43cd825e0fa7a627) }
 
 var fileDescriptor_43cd825e0fa7a627 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x9a, 0x94, 0xf6, 0x92, 0x20, 0x38, 0x82, 0x64, 0x35, 0xc1, 0x36, 0x9e, 0xb2,
-	0x60, 0xab, 0x65, 0x43, 0x62, 0xb1, 0x90, 0x50, 0x07, 0xa4, 0x62, 0x10, 0x03, 0x4b, 0x74, 0x89,
-	0x4f, 0x87, 0x85, 0xdd, 0x67, 0xf9, 0x1d, 0x16, 0xf9, 0x16, 0x7c, 0x0e, 0x3e, 0x49, 0xc7, 0x8e,
-	0x4c, 0x01, 0x25, 0x13, 0x6b, 0x3f, 0x01, 0xf2, 0xdd, 0x19, 0x1c, 0x61, 0x3a, 0x25, 0x7e, 0xf7,
-	0xbf, 0xdf, 0xef, 0xdd, 0xd3, 0x23, 0xde, 0x0a, 0x30, 0x07, 0x0c, 0x05, 0x54, 0x61, 0x75, 0xba,
-	0xe4, 0x92, 0x9d, 0x86, 0x82, 0x5f, 0x72, 0x4c, 0x31, 0x28, 0x4a, 0x90, 0x40, 0xa9, 0x4e, 0x04,
-	0x02, 0xaa, 0xc0, 0x24, 0x4e, 0x26, 0x02, 0x04, 0xa8, 0xe3, 0xb0, 0xfe, 0xa7, 0x93, 0x27, 0xb3,
-	0x2e, 0x16, 0x54, 0xfa, 0xd4, 0xff, 0xd5, 0x27, 0xa3, 0x57, 0x9a, 0xfc, 0x56, 0x32, 0xc9, 0xe9,
-	0x1b, 0x32, 0x41, 0xc9, 0x4a, 0x99, 0x5e, 0x8a, 0x45, 0x51, 0x42, 0x01, 0xc8, 0xb2, 0x45, 0x9a,
-	0xd8, 0x96, 0x67, 0xcd, 0xfb, 0x91, 0x7b, 0xb3, 0x71, 0xa7, 0x6b, 0x96, 0x67, 0xcf, 0xfd, 0xae,
-	0x94, 0x1f, 0xd3, 0xa6, 0x7c, 0x61, 0xaa, 0xe7, 0x09, 0x3d, 0x27, 0x47, 0x09, 0x2f, 0x00, 0x53,
-	0x89, 0xf6, 0x1d, 0xef, 0x60, 0x3e, 0x3c, 0x9b, 0x06, 0xff, 0xb6, 0x1f, 0xbc, 0xd4, 0x99, 0xe8,
-	0xfe, 0xd5, 0xc6, 0xed, 0x7d, 0xfb, 0xe1, 0x1e, 0x99, 0x02, 0xc6, 0x7f, 0xae, 0xd3, 0x17, 0x64,
-	0x50, 0x81, 0xe4, 0x68, 0x1f, 0x28, 0x8e, 0xdd, 0xc5, 0x79, 0x0f, 0x92, 0x47, 0x63, 0x03, 0x19,
-	0xd4, 0x5f, 0x18, 0xeb, 0x5b, 0xf4, 0x35, 0x39, 0x6e, 0xba, 0x45, 0xbb, 0xaf, 0x10, 0xb3, 0x2e,
-	0x44, 0xd3, 0x7c, 0xf4, 0xc0, 0x60, 0x8e, 0x9b, 0x0a, 0xc6, 0x7f, 0x09, 0x54, 0x90, 0x7b, 0xa6,
-	0xb3, 0x45, 0xc1, 0x4a, 0x96, 0xa3, 0x3d, 0xf0, 0xac, 0xf9, 0xf0, 0xec, 0xc9, 0x2d, 0xcf, 0xbb,
-	0x50, 0xc1, 0xe8, 0x71, 0x0d, 0xbe, 0xd9, 0xb8, 0x8f, 0xf4, 0x30, 0xf7, 0x31, 0x7e, 0x3c, 0x4e,
-	0xda, 0x69, 0xba, 0x22, 0xe3, 0x0a, 0xf4, 0xb0, 0xb5, 0xe7, 0x50, 0x79, 0xbc, 0xff, 0x3c, 0xbf,
-	0x1e, 0xbf, 0xd6, 0xcc, 0x8c, 0x66, 0xa2, 0x35, 0x7b, 0x10, 0x3f, 0x1e, 0x55, 0xad, 0x2c, 0x5d,
-	0x90, 0x91, 0x64, 0x59, 0xb6, 0x6e, 0x1c, 0x77, 0x95, 0xc3, 0xed, 0x72, 0xbc, 0xab, 0x73, 0x46,
-	0x31, 0x35, 0x8a, 0x87, 0x5a, 0xd1, 0x46, 0xf8, 0xf1, 0x50, 0xb6, 0x92, 0xd1, 0xd5, 0xd6, 0xb1,
-	0xae, 0xb7, 0x8e, 0xf5, 0x73, 0xeb, 0x58, 0x5f, 0x77, 0x4e, 0xef, 0x7a, 0xe7, 0xf4, 0xbe, 0xef,
-	0x9c, 0xde, 0x87, 0xb9, 0x48, 0xe5, 0xc7, 0xcf, 0xcb, 0x60, 0x05, 0x79, 0x68, 0xd6, 0x55, 0xff,
-	0x3c, 0xc5, 0xe4, 0x53, 0xf8, 0x45, 0xed, 0xae, 0x5c, 0x17, 0x1c, 0x97, 0x87, 0x6a, 0x6d, 0x9f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x63, 0x67, 0xf6, 0x22, 0x03, 0x00, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xb6, 0x95, 0xd6, 0x6d, 0x61, 0x78, 0x45, 0x44, 0x6d, 0x49, 0x4a, 0x24, 0xa4,
+	0x4a, 0x88, 0x44, 0x1b, 0x37, 0x24, 0x2e, 0x16, 0x12, 0xda, 0x01, 0x31, 0x02, 0xe2, 0xc0, 0xa5,
+	0x72, 0x6b, 0x2b, 0x44, 0x4b, 0xeb, 0x28, 0x9f, 0xc9, 0x28, 0x4f, 0xc1, 0x73, 0xf0, 0x24, 0x3b,
+	0xee, 0xc8, 0xa9, 0xa0, 0xf6, 0x0d, 0xf6, 0x04, 0x28, 0xb6, 0x43, 0x5b, 0x16, 0x38, 0xb5, 0xf9,
+	0xfc, 0xff, 0x7e, 0xbf, 0xcf, 0xb6, 0x8c, 0x86, 0x53, 0x01, 0x33, 0x01, 0x41, 0x24, 0xf2, 0x20,
+	0x3f, 0x9e, 0x70, 0x49, 0x8f, 0x83, 0x88, 0xcf, 0x39, 0xc4, 0xe0, 0xa7, 0x99, 0x90, 0x02, 0x63,
+	0x9d, 0xf0, 0x23, 0x91, 0xfb, 0x26, 0xd1, 0xeb, 0x46, 0x22, 0x12, 0x6a, 0x39, 0x28, 0xfe, 0xe9,
+	0x64, 0x6f, 0x50, 0xc5, 0x12, 0xb9, 0x5e, 0xf5, 0x96, 0x75, 0xd4, 0x7e, 0xa5, 0xc9, 0xef, 0x24,
+	0x95, 0x1c, 0xbf, 0x45, 0x5d, 0x90, 0x34, 0x93, 0xf1, 0x3c, 0x1a, 0xa7, 0x99, 0x48, 0x05, 0xd0,
+	0x64, 0x1c, 0x33, 0xdb, 0x1a, 0x5a, 0xa3, 0x7d, 0xe2, 0x5e, 0x2f, 0xdd, 0xfe, 0x82, 0xce, 0x92,
+	0xe7, 0x5e, 0x55, 0xca, 0x0b, 0x71, 0x59, 0x3e, 0x33, 0xd5, 0x53, 0x86, 0x4f, 0x51, 0x83, 0xf1,
+	0x54, 0x40, 0x2c, 0xc1, 0xbe, 0x35, 0xdc, 0x1b, 0xb5, 0x4e, 0xfa, 0xfe, 0xcd, 0xf1, 0xfd, 0x97,
+	0x3a, 0x43, 0x0e, 0x2f, 0x97, 0x6e, 0xed, 0xfb, 0x4f, 0xb7, 0x61, 0x0a, 0x10, 0xfe, 0x69, 0xc7,
+	0x2f, 0xd0, 0x41, 0x2e, 0x24, 0x07, 0x7b, 0x4f, 0x71, 0xec, 0x2a, 0xce, 0x07, 0x21, 0x39, 0xe9,
+	0x18, 0xc8, 0x41, 0xf1, 0x05, 0xa1, 0xee, 0xc2, 0xaf, 0x51, 0xb3, 0x9c, 0x16, 0xec, 0x7d, 0x85,
+	0x18, 0x54, 0x21, 0xca, 0xe1, 0xc9, 0x3d, 0x83, 0x69, 0x96, 0x15, 0x08, 0x37, 0x04, 0x1c, 0xa1,
+	0x3b, 0x66, 0xb2, 0x71, 0x4a, 0x33, 0x3a, 0x03, 0xfb, 0x60, 0x68, 0x8d, 0x5a, 0x27, 0x8f, 0xfe,
+	0xb3, 0xbd, 0x33, 0x15, 0x24, 0x0f, 0x0b, 0xf0, 0xf5, 0xd2, 0xbd, 0xaf, 0x0f, 0x73, 0x17, 0xe3,
+	0x85, 0x1d, 0xb6, 0x9d, 0xc6, 0x53, 0xd4, 0xc9, 0x85, 0x3e, 0x6c, 0xed, 0xa9, 0x2b, 0xcf, 0xf0,
+	0x1f, 0xdb, 0x2f, 0x8e, 0x5f, 0x6b, 0x06, 0x46, 0xd3, 0xd5, 0x9a, 0x1d, 0x88, 0x17, 0xb6, 0xf3,
+	0xad, 0x2c, 0x1e, 0xa3, 0xb6, 0xa4, 0x49, 0xb2, 0x28, 0x1d, 0xb7, 0x95, 0xc3, 0xad, 0x72, 0xbc,
+	0x2f, 0x72, 0x46, 0xd1, 0x37, 0x8a, 0x23, 0xad, 0xd8, 0x46, 0x78, 0x61, 0x4b, 0x6e, 0x92, 0xf8,
+	0x02, 0x1d, 0xea, 0xd5, 0x49, 0xc6, 0xe9, 0x39, 0x13, 0x17, 0x73, 0xb0, 0x1b, 0xea, 0x12, 0x9e,
+	0x54, 0x6e, 0x84, 0x26, 0x31, 0xa3, 0x52, 0x64, 0xca, 0x46, 0xca, 0x1e, 0xe2, 0x1a, 0xe1, 0x83,
+	0x6d, 0xe1, 0x06, 0xe9, 0x85, 0x77, 0xe5, 0x4e, 0x03, 0xe0, 0xaf, 0xe8, 0x88, 0xf1, 0x84, 0x47,
+	0x05, 0x6c, 0x2c, 0x72, 0x9e, 0x65, 0x31, 0xe3, 0x60, 0x37, 0x95, 0xfb, 0x71, 0xf5, 0x65, 0x99,
+	0xf8, 0x1b, 0x93, 0x26, 0x9e, 0xb1, 0xf6, 0xca, 0x0b, 0xbb, 0xc1, 0xf3, 0x42, 0xcc, 0xfe, 0x6e,
+	0x03, 0x42, 0x2e, 0x57, 0x8e, 0x75, 0xb5, 0x72, 0xac, 0x5f, 0x2b, 0xc7, 0xfa, 0xb6, 0x76, 0x6a,
+	0x57, 0x6b, 0xa7, 0xf6, 0x63, 0xed, 0xd4, 0x3e, 0x8e, 0xa2, 0x58, 0x7e, 0xfa, 0x3c, 0xf1, 0xa7,
+	0x62, 0x16, 0x98, 0x37, 0xaa, 0x7f, 0x9e, 0x02, 0x3b, 0x0f, 0xbe, 0xa8, 0x07, 0x2b, 0x17, 0x29,
+	0x87, 0x49, 0x5d, 0xbd, 0xd5, 0x67, 0xbf, 0x07, 0x00, 0x3d, 0x6c, 0xb2, 0xfc, 0x17, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -180,6 +206,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorOverrides) > 0 {
+		for iNdEx := len(m.DelegatorOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TallyBreakdowns) > 0 {
+		for iNdEx := len(m.TallyBreakdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyBreakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -304,6 +358,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TallyBreakdowns) > 0 {
+		for _, e := range m.TallyBreakdowns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorOverrides) > 0 {
+		for _, e := range m.DelegatorOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -562,6 +628,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyBreakdowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyBreakdowns = append(m.TallyBreakdowns, ValidatorTallyBreakdown{})
+			if err := m.TallyBreakdowns[len(m.TallyBreakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorOverrides = append(m.DelegatorOverrides, DelegatorOverride{})
+			if err := m.DelegatorOverrides[len(m.DelegatorOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
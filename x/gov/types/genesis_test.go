@@ -1,9 +1,12 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +23,133 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*GenesisState)
+		expPass  bool
+	}{
+		{"default genesis", func(*GenesisState) {}, true},
+		{
+			"expedited threshold not greater than threshold",
+			func(gs *GenesisState) { gs.TallyParams.ExpeditedThreshold = gs.TallyParams.Threshold },
+			false,
+		},
+		{
+			"expedited threshold greater than one",
+			func(gs *GenesisState) { gs.TallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(11, 1) },
+			false,
+		},
+		{
+			"expedited voting period not shorter than voting period",
+			func(gs *GenesisState) { gs.VotingParams.ExpeditedVotingPeriod = gs.VotingParams.VotingPeriod },
+			false,
+		},
+		{
+			"zero expedited voting period",
+			func(gs *GenesisState) { gs.VotingParams.ExpeditedVotingPeriod = 0 },
+			false,
+		},
+		{
+			"zero proposal cancel ratio",
+			func(gs *GenesisState) { gs.DepositParams.ProposalCancelRatio = sdk.ZeroDec() },
+			true,
+		},
+		{
+			"negative proposal cancel ratio",
+			func(gs *GenesisState) { gs.DepositParams.ProposalCancelRatio = sdk.NewDec(-1) },
+			false,
+		},
+		{
+			"proposal cancel ratio greater than one",
+			func(gs *GenesisState) { gs.DepositParams.ProposalCancelRatio = sdk.NewDecWithPrec(11, 1) },
+			false,
+		},
+		{
+			"valid tally override",
+			func(gs *GenesisState) {
+				gs.TallyParams.Overrides = []TallyOverride{
+					NewTallyOverride("/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal", sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(667, 3), sdk.NewDecWithPrec(334, 3)),
+				}
+			},
+			true,
+		},
+		{
+			"tally override without type URL",
+			func(gs *GenesisState) {
+				gs.TallyParams.Overrides = []TallyOverride{
+					NewTallyOverride("", sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(667, 3), sdk.NewDecWithPrec(334, 3)),
+				}
+			},
+			false,
+		},
+		{
+			"tally override threshold greater than one",
+			func(gs *GenesisState) {
+				gs.TallyParams.Overrides = []TallyOverride{
+					NewTallyOverride("/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal", sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(11, 1), sdk.NewDecWithPrec(334, 3)),
+				}
+			},
+			false,
+		},
+		{
+			"tally override without veto threshold",
+			func(gs *GenesisState) {
+				gs.TallyParams.Overrides = []TallyOverride{
+					{TypeUrl: "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal", Quorum: sdk.NewDecWithPrec(5, 1), Threshold: sdk.NewDecWithPrec(667, 3)},
+				}
+			},
+			false,
+		},
+		{
+			"duplicate tally overrides",
+			func(gs *GenesisState) {
+				override := NewTallyOverride("/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal", sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(667, 3), sdk.NewDecWithPrec(334, 3))
+				gs.TallyParams.Overrides = []TallyOverride{override, override}
+			},
+			false,
+		},
+		{
+			"proposal metadata too long",
+			func(gs *GenesisState) {
+				gs.Proposals = Proposals{{ProposalId: 1, Metadata: strings.Repeat("a", MaxMetadataLength+1)}}
+			},
+			false,
+		},
+		{
+			"vote metadata too long",
+			func(gs *GenesisState) {
+				gs.Votes = Votes{{ProposalId: 1, Metadata: strings.Repeat("a", MaxMetadataLength+1)}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genState := DefaultGenesisState()
+			tc.malleate(genState)
+
+			err := ValidateGenesis(genState)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+
+			// the params validation is consistent with the genesis validation
+			paramsErr := validateDepositParams(genState.DepositParams)
+			if paramsErr == nil {
+				paramsErr = validateVotingParams(genState.VotingParams)
+			}
+			if paramsErr == nil {
+				paramsErr = validateTallyParams(genState.TallyParams)
+			}
+			if len(genState.Proposals) == 0 && len(genState.Votes) == 0 {
+				require.Equal(t, tc.expPass, paramsErr == nil, paramsErr)
+			}
+		})
+	}
+}
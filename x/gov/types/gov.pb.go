@@ -4,10 +4,11 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -200,18 +201,64 @@ func (m *TextProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TextProposal proto.InternalMessageInfo
 
+// ExecutionProposal defines a proposal executing a list of messages, signed by
+// the governance module account, through the message service router once it
+// passes.
+type ExecutionProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// messages are the messages to execute, in order, whose only signer must be
+	// the governance module account.
+	Messages []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ExecutionProposal) Reset()      { *m = ExecutionProposal{} }
+func (*ExecutionProposal) ProtoMessage() {}
+func (*ExecutionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+}
+func (m *ExecutionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionProposal.Merge(m, src)
+}
+func (m *ExecutionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionProposal proto.InternalMessageInfo
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
 	ProposalId uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Depositor  string                                   `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// metadata is any arbitrary metadata attached to the latest deposit of the
+	// depositor.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +290,7 @@ var xxx_messageInfo_Deposit proto.InternalMessageInfo
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	ProposalId       uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"id" yaml:"id"`
-	Content          *types1.Any                              `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Content          *types.Any                               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Status           ProposalStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1beta1.ProposalStatus" json:"status,omitempty" yaml:"proposal_status"`
 	FinalTallyResult TallyResult                              `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result" yaml:"final_tally_result"`
 	SubmitTime       time.Time                                `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
@@ -251,12 +298,23 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// proposer is the address of the proposal submitter.
+	Proposer string `protobuf:"bytes,10,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expedited defines if the proposal is expedited, with a shorter voting
+	// period and a higher threshold.
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal, e.g. an IPFS
+	// link.
+	Metadata string `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// msg_results are the encoded responses of the messages of a passed
+	// ExecutionProposal, in the order of its messages.
+	MsgResults [][]byte `protobuf:"bytes,13,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty" yaml:"msg_results"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +354,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,12 +394,14 @@ type Vote struct {
 	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
 	// Since: cosmos-sdk 0.43
 	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,6 +430,143 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// ValidatorRunningTally defines the running tally of the votes cast on a
+// proposal in voting period by the delegators of a validator, in delegator
+// shares of the validator.
+type ValidatorRunningTally struct {
+	ValidatorAddress string                                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	YesShares        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=yes_shares,json=yesShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yes_shares" yaml:"yes_shares"`
+	AbstainShares    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=abstain_shares,json=abstainShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"abstain_shares" yaml:"abstain_shares"`
+	NoShares         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=no_shares,json=noShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_shares" yaml:"no_shares"`
+	NoWithVetoShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=no_with_veto_shares,json=noWithVetoShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_with_veto_shares" yaml:"no_with_veto_shares"`
+	// deducted_shares are the shares of the voting delegators, deducted from the
+	// voting power of the vote of the validator.
+	DeductedShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=deducted_shares,json=deductedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deducted_shares" yaml:"deducted_shares"`
+}
+
+func (m *ValidatorRunningTally) Reset()      { *m = ValidatorRunningTally{} }
+func (*ValidatorRunningTally) ProtoMessage() {}
+func (*ValidatorRunningTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+}
+func (m *ValidatorRunningTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRunningTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRunningTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRunningTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRunningTally.Merge(m, src)
+}
+func (m *ValidatorRunningTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRunningTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRunningTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRunningTally proto.InternalMessageInfo
+
+// ValidatorTallyBreakdown defines the part of the final tally of a proposal
+// carried by the vote of a validator. The delegators of the validator who
+// overrode its vote with their own are stored separately, as DelegatorOverride.
+type ValidatorTallyBreakdown struct {
+	ProposalId       uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// options are the vote options of the validator, empty if it did not vote.
+	Options []WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+	// voting_power is the voting power carried by the vote of the validator,
+	// after the deduction of the voting power of the delegators who voted.
+	VotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power" yaml:"voting_power"`
+	// deducted_voting_power is the voting power of the delegators who voted,
+	// deducted from the vote of the validator.
+	DeductedVotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=deducted_voting_power,json=deductedVotingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deducted_voting_power" yaml:"deducted_voting_power"`
+}
+
+func (m *ValidatorTallyBreakdown) Reset()      { *m = ValidatorTallyBreakdown{} }
+func (*ValidatorTallyBreakdown) ProtoMessage() {}
+func (*ValidatorTallyBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
+}
+func (m *ValidatorTallyBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorTallyBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorTallyBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorTallyBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTallyBreakdown.Merge(m, src)
+}
+func (m *ValidatorTallyBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorTallyBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTallyBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorTallyBreakdown proto.InternalMessageInfo
+
+// DelegatorOverride defines the vote of a delegator overriding the one of its
+// validator for the voting power of its delegation, in the final tally of a
+// proposal.
+type DelegatorOverride struct {
+	ProposalId       uint64                                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	ValidatorAddress string                                 `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	DelegatorAddress string                                 `protobuf:"bytes,3,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Options          []WeightedVoteOption                   `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+	VotingPower      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power" yaml:"voting_power"`
+}
+
+func (m *DelegatorOverride) Reset()      { *m = DelegatorOverride{} }
+func (*DelegatorOverride) ProtoMessage() {}
+func (*DelegatorOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
+}
+func (m *DelegatorOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorOverride.Merge(m, src)
+}
+func (m *DelegatorOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorOverride proto.InternalMessageInfo
+
 // DepositParams defines the params for deposits on governance proposals.
 type DepositParams struct {
 	//  Minimum deposit for a proposal to enter voting period.
@@ -377,12 +574,15 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	//  Proportion of the deposits burned when a proposal is canceled, the rest
+	//  being refunded. Default value: 0.5.
+	ProposalCancelRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proposal_cancel_ratio,omitempty" yaml:"proposal_cancel_ratio"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{10}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,12 +615,15 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Length of the voting period of expedited proposals, shorter than the
+	//  voting period.
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{11}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,12 +662,18 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty" yaml:"veto_threshold"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass,
+	//  higher than the threshold. Default value: 0.667.
+	ExpeditedThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_threshold,omitempty" yaml:"expedited_threshold"`
+	//  Quorum, threshold and veto threshold overriding the above ones for the
+	//  proposals of given content or message types.
+	Overrides []TallyOverride `protobuf:"bytes,5,rep,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{12}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,115 +702,206 @@ func (m *TallyParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 
+// TallyOverride defines the tally params overriding the default ones for the
+// proposals of a given type.
+type TallyOverride struct {
+	//  Type URL of the proposal content, or of one of the messages of an
+	//  execution proposal, the override applies to.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty" yaml:"veto_threshold"`
+}
+
+func (m *TallyOverride) Reset()      { *m = TallyOverride{} }
+func (*TallyOverride) ProtoMessage() {}
+func (*TallyOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{13}
+}
+func (m *TallyOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyOverride.Merge(m, src)
+}
+func (m *TallyOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyOverride proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
+	proto.RegisterType((*ExecutionProposal)(nil), "cosmos.gov.v1beta1.ExecutionProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1beta1.Vote")
+	proto.RegisterType((*ValidatorRunningTally)(nil), "cosmos.gov.v1beta1.ValidatorRunningTally")
+	proto.RegisterType((*ValidatorTallyBreakdown)(nil), "cosmos.gov.v1beta1.ValidatorTallyBreakdown")
+	proto.RegisterType((*DelegatorOverride)(nil), "cosmos.gov.v1beta1.DelegatorOverride")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1beta1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1beta1.TallyParams")
+	proto.RegisterType((*TallyOverride)(nil), "cosmos.gov.v1beta1.TallyOverride")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x51, 0x68, 0xdb, 0x56,
-	0x17, 0xb6, 0x6c, 0xc7, 0x89, 0xaf, 0x9d, 0x44, 0xbd, 0x49, 0x13, 0xc7, 0x7f, 0x7f, 0xc9, 0xbf,
-	0xfe, 0x9f, 0x12, 0x4a, 0xeb, 0xb4, 0xf9, 0xc7, 0xc6, 0x52, 0xd8, 0x66, 0xc5, 0xca, 0xea, 0x51,
-	0x6c, 0x23, 0xab, 0x0e, 0xed, 0x1e, 0x84, 0x62, 0xdf, 0x3a, 0xda, 0x2c, 0x5d, 0xcf, 0xba, 0x4e,
-	0x13, 0xf6, 0xb2, 0xc7, 0xe2, 0xc1, 0xe8, 0x63, 0x61, 0x18, 0x0a, 0x63, 0x2f, 0x7b, 0xde, 0xf3,
-	0x9e, 0xc3, 0x18, 0xac, 0xec, 0xa9, 0x6c, 0xe0, 0xae, 0x09, 0x8c, 0x92, 0xc7, 0x3c, 0xec, 0x79,
-	0x48, 0xf7, 0x2a, 0x96, 0xed, 0xb0, 0xd4, 0x7b, 0x8a, 0x74, 0xee, 0xf9, 0xbe, 0x73, 0xce, 0xe7,
-	0x73, 0xce, 0x55, 0xc0, 0x95, 0x1a, 0x76, 0x2c, 0xec, 0xac, 0x35, 0xf0, 0xde, 0xda, 0xde, 0xad,
-	0x1d, 0x44, 0x8c, 0x5b, 0xee, 0x73, 0xb6, 0xd5, 0xc6, 0x04, 0x43, 0x48, 0x4f, 0xb3, 0xae, 0x85,
-	0x9d, 0xa6, 0x05, 0x86, 0xd8, 0x31, 0x1c, 0x74, 0x06, 0xa9, 0x61, 0xd3, 0xa6, 0x98, 0xf4, 0x62,
-	0x03, 0x37, 0xb0, 0xf7, 0xb8, 0xe6, 0x3e, 0x31, 0xeb, 0x0a, 0x45, 0xe9, 0xf4, 0x80, 0xd1, 0xd2,
-	0x23, 0xb1, 0x81, 0x71, 0xa3, 0x89, 0xd6, 0xbc, 0xb7, 0x9d, 0xce, 0xc3, 0x35, 0x62, 0x5a, 0xc8,
-	0x21, 0x86, 0xd5, 0xf2, 0xb1, 0xa3, 0x0e, 0x86, 0x7d, 0xc0, 0x8e, 0x84, 0xd1, 0xa3, 0x7a, 0xa7,
-	0x6d, 0x10, 0x13, 0xb3, 0x64, 0xa4, 0x6f, 0x39, 0x00, 0xb7, 0x91, 0xd9, 0xd8, 0x25, 0xa8, 0x5e,
-	0xc5, 0x04, 0x95, 0x5a, 0xee, 0x21, 0x7c, 0x1b, 0xc4, 0xb0, 0xf7, 0x94, 0xe2, 0x32, 0xdc, 0xea,
-	0xdc, 0xba, 0x90, 0x1d, 0x2f, 0x34, 0x3b, 0xf0, 0x57, 0x99, 0x37, 0xdc, 0x06, 0xb1, 0x47, 0x1e,
-	0x5b, 0x2a, 0x9c, 0xe1, 0x56, 0xe3, 0xf2, 0xfb, 0x87, 0x7d, 0x31, 0xf4, 0x6b, 0x5f, 0xbc, 0xda,
-	0x30, 0xc9, 0x6e, 0x67, 0x27, 0x5b, 0xc3, 0x16, 0xab, 0x8d, 0xfd, 0xb9, 0xe1, 0xd4, 0x3f, 0x5d,
-	0x23, 0x07, 0x2d, 0xe4, 0x64, 0xf3, 0xa8, 0x76, 0xda, 0x17, 0x67, 0x0f, 0x0c, 0xab, 0xb9, 0x21,
-	0x51, 0x16, 0x49, 0x65, 0x74, 0xd2, 0x36, 0x48, 0x6a, 0x68, 0x9f, 0x94, 0xdb, 0xb8, 0x85, 0x1d,
-	0xa3, 0x09, 0x17, 0xc1, 0x14, 0x31, 0x49, 0x13, 0x79, 0xf9, 0xc5, 0x55, 0xfa, 0x02, 0x33, 0x20,
-	0x51, 0x47, 0x4e, 0xad, 0x6d, 0xd2, 0xdc, 0xbd, 0x1c, 0xd4, 0xa0, 0x69, 0x63, 0xfe, 0xf5, 0x33,
-	0x91, 0xfb, 0xe5, 0xfb, 0x1b, 0xd3, 0x9b, 0xd8, 0x26, 0xc8, 0x26, 0xd2, 0xcf, 0x1c, 0x98, 0xce,
-	0xa3, 0x16, 0x76, 0x4c, 0x02, 0xdf, 0x01, 0x89, 0x16, 0x0b, 0xa0, 0x9b, 0x75, 0x8f, 0x3a, 0x2a,
-	0x2f, 0x9d, 0xf6, 0x45, 0x48, 0x93, 0x0a, 0x1c, 0x4a, 0x2a, 0xf0, 0xdf, 0x0a, 0x75, 0x78, 0x05,
-	0xc4, 0xeb, 0x94, 0x03, 0xb7, 0x59, 0xd4, 0x81, 0x01, 0xd6, 0x40, 0xcc, 0xb0, 0x70, 0xc7, 0x26,
-	0xa9, 0x48, 0x26, 0xb2, 0x9a, 0x58, 0x5f, 0xf1, 0xc5, 0x74, 0x3b, 0xe4, 0x4c, 0xcd, 0x4d, 0x6c,
-	0xda, 0xf2, 0x4d, 0x57, 0xaf, 0xef, 0x5e, 0x8a, 0xab, 0x6f, 0xa0, 0x97, 0x0b, 0x70, 0x54, 0x46,
-	0xbd, 0x31, 0xf3, 0xf8, 0x99, 0x18, 0x7a, 0xfd, 0x4c, 0x0c, 0x49, 0x7f, 0xc6, 0xc0, 0xcc, 0x99,
-	0x4e, 0x6f, 0x9d, 0x57, 0xd2, 0xc2, 0x49, 0x5f, 0x0c, 0x9b, 0xf5, 0xd3, 0xbe, 0x18, 0xa7, 0x85,
-	0x8d, 0xd6, 0x73, 0x1b, 0x4c, 0xd7, 0xa8, 0x3e, 0x5e, 0x35, 0x89, 0xf5, 0xc5, 0x2c, 0xed, 0xa3,
-	0xac, 0xdf, 0x47, 0xd9, 0x9c, 0x7d, 0x20, 0x27, 0x7e, 0x1c, 0x08, 0xa9, 0xfa, 0x08, 0x58, 0x05,
-	0x31, 0x87, 0x18, 0xa4, 0xe3, 0xa4, 0x22, 0x5e, 0xef, 0x48, 0xe7, 0xf5, 0x8e, 0x9f, 0x60, 0xc5,
-	0xf3, 0x94, 0xd3, 0xa7, 0x7d, 0x71, 0x69, 0x44, 0x64, 0x4a, 0x22, 0xa9, 0x8c, 0x0d, 0xb6, 0x00,
-	0x7c, 0x68, 0xda, 0x46, 0x53, 0x27, 0x46, 0xb3, 0x79, 0xa0, 0xb7, 0x91, 0xd3, 0x69, 0x92, 0x54,
-	0xd4, 0xcb, 0x4f, 0x3c, 0x2f, 0x86, 0xe6, 0xfa, 0xa9, 0x9e, 0x9b, 0xfc, 0x1f, 0x57, 0xd8, 0xd3,
-	0xbe, 0xb8, 0x42, 0x83, 0x8c, 0x13, 0x49, 0x2a, 0xef, 0x19, 0x03, 0x20, 0xf8, 0x31, 0x48, 0x38,
-	0x9d, 0x1d, 0xcb, 0x24, 0xba, 0x3b, 0x71, 0xa9, 0x29, 0x2f, 0x54, 0x7a, 0x4c, 0x0a, 0xcd, 0x1f,
-	0x47, 0x59, 0x60, 0x51, 0x58, 0xbf, 0x04, 0xc0, 0xd2, 0x93, 0x97, 0x22, 0xa7, 0x02, 0x6a, 0x71,
-	0x01, 0xd0, 0x04, 0x3c, 0x6b, 0x11, 0x1d, 0xd9, 0x75, 0x1a, 0x21, 0x76, 0x61, 0x84, 0xff, 0xb2,
-	0x08, 0xcb, 0x34, 0xc2, 0x28, 0x03, 0x0d, 0x33, 0xc7, 0xcc, 0x8a, 0x5d, 0xf7, 0x42, 0x3d, 0xe6,
-	0xc0, 0x2c, 0xc1, 0xc4, 0x68, 0xea, 0xec, 0x20, 0x35, 0x7d, 0x51, 0x23, 0xde, 0x61, 0x71, 0x16,
-	0x69, 0x9c, 0x21, 0xb4, 0x34, 0x51, 0x83, 0x26, 0x3d, 0xac, 0x3f, 0x62, 0x4d, 0x70, 0x69, 0x0f,
-	0x13, 0xd3, 0x6e, 0xb8, 0x3f, 0x6f, 0x9b, 0x09, 0x3b, 0x73, 0x61, 0xd9, 0xff, 0x63, 0xe9, 0xa4,
-	0x68, 0x3a, 0x63, 0x14, 0xb4, 0xee, 0x79, 0x6a, 0xaf, 0xb8, 0x66, 0xaf, 0xf0, 0x87, 0x80, 0x99,
-	0x06, 0x12, 0xc7, 0x2f, 0x8c, 0x25, 0xb1, 0x58, 0x4b, 0x43, 0xb1, 0x86, 0x15, 0x9e, 0xa5, 0x56,
-	0x26, 0xf0, 0x46, 0xd4, 0xdd, 0x2a, 0xd2, 0x61, 0x18, 0x24, 0x82, 0xed, 0xf3, 0x01, 0x88, 0x1c,
-	0x20, 0x87, 0x6e, 0x28, 0x39, 0x3b, 0xc1, 0x26, 0x2c, 0xd8, 0x44, 0x75, 0xa1, 0xf0, 0x0e, 0x98,
-	0x36, 0x76, 0x1c, 0x62, 0x98, 0x6c, 0x97, 0x4d, 0xcc, 0xe2, 0xc3, 0xe1, 0x7b, 0x20, 0x6c, 0x63,
-	0x6f, 0x20, 0x27, 0x27, 0x09, 0xdb, 0x18, 0x36, 0x40, 0xd2, 0xc6, 0xfa, 0x23, 0x93, 0xec, 0xea,
-	0x7b, 0x88, 0x60, 0x6f, 0xec, 0xe2, 0xb2, 0x32, 0x19, 0xd3, 0x69, 0x5f, 0x5c, 0xa0, 0xa2, 0x06,
-	0xb9, 0x24, 0x15, 0xd8, 0x78, 0xdb, 0x24, 0xbb, 0x55, 0x44, 0x30, 0x93, 0xf2, 0x98, 0x03, 0x51,
-	0xf7, 0x7a, 0xf9, 0xe7, 0x2b, 0x79, 0x11, 0x4c, 0xed, 0x61, 0x82, 0xfc, 0x75, 0x4c, 0x5f, 0xe0,
-	0xc6, 0xd9, 0xbd, 0x16, 0x79, 0x93, 0x7b, 0x4d, 0x0e, 0xa7, 0xb8, 0xb3, 0xbb, 0x6d, 0x0b, 0x4c,
-	0xd3, 0x27, 0x27, 0x15, 0xf5, 0xc6, 0xe7, 0xea, 0x79, 0xe0, 0xf1, 0xcb, 0x54, 0x8e, 0xba, 0x2a,
-	0xa9, 0x3e, 0x78, 0x63, 0xe6, 0xa9, 0xbf, 0xa9, 0x7f, 0x08, 0x83, 0x59, 0x36, 0x18, 0x65, 0xa3,
-	0x6d, 0x58, 0x0e, 0xfc, 0x9a, 0x03, 0x09, 0xcb, 0xb4, 0xcf, 0xe6, 0x94, 0xbb, 0x68, 0x4e, 0x75,
-	0x97, 0xfb, 0xa4, 0x2f, 0x5e, 0x0e, 0xa0, 0xae, 0x63, 0xcb, 0x24, 0xc8, 0x6a, 0x91, 0x83, 0x81,
-	0x4e, 0x81, 0xe3, 0xc9, 0xc6, 0x17, 0x58, 0xa6, 0xed, 0x0f, 0xef, 0x57, 0x1c, 0x80, 0x96, 0xb1,
-	0xef, 0x13, 0xe9, 0x2d, 0xd4, 0x36, 0x71, 0x9d, 0x5d, 0x11, 0x2b, 0x63, 0x23, 0x95, 0x67, 0x9f,
-	0x1a, 0xb4, 0x4d, 0x4e, 0xfa, 0xe2, 0x95, 0x71, 0xf0, 0x50, 0xae, 0x6c, 0x39, 0x8f, 0x7b, 0x49,
-	0x4f, 0xdd, 0xa1, 0xe3, 0x2d, 0x63, 0xdf, 0x97, 0x8b, 0x9a, 0xbf, 0xe4, 0x40, 0xb2, 0xea, 0x4d,
-	0x22, 0xd3, 0xef, 0x73, 0xc0, 0x26, 0xd3, 0xcf, 0x8d, 0xbb, 0x28, 0xb7, 0xdb, 0x2c, 0xb7, 0xe5,
-	0x21, 0xdc, 0x50, 0x5a, 0x8b, 0x43, 0x8b, 0x20, 0x98, 0x51, 0x92, 0xda, 0x58, 0x36, 0xbf, 0xf9,
-	0xf3, 0xcf, 0x92, 0x79, 0x00, 0x62, 0x9f, 0x75, 0x70, 0xbb, 0x63, 0x79, 0x59, 0x24, 0x65, 0x79,
-	0xb2, 0x8f, 0xa1, 0x93, 0xbe, 0xc8, 0x53, 0xfc, 0x20, 0x1b, 0x95, 0x31, 0xc2, 0x1a, 0x88, 0x93,
-	0xdd, 0x36, 0x72, 0x76, 0x71, 0x93, 0xfe, 0x00, 0xc9, 0x89, 0x86, 0x91, 0xd2, 0x2f, 0x9c, 0x51,
-	0x04, 0x22, 0x0c, 0x78, 0x61, 0x97, 0x03, 0x73, 0xee, 0x84, 0xea, 0x83, 0x50, 0x11, 0x2f, 0x54,
-	0x6d, 0xe2, 0x50, 0xa9, 0x61, 0x9e, 0x21, 0x7d, 0x2f, 0x33, 0x7d, 0x87, 0x3c, 0x24, 0x75, 0xd6,
-	0x35, 0x68, 0xfe, 0xfb, 0xb5, 0x3f, 0x38, 0x00, 0x02, 0x5f, 0xa8, 0xd7, 0xc1, 0x72, 0xb5, 0xa4,
-	0x29, 0x7a, 0xa9, 0xac, 0x15, 0x4a, 0x45, 0xfd, 0x5e, 0xb1, 0x52, 0x56, 0x36, 0x0b, 0x5b, 0x05,
-	0x25, 0xcf, 0x87, 0xd2, 0xf3, 0xdd, 0x5e, 0x26, 0x41, 0x1d, 0x15, 0x37, 0x08, 0x94, 0xc0, 0x7c,
-	0xd0, 0xfb, 0xbe, 0x52, 0xe1, 0xb9, 0xf4, 0x6c, 0xb7, 0x97, 0x89, 0x53, 0xaf, 0xfb, 0xc8, 0x81,
-	0xd7, 0xc0, 0x42, 0xd0, 0x27, 0x27, 0x57, 0xb4, 0x5c, 0xa1, 0xc8, 0x87, 0xd3, 0x97, 0xba, 0xbd,
-	0xcc, 0x2c, 0xf5, 0xcb, 0xb1, 0x75, 0x9a, 0x01, 0x73, 0x41, 0xdf, 0x62, 0x89, 0x8f, 0xa4, 0x93,
-	0xdd, 0x5e, 0x66, 0x86, 0xba, 0x15, 0x31, 0x5c, 0x07, 0xa9, 0x61, 0x0f, 0x7d, 0xbb, 0xa0, 0xdd,
-	0xd1, 0xab, 0x8a, 0x56, 0xe2, 0xa3, 0xe9, 0xc5, 0x6e, 0x2f, 0xc3, 0xfb, 0xbe, 0xfe, 0xee, 0x4b,
-	0x47, 0x1f, 0x7f, 0x23, 0x84, 0xae, 0xfd, 0x14, 0x06, 0x73, 0xc3, 0x9f, 0x47, 0x30, 0x0b, 0xfe,
-	0x55, 0x56, 0x4b, 0xe5, 0x52, 0x25, 0x77, 0x57, 0xaf, 0x68, 0x39, 0xed, 0x5e, 0x65, 0xa4, 0x60,
-	0xaf, 0x14, 0xea, 0x5c, 0x34, 0x9b, 0xf0, 0x36, 0x10, 0x46, 0xfd, 0xf3, 0x4a, 0xb9, 0x54, 0x29,
-	0x68, 0x7a, 0x59, 0x51, 0x0b, 0xa5, 0x3c, 0xcf, 0xa5, 0x97, 0xbb, 0xbd, 0xcc, 0x02, 0x85, 0x0c,
-	0x0d, 0x15, 0x7c, 0x17, 0xfc, 0x7b, 0x14, 0x5c, 0x2d, 0x69, 0x85, 0xe2, 0x87, 0x3e, 0x36, 0x9c,
-	0x5e, 0xea, 0xf6, 0x32, 0x90, 0x62, 0xab, 0x81, 0x09, 0x80, 0xd7, 0xc1, 0xd2, 0x28, 0xb4, 0x9c,
-	0xab, 0x54, 0x94, 0x3c, 0x1f, 0x49, 0xf3, 0xdd, 0x5e, 0x26, 0x49, 0x31, 0x65, 0xc3, 0x71, 0x50,
-	0x1d, 0xde, 0x04, 0xa9, 0x51, 0x6f, 0x55, 0xf9, 0x48, 0xd9, 0xd4, 0x94, 0x3c, 0x1f, 0x4d, 0xc3,
-	0x6e, 0x2f, 0x33, 0x47, 0xfd, 0x55, 0xf4, 0x09, 0xaa, 0x11, 0x74, 0x2e, 0xff, 0x56, 0xae, 0x70,
-	0x57, 0xc9, 0xf3, 0x53, 0x41, 0xfe, 0x2d, 0xc3, 0x6c, 0xa2, 0x3a, 0x95, 0x53, 0x2e, 0x1e, 0xbe,
-	0x12, 0x42, 0x2f, 0x5e, 0x09, 0xa1, 0x2f, 0x8e, 0x84, 0xd0, 0xe1, 0x91, 0xc0, 0x3d, 0x3f, 0x12,
-	0xb8, 0xdf, 0x8f, 0x04, 0xee, 0xc9, 0xb1, 0x10, 0x7a, 0x7e, 0x2c, 0x84, 0x5e, 0x1c, 0x0b, 0xa1,
-	0x07, 0x7f, 0xbf, 0x10, 0xf7, 0xbd, 0x7f, 0xff, 0xbc, 0x7e, 0xde, 0x89, 0x79, 0x3b, 0xe4, 0xff,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x14, 0xb4, 0xf2, 0xfe, 0x19, 0x0e, 0x00, 0x00,
+	// 2054 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x92, 0x94, 0x44, 0x3e, 0x92, 0x12, 0x35, 0xd4, 0x0f, 0x9a, 0x51, 0xb8, 0xf4, 0x7e,
+	0xbf, 0x08, 0x04, 0xc3, 0xa1, 0x1c, 0xb7, 0x68, 0x51, 0x19, 0x68, 0x2b, 0x8a, 0x74, 0xcc, 0xc2,
+	0x10, 0x89, 0x25, 0x2d, 0x23, 0x69, 0x81, 0xc5, 0x8a, 0x3b, 0xa6, 0xb6, 0xe1, 0xee, 0xd0, 0xbb,
+	0x43, 0x59, 0x42, 0x2e, 0x45, 0x81, 0x02, 0x06, 0x81, 0x14, 0x39, 0xf4, 0x10, 0xa0, 0x50, 0x61,
+	0xb4, 0xc8, 0xa5, 0xa7, 0x1e, 0xfa, 0x47, 0x18, 0x45, 0x0f, 0x41, 0xd1, 0x43, 0x90, 0x03, 0xd3,
+	0xd8, 0x40, 0x10, 0xb8, 0x37, 0xfd, 0x03, 0x2d, 0x76, 0x67, 0x76, 0xb9, 0x4b, 0xca, 0x55, 0x28,
+	0x37, 0x6d, 0x4f, 0xda, 0x7d, 0xf3, 0xde, 0xfb, 0x7c, 0xe6, 0xcd, 0x67, 0x66, 0xde, 0x52, 0xb0,
+	0xd1, 0x21, 0xb6, 0x41, 0xec, 0xad, 0x2e, 0x39, 0xda, 0x3a, 0x7a, 0xeb, 0x00, 0x53, 0xf5, 0x2d,
+	0xe7, 0xb9, 0xdc, 0xb7, 0x08, 0x25, 0x08, 0xb1, 0xd1, 0xb2, 0x63, 0xe1, 0xa3, 0x85, 0x22, 0x8f,
+	0x38, 0x50, 0x6d, 0xec, 0x87, 0x74, 0x88, 0x6e, 0xb2, 0x98, 0xc2, 0x4a, 0x97, 0x74, 0x89, 0xfb,
+	0xb8, 0xe5, 0x3c, 0x71, 0xeb, 0x15, 0x16, 0xa5, 0xb0, 0x01, 0x9e, 0x96, 0x0d, 0x89, 0x5d, 0x42,
+	0xba, 0x3d, 0xbc, 0xe5, 0xbe, 0x1d, 0x0c, 0x1e, 0x6c, 0x51, 0xdd, 0xc0, 0x36, 0x55, 0x8d, 0xbe,
+	0x17, 0x3b, 0xe9, 0xa0, 0x9a, 0x27, 0x7c, 0xa8, 0x38, 0x39, 0xa4, 0x0d, 0x2c, 0x95, 0xea, 0x84,
+	0x93, 0x91, 0x3e, 0x16, 0x00, 0xdd, 0xc7, 0x7a, 0xf7, 0x90, 0x62, 0x6d, 0x9f, 0x50, 0xdc, 0xe8,
+	0x3b, 0x83, 0xe8, 0x3b, 0x30, 0x4f, 0xdc, 0xa7, 0xbc, 0x50, 0x12, 0x36, 0x17, 0x6f, 0x16, 0xcb,
+	0xd3, 0x13, 0x2d, 0x8f, 0xfd, 0x65, 0xee, 0x8d, 0xee, 0xc3, 0xfc, 0x23, 0x37, 0x5b, 0x3e, 0x5a,
+	0x12, 0x36, 0x93, 0x95, 0x1f, 0x3c, 0x1d, 0x89, 0x91, 0xcf, 0x46, 0xe2, 0x1b, 0x5d, 0x9d, 0x1e,
+	0x0e, 0x0e, 0xca, 0x1d, 0x62, 0xf0, 0xb9, 0xf1, 0x3f, 0x6f, 0xda, 0xda, 0x7b, 0x5b, 0xf4, 0xa4,
+	0x8f, 0xed, 0x72, 0x15, 0x77, 0xce, 0x46, 0x62, 0xe6, 0x44, 0x35, 0x7a, 0xdb, 0x12, 0xcb, 0x22,
+	0xc9, 0x3c, 0x9d, 0x74, 0x1f, 0xd2, 0x6d, 0x7c, 0x4c, 0x9b, 0x16, 0xe9, 0x13, 0x5b, 0xed, 0xa1,
+	0x15, 0x98, 0xa3, 0x3a, 0xed, 0x61, 0x97, 0x5f, 0x52, 0x66, 0x2f, 0xa8, 0x04, 0x29, 0x0d, 0xdb,
+	0x1d, 0x4b, 0x67, 0xdc, 0x5d, 0x0e, 0x72, 0xd0, 0xb4, 0xbd, 0xf4, 0xd5, 0x13, 0x51, 0xf8, 0xcb,
+	0x1f, 0xdf, 0x5c, 0xd8, 0x25, 0x26, 0xc5, 0x26, 0x95, 0x86, 0x02, 0x2c, 0xd7, 0x8e, 0x71, 0x67,
+	0xe0, 0x0c, 0xbf, 0x6a, 0x7a, 0x74, 0x03, 0x12, 0x06, 0xb6, 0x6d, 0xb5, 0x8b, 0xed, 0x7c, 0xac,
+	0x14, 0xdb, 0x4c, 0xdd, 0x5c, 0x29, 0xb3, 0x15, 0x28, 0x7b, 0x2b, 0x50, 0xde, 0x31, 0x4f, 0x64,
+	0xdf, 0x6b, 0x3b, 0x15, 0x24, 0xf3, 0x77, 0x01, 0x16, 0xaa, 0xb8, 0x4f, 0x6c, 0x9d, 0xa2, 0xef,
+	0x42, 0xaa, 0xcf, 0xe9, 0x28, 0xba, 0xe6, 0x12, 0x89, 0x57, 0xd6, 0xce, 0x46, 0x22, 0x62, 0x15,
+	0x0a, 0x0c, 0x4a, 0x32, 0x78, 0x6f, 0x75, 0x0d, 0x6d, 0x40, 0x52, 0x63, 0x39, 0x88, 0xc5, 0x39,
+	0x8e, 0x0d, 0xa8, 0x03, 0xf3, 0xaa, 0x41, 0x06, 0x26, 0xe5, 0xfc, 0xae, 0x78, 0x2b, 0xeb, 0xc8,
+	0xd5, 0x5f, 0xda, 0x5d, 0xa2, 0x9b, 0x95, 0x1b, 0xce, 0xe2, 0xfd, 0xfe, 0x73, 0x71, 0xf3, 0x6b,
+	0x2c, 0x9e, 0x13, 0x60, 0xcb, 0x3c, 0x35, 0x2a, 0x38, 0x65, 0xa0, 0xaa, 0xa6, 0x52, 0x35, 0x1f,
+	0x77, 0x19, 0xf8, 0xef, 0xdb, 0x89, 0xc7, 0x4f, 0xc4, 0xc8, 0x57, 0x4f, 0xc4, 0x88, 0xf4, 0x8b,
+	0x04, 0x24, 0xfc, 0x8a, 0x7f, 0xfb, 0xbc, 0xe9, 0xe6, 0x5e, 0x8c, 0xc4, 0xa8, 0xae, 0x9d, 0x8d,
+	0xc4, 0x24, 0x9b, 0xf4, 0xe4, 0x5c, 0x6f, 0xc1, 0x42, 0x87, 0xd5, 0xce, 0x9d, 0xe9, 0x4b, 0xca,
+	0x5d, 0x49, 0xfd, 0x69, 0x5c, 0x64, 0xd9, 0x8b, 0x40, 0xfb, 0x30, 0x6f, 0x53, 0x95, 0x0e, 0x9c,
+	0xa5, 0x72, 0x44, 0x2e, 0x9d, 0x27, 0x72, 0x8f, 0x60, 0xcb, 0xf5, 0xac, 0x14, 0xce, 0x46, 0xe2,
+	0xda, 0xc4, 0x02, 0xb0, 0x24, 0x92, 0xcc, 0xb3, 0xa1, 0x3e, 0xa0, 0x07, 0xba, 0xa9, 0xf6, 0x14,
+	0xaa, 0xf6, 0x7a, 0x27, 0x8a, 0x85, 0xed, 0x41, 0x8f, 0xba, 0x75, 0x48, 0xdd, 0x14, 0xcf, 0xc3,
+	0x68, 0x3b, 0x7e, 0xb2, 0xeb, 0x56, 0xb9, 0xea, 0x14, 0xfd, 0x6c, 0x24, 0x5e, 0x61, 0x20, 0xd3,
+	0x89, 0x24, 0x39, 0xeb, 0x1a, 0x03, 0x41, 0xe8, 0xc7, 0x90, 0xb2, 0x07, 0x07, 0x86, 0x4e, 0x15,
+	0xe7, 0x68, 0xc8, 0xcf, 0xb9, 0x50, 0x85, 0xa9, 0x52, 0xb4, 0xbd, 0x73, 0xa3, 0x52, 0xe4, 0x28,
+	0x5c, 0x4b, 0x81, 0x60, 0xe9, 0xc3, 0xcf, 0x45, 0x41, 0x06, 0x66, 0x71, 0x02, 0x90, 0x0e, 0x59,
+	0x2e, 0x1f, 0x05, 0x9b, 0x1a, 0x43, 0x98, 0xbf, 0x10, 0xe1, 0xff, 0x38, 0xc2, 0x3a, 0x43, 0x98,
+	0xcc, 0xc0, 0x60, 0x16, 0xb9, 0xb9, 0x66, 0x6a, 0x2e, 0xd4, 0x63, 0x01, 0x32, 0x94, 0x50, 0xb5,
+	0xa7, 0xf0, 0x81, 0xfc, 0xc2, 0x45, 0x22, 0xbd, 0xc3, 0x71, 0x56, 0x18, 0x4e, 0x28, 0x5a, 0x9a,
+	0x49, 0xbc, 0x69, 0x37, 0xd6, 0xdb, 0x7e, 0x3d, 0x58, 0x3e, 0x22, 0x54, 0x37, 0xbb, 0xce, 0xf2,
+	0x5a, 0xbc, 0xb0, 0x89, 0x0b, 0xa7, 0xfd, 0xff, 0x9c, 0x4e, 0x9e, 0xd1, 0x99, 0x4a, 0xc1, 0xe6,
+	0xbd, 0xc4, 0xec, 0x2d, 0xc7, 0xec, 0x4e, 0xfc, 0x01, 0x70, 0xd3, 0xb8, 0xc4, 0xc9, 0x0b, 0xb1,
+	0x24, 0x8e, 0xb5, 0x16, 0xc2, 0x0a, 0x57, 0x38, 0xc3, 0xac, 0x5e, 0x81, 0x0b, 0x90, 0x60, 0xb2,
+	0xc5, 0x56, 0x1e, 0xd8, 0xc6, 0xf4, 0xde, 0x9d, 0x73, 0x03, 0x1f, 0xf7, 0xb1, 0xa6, 0x53, 0xac,
+	0xe5, 0x53, 0x25, 0x61, 0x33, 0x21, 0x8f, 0x0d, 0xa1, 0x2d, 0x9d, 0x0e, 0x6f, 0x69, 0xe7, 0xa8,
+	0x32, 0xec, 0x2e, 0xd7, 0xa7, 0x9d, 0xcf, 0x94, 0x62, 0x9b, 0xe9, 0xe0, 0x51, 0x15, 0x18, 0x94,
+	0x64, 0x30, 0xec, 0x2e, 0x93, 0xad, 0xbd, 0x1d, 0x77, 0x4e, 0x63, 0xe9, 0x69, 0x14, 0x52, 0x41,
+	0x35, 0xff, 0x10, 0x62, 0x27, 0xd8, 0x66, 0x47, 0x6f, 0xa5, 0x3c, 0xc3, 0x0d, 0x52, 0x37, 0xa9,
+	0xec, 0x84, 0xa2, 0x3b, 0xb0, 0xa0, 0x1e, 0xd8, 0x54, 0xd5, 0xf9, 0x21, 0x3d, 0x73, 0x16, 0x2f,
+	0x1c, 0x7d, 0x1f, 0xa2, 0x26, 0xc9, 0xc7, 0x2e, 0x95, 0x24, 0x6a, 0x12, 0xd4, 0x85, 0xb4, 0x49,
+	0x94, 0x47, 0x3a, 0x3d, 0x54, 0x8e, 0x30, 0x25, 0xec, 0x34, 0xac, 0xd4, 0x66, 0xcb, 0x74, 0x36,
+	0x12, 0x73, 0xac, 0x92, 0xc1, 0x5c, 0x92, 0x0c, 0x26, 0xb9, 0xaf, 0xd3, 0xc3, 0x7d, 0x4c, 0x09,
+	0x2f, 0xe5, 0x3f, 0x04, 0x88, 0x3b, 0xd7, 0xf2, 0xe5, 0x6f, 0x8f, 0x15, 0x98, 0x3b, 0x22, 0x14,
+	0x7b, 0x37, 0x07, 0x7b, 0x41, 0xdb, 0x7e, 0x3f, 0x10, 0xfb, 0x3a, 0xfd, 0x40, 0x25, 0x9a, 0x17,
+	0xfc, 0x9e, 0xe0, 0x36, 0x2c, 0xb0, 0x27, 0x3b, 0x1f, 0x77, 0x77, 0xf3, 0x1b, 0xe7, 0x05, 0x4f,
+	0x37, 0x21, 0x95, 0xb8, 0x53, 0x25, 0xd9, 0x0b, 0x0e, 0x29, 0x70, 0x6e, 0xf2, 0x52, 0xf9, 0xc8,
+	0xbb, 0x54, 0x3e, 0x98, 0x83, 0xd5, 0x7d, 0xb5, 0xa7, 0x6b, 0x2a, 0x25, 0x96, 0x3c, 0x30, 0x4d,
+	0xdd, 0xec, 0xba, 0xe2, 0x42, 0x75, 0x58, 0x3e, 0xf2, 0x06, 0x14, 0x55, 0xd3, 0x2c, 0x6c, 0x7b,
+	0x22, 0xdb, 0x08, 0xec, 0xd8, 0x49, 0x17, 0x49, 0xce, 0xfa, 0xb6, 0x1d, 0x66, 0x42, 0x07, 0x00,
+	0x27, 0xd8, 0x56, 0xec, 0x43, 0xd5, 0xc2, 0x36, 0x97, 0xd8, 0xee, 0xcc, 0xad, 0xce, 0x32, 0x43,
+	0x1c, 0x67, 0x92, 0xe4, 0xe4, 0x09, 0xb6, 0x5b, 0xee, 0x33, 0x32, 0x61, 0x91, 0x8b, 0xd0, 0xc3,
+	0x61, 0x2a, 0x7c, 0x7b, 0x66, 0x9c, 0x55, 0x86, 0x13, 0xce, 0x26, 0xc9, 0x19, 0x6e, 0xe0, 0x78,
+	0x0a, 0x24, 0x4d, 0xe2, 0x41, 0x31, 0x99, 0x56, 0x66, 0x86, 0xca, 0xfa, 0x32, 0xf5, 0x50, 0x12,
+	0x26, 0xe1, 0x00, 0xef, 0x43, 0x2e, 0x28, 0x5f, 0x0f, 0xca, 0x5d, 0xca, 0xca, 0xdd, 0x99, 0xa1,
+	0x0a, 0xd3, 0x3b, 0xc2, 0x07, 0xcd, 0x8e, 0x37, 0x06, 0x07, 0x7f, 0x08, 0x4b, 0x1a, 0xd6, 0x06,
+	0x1d, 0x8a, 0x35, 0x0f, 0x78, 0xde, 0x05, 0xbe, 0x33, 0x33, 0xf0, 0x9a, 0x77, 0xa3, 0x85, 0xd2,
+	0x49, 0xf2, 0xa2, 0x67, 0x61, 0x90, 0x7c, 0x47, 0xfe, 0x35, 0x06, 0xeb, 0xbe, 0x1e, 0x5d, 0x21,
+	0x56, 0x2c, 0xac, 0xbe, 0xa7, 0x91, 0x47, 0xe6, 0xe5, 0x37, 0xe9, 0xb9, 0x52, 0x8e, 0x5e, 0x4a,
+	0xca, 0x81, 0xdd, 0x19, 0x7b, 0x95, 0xdd, 0x79, 0x08, 0x69, 0x7e, 0x01, 0xf5, 0xc9, 0x23, 0x6c,
+	0xbd, 0xea, 0x41, 0x17, 0xcc, 0x25, 0xc9, 0x29, 0xf6, 0xda, 0x74, 0xde, 0xd0, 0xcf, 0x05, 0x58,
+	0xf5, 0x8b, 0x1f, 0xc2, 0x64, 0x52, 0xda, 0x9b, 0x19, 0x73, 0x63, 0x62, 0x45, 0xc3, 0xe0, 0x39,
+	0xcf, 0xbe, 0x3f, 0x26, 0x21, 0xfd, 0x2a, 0x06, 0xcb, 0x55, 0xdc, 0xc3, 0x5d, 0xa7, 0x96, 0x8d,
+	0x23, 0x6c, 0x59, 0xba, 0x86, 0xff, 0x27, 0x16, 0xb4, 0x0e, 0xcb, 0x9a, 0x47, 0xcc, 0x4f, 0x15,
+	0x9b, 0x4c, 0x35, 0xe5, 0x22, 0xc9, 0x59, 0xdf, 0x76, 0x8e, 0x36, 0xe2, 0xff, 0x4e, 0x6d, 0xcc,
+	0x7d, 0x53, 0xda, 0x90, 0xbe, 0x8c, 0x41, 0x86, 0x77, 0x70, 0x4d, 0xd5, 0x52, 0x0d, 0x1b, 0xfd,
+	0x5a, 0x80, 0x94, 0xa1, 0x9b, 0x7e, 0x43, 0x29, 0x5c, 0xd4, 0x50, 0x2a, 0x0e, 0xad, 0x17, 0x23,
+	0x71, 0x35, 0x10, 0x75, 0x9d, 0x18, 0x3a, 0xc5, 0x46, 0x9f, 0x9e, 0x04, 0x9a, 0x1a, 0xdd, 0xbc,
+	0x5c, 0x9f, 0x09, 0x86, 0x6e, 0x7a, 0x5d, 0xe6, 0x2f, 0x05, 0x40, 0x86, 0x7a, 0xec, 0x25, 0x52,
+	0xfa, 0xd8, 0xd2, 0x89, 0xc6, 0xbf, 0x65, 0xae, 0x4c, 0xf5, 0x7e, 0x55, 0xfe, 0xf1, 0xce, 0x6a,
+	0xf7, 0x62, 0x24, 0x6e, 0x4c, 0x07, 0x87, 0xb8, 0xf2, 0xaf, 0x88, 0x69, 0x2f, 0xe9, 0x23, 0xa7,
+	0x3b, 0xcc, 0x1a, 0xea, 0xb1, 0x57, 0x2e, 0xd7, 0x8c, 0x3e, 0x16, 0x60, 0xd5, 0x57, 0x69, 0x47,
+	0x35, 0x3b, 0xb8, 0xa7, 0xb8, 0x98, 0xae, 0x84, 0xd2, 0x95, 0x87, 0xb3, 0x1d, 0x97, 0x2f, 0x46,
+	0xa2, 0x78, 0x6e, 0xba, 0x10, 0xcb, 0x8d, 0x89, 0xdd, 0x11, 0x74, 0x94, 0xe4, 0x9c, 0x67, 0xdf,
+	0x75, 0xcd, 0xb2, 0x6b, 0xfd, 0x43, 0x14, 0xd2, 0x7c, 0x3f, 0xb2, 0x75, 0x7e, 0x1f, 0x32, 0x9e,
+	0x2e, 0x58, 0x0d, 0x85, 0x8b, 0x6a, 0x78, 0x8b, 0xd7, 0x70, 0x3d, 0x14, 0x17, 0x22, 0xb6, 0x12,
+	0x16, 0x5c, 0xa0, 0x72, 0x5c, 0xd0, 0xbc, 0x6a, 0xbf, 0x15, 0x60, 0xdd, 0x6f, 0x95, 0x95, 0x30,
+	0x8f, 0x0b, 0xd7, 0xb2, 0xc1, 0x79, 0x5c, 0x7d, 0x49, 0x86, 0x10, 0xa3, 0x22, 0x63, 0xf4, 0x12,
+	0x57, 0xc6, 0x6d, 0xd5, 0x1f, 0xdd, 0x0f, 0x90, 0x94, 0x3e, 0x8b, 0xf3, 0x36, 0x9b, 0x57, 0xec,
+	0x5d, 0x98, 0x7f, 0x38, 0x20, 0xd6, 0xc0, 0x70, 0x4b, 0x95, 0x9e, 0xf5, 0xb6, 0x7f, 0x31, 0x12,
+	0xb3, 0x2c, 0x7e, 0x4c, 0x50, 0xe6, 0x19, 0x51, 0x07, 0x92, 0xf4, 0xd0, 0xc2, 0xf6, 0x21, 0xe9,
+	0xb1, 0x0a, 0xa4, 0x2b, 0xb5, 0x99, 0xd3, 0xe7, 0xfc, 0x14, 0x01, 0x84, 0x71, 0x5e, 0x34, 0x14,
+	0x60, 0xd1, 0xbd, 0xf6, 0xc7, 0x50, 0x4c, 0xa4, 0x9d, 0x99, 0xa1, 0xf2, 0xe1, 0x3c, 0xa1, 0x92,
+	0xf3, 0xf6, 0x29, 0xec, 0x21, 0xc9, 0x19, 0xc7, 0xd0, 0xf6, 0xc9, 0xfc, 0x46, 0x80, 0xdc, 0x78,
+	0x55, 0xc6, 0x8c, 0xe2, 0x2e, 0x23, 0x63, 0x66, 0x46, 0xaf, 0x9f, 0x93, 0x2c, 0x44, 0xab, 0x30,
+	0xa9, 0x84, 0x00, 0x37, 0xe4, 0x5b, 0xc7, 0x04, 0x7f, 0x02, 0x49, 0xc2, 0xef, 0x29, 0xa7, 0xe9,
+	0x72, 0x4e, 0xc1, 0xab, 0x2f, 0xfd, 0x31, 0xc2, 0xbb, 0xd1, 0x2a, 0xaf, 0x71, 0x71, 0xe6, 0xfc,
+	0xd8, 0xe0, 0x5a, 0xf8, 0x46, 0xe9, 0x83, 0x18, 0x64, 0x42, 0x91, 0xa8, 0x0c, 0x09, 0x67, 0x3e,
+	0xca, 0xc0, 0xea, 0xf1, 0x2e, 0x3b, 0x77, 0x36, 0x12, 0x97, 0x18, 0x6b, 0x6f, 0x44, 0x92, 0x17,
+	0x9c, 0xc7, 0x7b, 0x56, 0x2f, 0x20, 0xc7, 0xe8, 0x37, 0x2b, 0xc7, 0xd8, 0x7f, 0x4e, 0x8e, 0xf1,
+	0xff, 0x96, 0x1c, 0xaf, 0x7d, 0x29, 0x00, 0x04, 0x7e, 0xcf, 0xbd, 0x0e, 0xeb, 0xfb, 0x8d, 0x76,
+	0x4d, 0x69, 0x34, 0xdb, 0xf5, 0xc6, 0x9e, 0x72, 0x6f, 0xaf, 0xd5, 0xac, 0xed, 0xd6, 0x6f, 0xd7,
+	0x6b, 0xd5, 0x6c, 0xa4, 0xb0, 0x34, 0x3c, 0x2d, 0xa5, 0x98, 0x63, 0xcd, 0x01, 0x41, 0x12, 0x2c,
+	0x05, 0xbd, 0xdf, 0xa9, 0xb5, 0xb2, 0x42, 0x21, 0x33, 0x3c, 0x2d, 0x25, 0x99, 0xd7, 0x3b, 0xd8,
+	0x46, 0xd7, 0x20, 0x17, 0xf4, 0xd9, 0xa9, 0xb4, 0xda, 0x3b, 0xf5, 0xbd, 0x6c, 0xb4, 0xb0, 0x3c,
+	0x3c, 0x2d, 0x65, 0x98, 0xdf, 0x0e, 0xff, 0x88, 0x2e, 0xc1, 0x62, 0xd0, 0x77, 0xaf, 0x91, 0x8d,
+	0x15, 0xd2, 0xc3, 0xd3, 0x52, 0x82, 0xb9, 0xed, 0x11, 0x74, 0x13, 0xf2, 0x61, 0x0f, 0xe5, 0x7e,
+	0xbd, 0x7d, 0x47, 0xd9, 0xaf, 0xb5, 0x1b, 0xd9, 0x78, 0x61, 0x65, 0x78, 0x5a, 0xca, 0x7a, 0xbe,
+	0x5e, 0x63, 0x5f, 0x88, 0x3f, 0xfe, 0x5d, 0x31, 0x72, 0xed, 0xcf, 0x51, 0x58, 0x0c, 0xff, 0x46,
+	0x87, 0xca, 0xf0, 0x5a, 0x53, 0x6e, 0x34, 0x1b, 0xad, 0x9d, 0xbb, 0x4a, 0xab, 0xbd, 0xd3, 0xbe,
+	0xd7, 0x9a, 0x98, 0xb0, 0x3b, 0x15, 0xe6, 0xbc, 0xa7, 0xf7, 0xd0, 0x2d, 0x28, 0x4e, 0xfa, 0x57,
+	0x6b, 0xcd, 0x46, 0xab, 0xde, 0x56, 0x9a, 0x35, 0xb9, 0xde, 0xa8, 0x66, 0x85, 0xc2, 0xfa, 0xf0,
+	0xb4, 0x94, 0x63, 0x21, 0xe1, 0x0b, 0xf3, 0x7b, 0xf0, 0xfa, 0x64, 0xf0, 0x7e, 0xa3, 0x5d, 0xdf,
+	0x7b, 0xdb, 0x8b, 0x8d, 0x16, 0xd6, 0x86, 0xa7, 0x25, 0xc4, 0x62, 0x83, 0x07, 0x32, 0xba, 0x0e,
+	0x6b, 0x93, 0xa1, 0xcd, 0x9d, 0x56, 0xab, 0x56, 0xcd, 0xc6, 0x0a, 0xd9, 0xe1, 0x69, 0x29, 0xcd,
+	0x62, 0x9a, 0xaa, 0x6d, 0x63, 0x0d, 0xdd, 0x80, 0xfc, 0xa4, 0xb7, 0x5c, 0xfb, 0x51, 0x6d, 0xb7,
+	0x5d, 0xab, 0x66, 0xe3, 0x05, 0x34, 0x3c, 0x2d, 0x2d, 0x32, 0x7f, 0x19, 0xff, 0x14, 0x3b, 0xed,
+	0xea, 0x79, 0xf9, 0x6f, 0xef, 0xd4, 0xef, 0xd6, 0xaa, 0xd9, 0xb9, 0x60, 0xfe, 0xdb, 0xaa, 0xde,
+	0xc3, 0x1a, 0x2b, 0x67, 0x65, 0xef, 0xe9, 0x17, 0xc5, 0xc8, 0xa7, 0x5f, 0x14, 0x23, 0x3f, 0x7b,
+	0x56, 0x8c, 0x3c, 0x7d, 0x56, 0x14, 0x3e, 0x79, 0x56, 0x14, 0xfe, 0xf6, 0xac, 0x28, 0x7c, 0xf8,
+	0xbc, 0x18, 0xf9, 0xe4, 0x79, 0x31, 0xf2, 0xe9, 0xf3, 0x62, 0xe4, 0xdd, 0x7f, 0xdd, 0xec, 0x1c,
+	0xbb, 0xff, 0x2c, 0x71, 0xf5, 0x7c, 0x30, 0xef, 0xde, 0x77, 0xdf, 0xfa, 0xe7, 0x00, 0x84, 0x5e,
+	0x37, 0x25, 0x47, 0x19, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -682,6 +982,23 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.Proposer != that1.Proposer {
+		return false
+	}
+	if this.Expedited != that1.Expedited {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	if len(this.MsgResults) != len(that1.MsgResults) {
+		return false
+	}
+	for i := range this.MsgResults {
+		if !bytes.Equal(this.MsgResults[i], that1.MsgResults[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -717,6 +1034,45 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ValidatorRunningTally) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRunningTally)
+	if !ok {
+		that2, ok := that.(ValidatorRunningTally)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.YesShares.Equal(that1.YesShares) {
+		return false
+	}
+	if !this.AbstainShares.Equal(that1.AbstainShares) {
+		return false
+	}
+	if !this.NoShares.Equal(that1.NoShares) {
+		return false
+	}
+	if !this.NoWithVetoShares.Equal(that1.NoWithVetoShares) {
+		return false
+	}
+	if !this.DeductedShares.Equal(that1.DeductedShares) {
+		return false
+	}
+	return true
+}
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -792,6 +1148,57 @@ func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -812,6 +1219,13 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -861,6 +1275,39 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgResults[iNdEx])
+			copy(dAtA[i:], m.MsgResults[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.MsgResults[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x52
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -1025,6 +1472,13 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Options) > 0 {
 		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1059,7 +1513,7 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorRunningTally) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1069,40 +1523,265 @@ func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorRunningTally) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorRunningTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintGov(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x12
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.DeductedShares.Size()
+		i -= size
+		if _, err := m.DeductedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.NoWithVetoShares.Size()
+		i -= size
+		if _, err := m.NoWithVetoShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.NoShares.Size()
+		i -= size
+		if _, err := m.NoShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AbstainShares.Size()
+		i -= size
+		if _, err := m.AbstainShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.YesShares.Size()
+		i -= size
+		if _, err := m.YesShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorTallyBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorTallyBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorTallyBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeductedVotingPower.Size()
+		i -= size
+		if _, err := m.DeductedVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProposalCancelRatio.Size()
+		i -= size
+		if _, err := m.ProposalCancelRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGov(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *VotingParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -1124,13 +1803,21 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
 	if err8 != nil {
 		return 0, err8
 	}
 	i -= n8
 	i = encodeVarintGov(dAtA, i, uint64(n8))
 	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1155,6 +1842,30 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.ExpeditedThreshold.Size()
+		i -= size
+		if _, err := m.ExpeditedThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.VetoThreshold.Size()
 		i -= size
@@ -1188,34 +1899,111 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TallyOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
-	}
-	l = m.Weight.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+
+func (m *TallyOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TallyOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VetoThreshold.Size()
+		i -= size
+		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *TextProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionProposal) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1227,6 +2015,12 @@ func (m *TextProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1249,6 +2043,10 @@ func (m *Deposit) Size() (n int) {
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1284,6 +2082,23 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MsgResults) > 0 {
+		for _, b := range m.MsgResults {
+			l = len(b)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1326,6 +2141,87 @@ func (m *Vote) Size() (n int) {
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorRunningTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.YesShares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.AbstainShares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.NoShares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.NoWithVetoShares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.DeductedShares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *ValidatorTallyBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.DeductedVotingPower.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *DelegatorOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1343,6 +2239,8 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ProposalCancelRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1354,6 +2252,8 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1369,6 +2269,33 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.VetoThreshold.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TallyOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Quorum.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VetoThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1388,30 +2315,1069 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGov
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, make([]byte, postIndex-iNdEx))
+			copy(m.MsgResults[len(m.MsgResults)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
 			}
-			m.Option = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1421,14 +3387,29 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1456,7 +3437,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1481,7 +3462,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextProposal) Unmarshal(dAtA []byte) error {
+func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1504,15 +3485,34 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1540,11 +3540,64 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1572,7 +3625,7 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1595,7 +3648,7 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRunningTally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1618,17 +3671,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRunningTally: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRunningTally: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1638,14 +3691,129 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YesShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstainShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1673,13 +3841,15 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if err := m.NoWithVetoShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeductedShares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1689,23 +3859,23 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeductedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1730,7 +3900,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *ValidatorTallyBreakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1753,10 +3923,10 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorTallyBreakdown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorTallyBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1780,9 +3950,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1792,33 +3962,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Content == nil {
-				m.Content = &types1.Any{}
-			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1828,16 +3994,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1847,30 +4028,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeductedVotingPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1880,30 +4062,100 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeductedVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1913,30 +4165,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1946,29 +4197,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1995,15 +4244,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2013,22 +4263,23 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2053,7 +4304,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2076,17 +4327,17 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2096,65 +4347,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2164,31 +4381,30 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2198,23 +4414,22 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProposalCancelRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2239,7 +4454,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2262,36 +4477,17 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2301,46 +4497,28 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 4:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2367,8 +4545,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2393,7 +4570,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2416,17 +4593,17 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2436,31 +4613,30 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2470,78 +4646,94 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ExpeditedThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2568,7 +4760,8 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.Overrides = append(m.Overrides, TallyOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2593,7 +4786,7 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
+func (m *TallyOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2616,13 +4809,45 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
@@ -2655,7 +4880,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
@@ -2688,7 +4913,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
@@ -37,6 +37,14 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30<proposalID_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorRunningTally
+//
+// - 0x31<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: VotedShares
+//
+// - 0x40<proposalID_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorTallyBreakdown
+//
+// - 0x41<proposalID_Bytes><valAddrLen (1 Byte)><valAddr_Bytes><delAddrLen (1 Byte)><delAddr_Bytes>: DelegatorOverride
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -46,6 +54,12 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	ValidatorRunningTalliesKeyPrefix = []byte{0x30}
+	VotedSharesKeyPrefix             = []byte{0x31}
+
+	TallyBreakdownsKeyPrefix    = []byte{0x40}
+	DelegatorOverridesKeyPrefix = []byte{0x41}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -107,6 +121,62 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// ValidatorRunningTalliesKey gets the first part of the validator running
+// tallies key based on the proposalID
+func ValidatorRunningTalliesKey(proposalID uint64) []byte {
+	return append(ValidatorRunningTalliesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ValidatorRunningTallyKey key of the running tally of the delegators of a
+// validator on a proposal
+func ValidatorRunningTallyKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(ValidatorRunningTalliesKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// VotedSharesKey gets the first part of the voted shares key based on the
+// proposalID and the voter address
+func VotedSharesKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(
+		append(VotedSharesKeyPrefix, GetProposalIDBytes(proposalID)...),
+		address.MustLengthPrefix(voterAddr.Bytes())...,
+	)
+}
+
+// VotedShareKey key of the delegator shares of a validator counted in the
+// running tally of a proposal for the vote of a voter
+func VotedShareKey(proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(VotedSharesKey(proposalID, voterAddr), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// TallyBreakdownsKey gets the first part of the tally breakdowns key based on
+// the proposalID
+func TallyBreakdownsKey(proposalID uint64) []byte {
+	return append(TallyBreakdownsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// TallyBreakdownKey key of the tally breakdown of a validator on a proposal
+func TallyBreakdownKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(TallyBreakdownsKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// DelegatorOverridesKey gets the first part of the delegator overrides key
+// based on the proposalID
+func DelegatorOverridesKey(proposalID uint64) []byte {
+	return append(DelegatorOverridesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ValidatorDelegatorOverridesKey gets the first part of the delegator
+// overrides key of the delegators of a validator on a proposal
+func ValidatorDelegatorOverridesKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(DelegatorOverridesKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// DelegatorOverrideKey key of the override of the vote of a validator by one of
+// its delegators on a proposal
+func DelegatorOverrideKey(proposalID uint64, valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(ValidatorDelegatorOverridesKey(proposalID, valAddr), address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -138,6 +208,17 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitVotedShareKey split the voted share key and returns the proposal id,
+// voter address and validator address
+func SplitVotedShareKey(key []byte) (proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// <prefix (1 Byte)><proposalID (8 bytes)><voterAddrLen (1 Byte)><voterAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	proposalID = GetProposalIDFromBytes(key[1:9])
+	voterAddrLen := int(key[9])
+	voterAddr = sdk.AccAddress(key[10 : 10+voterAddrLen])
+	valAddr = sdk.ValAddress(key[11+voterAddrLen:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
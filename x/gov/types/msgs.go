@@ -18,11 +18,16 @@ const (
 	TypeMsgVote           = "vote"
 	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
 )
 
+// MaxMetadataLength is the maximum length of the metadata of proposals, votes
+// and deposits.
+const MaxMetadataLength = 255
+
 var (
-	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
-	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgCancelProposal{}
+	_             types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -92,6 +97,9 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 	if m.InitialDeposit.IsAnyNegative() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.InitialDeposit.String())
 	}
+	if err := ValidateMetadata(m.Metadata); err != nil {
+		return err
+	}
 
 	content := m.GetContent()
 	if content == nil {
@@ -138,7 +146,7 @@ func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 // NewMsgDeposit creates a new MsgDeposit instance
 //nolint:interfacer
 func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) *MsgDeposit {
-	return &MsgDeposit{proposalID, depositor.String(), amount}
+	return &MsgDeposit{ProposalId: proposalID, Depositor: depositor.String(), Amount: amount}
 }
 
 // Route implements Msg
@@ -159,7 +167,7 @@ func (msg MsgDeposit) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
 	}
 
-	return nil
+	return ValidateMetadata(msg.Metadata)
 }
 
 // String implements the Stringer interface
@@ -183,7 +191,7 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 // NewMsgVote creates a message to cast a vote on an active proposal
 //nolint:interfacer
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option}
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option}
 }
 
 // Route implements Msg
@@ -202,7 +210,7 @@ func (msg MsgVote) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidVote, msg.Option.String())
 	}
 
-	return nil
+	return ValidateMetadata(msg.Metadata)
 }
 
 // String implements the Stringer interface
@@ -226,7 +234,7 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 // NewMsgVoteWeighted creates a message to cast a vote on an active proposal
 //nolint:interfacer
 func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
-	return &MsgVoteWeighted{proposalID, voter.String(), options}
+	return &MsgVoteWeighted{ProposalId: proposalID, Voter: voter.String(), Options: options}
 }
 
 // Route implements Msg
@@ -266,7 +274,7 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidVote, "Total weight lower than 1.00")
 	}
 
-	return nil
+	return ValidateMetadata(msg.Metadata)
 }
 
 // String implements the Stringer interface
@@ -286,3 +294,52 @@ func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
 	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
 	return []sdk.AccAddress{voter}
 }
+
+// NewMsgCancelProposal creates a message to cancel a proposal by its proposer
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{ProposalId: proposalID, Proposer: proposer.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelProposal) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// ValidateMetadata checks that the metadata of a proposal, vote or deposit is
+// not longer than MaxMetadataLength.
+func ValidateMetadata(metadata string) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrMetadataTooLong, "%d > %d", len(metadata), MaxMetadataLength)
+	}
+
+	return nil
+}
@@ -221,3 +221,47 @@ func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 		`{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"abcd","title":"test"}},"initial_deposit":[]}}`,
 		string(bz))
 }
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		proposer   sdk.AccAddress
+		expectPass bool
+	}{
+		{1, addrs[0], true},
+		{0, addrs[0], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposalID, tc.proposer)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.proposer}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test that the metadata of all the messages is bounded
+func TestMsgsMetadata(t *testing.T) {
+	longMetadata := strings.Repeat("a", MaxMetadataLength+1)
+
+	submit, err := NewMsgSubmitProposal(NewTextProposal("Test", "description"), coinsPos, addrs[0])
+	require.NoError(t, err)
+	deposit := NewMsgDeposit(addrs[0], 1, coinsPos)
+	vote := NewMsgVote(addrs[0], 1, OptionYes)
+	weightedVote := NewMsgVoteWeighted(addrs[0], 1, NewNonSplitVoteOption(OptionYes))
+
+	submit.Metadata, deposit.Metadata, vote.Metadata, weightedVote.Metadata = "ipfs://CID", "ipfs://CID", "ipfs://CID", "ipfs://CID"
+	for _, msg := range []sdk.Msg{submit, deposit, vote, weightedVote} {
+		require.NoError(t, msg.ValidateBasic())
+	}
+
+	submit.Metadata, deposit.Metadata, vote.Metadata, weightedVote.Metadata = longMetadata, longMetadata, longMetadata, longMetadata
+	for _, msg := range []sdk.Msg{submit, deposit, vote, weightedVote} {
+		require.ErrorIs(t, msg.ValidateBasic(), ErrMetadataTooLong)
+	}
+}
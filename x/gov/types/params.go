@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -12,7 +13,8 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
@@ -21,6 +23,9 @@ var (
 	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
 	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
 	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+
+	DefaultExpeditedThreshold  = sdk.NewDecWithPrec(667, 3)
+	DefaultProposalCancelRatio = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -40,10 +45,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, proposalCancelRatio sdk.Dec) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ProposalCancelRatio: proposalCancelRatio,
 	}
 }
 
@@ -52,6 +58,7 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		DefaultProposalCancelRatio,
 	)
 }
 
@@ -63,7 +70,8 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ProposalCancelRatio.Equal(dp2.ProposalCancelRatio)
 }
 
 func validateDepositParams(i interface{}) error {
@@ -78,27 +86,96 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if v.ProposalCancelRatio.IsNil() || v.ProposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", v.ProposalCancelRatio)
+	}
+	if v.ProposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", v.ProposalCancelRatio)
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
+		Quorum:             quorum,
+		Threshold:          threshold,
+		VetoThreshold:      vetoThreshold,
+		ExpeditedThreshold: expeditedThreshold,
+	}
+}
+
+// NewTallyOverride creates a new TallyOverride object
+func NewTallyOverride(typeURL string, quorum, threshold, vetoThreshold sdk.Dec) TallyOverride {
+	return TallyOverride{
+		TypeUrl:       typeURL,
 		Quorum:        quorum,
 		Threshold:     threshold,
 		VetoThreshold: vetoThreshold,
 	}
 }
 
+// String implements stringer insterface
+func (to TallyOverride) String() string {
+	out, _ := yaml.Marshal(to)
+	return string(out)
+}
+
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold)
+	if len(tp.Overrides) != len(other.Overrides) {
+		return false
+	}
+	for i, override := range tp.Overrides {
+		o := other.Overrides[i]
+		if override.TypeUrl != o.TypeUrl || !override.Quorum.Equal(o.Quorum) || !override.Threshold.Equal(o.Threshold) ||
+			!override.VetoThreshold.Equal(o.VetoThreshold) {
+			return false
+		}
+	}
+
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold) &&
+		tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold)
+}
+
+// ForTypes returns the tally params applying to a proposal of the given
+// content and message type URLs, without overrides. The quorum, threshold and
+// veto threshold of the overrides of these types replace the default ones and,
+// when several of them apply, the strictest values are retained.
+func (tp TallyParams) ForTypes(typeURLs ...string) TallyParams {
+	resolved := NewTallyParams(tp.Quorum, tp.Threshold, tp.VetoThreshold, tp.ExpeditedThreshold)
+
+	matched := false
+	for _, override := range tp.Overrides {
+		applies := false
+		for _, typeURL := range typeURLs {
+			if override.TypeUrl == typeURL {
+				applies = true
+				break
+			}
+		}
+		if !applies {
+			continue
+		}
+
+		if !matched {
+			resolved.Quorum, resolved.Threshold, resolved.VetoThreshold = override.Quorum, override.Threshold, override.VetoThreshold
+			matched = true
+			continue
+		}
+
+		resolved.Quorum = sdk.MaxDec(resolved.Quorum, override.Quorum)
+		resolved.Threshold = sdk.MaxDec(resolved.Threshold, override.Threshold)
+		resolved.VetoThreshold = sdk.MinDec(resolved.VetoThreshold, override.VetoThreshold)
+	}
+
+	return resolved
 }
 
 // String implements stringer insterface
@@ -131,25 +208,69 @@ func validateTallyParams(i interface{}) error {
 	if v.VetoThreshold.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedThreshold.IsNil() || v.ExpeditedThreshold.LTE(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s", v.ExpeditedThreshold)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+
+	typeURLs := make(map[string]bool, len(v.Overrides))
+	for _, override := range v.Overrides {
+		if err := validateTallyOverride(override); err != nil {
+			return err
+		}
+		if typeURLs[override.TypeUrl] {
+			return fmt.Errorf("duplicate tally override for type %s", override.TypeUrl)
+		}
+		typeURLs[override.TypeUrl] = true
+	}
+
+	return nil
+}
+
+func validateTallyOverride(o TallyOverride) error {
+	if strings.TrimSpace(o.TypeUrl) == "" {
+		return fmt.Errorf("tally override type URL cannot be blank")
+	}
+	if o.Quorum.IsNil() || o.Quorum.IsNegative() {
+		return fmt.Errorf("quorom of tally override for type %s cannot be negative: %s", o.TypeUrl, o.Quorum)
+	}
+	if o.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("quorom of tally override for type %s too large: %s", o.TypeUrl, o.Quorum)
+	}
+	if o.Threshold.IsNil() || !o.Threshold.IsPositive() {
+		return fmt.Errorf("vote threshold of tally override for type %s must be positive: %s", o.TypeUrl, o.Threshold)
+	}
+	if o.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("vote threshold of tally override for type %s too large: %s", o.TypeUrl, o.Threshold)
+	}
+	if o.VetoThreshold.IsNil() || !o.VetoThreshold.IsPositive() {
+		return fmt.Errorf("veto threshold of tally override for type %s must be positive: %s", o.TypeUrl, o.VetoThreshold)
+	}
+	if o.VetoThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("veto threshold of tally override for type %s too large: %s", o.TypeUrl, o.VetoThreshold)
+	}
 
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 // String implements stringer interface
@@ -167,6 +288,12 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be shorter than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
 
 	return nil
 }
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTallyParamsForTypes(t *testing.T) {
+	tallyParams := DefaultTallyParams()
+	tallyParams.Overrides = []TallyOverride{
+		NewTallyOverride("/a", sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(3, 1)),
+		NewTallyOverride("/b", sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(55, 2), sdk.NewDecWithPrec(2, 1)),
+		NewTallyOverride("/c", sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(5, 1)),
+	}
+
+	testCases := []struct {
+		name     string
+		typeURLs []string
+		expected TallyParams
+	}{
+		{"no type", nil, DefaultTallyParams()},
+		{"type without override", []string{"/d"}, DefaultTallyParams()},
+		{
+			"single override",
+			[]string{"/d", "/c"},
+			NewTallyParams(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(5, 1), DefaultExpeditedThreshold),
+		},
+		{
+			"strictest values of several overrides",
+			[]string{"/a", "/b"},
+			NewTallyParams(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(2, 1), DefaultExpeditedThreshold),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resolved := tallyParams.ForTypes(tc.typeURLs...)
+			require.True(t, tc.expected.Equal(resolved), resolved.String())
+			require.Empty(t, resolved.Overrides)
+		})
+	}
+}
@@ -180,7 +180,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText      string = "Text"
+	ProposalTypeExecution string = "Execution"
 )
 
 // Implements Content Interface
@@ -212,8 +213,110 @@ func (tp TextProposal) String() string {
 	return string(out)
 }
 
+// Implements Content Interface
+var (
+	_ Content                       = &ExecutionProposal{}
+	_ types.UnpackInterfacesMessage = &ExecutionProposal{}
+)
+
+// NewExecutionProposal creates an execution proposal Content executing the
+// given messages, signed by the governance module account, once it passes.
+func NewExecutionProposal(title, description string, msgs []sdk.Msg) (*ExecutionProposal, error) {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return &ExecutionProposal{Title: title, Description: description, Messages: msgsAny}, nil
+}
+
+// GetTitle returns the proposal title
+func (ep *ExecutionProposal) GetTitle() string { return ep.Title }
+
+// GetDescription returns the proposal description
+func (ep *ExecutionProposal) GetDescription() string { return ep.Description }
+
+// ProposalRoute returns the proposal router key
+func (ep *ExecutionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "Execution"
+func (ep *ExecutionProposal) ProposalType() string { return ProposalTypeExecution }
+
+// GetMessages returns the cached messages of the proposal.
+func (ep *ExecutionProposal) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(ep.Messages))
+	for i, msgAny := range ep.Messages {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// ValidateBasic validates the content's title and description and each of its
+// messages.
+func (ep *ExecutionProposal) ValidateBasic() error {
+	if err := ValidateAbstract(ep); err != nil {
+		return err
+	}
+
+	if len(ep.Messages) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "execution proposal must have at least one message")
+	}
+
+	msgs, err := ep.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "message %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (ep ExecutionProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Execution Proposal:
+  Title:       %s
+  Description: %s
+  Messages:
+`, ep.Title, ep.Description))
+
+	for _, msg := range ep.Messages {
+		b.WriteString(fmt.Sprintf("    %s\n", msg.TypeUrl))
+	}
+
+	return b.String()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ep ExecutionProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, msgAny := range ep.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(msgAny, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:      {},
+	ProposalTypeExecution: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
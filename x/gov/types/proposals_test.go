@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +25,41 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestExecutionProposal_ValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	tests := []struct {
+		name        string
+		title       string
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{"valid", "title", []sdk.Msg{banktypes.NewMsgSend(addr1, addr2, coins)}, nil},
+		{"blank title", "", []sdk.Msg{banktypes.NewMsgSend(addr1, addr2, coins)}, ErrInvalidProposalContent},
+		{"no messages", "title", []sdk.Msg{}, ErrInvalidProposalContent},
+		{"invalid message", "title", []sdk.Msg{banktypes.NewMsgSend(addr1, addr2, nil)}, ErrInvalidProposalContent},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ep, err := NewExecutionProposal(tt.title, "description", tt.msgs)
+			require.NoError(t, err)
+
+			msgs, err := ep.GetMessages()
+			require.NoError(t, err)
+			require.Equal(t, tt.msgs, msgs)
+			require.Equal(t, ProposalTypeExecution, ep.ProposalType())
+			require.Equal(t, RouterKey, ep.ProposalRoute())
+
+			err = ep.ValidateBasic()
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -775,6 +776,10 @@ func (m *QueryTallyResultRequest) GetProposalId() uint64 {
 type QueryTallyResultResponse struct {
 	// tally defines the requested tally.
 	Tally TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+	// tally_params defines the tally params currently applying to the proposal,
+	// with the quorum, threshold and veto threshold of its tally overrides if
+	// any.
+	TallyParams TallyParams `protobuf:"bytes,2,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
 }
 
 func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultResponse{} }
@@ -817,6 +822,369 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+func (m *QueryTallyResultResponse) GetTallyParams() TallyParams {
+	if m != nil {
+		return m.TallyParams
+	}
+	return TallyParams{}
+}
+
+// QueryRunningTallyRequest is the request type for the Query/RunningTally RPC
+// method.
+type QueryRunningTallyRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryRunningTallyRequest) Reset()         { *m = QueryRunningTallyRequest{} }
+func (m *QueryRunningTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRunningTallyRequest) ProtoMessage()    {}
+func (*QueryRunningTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{16}
+}
+func (m *QueryRunningTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRunningTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunningTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRunningTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunningTallyRequest.Merge(m, src)
+}
+func (m *QueryRunningTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunningTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunningTallyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRunningTallyRequest proto.InternalMessageInfo
+
+func (m *QueryRunningTallyRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryRunningTallyResponse is the response type for the Query/RunningTally RPC
+// method.
+type QueryRunningTallyResponse struct {
+	// tally defines the voting power cast for each option.
+	Tally TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+	// voting_power defines the total voting power cast.
+	VotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power"`
+	// bonded_tokens defines the total bonded tokens.
+	BondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens"`
+	// turnout defines the ratio of the voting power cast to the bonded tokens.
+	Turnout github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=turnout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"turnout"`
+	// quorum_reached defines whether the turnout reaches the quorum applying to
+	// the proposal.
+	QuorumReached bool `protobuf:"varint,5,opt,name=quorum_reached,json=quorumReached,proto3" json:"quorum_reached,omitempty"`
+	// projected_status defines the status the proposal would have if its voting
+	// period ended now, passed or rejected.
+	ProjectedStatus ProposalStatus `protobuf:"varint,6,opt,name=projected_status,json=projectedStatus,proto3,enum=cosmos.gov.v1beta1.ProposalStatus" json:"projected_status,omitempty"`
+}
+
+func (m *QueryRunningTallyResponse) Reset()         { *m = QueryRunningTallyResponse{} }
+func (m *QueryRunningTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRunningTallyResponse) ProtoMessage()    {}
+func (*QueryRunningTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{17}
+}
+func (m *QueryRunningTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRunningTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunningTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRunningTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunningTallyResponse.Merge(m, src)
+}
+func (m *QueryRunningTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunningTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunningTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRunningTallyResponse proto.InternalMessageInfo
+
+func (m *QueryRunningTallyResponse) GetTally() TallyResult {
+	if m != nil {
+		return m.Tally
+	}
+	return TallyResult{}
+}
+
+func (m *QueryRunningTallyResponse) GetQuorumReached() bool {
+	if m != nil {
+		return m.QuorumReached
+	}
+	return false
+}
+
+func (m *QueryRunningTallyResponse) GetProjectedStatus() ProposalStatus {
+	if m != nil {
+		return m.ProjectedStatus
+	}
+	return StatusNil
+}
+
+// QueryValidatorTallyBreakdownRequest is the request type for the
+// Query/ValidatorTallyBreakdown RPC method.
+type QueryValidatorTallyBreakdownRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorTallyBreakdownRequest) Reset()         { *m = QueryValidatorTallyBreakdownRequest{} }
+func (m *QueryValidatorTallyBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorTallyBreakdownRequest) ProtoMessage()    {}
+func (*QueryValidatorTallyBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{18}
+}
+func (m *QueryValidatorTallyBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorTallyBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorTallyBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorTallyBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorTallyBreakdownRequest.Merge(m, src)
+}
+func (m *QueryValidatorTallyBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorTallyBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorTallyBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorTallyBreakdownRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorTallyBreakdownRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryValidatorTallyBreakdownRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorTallyBreakdownResponse is the response type for the
+// Query/ValidatorTallyBreakdown RPC method.
+type QueryValidatorTallyBreakdownResponse struct {
+	// breakdowns defines the tally breakdown of the validators which voted, or
+	// whose delegators voted, on the proposal.
+	Breakdowns []ValidatorTallyBreakdown `protobuf:"bytes,1,rep,name=breakdowns,proto3" json:"breakdowns"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorTallyBreakdownResponse) Reset()         { *m = QueryValidatorTallyBreakdownResponse{} }
+func (m *QueryValidatorTallyBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorTallyBreakdownResponse) ProtoMessage()    {}
+func (*QueryValidatorTallyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{19}
+}
+func (m *QueryValidatorTallyBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorTallyBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorTallyBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorTallyBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorTallyBreakdownResponse.Merge(m, src)
+}
+func (m *QueryValidatorTallyBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorTallyBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorTallyBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorTallyBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorTallyBreakdownResponse) GetBreakdowns() []ValidatorTallyBreakdown {
+	if m != nil {
+		return m.Breakdowns
+	}
+	return nil
+}
+
+func (m *QueryValidatorTallyBreakdownResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorOverridesRequest is the request type for the
+// Query/DelegatorOverrides RPC method.
+type QueryDelegatorOverridesRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// validator_address optionally restricts the overrides to the delegators of
+	// a validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorOverridesRequest) Reset()         { *m = QueryDelegatorOverridesRequest{} }
+func (m *QueryDelegatorOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorOverridesRequest) ProtoMessage()    {}
+func (*QueryDelegatorOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{20}
+}
+func (m *QueryDelegatorOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOverridesRequest.Merge(m, src)
+}
+func (m *QueryDelegatorOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorOverridesRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorOverridesRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryDelegatorOverridesRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryDelegatorOverridesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorOverridesResponse is the response type for the
+// Query/DelegatorOverrides RPC method.
+type QueryDelegatorOverridesResponse struct {
+	// overrides defines the votes of the delegators who overrode the vote of
+	// their validator.
+	Overrides []DelegatorOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorOverridesResponse) Reset()         { *m = QueryDelegatorOverridesResponse{} }
+func (m *QueryDelegatorOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorOverridesResponse) ProtoMessage()    {}
+func (*QueryDelegatorOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{21}
+}
+func (m *QueryDelegatorOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorOverridesResponse.Merge(m, src)
+}
+func (m *QueryDelegatorOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorOverridesResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorOverridesResponse) GetOverrides() []DelegatorOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryDelegatorOverridesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1beta1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1beta1.QueryProposalResponse")
@@ -834,73 +1202,102 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1beta1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1beta1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryRunningTallyRequest)(nil), "cosmos.gov.v1beta1.QueryRunningTallyRequest")
+	proto.RegisterType((*QueryRunningTallyResponse)(nil), "cosmos.gov.v1beta1.QueryRunningTallyResponse")
+	proto.RegisterType((*QueryValidatorTallyBreakdownRequest)(nil), "cosmos.gov.v1beta1.QueryValidatorTallyBreakdownRequest")
+	proto.RegisterType((*QueryValidatorTallyBreakdownResponse)(nil), "cosmos.gov.v1beta1.QueryValidatorTallyBreakdownResponse")
+	proto.RegisterType((*QueryDelegatorOverridesRequest)(nil), "cosmos.gov.v1beta1.QueryDelegatorOverridesRequest")
+	proto.RegisterType((*QueryDelegatorOverridesResponse)(nil), "cosmos.gov.v1beta1.QueryDelegatorOverridesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x55,
-	0x10, 0xf6, 0x4b, 0x9c, 0xd6, 0x9e, 0xb4, 0x01, 0x86, 0x00, 0xd6, 0x12, 0xec, 0xb0, 0xa2, 0xad,
-	0x49, 0xa9, 0x97, 0x24, 0x05, 0xd4, 0x16, 0x50, 0x89, 0x50, 0x5b, 0x54, 0x09, 0x15, 0xa7, 0x02,
-	0x89, 0x03, 0xd1, 0xa6, 0x5e, 0x2d, 0x2b, 0x1c, 0xbf, 0xad, 0xdf, 0xb3, 0x45, 0x14, 0x22, 0x24,
-	0x4e, 0x20, 0x2e, 0xa0, 0x22, 0x6e, 0x88, 0x4a, 0x95, 0xf8, 0x2d, 0x3d, 0x56, 0x82, 0x03, 0x27,
-	0x84, 0x12, 0x0e, 0x88, 0xdf, 0xc0, 0x01, 0xed, 0x7b, 0xf3, 0xd6, 0xbb, 0xce, 0x3a, 0xbb, 0x29,
-	0x55, 0x4f, 0xb1, 0xe7, 0x7d, 0x33, 0xf3, 0x7d, 0x33, 0xf3, 0xe6, 0xc5, 0x50, 0xbf, 0xc5, 0xc5,
-	0x16, 0x17, 0x8e, 0xcf, 0x87, 0xce, 0x70, 0x79, 0xd3, 0x93, 0xee, 0xb2, 0x73, 0x7b, 0xe0, 0xf5,
-	0xb7, 0x5b, 0x61, 0x9f, 0x4b, 0x8e, 0xa8, 0xcf, 0x5b, 0x3e, 0x1f, 0xb6, 0xe8, 0xdc, 0x5a, 0x22,
-	0x9f, 0x4d, 0x57, 0x78, 0x1a, 0x1c, 0xbb, 0x86, 0xae, 0x1f, 0xf4, 0x5c, 0x19, 0xf0, 0x9e, 0xf6,
-	0xb7, 0xe6, 0x7d, 0xee, 0x73, 0xf5, 0xd1, 0x89, 0x3e, 0x91, 0x75, 0xc1, 0xe7, 0xdc, 0xef, 0x7a,
-	0x8e, 0x1b, 0x06, 0x8e, 0xdb, 0xeb, 0x71, 0xa9, 0x5c, 0x84, 0x39, 0xcd, 0xe0, 0x14, 0xe5, 0x57,
-	0xa7, 0xf6, 0x1b, 0x30, 0xff, 0x41, 0x94, 0xf3, 0x46, 0x9f, 0x87, 0x5c, 0xb8, 0xdd, 0xb6, 0x77,
-	0x7b, 0xe0, 0x09, 0x89, 0x0d, 0x98, 0x0d, 0xc9, 0xb4, 0x11, 0x74, 0x6a, 0x6c, 0x91, 0x35, 0xcb,
-	0x6d, 0x30, 0xa6, 0xf7, 0x3a, 0xf6, 0x47, 0xf0, 0xcc, 0x98, 0xa3, 0x08, 0x79, 0x4f, 0x78, 0xf8,
-	0x36, 0x54, 0x0c, 0x4c, 0xb9, 0xcd, 0xae, 0x2c, 0xb4, 0x0e, 0xca, 0x6e, 0x19, 0xbf, 0xb5, 0xf2,
-	0xfd, 0x3f, 0x1a, 0xa5, 0x76, 0xec, 0x63, 0xff, 0xc3, 0xc6, 0x22, 0x0b, 0xc3, 0xe9, 0x3a, 0x3c,
-	0x11, 0x73, 0x12, 0xd2, 0x95, 0x03, 0xa1, 0x12, 0xcc, 0xad, 0xd8, 0x87, 0x25, 0x58, 0x57, 0xc8,
-	0xf6, 0x5c, 0x98, 0xfa, 0x8e, 0xf3, 0x30, 0x33, 0xe4, 0xd2, 0xeb, 0xd7, 0xa6, 0x16, 0x59, 0xb3,
-	0xda, 0xd6, 0x5f, 0x70, 0x01, 0xaa, 0x1d, 0x2f, 0xe4, 0x22, 0x90, 0xbc, 0x5f, 0x9b, 0x56, 0x27,
-	0x23, 0x03, 0x5e, 0x01, 0x18, 0xb5, 0xa4, 0x56, 0x56, 0xe2, 0x4e, 0x9b, 0xdc, 0x51, 0xff, 0x5a,
-	0xba, 0xd9, 0x31, 0x05, 0xd7, 0xf7, 0x88, 0x7c, 0x3b, 0xe1, 0x79, 0xb1, 0xf2, 0xf5, 0xdd, 0x46,
-	0xe9, 0xef, 0xbb, 0x8d, 0x92, 0x7d, 0x8f, 0xc1, 0xb3, 0xe3, 0x62, 0xa9, 0x8e, 0x97, 0xa1, 0x6a,
-	0x28, 0x47, 0x3a, 0xa7, 0x0b, 0x16, 0x72, 0xe4, 0x84, 0x57, 0x53, 0x74, 0xa7, 0x14, 0xdd, 0x33,
-	0xb9, 0x74, 0x75, 0xfa, 0x24, 0x5f, 0x7b, 0x1d, 0x9e, 0x54, 0x24, 0x3f, 0xe4, 0xd2, 0x2b, 0x3a,
-	0x20, 0xd9, 0x05, 0x4e, 0x48, 0xbf, 0x0a, 0x4f, 0x25, 0x82, 0x92, 0xe8, 0x15, 0x28, 0x47, 0x38,
-	0x1a, 0x9c, 0x5a, 0x96, 0xde, 0x08, 0x4f, 0x5a, 0x15, 0xd6, 0xfe, 0x22, 0x11, 0x48, 0x14, 0xa6,
-	0x77, 0x25, 0xa3, 0x38, 0x0f, 0xd1, 0x4b, 0xfb, 0x0e, 0x03, 0x4c, 0xa6, 0x27, 0x21, 0xe7, 0xb5,
-	0x7a, 0xd3, 0xb9, 0x3c, 0x25, 0x1a, 0xfc, 0xe8, 0x3a, 0xf6, 0x1a, 0x91, 0xba, 0xe1, 0xf6, 0xdd,
-	0xad, 0x54, 0x51, 0x94, 0x61, 0x43, 0x6e, 0x87, 0xba, 0xc8, 0xd5, 0xc8, 0x2d, 0x32, 0xdd, 0xdc,
-	0x0e, 0x3d, 0xfb, 0x5f, 0x06, 0x4f, 0xa7, 0xfc, 0x48, 0xcd, 0x75, 0x38, 0x39, 0xe4, 0x32, 0xe8,
-	0xf9, 0x1b, 0x1a, 0x4c, 0xfd, 0x59, 0x9c, 0xa0, 0x2a, 0xe8, 0xf9, 0x3a, 0x00, 0xa9, 0x3b, 0x31,
-	0x4c, 0xd8, 0xf0, 0x7d, 0x98, 0xa3, 0x2b, 0x65, 0xa2, 0x69, 0xa1, 0x2f, 0x66, 0x45, 0x7b, 0x57,
-	0x23, 0x53, 0xe1, 0x4e, 0x76, 0x92, 0x46, 0xbc, 0x06, 0x27, 0xa4, 0xdb, 0xed, 0x6e, 0x9b, 0x68,
-	0xd3, 0x2a, 0x5a, 0x23, 0x2b, 0xda, 0xcd, 0x08, 0x97, 0x8a, 0x35, 0x2b, 0x47, 0x26, 0xfb, 0x13,
-	0x52, 0x4f, 0x49, 0x0b, 0xcf, 0x52, 0x6a, 0x6b, 0x4c, 0x8d, 0x6d, 0x8d, 0xc4, 0xc8, 0xaf, 0xd3,
-	0xb2, 0x8d, 0xe3, 0x53, 0x79, 0x2f, 0xc1, 0x71, 0x82, 0x53, 0x61, 0x9f, 0x3f, 0xa4, 0x14, 0x44,
-	0xdc, 0x78, 0xd8, 0x5f, 0xa6, 0x83, 0x3e, 0xfe, 0x1b, 0xf0, 0xb3, 0x59, 0xd8, 0x23, 0x06, 0xa4,
-	0xeb, 0x2d, 0xa8, 0x10, 0x4b, 0x73, 0x0f, 0x0a, 0x08, 0x8b, 0x5d, 0x1e, 0xdd, 0x6d, 0xb8, 0x08,
-	0xcf, 0x29, 0x82, 0xaa, 0xfd, 0x6d, 0x4f, 0x0c, 0xba, 0xf2, 0x08, 0xef, 0x5c, 0xed, 0xa0, 0x6f,
-	0xdc, 0xb7, 0x19, 0x35, 0x3e, 0xd4, 0xb5, 0xc9, 0x23, 0xa7, 0xfd, 0xcc, 0x5d, 0x57, 0x3e, 0x2b,
-	0xbf, 0x55, 0x61, 0x46, 0x45, 0xc6, 0x1f, 0x18, 0x54, 0xcc, 0x16, 0xc7, 0x66, 0x56, 0x90, 0xac,
-	0x27, 0xda, 0x7a, 0xb9, 0x00, 0x52, 0x13, 0xb5, 0x57, 0xbf, 0xfa, 0xf5, 0xaf, 0x3b, 0x53, 0xe7,
-	0xf0, 0xac, 0x93, 0xf1, 0xcf, 0x40, 0xfc, 0x60, 0x38, 0x3b, 0x89, 0x52, 0xec, 0xe2, 0x37, 0x0c,
-	0xaa, 0xf1, 0xb3, 0x84, 0xf9, 0xd9, 0xcc, 0xe4, 0x59, 0x4b, 0x45, 0xa0, 0xc4, 0xec, 0x94, 0x62,
-	0xd6, 0xc0, 0x17, 0x0e, 0x65, 0x86, 0x3f, 0x32, 0x28, 0x47, 0xeb, 0x12, 0x5f, 0x9a, 0x18, 0x3b,
-	0xf1, 0x38, 0x59, 0xa7, 0x72, 0x50, 0x94, 0xfc, 0x1d, 0x95, 0xfc, 0x12, 0x5e, 0x38, 0x42, 0x59,
-	0x1c, 0xb5, 0xa9, 0x9d, 0x1d, 0xf5, 0x9c, 0xed, 0xe2, 0xf7, 0x0c, 0x66, 0xd4, 0xe6, 0xc7, 0xc3,
-	0x73, 0xc6, 0xc5, 0x39, 0x9d, 0x07, 0x23, 0x6e, 0x17, 0x14, 0xb7, 0x55, 0x5c, 0x3e, 0x32, 0x37,
-	0xfc, 0x96, 0xc1, 0x31, 0xda, 0x8d, 0x93, 0xb3, 0xa5, 0x5e, 0x06, 0xeb, 0x4c, 0x2e, 0x8e, 0x68,
-	0xbd, 0xaa, 0x68, 0x2d, 0x61, 0x33, 0x93, 0x96, 0xc2, 0x3a, 0x3b, 0x89, 0x47, 0x66, 0x17, 0x7f,
-	0x61, 0x70, 0x9c, 0x6e, 0x38, 0x4e, 0x4e, 0x93, 0x5e, 0xb9, 0x56, 0x33, 0x1f, 0x48, 0x84, 0xae,
-	0x29, 0x42, 0x6b, 0x78, 0xf9, 0x28, 0x75, 0x32, 0x2b, 0xc6, 0xd9, 0x89, 0xd7, 0xf4, 0x2e, 0xfe,
-	0xc4, 0xa0, 0x62, 0x56, 0x18, 0xe6, 0x12, 0x10, 0xf9, 0xd7, 0x70, 0x7c, 0x1f, 0xda, 0x6f, 0x2a,
-	0xae, 0xaf, 0xe3, 0xf9, 0x87, 0xe1, 0x8a, 0xf7, 0x18, 0xcc, 0x26, 0xb6, 0x09, 0x9e, 0x9d, 0x98,
-	0xf8, 0xe0, 0x9e, 0xb3, 0x5e, 0x29, 0x06, 0xfe, 0x3f, 0xc3, 0xa7, 0xd6, 0xda, 0xda, 0xda, 0xfd,
-	0xbd, 0x3a, 0x7b, 0xb0, 0x57, 0x67, 0x7f, 0xee, 0xd5, 0xd9, 0x77, 0xfb, 0xf5, 0xd2, 0x83, 0xfd,
-	0x7a, 0xe9, 0xf7, 0xfd, 0x7a, 0xe9, 0xe3, 0xa6, 0x1f, 0xc8, 0x4f, 0x07, 0x9b, 0xad, 0x5b, 0x7c,
-	0xcb, 0x84, 0xd5, 0x7f, 0xce, 0x89, 0xce, 0x67, 0xce, 0xe7, 0x2a, 0x47, 0x34, 0x32, 0x62, 0xf3,
-	0x98, 0xfa, 0x6d, 0xb2, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x86, 0xe2, 0x1a, 0x4f,
-	0x0d, 0x00, 0x00,
+	// 1336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x24, 0x4e, 0x6b, 0xbf, 0xfc, 0x68, 0x3a, 0xdf, 0x7c, 0xa9, 0x31, 0xc1, 0x0e, 0x4b,
+	0x93, 0x9a, 0x84, 0x78, 0x49, 0x52, 0x28, 0x6d, 0x28, 0x34, 0xa6, 0x6a, 0x12, 0x55, 0x40, 0xb3,
+	0x89, 0x40, 0xe2, 0x80, 0xb5, 0xce, 0x8e, 0xb6, 0x26, 0xce, 0x8e, 0xb3, 0xbb, 0x76, 0x89, 0x42,
+	0x84, 0xc4, 0x09, 0x84, 0x84, 0x40, 0x45, 0xdc, 0x10, 0x85, 0x4a, 0x1c, 0xb9, 0xc1, 0x19, 0x71,
+	0x40, 0x3d, 0x16, 0x71, 0x41, 0x1c, 0x2a, 0x94, 0x70, 0x40, 0xfc, 0x0d, 0x1c, 0xd0, 0xce, 0x8f,
+	0xcd, 0xda, 0x59, 0xdb, 0xeb, 0x34, 0xea, 0x29, 0xf6, 0x9b, 0xf7, 0x3e, 0xef, 0xf3, 0xde, 0xbc,
+	0x99, 0xf9, 0x38, 0x90, 0x59, 0xa7, 0xce, 0x26, 0x75, 0x54, 0x93, 0xd6, 0xd5, 0xfa, 0x4c, 0x89,
+	0xb8, 0xfa, 0x8c, 0xba, 0x55, 0x23, 0xf6, 0x76, 0xbe, 0x6a, 0x53, 0x97, 0x62, 0xcc, 0xd7, 0xf3,
+	0x26, 0xad, 0xe7, 0xc5, 0x7a, 0x7a, 0x52, 0xc4, 0x94, 0x74, 0x87, 0x70, 0x67, 0x3f, 0xb4, 0xaa,
+	0x9b, 0x65, 0x4b, 0x77, 0xcb, 0xd4, 0xe2, 0xf1, 0xe9, 0x11, 0x93, 0x9a, 0x94, 0x7d, 0x54, 0xbd,
+	0x4f, 0xc2, 0x3a, 0x6a, 0x52, 0x6a, 0x56, 0x88, 0xaa, 0x57, 0xcb, 0xaa, 0x6e, 0x59, 0xd4, 0x65,
+	0x21, 0x8e, 0x5c, 0x0d, 0xe1, 0xe4, 0xe5, 0x67, 0xab, 0xca, 0x05, 0x18, 0x59, 0xf1, 0x72, 0xde,
+	0xb0, 0x69, 0x95, 0x3a, 0x7a, 0x45, 0x23, 0x5b, 0x35, 0xe2, 0xb8, 0x38, 0x0b, 0xfd, 0x55, 0x61,
+	0x2a, 0x96, 0x8d, 0x14, 0x1a, 0x43, 0xb9, 0xb8, 0x06, 0xd2, 0xb4, 0x6c, 0x28, 0x6f, 0xc1, 0xff,
+	0x9b, 0x02, 0x9d, 0x2a, 0xb5, 0x1c, 0x82, 0x5f, 0x86, 0x84, 0x74, 0x63, 0x61, 0xfd, 0xb3, 0xa3,
+	0xf9, 0xc3, 0x65, 0xe7, 0x65, 0x5c, 0x21, 0x7e, 0xef, 0x41, 0x36, 0xa6, 0xf9, 0x31, 0xca, 0x3f,
+	0xa8, 0x09, 0xd9, 0x91, 0x9c, 0xae, 0xc3, 0x29, 0x9f, 0x93, 0xe3, 0xea, 0x6e, 0xcd, 0x61, 0x09,
+	0x86, 0x66, 0x95, 0x76, 0x09, 0x56, 0x99, 0xa7, 0x36, 0x54, 0x6d, 0xf8, 0x8e, 0x47, 0xa0, 0xaf,
+	0x4e, 0x5d, 0x62, 0xa7, 0x7a, 0xc6, 0x50, 0x2e, 0xa9, 0xf1, 0x2f, 0x78, 0x14, 0x92, 0x06, 0xa9,
+	0x52, 0xa7, 0xec, 0x52, 0x3b, 0xd5, 0xcb, 0x56, 0x0e, 0x0c, 0xf8, 0x1a, 0xc0, 0xc1, 0x96, 0xa4,
+	0xe2, 0xac, 0xb8, 0x09, 0x99, 0xdb, 0xdb, 0xbf, 0x3c, 0xdf, 0x6c, 0x9f, 0x82, 0x6e, 0x12, 0x41,
+	0x5e, 0x0b, 0x44, 0x5e, 0x4a, 0x7c, 0x74, 0x27, 0x1b, 0xfb, 0xfb, 0x4e, 0x36, 0xa6, 0xdc, 0x45,
+	0xf0, 0x58, 0x73, 0xb1, 0xa2, 0x8f, 0x57, 0x20, 0x29, 0x29, 0x7b, 0x75, 0xf6, 0x46, 0x6c, 0xe4,
+	0x41, 0x10, 0x5e, 0x6c, 0xa0, 0xdb, 0xc3, 0xe8, 0x9e, 0xeb, 0x48, 0x97, 0xa7, 0x0f, 0xf2, 0x55,
+	0x56, 0x61, 0x98, 0x91, 0x7c, 0x93, 0xba, 0x24, 0xea, 0x80, 0x84, 0x37, 0x38, 0x50, 0xfa, 0x22,
+	0x9c, 0x0e, 0x80, 0x8a, 0xa2, 0x67, 0x21, 0xee, 0xf9, 0x89, 0xc1, 0x49, 0x85, 0xd5, 0xeb, 0xf9,
+	0x8b, 0x5a, 0x99, 0xaf, 0xf2, 0x7e, 0x00, 0xc8, 0x89, 0x4c, 0xef, 0x5a, 0x48, 0x73, 0x8e, 0xb0,
+	0x97, 0xca, 0x6d, 0x04, 0x38, 0x98, 0x5e, 0x14, 0x72, 0x9e, 0x57, 0x2f, 0x77, 0xae, 0x53, 0x25,
+	0xdc, 0xf9, 0xf8, 0x76, 0xec, 0x79, 0x41, 0xea, 0x86, 0x6e, 0xeb, 0x9b, 0x0d, 0x4d, 0x61, 0x86,
+	0xa2, 0xbb, 0x5d, 0xe5, 0x4d, 0x4e, 0x6a, 0xc0, 0x4d, 0x6b, 0xdb, 0x55, 0xa2, 0xfc, 0x8b, 0xe0,
+	0x7f, 0x0d, 0x71, 0xa2, 0x9a, 0xeb, 0x30, 0x58, 0xa7, 0x6e, 0xd9, 0x32, 0x8b, 0xdc, 0x59, 0xec,
+	0xcf, 0x58, 0x8b, 0xaa, 0xca, 0x96, 0xc9, 0x01, 0x44, 0x75, 0x03, 0xf5, 0x80, 0x0d, 0xbf, 0x0e,
+	0x43, 0xe2, 0x48, 0x49, 0x34, 0x5e, 0xe8, 0x53, 0x61, 0x68, 0x57, 0xb9, 0x67, 0x03, 0xdc, 0xa0,
+	0x11, 0x34, 0xe2, 0x25, 0x18, 0x70, 0xf5, 0x4a, 0x65, 0x5b, 0xa2, 0xf5, 0x32, 0xb4, 0x6c, 0x18,
+	0xda, 0x9a, 0xe7, 0xd7, 0x80, 0xd5, 0xef, 0x1e, 0x98, 0x94, 0x77, 0x44, 0xf5, 0x22, 0x69, 0xe4,
+	0x59, 0x6a, 0xb8, 0x35, 0x7a, 0x9a, 0x6e, 0x8d, 0xc0, 0xc8, 0xaf, 0xc2, 0x48, 0x23, 0xbe, 0x68,
+	0xef, 0x3c, 0x9c, 0x14, 0xee, 0xa2, 0xb1, 0x4f, 0xb4, 0x69, 0x85, 0x20, 0x2e, 0x23, 0x94, 0x0f,
+	0x1a, 0x41, 0x1f, 0xfd, 0x09, 0xf8, 0x5a, 0x5e, 0xd8, 0x07, 0x0c, 0x44, 0x5d, 0x97, 0x21, 0x21,
+	0x58, 0xca, 0x73, 0x10, 0xa1, 0x30, 0x3f, 0xe4, 0xf8, 0x4e, 0xc3, 0x25, 0x38, 0xc3, 0x08, 0xb2,
+	0xed, 0xd7, 0x88, 0x53, 0xab, 0x44, 0xde, 0x5b, 0xe5, 0x1b, 0x04, 0xa9, 0xc3, 0xc1, 0xfe, 0xc6,
+	0xf5, 0xb1, 0xf9, 0x49, 0xa1, 0x0e, 0x33, 0xc7, 0xe3, 0xe4, 0x61, 0x67, 0x31, 0x87, 0xe6, 0xb6,
+	0xe7, 0xc8, 0x73, 0x3b, 0x2f, 0x28, 0x6a, 0x35, 0xcb, 0x2a, 0x5b, 0xa6, 0xc8, 0x18, 0xb1, 0xc0,
+	0x9f, 0x7a, 0xe1, 0xf1, 0x90, 0xe8, 0xe3, 0xa8, 0x70, 0x05, 0x06, 0xe4, 0xb5, 0x41, 0x6f, 0xc9,
+	0x97, 0xa0, 0x90, 0xf7, 0x5c, 0xfe, 0x78, 0x90, 0x9d, 0x30, 0xcb, 0xee, 0xcd, 0x5a, 0x29, 0xbf,
+	0x4e, 0x37, 0x55, 0xa1, 0x51, 0xf8, 0x9f, 0x69, 0xc7, 0xd8, 0x50, 0xbd, 0x1b, 0xca, 0xc9, 0x2f,
+	0x5b, 0xae, 0xd6, 0x2f, 0x6e, 0x0f, 0x0f, 0x02, 0xaf, 0xc2, 0x60, 0x89, 0x5a, 0x06, 0x31, 0x8a,
+	0x2e, 0xdd, 0x20, 0x16, 0x3f, 0xed, 0xdd, 0x63, 0x0e, 0x70, 0x90, 0x35, 0x86, 0x81, 0x97, 0xe0,
+	0xa4, 0x5b, 0xb3, 0x2d, 0x5a, 0x73, 0x53, 0xf1, 0xae, 0xe1, 0xae, 0x92, 0x75, 0x4d, 0x86, 0xe3,
+	0x71, 0x18, 0xda, 0xaa, 0x51, 0xbb, 0xb6, 0x59, 0xb4, 0x89, 0xbe, 0x7e, 0x93, 0x18, 0xa9, 0xbe,
+	0x31, 0x94, 0x4b, 0x68, 0x83, 0xdc, 0xaa, 0x71, 0x23, 0x7e, 0x0d, 0x86, 0xab, 0x36, 0x7d, 0x97,
+	0xac, 0xbb, 0xc4, 0x90, 0x52, 0xe6, 0x44, 0x64, 0x29, 0x73, 0xca, 0x8f, 0xe5, 0x06, 0xe5, 0x53,
+	0x04, 0x4f, 0xf3, 0x37, 0x48, 0xaf, 0x94, 0x0d, 0xdd, 0xa5, 0x36, 0xdb, 0x92, 0x82, 0x4d, 0xf4,
+	0x0d, 0x83, 0xde, 0xb2, 0x1e, 0xf9, 0x95, 0xf0, 0x33, 0x82, 0xb3, 0xed, 0x09, 0x89, 0xf1, 0x5a,
+	0x01, 0x28, 0x49, 0xa3, 0xbc, 0x23, 0xa6, 0x42, 0x5f, 0x95, 0x70, 0x20, 0x31, 0x6f, 0x01, 0x90,
+	0xe3, 0xbb, 0x35, 0x7e, 0x44, 0x90, 0x11, 0xf7, 0x5a, 0x85, 0x98, 0x5e, 0xee, 0x37, 0xea, 0xc4,
+	0xb6, 0xcb, 0x46, 0x17, 0x2a, 0x63, 0x0a, 0x4e, 0xd7, 0x25, 0xf3, 0xa2, 0x6e, 0x18, 0x36, 0x71,
+	0x1c, 0xf1, 0x42, 0x0c, 0xfb, 0x0b, 0x0b, 0xdc, 0xde, 0xd4, 0xfd, 0xde, 0x23, 0x77, 0xff, 0x07,
+	0x04, 0xd9, 0x96, 0xc4, 0x45, 0xe3, 0x97, 0x21, 0x49, 0xa5, 0x51, 0xf4, 0x7d, 0x3c, 0xfc, 0x6e,
+	0x6e, 0x82, 0x90, 0x32, 0xd3, 0x8f, 0x3e, 0xb6, 0x86, 0xcf, 0x7e, 0x3b, 0x04, 0x7d, 0x8c, 0x37,
+	0xfe, 0x02, 0x41, 0x42, 0x0e, 0x3d, 0xce, 0x85, 0xf1, 0x0a, 0xfb, 0xd1, 0x92, 0x7e, 0x26, 0x82,
+	0x27, 0xcf, 0xab, 0xcc, 0x7d, 0xf8, 0xdb, 0x5f, 0xb7, 0x7b, 0xa6, 0xf1, 0x94, 0x1a, 0xf2, 0xf3,
+	0xc8, 0x97, 0xd0, 0xea, 0x4e, 0x60, 0x7b, 0x77, 0xf1, 0xc7, 0x08, 0x92, 0x12, 0xc9, 0xc1, 0x9d,
+	0xb3, 0xc9, 0x39, 0x49, 0x4f, 0x46, 0x71, 0x15, 0xcc, 0xc6, 0x19, 0xb3, 0x2c, 0x7e, 0xb2, 0x2d,
+	0x33, 0xfc, 0x25, 0x82, 0xb8, 0x27, 0x20, 0xf1, 0xd9, 0x96, 0xd8, 0x01, 0xb9, 0x9e, 0x1e, 0xef,
+	0xe0, 0x25, 0x92, 0x2f, 0xb0, 0xe4, 0xf3, 0xf8, 0x62, 0x17, 0x6d, 0x51, 0x99, 0x76, 0x55, 0x77,
+	0xbc, 0x3f, 0xf6, 0x2e, 0xfe, 0x1c, 0x41, 0x9f, 0x87, 0xe9, 0xe0, 0xf6, 0x39, 0xfd, 0xe6, 0x4c,
+	0x74, 0x72, 0x13, 0xdc, 0x2e, 0x32, 0x6e, 0x73, 0x78, 0xa6, 0x6b, 0x6e, 0xf8, 0x13, 0x04, 0x27,
+	0x84, 0x5a, 0x6c, 0x9d, 0xad, 0x41, 0x2b, 0xa7, 0xcf, 0x75, 0xf4, 0x13, 0xb4, 0x9e, 0x63, 0xb4,
+	0x26, 0x71, 0x2e, 0x94, 0x16, 0xf3, 0x55, 0x77, 0x02, 0xb2, 0x7b, 0x17, 0x7f, 0x87, 0xe0, 0xa4,
+	0xd0, 0x3c, 0xb8, 0x75, 0x9a, 0x46, 0x11, 0x9a, 0xce, 0x75, 0x76, 0x14, 0x84, 0x96, 0x18, 0xa1,
+	0x02, 0xbe, 0xd2, 0x4d, 0x9f, 0xa4, 0xe8, 0x52, 0x77, 0xc4, 0x27, 0x6a, 0xef, 0xe2, 0xaf, 0x10,
+	0x24, 0x04, 0xba, 0x83, 0x3b, 0x12, 0x70, 0x3a, 0x1f, 0xc3, 0x66, 0x85, 0xa8, 0xbc, 0xc4, 0xb8,
+	0xbe, 0x80, 0xcf, 0x1f, 0x85, 0x2b, 0xbe, 0x8b, 0xa0, 0x3f, 0x20, 0x3e, 0xf0, 0x54, 0xcb, 0xc4,
+	0x87, 0x95, 0x5f, 0xfa, 0xd9, 0x68, 0xce, 0x0f, 0x33, 0x7c, 0x5c, 0x05, 0x7d, 0x8f, 0x60, 0x20,
+	0xa8, 0xad, 0x70, 0xeb, 0xcc, 0x21, 0x02, 0x2e, 0x3d, 0x1d, 0xd1, 0xfb, 0x61, 0x4e, 0xb0, 0xcd,
+	0x91, 0x8a, 0x9c, 0xf0, 0xaf, 0x08, 0xce, 0xb4, 0x78, 0x6f, 0xf1, 0x85, 0xd6, 0x87, 0xb5, 0xad,
+	0xf6, 0x48, 0xbf, 0xd8, 0x7d, 0xa0, 0xa8, 0xe8, 0x55, 0x56, 0xd1, 0x65, 0x3c, 0xdf, 0x75, 0xeb,
+	0x8b, 0xbe, 0x2c, 0xc0, 0xbf, 0x20, 0xc0, 0x87, 0x9f, 0x43, 0x3c, 0xdb, 0x66, 0x54, 0x5b, 0x3c,
+	0xfa, 0xe9, 0xb9, 0xae, 0x62, 0x44, 0x11, 0x8b, 0xac, 0x88, 0x05, 0xfc, 0x4a, 0x77, 0x83, 0x2e,
+	0xf0, 0x8a, 0xfe, 0x6b, 0x5b, 0x28, 0xdc, 0xdb, 0xcb, 0xa0, 0xfb, 0x7b, 0x19, 0xf4, 0xe7, 0x5e,
+	0x06, 0x7d, 0xb6, 0x9f, 0x89, 0xdd, 0xdf, 0xcf, 0xc4, 0x7e, 0xdf, 0xcf, 0xc4, 0xde, 0xce, 0xb5,
+	0x15, 0xab, 0xef, 0xb1, 0x8c, 0x4c, 0xb2, 0x96, 0x4e, 0xb0, 0xff, 0xfd, 0xcd, 0xfd, 0x37, 0x00,
+	0x43, 0x92, 0x76, 0x56, 0xaf, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -931,6 +1328,16 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// RunningTally queries the running tally of a proposal in voting period,
+	// with its turnout and projected outcome.
+	RunningTally(ctx context.Context, in *QueryRunningTallyRequest, opts ...grpc.CallOption) (*QueryRunningTallyResponse, error)
+	// ValidatorTallyBreakdown queries the part of the final tally of a proposal
+	// carried by each validator.
+	ValidatorTallyBreakdown(ctx context.Context, in *QueryValidatorTallyBreakdownRequest, opts ...grpc.CallOption) (*QueryValidatorTallyBreakdownResponse, error)
+	// DelegatorOverrides queries the delegators who overrode the vote of their
+	// validator in the final tally of a proposal, optionally of a single
+	// validator.
+	DelegatorOverrides(ctx context.Context, in *QueryDelegatorOverridesRequest, opts ...grpc.CallOption) (*QueryDelegatorOverridesResponse, error)
 }
 
 type queryClient struct {
@@ -1013,6 +1420,33 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) RunningTally(ctx context.Context, in *QueryRunningTallyRequest, opts ...grpc.CallOption) (*QueryRunningTallyResponse, error) {
+	out := new(QueryRunningTallyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/RunningTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorTallyBreakdown(ctx context.Context, in *QueryValidatorTallyBreakdownRequest, opts ...grpc.CallOption) (*QueryValidatorTallyBreakdownResponse, error) {
+	out := new(QueryValidatorTallyBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/ValidatorTallyBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorOverrides(ctx context.Context, in *QueryDelegatorOverridesRequest, opts ...grpc.CallOption) (*QueryDelegatorOverridesResponse, error) {
+	out := new(QueryDelegatorOverridesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/DelegatorOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1031,6 +1465,16 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// RunningTally queries the running tally of a proposal in voting period,
+	// with its turnout and projected outcome.
+	RunningTally(context.Context, *QueryRunningTallyRequest) (*QueryRunningTallyResponse, error)
+	// ValidatorTallyBreakdown queries the part of the final tally of a proposal
+	// carried by each validator.
+	ValidatorTallyBreakdown(context.Context, *QueryValidatorTallyBreakdownRequest) (*QueryValidatorTallyBreakdownResponse, error)
+	// DelegatorOverrides queries the delegators who overrode the vote of their
+	// validator in the final tally of a proposal, optionally of a single
+	// validator.
+	DelegatorOverrides(context.Context, *QueryDelegatorOverridesRequest) (*QueryDelegatorOverridesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1061,6 +1505,15 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) RunningTally(ctx context.Context, req *QueryRunningTallyRequest) (*QueryRunningTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunningTally not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorTallyBreakdown(ctx context.Context, req *QueryValidatorTallyBreakdownRequest) (*QueryValidatorTallyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorTallyBreakdown not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorOverrides(ctx context.Context, req *QueryDelegatorOverridesRequest) (*QueryDelegatorOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorOverrides not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1210,25 +1663,79 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.gov.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Proposal",
-			Handler:    _Query_Proposal_Handler,
-		},
-		{
-			MethodName: "Proposals",
-			Handler:    _Query_Proposals_Handler,
-		},
-		{
-			MethodName: "Vote",
-			Handler:    _Query_Vote_Handler,
-		},
-		{
-			MethodName: "Votes",
-			Handler:    _Query_Votes_Handler,
+func _Query_RunningTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunningTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RunningTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/RunningTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RunningTally(ctx, req.(*QueryRunningTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorTallyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorTallyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorTallyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/ValidatorTallyBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorTallyBreakdown(ctx, req.(*QueryValidatorTallyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/DelegatorOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorOverrides(ctx, req.(*QueryDelegatorOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.gov.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Query_Vote_Handler,
+		},
+		{
+			MethodName: "Votes",
+			Handler:    _Query_Votes_Handler,
 		},
 		{
 			MethodName: "Params",
@@ -1246,6 +1753,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "RunningTally",
+			Handler:    _Query_RunningTally_Handler,
+		},
+		{
+			MethodName: "ValidatorTallyBreakdown",
+			Handler:    _Query_ValidatorTallyBreakdown_Handler,
+		},
+		{
+			MethodName: "DelegatorOverrides",
+			Handler:    _Query_DelegatorOverrides_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1beta1/query.proto",
@@ -1860,6 +2379,16 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1873,124 +2402,415 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRunningTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryRunningTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunningTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRunningTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRunningTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunningTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalStatus))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ProjectedStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProjectedStatus))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.QuorumReached {
+		i--
+		if m.QuorumReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Turnout.Size()
+		i -= size
+		if _, err := m.Turnout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorTallyBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Vote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryValidatorTallyBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorTallyBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorTallyBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorTallyBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorTallyBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Breakdowns) > 0 {
+		for iNdEx := len(m.Breakdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryVotesResponse) Size() (n int) {
@@ -2111,27 +2931,868 @@ func (m *QueryTallyResultRequest) Size() (n int) {
 	if m.ProposalId != 0 {
 		n += 1 + sovQuery(uint64(m.ProposalId))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryTallyResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRunningTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryRunningTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Turnout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.QuorumReached {
+		n += 2
+	}
+	if m.ProjectedStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProjectedStatus))
+	}
+	return n
+}
+
+func (m *QueryValidatorTallyBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorTallyBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Breakdowns) > 0 {
+		for _, e := range m.Breakdowns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			}
+			m.ProposalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalStatus |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryTallyResultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Tally.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2154,17 +3815,17 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2174,11 +3835,62 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2200,7 +3912,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2223,17 +3935,17 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2243,24 +3955,23 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ParamsType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2283,7 +3994,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,36 +4017,17 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
-			}
-			m.ProposalStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalStatus |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2345,29 +4037,30 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2377,27 +4070,28 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2424,10 +4118,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2452,7 +4143,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2475,17 +4166,36 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2495,29 +4205,77 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2544,10 +4302,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2572,7 +4327,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2595,10 +4350,10 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2622,9 +4377,9 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2634,23 +4389,27 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2673,7 +4432,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2696,15 +4455,15 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2731,7 +4490,44 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2756,7 +4552,7 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2779,10 +4575,10 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2804,42 +4600,6 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2861,7 +4621,7 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2884,15 +4644,15 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2919,14 +4679,13 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2953,10 +4712,7 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2981,7 +4737,7 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRunningTallyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,17 +4760,17 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRunningTallyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRunningTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3024,24 +4780,11 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParamsType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3063,7 +4806,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRunningTallyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3086,15 +4829,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRunningTallyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRunningTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3114,22 +4857,90 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Turnout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3139,30 +4950,31 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Turnout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumReached", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3172,25 +4984,31 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.QuorumReached = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedStatus", wireType)
 			}
-			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProjectedStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectedStatus |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3212,7 +5030,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorTallyBreakdownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3235,10 +5053,10 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorTallyBreakdownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorTallyBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3262,9 +5080,9 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3274,23 +5092,27 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3313,7 +5135,7 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorTallyBreakdownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3336,15 +5158,15 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorTallyBreakdownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorTallyBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3371,79 +5193,11 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Breakdowns = append(m.Breakdowns, ValidatorTallyBreakdown{})
+			if err := m.Breakdowns[len(m.Breakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -3474,7 +5228,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3501,7 +5255,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOverridesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3524,17 +5278,36 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOverridesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3544,27 +5317,25 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3594,7 +5365,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3621,7 +5392,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorOverridesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3644,17 +5415,17 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorOverridesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3664,64 +5435,29 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Overrides = append(m.Overrides, DelegatorOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3748,7 +5484,10 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28,6 +28,86 @@ func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegator
 	}
 }
 
+// NewValidatorRunningTally creates a ValidatorRunningTally instance without
+// any voted shares
+func NewValidatorRunningTally(valAddr sdk.ValAddress) ValidatorRunningTally {
+	return ValidatorRunningTally{
+		ValidatorAddress: valAddr.String(),
+		YesShares:        sdk.ZeroDec(),
+		AbstainShares:    sdk.ZeroDec(),
+		NoShares:         sdk.ZeroDec(),
+		NoWithVetoShares: sdk.ZeroDec(),
+		DeductedShares:   sdk.ZeroDec(),
+	}
+}
+
+// AddOptionShares adds the given delegator shares, possibly negative, to the
+// shares voted for the given options, split according to their weights.
+func (vrt *ValidatorRunningTally) AddOptionShares(shares sdk.Dec, options WeightedVoteOptions) {
+	for _, option := range options {
+		subShares := shares.Mul(option.Weight)
+
+		switch option.Option {
+		case OptionYes:
+			vrt.YesShares = vrt.YesShares.Add(subShares)
+		case OptionAbstain:
+			vrt.AbstainShares = vrt.AbstainShares.Add(subShares)
+		case OptionNo:
+			vrt.NoShares = vrt.NoShares.Add(subShares)
+		case OptionNoWithVeto:
+			vrt.NoWithVetoShares = vrt.NoWithVetoShares.Add(subShares)
+		}
+	}
+}
+
+// OptionShares returns the delegator shares voted for each option
+func (vrt ValidatorRunningTally) OptionShares() map[VoteOption]sdk.Dec {
+	return map[VoteOption]sdk.Dec{
+		OptionYes:        vrt.YesShares,
+		OptionAbstain:    vrt.AbstainShares,
+		OptionNo:         vrt.NoShares,
+		OptionNoWithVeto: vrt.NoWithVetoShares,
+	}
+}
+
+// IsEmpty returns true if no delegator shares are counted in the running tally
+func (vrt ValidatorRunningTally) IsEmpty() bool {
+	return vrt.DeductedShares.IsZero() && vrt.YesShares.IsZero() && vrt.AbstainShares.IsZero() &&
+		vrt.NoShares.IsZero() && vrt.NoWithVetoShares.IsZero()
+}
+
+// String implements stringer interface
+func (vrt ValidatorRunningTally) String() string {
+	out, _ := yaml.Marshal(vrt)
+	return string(out)
+}
+
+// NewDelegatorOverride creates a DelegatorOverride instance
+//nolint:interfacer
+func NewDelegatorOverride(
+	proposalID uint64, valAddr sdk.ValAddress, delAddr sdk.AccAddress, options WeightedVoteOptions, votingPower sdk.Int,
+) DelegatorOverride {
+	return DelegatorOverride{
+		ProposalId:       proposalID,
+		ValidatorAddress: valAddr.String(),
+		DelegatorAddress: delAddr.String(),
+		Options:          options,
+		VotingPower:      votingPower,
+	}
+}
+
+// String implements stringer interface
+func (do DelegatorOverride) String() string {
+	out, _ := yaml.Marshal(do)
+	return string(out)
+}
+
+// String implements stringer interface
+func (vtb ValidatorTallyBreakdown) String() string {
+	out, _ := yaml.Marshal(vtb)
+	return string(out)
+}
+
 // NewTallyResult creates a new TallyResult instance
 func NewTallyResult(yes, abstain, no, noWithVeto sdk.Int) TallyResult {
 	return TallyResult{
@@ -33,8 +33,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// send the minted coins to the recipients of the distribution proportions
+	err = k.DistributeMintedCoin(ctx, params.DistributionProportions, mintedCoin)
 	if err != nil {
 		panic(err)
 	}
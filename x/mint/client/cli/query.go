@@ -2,14 +2,19 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
+// FlagBondedRatio is the flag of the bonded ratio assumed by a projection.
+const FlagBondedRatio = "bonded-ratio"
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -24,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryProjection(),
 	)
 
 	return mintingQueryCmd
@@ -118,3 +124,56 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjection implements a command to simulate the minter forward and
+// return samples of the projected supply, inflation and annual provisions.
+func GetCmdQueryProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection [horizon-blocks]",
+		Short: "Query a projection of the supply, inflation and annual provisions",
+		Long: fmt.Sprintf(`Query a projection of the supply, inflation and annual provisions, simulating
+the minter forward for the given number of blocks, at most %d. Horizons longer than
+%d blocks are simulated in %d steps of several blocks, computing the inflation once
+per step. The bonded ratio is assumed to stay at its current value unless it is set
+with --%s.
+
+Example:
+$ %s query mint projection 100000 --%s=0.5
+`, types.MaxProjectionHorizon, types.MaxProjectionSteps, types.MaxProjectionSteps, FlagBondedRatio, version.AppName, FlagBondedRatio),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			horizon, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bondedRatio, err := cmd.Flags().GetString(FlagBondedRatio)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryProjectionRequest{
+				HorizonBlocks:      horizon,
+				AssumedBondedRatio: bondedRatio,
+			}
+			res, err := queryClient.Projection(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagBondedRatio, "", "The bonded ratio assumed for the simulated blocks (defaults to the current one)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -73,7 +73,9 @@ func (s *IntegrationTestSuite) TestQueryGRPC() {
 			&minttypes.QueryParamsResponse{},
 			&minttypes.QueryParamsResponse{
 				Params: minttypes.NewParams("stake", sdk.NewDecWithPrec(13, 2), sdk.NewDecWithPrec(100, 2),
-					sdk.NewDec(1), sdk.NewDecWithPrec(67, 2), (60 * 60 * 8766 / 5)),
+					sdk.NewDec(1), sdk.NewDecWithPrec(67, 2), (60 * 60 * 8766 / 5),
+					minttypes.DefaultHyperInflationPeak, minttypes.DefaultHyperInflationStdDev, sdk.ZeroInt(),
+					minttypes.DefaultDistributionProportions()),
 			},
 		},
 		{
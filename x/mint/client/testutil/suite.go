@@ -66,13 +66,19 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","hyper_inflation_peak":"150000000000000000000000000","hyper_inflation_std_dev":"50000000000000000000000000","end_hyper_inflation":"0","distribution_proportions":[{"recipient":"fee_collector","proportion":"1.000000000000000000"}]}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`blocks_per_year: "6311520"
+distribution_proportions:
+- proportion: "1.000000000000000000"
+  recipient: fee_collector
+end_hyper_inflation: "0"
 goal_bonded: "0.670000000000000000"
+hyper_inflation_peak: "150000000000000000000000000"
+hyper_inflation_std_dev: "50000000000000000000000000"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
@@ -161,3 +167,68 @@ func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryProjection() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name       string
+		args       []string
+		expectErr  bool
+		expSamples int
+	}{
+		{
+			"invalid horizon",
+			[]string{"ten", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true, 0,
+		},
+		{
+			"zero horizon",
+			[]string{"0", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true, 0,
+		},
+		{
+			"invalid bonded ratio",
+			[]string{"3", fmt.Sprintf("--%s=2", cli.FlagBondedRatio), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true, 0,
+		},
+		{
+			"valid projection",
+			[]string{"3", fmt.Sprintf("--%s=0.5", cli.FlagBondedRatio), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false, 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryProjection()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var res minttypes.QueryProjectionResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Len(res.Samples, tc.expSamples)
+
+			// the inflation is fixed to 100%, so that the annual provisions of a
+			// block are the supply before it
+			blocksPerYear := int64(minttypes.DefaultParams().BlocksPerYear)
+			for i, sample := range res.Samples {
+				s.Require().Equal(sdk.OneDec(), sample.Inflation)
+				provision := sample.AnnualProvisions.QuoInt64(blocksPerYear).TruncateInt()
+				s.Require().Equal(sample.AnnualProvisions.TruncateInt().Add(provision), sample.TotalSupply)
+				if i > 0 {
+					s.Require().Equal(res.Samples[i-1].Height+1, sample.Height)
+					s.Require().Equal(res.Samples[i-1].TotalSupply.ToDec(), sample.AnnualProvisions)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -32,3 +35,37 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// Projection simulates the minter of the mint module forward and returns samples
+// of the projected supply, inflation and annual provisions.
+func (k Keeper) Projection(c context.Context, req *types.QueryProjectionRequest) (*types.QueryProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.HorizonBlocks == 0 || req.HorizonBlocks > types.MaxProjectionHorizon {
+		return nil, status.Errorf(codes.InvalidArgument, "horizon must be between 1 and %d blocks", types.MaxProjectionHorizon)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var bondedRatio sdk.Dec
+	if req.AssumedBondedRatio == "" {
+		bondedRatio = k.BondedRatio(ctx)
+	} else {
+		var err error
+		bondedRatio, err = sdk.NewDecFromStr(req.AssumedBondedRatio)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bonded ratio: %s", err.Error())
+		}
+		if bondedRatio.IsNegative() || bondedRatio.GT(sdk.OneDec()) {
+			return nil, status.Errorf(codes.InvalidArgument, "bonded ratio must be between 0 and 1: %s", bondedRatio)
+		}
+	}
+
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	samples := minter.Project(params, bondedRatio, k.StakingTokenSupply(ctx), ctx.BlockHeight(), req.HorizonBlocks)
+
+	return &types.QueryProjectionResponse{Samples: samples}, nil
+}
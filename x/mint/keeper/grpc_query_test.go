@@ -51,6 +51,64 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCProjection() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	minter, supply := app.MintKeeper.GetMinter(ctx), app.MintKeeper.StakingTokenSupply(ctx)
+
+	testCases := []struct {
+		msg      string
+		req      *types.QueryProjectionRequest
+		expPass  bool
+		expRatio sdk.Dec
+	}{
+		{"zero horizon", &types.QueryProjectionRequest{}, false, sdk.Dec{}},
+		{
+			"horizon too large",
+			&types.QueryProjectionRequest{HorizonBlocks: types.MaxProjectionHorizon + 1},
+			false, sdk.Dec{},
+		},
+		{
+			"invalid bonded ratio",
+			&types.QueryProjectionRequest{HorizonBlocks: 10, AssumedBondedRatio: "half"},
+			false, sdk.Dec{},
+		},
+		{
+			"bonded ratio above one",
+			&types.QueryProjectionRequest{HorizonBlocks: 10, AssumedBondedRatio: "1.5"},
+			false, sdk.Dec{},
+		},
+		{
+			"current bonded ratio",
+			&types.QueryProjectionRequest{HorizonBlocks: 10},
+			true, app.MintKeeper.BondedRatio(ctx),
+		},
+		{
+			"assumed bonded ratio",
+			&types.QueryProjectionRequest{HorizonBlocks: 500, AssumedBondedRatio: "0.9"},
+			true, sdk.NewDecWithPrec(9, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			res, err := queryClient.Projection(gocontext.Background(), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			expSamples := minter.Project(app.MintKeeper.GetParams(ctx), tc.expRatio, supply, ctx.BlockHeight(), tc.req.HorizonBlocks)
+			suite.Require().Equal(expSamples, res.Samples)
+		})
+	}
+
+	// the projection does not change the state
+	suite.Require().Equal(minter, app.MintKeeper.GetMinter(ctx))
+	suite.Require().Equal(supply, app.MintKeeper.StakingTokenSupply(ctx))
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
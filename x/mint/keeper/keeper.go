@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -15,34 +18,55 @@ type Keeper struct {
 	storeKey         sdk.StoreKey
 	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
+
+	// permittedRecipients are the module accounts the minted coins can be
+	// distributed to
+	permittedRecipients map[string]bool
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance. The minted coins can be
+// distributed to the fee collector, the distribution module account and the
+// module accounts of extraRecipients.
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper, dk types.DistributionKeeper,
+	feeCollectorName string, extraRecipients ...string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the mint module account has not been set")
 	}
 
+	permittedRecipients := map[string]bool{feeCollectorName: true, distrtypes.ModuleName: true}
+	for _, recipient := range extraRecipients {
+		if addr := ak.GetModuleAddress(recipient); addr == nil {
+			panic(fmt.Sprintf("the %s module account has not been set", recipient))
+		}
+		permittedRecipients[recipient] = true
+	}
+
+	k := Keeper{
+		cdc:                 cdc,
+		storeKey:            key,
+		stakingKeeper:       sk,
+		authKeeper:          ak,
+		bankKeeper:          bk,
+		distrKeeper:         dk,
+		feeCollectorName:    feeCollectorName,
+		permittedRecipients: permittedRecipients,
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTableWithRecipientValidation(k.ValidateDistributionRecipients))
 	}
+	k.paramSpace = paramSpace
 
-	return Keeper{
-		cdc:              cdc,
-		storeKey:         key,
-		paramSpace:       paramSpace,
-		stakingKeeper:    sk,
-		bankKeeper:       bk,
-		feeCollectorName: feeCollectorName,
-	}
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -108,3 +132,66 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoin sends the minted coin to the recipients of the given
+// distribution proportions, emitting an event per recipient. The amount sent to
+// each recipient is truncated, and the last recipient receives the remainder.
+// The minted coins sent to the distribution module account fund the community
+// pool.
+func (k Keeper) DistributeMintedCoin(ctx sdk.Context, proportions []types.DistributionProportion, mintedCoin sdk.Coin) error {
+	if err := k.ValidateDistributionRecipients(proportions); err != nil {
+		return err
+	}
+
+	remaining := mintedCoin.Amount
+	for i, dp := range proportions {
+		amount := remaining
+		if i < len(proportions)-1 {
+			amount = mintedCoin.Amount.ToDec().Mul(dp.Proportion).TruncateInt()
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		remaining = remaining.Sub(amount)
+
+		coins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, amount))
+		var err error
+		switch dp.Recipient {
+		case k.feeCollectorName:
+			err = k.AddCollectedFees(ctx, coins)
+		case distrtypes.ModuleName:
+			err = k.distrKeeper.FundCommunityPool(ctx, coins, k.authKeeper.GetModuleAddress(types.ModuleName))
+		default:
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, dp.Recipient, coins)
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDistribution,
+				sdk.NewAttribute(types.AttributeKeyRecipient, dp.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// ValidateDistributionRecipients returns an error if a recipient of the given
+// distribution proportions is not one of the permitted recipients of the minted
+// coins, or is not a module account.
+func (k Keeper) ValidateDistributionRecipients(proportions []types.DistributionProportion) error {
+	for _, dp := range proportions {
+		if !k.permittedRecipients[dp.Recipient] {
+			return fmt.Errorf("mint distribution recipient %s is not permitted", dp.Recipient)
+		}
+		if k.authKeeper.GetModuleAddress(dp.Recipient) == nil {
+			return fmt.Errorf("mint distribution recipient %s is not a module account", dp.Recipient)
+		}
+	}
+
+	return nil
+}
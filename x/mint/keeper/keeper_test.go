@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDistributeMintedCoin(t *testing.T) {
+	app, ctx := createTestApp(false)
+	mintKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName, govtypes.ModuleName,
+	)
+
+	proportions := []types.DistributionProportion{
+		{Recipient: authtypes.FeeCollectorName, Proportion: sdk.NewDecWithPrec(5, 1)},
+		{Recipient: distrtypes.ModuleName, Proportion: sdk.NewDecWithPrec(3, 1)},
+		{Recipient: govtypes.ModuleName, Proportion: sdk.NewDecWithPrec(2, 1)},
+	}
+	params := types.DefaultParams()
+	params.DistributionProportions = proportions
+	require.NoError(t, params.Validate())
+
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001)
+	require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(mintedCoin)))
+
+	balance := func(name string) sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(name), sdk.DefaultBondDenom).Amount
+	}
+	feeCollectorBalance, distrBalance, govBalance := balance(authtypes.FeeCollectorName), balance(distrtypes.ModuleName), balance(govtypes.ModuleName)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, mintKeeper.DistributeMintedCoin(ctx, proportions, mintedCoin))
+
+	// the amounts are truncated and the last recipient receives the remainder
+	require.True(t, balance(types.ModuleName).IsZero())
+	require.Equal(t, feeCollectorBalance.AddRaw(500), balance(authtypes.FeeCollectorName))
+	require.Equal(t, distrBalance.AddRaw(300), balance(distrtypes.ModuleName))
+	require.Equal(t, govBalance.AddRaw(201), balance(govtypes.ModuleName))
+
+	// the coins sent to the distribution module account fund the community pool
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 300)), communityPool)
+
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMintDistribution {
+			events = append(events, event)
+		}
+	}
+	require.Len(t, events, 3)
+	require.Equal(t, sdk.NewEvent(
+		types.EventTypeMintDistribution,
+		sdk.NewAttribute(types.AttributeKeyRecipient, govtypes.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "201"+sdk.DefaultBondDenom),
+	), events[2])
+
+	// the recipients which are not permitted cannot receive the minted coins
+	require.NoError(t, mintKeeper.MintCoins(ctx, sdk.NewCoins(mintedCoin)))
+	for _, recipient := range []string{"unknown", stakingtypes.BondedPoolName} {
+		err := mintKeeper.DistributeMintedCoin(ctx, []types.DistributionProportion{
+			{Recipient: authtypes.FeeCollectorName, Proportion: sdk.NewDecWithPrec(5, 1)},
+			{Recipient: recipient, Proportion: sdk.NewDecWithPrec(5, 1)},
+		}, mintedCoin)
+		require.Error(t, err)
+	}
+	require.Equal(t, mintedCoin.Amount, balance(types.ModuleName))
+	require.Equal(t, feeCollectorBalance.AddRaw(500), balance(authtypes.FeeCollectorName))
+
+	// the extra recipients must be module accounts
+	require.Panics(t, func() {
+		keeper.NewKeeper(
+			app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper,
+			app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName, "unknown",
+		)
+	})
+}
+
+func TestDistributionProportionsParamChange(t *testing.T) {
+	app, ctx := createTestApp(false)
+	subspace := app.GetSubspace(types.ModuleName)
+
+	cases := map[string]struct {
+		recipient string
+		valid     bool
+	}{
+		"distribution module":     {distrtypes.ModuleName, true},
+		"unknown recipient":       {"fee_colector", false},
+		"forbidden module":        {stakingtypes.BondedPoolName, false},
+		"not permitted by keeper": {govtypes.ModuleName, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			proportions := []types.DistributionProportion{
+				{Recipient: authtypes.FeeCollectorName, Proportion: sdk.NewDecWithPrec(5, 1)},
+				{Recipient: tc.recipient, Proportion: sdk.NewDecWithPrec(5, 1)},
+			}
+			bz, err := app.LegacyAmino().MarshalJSON(proportions)
+			require.NoError(t, err)
+
+			// param change proposals update the params with Subspace.Update
+			err = subspace.Update(ctx, types.KeyDistributionProportions, bz)
+			if !tc.valid {
+				require.Error(t, err)
+				require.Equal(t, types.DefaultDistributionProportions(), app.MintKeeper.GetParams(ctx).DistributionProportions)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, proportions, app.MintKeeper.GetParams(ctx).DistributionProportions)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package v045
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Add the HyperInflationPeak, HyperInflationStdDev and EndHyperInflation
+// params, set to the values that were previously hardcoded.
+// - Add the DistributionProportions param, sending all the minted coins to the
+// given fee collector as before.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace, feeCollectorName string) error {
+	paramSpace.Set(ctx, types.KeyHyperInflationPeak, types.DefaultHyperInflationPeak)
+	paramSpace.Set(ctx, types.KeyHyperInflationStdDev, types.DefaultHyperInflationStdDev)
+	paramSpace.Set(ctx, types.KeyEndHyperInflation, types.DefaultEndHyperInflation)
+	paramSpace.Set(ctx, types.KeyDistributionProportions, types.FeeCollectorDistributionProportions(feeCollectorName))
+	return nil
+}
@@ -0,0 +1,40 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	v045mint "github.com/cosmos/cosmos-sdk/x/mint/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	mintKey := sdk.NewKVStoreKey("mint")
+	tMintKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(mintKey, tMintKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, mintKey, tMintKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramSpace.Has(ctx, types.KeyHyperInflationPeak))
+	require.NoError(t, v045mint.MigrateStore(ctx, paramSpace, authtypes.FeeCollectorName))
+
+	var peak, stdDev, end sdk.Int
+	paramSpace.Get(ctx, types.KeyHyperInflationPeak, &peak)
+	paramSpace.Get(ctx, types.KeyHyperInflationStdDev, &stdDev)
+	paramSpace.Get(ctx, types.KeyEndHyperInflation, &end)
+	require.Equal(t, types.DefaultHyperInflationPeak, peak)
+	require.Equal(t, types.DefaultHyperInflationStdDev, stdDev)
+	require.Equal(t, types.DefaultEndHyperInflation, end)
+
+	var proportions []types.DistributionProportion
+	paramSpace.Get(ctx, types.KeyDistributionProportions, &proportions)
+	require.Equal(t, []types.DistributionProportion{{Recipient: authtypes.FeeCollectorName, Proportion: sdk.OneDec()}}, proportions)
+	require.NoError(t, types.DefaultParams().Validate())
+}
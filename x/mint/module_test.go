@@ -8,7 +8,10 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -26,3 +29,25 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc := app.AccountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(types.ModuleName))
 	require.NotNil(t, acc)
 }
+
+func TestInitGenesisRequiresModuleAccountRecipients(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	genesisState := types.DefaultGenesisState()
+	genesisState.Params.DistributionProportions = []types.DistributionProportion{
+		{Recipient: authtypes.FeeCollectorName, Proportion: sdk.NewDecWithPrec(5, 1)},
+		{Recipient: "unknown", Proportion: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, types.ValidateGenesis(*genesisState))
+
+	require.Panics(t, func() {
+		mint.InitGenesis(ctx, app.MintKeeper, app.AccountKeeper, genesisState)
+	})
+
+	genesisState.Params.DistributionProportions[1].Recipient = distrtypes.ModuleName
+	require.NotPanics(t, func() {
+		mint.InitGenesis(ctx, app.MintKeeper, app.AccountKeeper, genesisState)
+	})
+	require.Equal(t, genesisState.Params, app.MintKeeper.GetParams(ctx))
+}
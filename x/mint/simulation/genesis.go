@@ -9,6 +9,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -46,6 +48,35 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenHyperInflationPeak randomized HyperInflationPeak, between 100M and 200M NOM
+func GenHyperInflationPeak(r *rand.Rand) sdk.Int {
+	return sdk.NewIntWithDecimal(int64(100_000_000+r.Intn(100_000_000)), 18)
+}
+
+// GenHyperInflationStdDev randomized HyperInflationStdDev, between 25M and 75M NOM
+func GenHyperInflationStdDev(r *rand.Rand) sdk.Int {
+	return sdk.NewIntWithDecimal(int64(25_000_000+r.Intn(50_000_000)), 18)
+}
+
+// GenEndHyperInflation randomized EndHyperInflation, between 200M and 300M NOM
+func GenEndHyperInflation(r *rand.Rand) sdk.Int {
+	return sdk.NewIntWithDecimal(int64(200_000_000+r.Intn(100_000_000)), 18)
+}
+
+// GenDistributionProportions randomized DistributionProportions, sending between 50% and 100% of
+// the minted coins to the fee collector and the rest to the community pool
+func GenDistributionProportions(r *rand.Rand) []types.DistributionProportion {
+	feeCollectorProportion := sdk.NewDecWithPrec(int64(50+r.Intn(51)), 2)
+	if feeCollectorProportion.Equal(sdk.OneDec()) {
+		return types.DefaultDistributionProportions()
+	}
+
+	return []types.DistributionProportion{
+		{Recipient: authtypes.FeeCollectorName, Proportion: feeCollectorProportion},
+		{Recipient: distrtypes.ModuleName, Proportion: sdk.OneDec().Sub(feeCollectorProportion)},
+	}
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -80,9 +111,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
+	distributionProportions := GenDistributionProportions(simState.Rand)
+
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params := types.NewParams(
+		mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear,
+		types.DefaultHyperInflationPeak, types.DefaultHyperInflationStdDev, types.DefaultEndHyperInflation,
+		distributionProportions,
+	)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
@@ -13,10 +13,13 @@ import (
 )
 
 const (
-	keyInflationRateChange = "InflationRateChange"
-	keyInflationMax        = "InflationMax"
-	keyInflationMin        = "InflationMin"
-	keyGoalBonded          = "GoalBonded"
+	keyInflationRateChange  = "InflationRateChange"
+	keyInflationMax         = "InflationMax"
+	keyInflationMin         = "InflationMin"
+	keyGoalBonded           = "GoalBonded"
+	keyHyperInflationPeak   = "HyperInflationPeak"
+	keyHyperInflationStdDev = "HyperInflationStdDev"
+	keyEndHyperInflation    = "EndHyperInflation"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -43,5 +46,20 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyHyperInflationPeak,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenHyperInflationPeak(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyHyperInflationStdDev,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenHyperInflationStdDev(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyEndHyperInflation,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenEndHyperInflation(r))
+			},
+		),
 	}
 }
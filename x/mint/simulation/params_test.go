@@ -23,10 +23,13 @@ func TestParamChangest(t *testing.T) {
 		{"mint/InflationMax", "InflationMax", "\"0.200000000000000000\"", "mint"},
 		{"mint/InflationMin", "InflationMin", "\"0.070000000000000000\"", "mint"},
 		{"mint/GoalBonded", "GoalBonded", "\"0.670000000000000000\"", "mint"},
+		{"mint/HyperInflationPeak", "HyperInflationPeak", "\"119727887000000000000000000\"", "mint"},
+		{"mint/HyperInflationStdDev", "HyperInflationStdDev", "\"52131847000000000000000000\"", "mint"},
+		{"mint/EndHyperInflation", "EndHyperInflation", "\"239984059000000000000000000\"", "mint"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
-	require.Len(t, paramChanges, 4)
+	require.Len(t, paramChanges, 7)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
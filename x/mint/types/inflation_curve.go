@@ -1,15 +1,21 @@
 package types
 
 import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
-
 	"math/big"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
-	precisionReuse       = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
-	globalFastExp        = newFastExp()
-	globalInflationCurve = newInflationCurve()
+	precisionReuse = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+	globalFastExp  = newFastExp()
+	// the inflation curve of the last used params, so that its constants are only derived again
+	// when the params change
+	inflationCurveCache struct {
+		sync.Mutex
+		curve *InflationCurve
+	}
 )
 
 // FastExp contains the constants needed for fast computation of i128f64 fixed point exponential functions.
@@ -64,28 +70,61 @@ func newFastExp() *FastExp {
 	}
 }
 
-// InflationCurve is the struct used for the inflation curve calculation. Use the instance
-// `globalInflationCurve` so that only one copy of the constants is needed.
+// InflationCurve is the struct used for the inflation curve calculation. Use `inflationCurveFor`
+// so that the constants are only derived once for given params.
 type InflationCurve struct {
 	fastExp *FastExp
-	// for curve peak position = 150_000_000 NOM
+	// curve peak position and standard deviation the constants are derived from
+	peak   sdk.Int
+	stdDev sdk.Int
+	// for curve peak position, `-peak`
 	peakOffset *big.Int
-	// adjusts peak height, `-1/(2*(stdDev^2))` with 384 fixed point, stdDev = 50_000_000 NOM
+	// adjusts peak height, `-1/(2*(stdDev^2))` with 384 fixed point
 	peakScale *big.Int
 }
 
-// Fast calculation of a bell curve for the hyperinflation regime.
-func newInflationCurve() *InflationCurve {
-	// see TestInflationConstants for calculation
+// NewInflationCurve derives the constants of the bell curve for the hyperinflation regime, with
+// the given peak position and standard deviation in units of token.
+func NewInflationCurve(peak, stdDev sdk.Int) *InflationCurve {
+	// we move the subtraction in the peak offset to the constant as a negative sign for technical
+	// reasons
+	var peakOffset = new(big.Int).Neg(peak.BigInt())
+
+	// see TestInflationConstants for the choice of the fixed point
+	var peakScale = stdDev.BigInt()
+	// square
+	peakScale.Mul(peakScale, peakScale)
+	// multiply by 2
+	peakScale.Lsh(peakScale, 1)
+	// invert last, with truncation rounding
+	peakScale.Quo(new(big.Int).Lsh(big.NewInt(1), 384), peakScale)
+	peakScale.Neg(peakScale)
+
 	return &InflationCurve{
 		fastExp:    globalFastExp,
-		peakOffset: newBigIntWithTenBase("-150000000000000000000000000"),
-		peakScale:  newBigIntWithTenBase("-7880401239278895842455808020028722761015947854093089333589658680"),
+		peak:       peak,
+		stdDev:     stdDev,
+		peakOffset: peakOffset,
+		peakScale:  peakScale,
+	}
+}
+
+// inflationCurveFor returns the inflation curve with the given peak position and standard
+// deviation. Its constants are cached, and only derived again when the params change.
+func inflationCurveFor(peak, stdDev sdk.Int) *InflationCurve {
+	inflationCurveCache.Lock()
+	defer inflationCurveCache.Unlock()
+
+	var curve = inflationCurveCache.curve
+	if curve == nil || !curve.peak.Equal(peak) || !curve.stdDev.Equal(stdDev) {
+		curve = NewInflationCurve(peak, stdDev)
+		inflationCurveCache.curve = curve
 	}
+	return curve
 }
 
 // DecExp quickly calculates `e^x` as a `Dec`. Returns `nil` if overflow occurs.
-// This should be called on `globalInflationCurve` so that constants can be reused.
+// This should be called on `globalFastExp` so that constants can be reused.
 func (fe *FastExp) DecExp(x sdk.Dec) *sdk.Dec {
 	// convert to i128f64 binary fixed point
 	var tmp0 = x.BigInt()
@@ -104,7 +143,6 @@ func (fe *FastExp) DecExp(x sdk.Dec) *sdk.Dec {
 }
 
 // CalculateInflationDec is the same as `calculateInflationBinary` but with an `Int` input and `Dec` output.
-// This should be called on `globalInflationCurve` so that constants can be reused.
 func (ic *InflationCurve) CalculateInflationDec(tokenSupply sdk.Int) sdk.Dec {
 	// People keep committing the same horrible mistake of using base 10 fixed point numbers at the
 	// computational layer instead of converting between binary fixed point at the human-machine
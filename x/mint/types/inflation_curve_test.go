@@ -9,6 +9,8 @@ import (
 	"testing"
 )
 
+var defaultInflationCurve = NewInflationCurve(DefaultHyperInflationPeak, DefaultHyperInflationStdDev)
+
 func mustNewDecFromStr(t *testing.T, str string) (d sdk.Dec) {
 	d, err := sdk.NewDecFromStr(str)
 	require.NoError(t, err)
@@ -53,11 +55,11 @@ func TestInflationMonotonicity(t *testing.T) {
 	var maxSecondDiff = big.NewInt(10)
 	for i := int64(0); i < numSteps; i++ {
 		var beforePeak0 = anom.Cmp(peak) == -1
-		var result0 = globalInflationCurve.calculateInflationBinary(anom)
+		var result0 = defaultInflationCurve.calculateInflationBinary(anom)
 		anom.Add(anom, small)
-		var result1 = globalInflationCurve.calculateInflationBinary(anom)
+		var result1 = defaultInflationCurve.calculateInflationBinary(anom)
 		anom.Add(anom, small)
-		var result2 = globalInflationCurve.calculateInflationBinary(anom)
+		var result2 = defaultInflationCurve.calculateInflationBinary(anom)
 		var beforePeak2 = anom.Cmp(peak) == -1
 		anom.Add(anom, step)
 
@@ -108,7 +110,7 @@ func TestInflationApproximate(t *testing.T) {
 		var outputTmp = new(big.Int).SetUint64(uint64(resultF))
 		outputTmp.Lsh(outputTmp, 12)
 
-		var result = globalInflationCurve.calculateInflationBinary(anom)
+		var result = defaultInflationCurve.calculateInflationBinary(anom)
 
 		// note that the limiting factor here is float precision
 		var diff = new(big.Int).Sub(outputTmp, result)
@@ -123,26 +125,26 @@ func TestInflationApproximate(t *testing.T) {
 func TestInflationExact(t *testing.T) {
 	var aNomConversion = sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil))
 	var input = sdk.NewInt(50_000_000).Mul(aNomConversion)
-	var actual = globalInflationCurve.CalculateInflationDec(input)
+	var actual = defaultInflationCurve.CalculateInflationDec(input)
 	// an independent high precision calculator says
 	// the (10^18 adjusted) value is  135335283236612691.8939...
 	var expected = sdk.NewDecWithPrec(135335283236612691, sdk.Precision)
 	require.True(t, actual.BigInt().Cmp(expected.BigInt()) == 0)
 
 	input = sdk.NewInt(123_456_789).Mul(aNomConversion)
-	actual = globalInflationCurve.CalculateInflationDec(input)
+	actual = defaultInflationCurve.CalculateInflationDec(input)
 	// perfect truncation again
 	expected = sdk.NewDecWithPrec(868568860243501720, sdk.Precision)
 	require.True(t, actual.BigInt().Cmp(expected.BigInt()) == 0)
 
 	// test exactly at peak in case there is some edge case
 	input = sdk.NewInt(150_000_000).Mul(aNomConversion)
-	actual = globalInflationCurve.CalculateInflationDec(input)
+	actual = defaultInflationCurve.CalculateInflationDec(input)
 	expected = sdk.NewDecWithPrec(1000000000000000000, sdk.Precision)
 	require.True(t, actual.BigInt().Cmp(expected.BigInt()) == 0)
 
 	input = sdk.NewInt(150_000_000).Mul(aNomConversion).Sub(sdk.NewInt(1))
-	actual = globalInflationCurve.CalculateInflationDec(input)
+	actual = defaultInflationCurve.CalculateInflationDec(input)
 	// truncation works as expected
 	expected = sdk.NewDecWithPrec(999999999999999999, sdk.Precision)
 	require.True(t, actual.BigInt().Cmp(expected.BigInt()) == 0)
@@ -764,9 +766,7 @@ func TestInflationConstants(t *testing.T) {
 	peakOffset.Neg(peakOffset)
 	peakOffset.Mul(peakOffset, aNomConversion)
 
-	// for usage in `newInflationCurve()`
-	//fmt.Printf("newBigIntWithTenBase(\"%s\"),\n", peakOffset);
-	require.True(t, peakOffset.Cmp(globalInflationCurve.peakOffset) == 0)
+	require.True(t, peakOffset.Cmp(defaultInflationCurve.peakOffset) == 0)
 
 	// we calculate `peakScale` as `-1/(2*(stdDev^2))` in 384 fixed point. The fixed point needs to
 	// be very high because this in aNom is a very small number (log_2(peakScale) ~= -171 zero bits
@@ -795,7 +795,47 @@ func TestInflationConstants(t *testing.T) {
 	peakScale.FpDivideAssign(&rem, one, &div, &o)
 	peakScale.Neg()
 	require.False(t, o)
-	// for usage in `newInflationCurve()`
-	//fmt.Printf("newBigIntWithTenBase(\"%s\"),\n", peakScale.bits);
-	require.True(t, peakScale.bits.Cmp(globalInflationCurve.peakScale) == 0)
+	require.True(t, peakScale.bits.Cmp(defaultInflationCurve.peakScale) == 0)
+}
+
+// legacyInflationCurve returns the inflation curve with the constants which were hard-coded
+// before the curve became configurable by the mint params.
+func legacyInflationCurve() *InflationCurve {
+	return &InflationCurve{
+		fastExp:    globalFastExp,
+		peakOffset: newBigIntWithTenBase("-150000000000000000000000000"),
+		peakScale:  newBigIntWithTenBase("-7880401239278895842455808020028722761015947854093089333589658680"),
+	}
+}
+
+func TestDefaultInflationCurveMatchesLegacyConstants(t *testing.T) {
+	var legacy = legacyInflationCurve()
+	require.True(t, legacy.peakOffset.Cmp(defaultInflationCurve.peakOffset) == 0)
+	require.True(t, legacy.peakScale.Cmp(defaultInflationCurve.peakScale) == 0)
+
+	// the outputs are identical over the whole hyperinflationary regime and beyond
+	var max = new(big.Int).Mul(big.NewInt(300_000_000), precisionReuse)
+	var numSteps = int64(10007)
+	var step = new(big.Int).Div(max, big.NewInt(numSteps))
+	var anom = new(big.Int)
+	for i := int64(0); i < numSteps; i++ {
+		var expected = legacy.CalculateInflationDec(sdk.NewIntFromBigInt(anom))
+		var actual = inflationCurveFor(DefaultHyperInflationPeak, DefaultHyperInflationStdDev).CalculateInflationDec(sdk.NewIntFromBigInt(anom))
+		require.True(t, expected.Equal(actual), "%s != %s at %s", expected, actual, anom)
+		anom.Add(anom, step)
+	}
+}
+
+func TestInflationCurveCache(t *testing.T) {
+	var curve = inflationCurveFor(DefaultHyperInflationPeak, DefaultHyperInflationStdDev)
+	// the constants are only derived once for the same params
+	require.Same(t, curve, inflationCurveFor(DefaultHyperInflationPeak, DefaultHyperInflationStdDev))
+
+	// and derived again when the params change
+	var peak = sdk.NewIntWithDecimal(100_000_000, 18)
+	var other = inflationCurveFor(peak, DefaultHyperInflationStdDev)
+	require.NotSame(t, curve, other)
+	require.True(t, new(big.Int).Neg(peak.BigInt()).Cmp(other.peakOffset) == 0)
+	require.True(t, curve.peakScale.Cmp(other.peakScale) == 0)
+	require.True(t, sdk.OneDec().Equal(other.CalculateInflationDec(peak)))
 }
@@ -79,6 +79,17 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// total supply at the peak of the hyperinflation bell curve
+	HyperInflationPeak github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=hyper_inflation_peak,json=hyperInflationPeak,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"hyper_inflation_peak" yaml:"hyper_inflation_peak"`
+	// standard deviation of the hyperinflation bell curve, in units of total
+	// supply
+	HyperInflationStdDev github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=hyper_inflation_std_dev,json=hyperInflationStdDev,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"hyper_inflation_std_dev" yaml:"hyper_inflation_std_dev"`
+	// total supply from which the hyperinflation regime ends and the inflation
+	// targets the goal of percent bonded atoms
+	EndHyperInflation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=end_hyper_inflation,json=endHyperInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"end_hyper_inflation" yaml:"end_hyper_inflation"`
+	// module accounts the minted coins are distributed to, with their
+	// proportions
+	DistributionProportions []DistributionProportion `protobuf:"bytes,10,rep,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions" yaml:"distribution_proportions"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,43 +138,111 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetDistributionProportions() []DistributionProportion {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return nil
+}
+
+// DistributionProportion defines the proportion of the minted coins sent to a
+// module account.
+type DistributionProportion struct {
+	// name of the recipient module account
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// proportion of the minted coins sent to the recipient
+	Proportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=proportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proportion"`
+}
+
+func (m *DistributionProportion) Reset()         { *m = DistributionProportion{} }
+func (m *DistributionProportion) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportion) ProtoMessage()    {}
+func (*DistributionProportion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *DistributionProportion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportion.Merge(m, src)
+}
+func (m *DistributionProportion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportion proto.InternalMessageInfo
+
+func (m *DistributionProportion) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*DistributionProportion)(nil), "cosmos.mint.v1beta1.DistributionProportion")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x28, 0x95, 0x6a, 0x98, 0x00, 0x6f, 0xa0, 0x68, 0x82, 0x64, 0xca, 0x01, 0x8d,
-	0x03, 0x89, 0x26, 0x6e, 0x3b, 0x66, 0x15, 0x07, 0xc4, 0x50, 0xe5, 0x1b, 0x5c, 0xa2, 0x7f, 0x52,
-	0x93, 0x59, 0x8d, 0xed, 0xca, 0xf6, 0x46, 0x7b, 0xe5, 0x09, 0x38, 0x72, 0xe4, 0x2d, 0x78, 0x85,
-	0xdd, 0xd8, 0x11, 0x71, 0xa8, 0x50, 0xfb, 0x06, 0x7b, 0x02, 0x14, 0xbb, 0x6a, 0xa1, 0x20, 0xa4,
-	0x4a, 0x9c, 0x92, 0xef, 0xfb, 0xff, 0xf3, 0xfd, 0xbe, 0x58, 0x32, 0x8e, 0x2a, 0x65, 0x84, 0x32,
-	0x99, 0xe0, 0xd2, 0x66, 0x17, 0x47, 0x25, 0xb3, 0x70, 0xe4, 0x44, 0x3a, 0xd6, 0xca, 0x2a, 0xb2,
-	0xeb, 0xe7, 0xa9, 0xb3, 0x96, 0xf3, 0xfd, 0xbd, 0x5a, 0xd5, 0xca, 0xcd, 0xb3, 0xf6, 0xcd, 0xaf,
-	0x26, 0x5f, 0x11, 0xee, 0x9e, 0x72, 0x69, 0x99, 0x26, 0xaf, 0x70, 0x8f, 0xcb, 0x77, 0x0d, 0x58,
-	0xae, 0x64, 0x88, 0x0e, 0xd0, 0x61, 0x2f, 0x4f, 0x2f, 0x67, 0x71, 0xf0, 0x7d, 0x16, 0x3f, 0xa9,
-	0xb9, 0x3d, 0x3b, 0x2f, 0xd3, 0x4a, 0x89, 0x6c, 0xc9, 0xf6, 0x8f, 0x67, 0x66, 0x38, 0xca, 0xec,
-	0x74, 0xcc, 0x4c, 0xda, 0x67, 0x15, 0x5d, 0x07, 0x90, 0xf7, 0xf8, 0x3e, 0x48, 0x79, 0x0e, 0x4d,
-	0x31, 0xd6, 0xea, 0x82, 0x1b, 0xae, 0xa4, 0x09, 0x6f, 0xb8, 0xd4, 0x97, 0xdb, 0xa5, 0x5e, 0xcf,
-	0xe2, 0x70, 0x0a, 0xa2, 0x39, 0x4e, 0xfe, 0x08, 0x4c, 0xe8, 0x3d, 0xef, 0x0d, 0xd6, 0xd6, 0x97,
-	0x0e, 0xee, 0x0e, 0x40, 0x83, 0x30, 0xe4, 0x31, 0xc6, 0xed, 0x11, 0x14, 0x43, 0x26, 0x95, 0xf0,
-	0xbf, 0x44, 0x7b, 0xad, 0xd3, 0x6f, 0x0d, 0xf2, 0x01, 0xe1, 0x07, 0xab, 0xc2, 0x85, 0x06, 0xcb,
-	0x8a, 0xea, 0x0c, 0x64, 0xcd, 0x96, 0x3d, 0x5f, 0x6f, 0xdd, 0xf3, 0x91, 0xef, 0xf9, 0xd7, 0xd0,
-	0x84, 0xee, 0xae, 0x7c, 0x0a, 0x96, 0x9d, 0x38, 0x97, 0x8c, 0xf0, 0xce, 0x7a, 0x5d, 0xc0, 0x24,
-	0xbc, 0xe9, 0xd8, 0x2f, 0xb6, 0x66, 0xef, 0x6d, 0xb2, 0x05, 0x4c, 0x12, 0x7a, 0x67, 0xa5, 0x4f,
-	0x61, 0xb2, 0x01, 0xe3, 0x32, 0xec, 0xfc, 0x37, 0x18, 0x97, 0xbf, 0xc1, 0xb8, 0x24, 0x0c, 0xdf,
-	0xae, 0x15, 0x34, 0x45, 0xa9, 0xe4, 0x90, 0x0d, 0xc3, 0x5b, 0x0e, 0xd5, 0xdf, 0x1a, 0x45, 0x3c,
-	0xea, 0x97, 0xa8, 0x84, 0xe2, 0x56, 0xe5, 0x4e, 0x90, 0x1c, 0xdf, 0x2d, 0x1b, 0x55, 0x8d, 0x4c,
-	0x31, 0x66, 0xba, 0x98, 0x32, 0xd0, 0x61, 0xf7, 0x00, 0x1d, 0x76, 0xf2, 0xfd, 0xeb, 0x59, 0xfc,
-	0xd0, 0x7f, 0xbc, 0xb1, 0x90, 0xd0, 0x1d, 0xef, 0x0c, 0x98, 0x7e, 0xc3, 0x40, 0x1f, 0x77, 0x3e,
-	0x7d, 0x8e, 0x83, 0xfc, 0xe4, 0x72, 0x1e, 0xa1, 0xab, 0x79, 0x84, 0x7e, 0xcc, 0x23, 0xf4, 0x71,
-	0x11, 0x05, 0x57, 0x8b, 0x28, 0xf8, 0xb6, 0x88, 0x82, 0xb7, 0x4f, 0xff, 0xd9, 0x76, 0xe2, 0x2f,
-	0xa2, 0x2b, 0x5d, 0x76, 0xdd, 0xbd, 0x7a, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x34, 0x52, 0xcf,
-	0x87, 0xa4, 0x03, 0x00, 0x00,
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x4f, 0x13, 0x4f,
+	0x18, 0xc6, 0xbb, 0xc0, 0xbf, 0x7f, 0x3a, 0x48, 0x94, 0xa1, 0xc2, 0x04, 0x71, 0x97, 0xcc, 0x41,
+	0x31, 0xc6, 0x6d, 0xd0, 0x1b, 0xc7, 0xd2, 0x18, 0x31, 0x40, 0x9a, 0xf1, 0xa4, 0x97, 0xcd, 0xb4,
+	0x3b, 0x96, 0x49, 0xbb, 0x33, 0x9b, 0xd9, 0xa1, 0xb6, 0x89, 0x89, 0x89, 0x07, 0x3d, 0xea, 0xd1,
+	0xa3, 0x1f, 0x87, 0x9b, 0xc4, 0x93, 0xf1, 0xd0, 0x18, 0xf8, 0x06, 0xfd, 0x04, 0x66, 0x67, 0x4a,
+	0x4b, 0xeb, 0x6a, 0xb2, 0xc6, 0x53, 0xfb, 0x3e, 0xef, 0xfb, 0x3e, 0xcf, 0x6f, 0xf7, 0xf0, 0x2e,
+	0x70, 0x9b, 0x32, 0x89, 0x64, 0x52, 0x89, 0xb8, 0xd0, 0x95, 0xee, 0x4e, 0x83, 0x69, 0xba, 0x63,
+	0x0a, 0x3f, 0x56, 0x52, 0x4b, 0xb8, 0x6a, 0xfb, 0xbe, 0x91, 0x46, 0xfd, 0x8d, 0x72, 0x4b, 0xb6,
+	0xa4, 0xe9, 0x57, 0xd2, 0x7f, 0x76, 0x14, 0x7f, 0x71, 0x40, 0xf1, 0x90, 0x0b, 0xcd, 0x14, 0x3c,
+	0x00, 0x25, 0x2e, 0x5e, 0x76, 0xa8, 0xe6, 0x52, 0x20, 0x67, 0xcb, 0xd9, 0x2e, 0x55, 0xfd, 0xd3,
+	0x81, 0x57, 0xf8, 0x3e, 0xf0, 0xee, 0xb4, 0xb8, 0x3e, 0x3e, 0x69, 0xf8, 0x4d, 0x19, 0x55, 0x46,
+	0xd9, 0xf6, 0xe7, 0x41, 0x12, 0xb6, 0x2b, 0xba, 0x1f, 0xb3, 0xc4, 0xaf, 0xb1, 0x26, 0x99, 0x18,
+	0xc0, 0x57, 0x60, 0x85, 0x0a, 0x71, 0x42, 0x3b, 0x41, 0xac, 0x64, 0x97, 0x27, 0x5c, 0x8a, 0x04,
+	0xcd, 0x19, 0xd7, 0xa7, 0xf9, 0x5c, 0x87, 0x03, 0x0f, 0xf5, 0x69, 0xd4, 0xd9, 0xc5, 0xbf, 0x18,
+	0x62, 0x72, 0xc3, 0x6a, 0xf5, 0x89, 0xf4, 0x75, 0x11, 0x14, 0xeb, 0x54, 0xd1, 0x28, 0x81, 0xb7,
+	0x01, 0x48, 0x5f, 0x41, 0x10, 0x32, 0x21, 0x23, 0xfb, 0x48, 0xa4, 0x94, 0x2a, 0xb5, 0x54, 0x80,
+	0x6f, 0x1d, 0x70, 0x73, 0x0c, 0x1c, 0x28, 0xaa, 0x59, 0xd0, 0x3c, 0xa6, 0xa2, 0xc5, 0x46, 0x9c,
+	0x47, 0xb9, 0x39, 0x37, 0x2d, 0x67, 0xa6, 0x29, 0x26, 0xab, 0x63, 0x9d, 0x50, 0xcd, 0xf6, 0x8c,
+	0x0a, 0xdb, 0x60, 0x79, 0x32, 0x1e, 0xd1, 0x1e, 0x9a, 0x37, 0xd9, 0x8f, 0x73, 0x67, 0x97, 0x67,
+	0xb3, 0x23, 0xda, 0xc3, 0xe4, 0xda, 0xb8, 0x3e, 0xa4, 0xbd, 0x99, 0x30, 0x2e, 0xd0, 0xc2, 0x3f,
+	0x0b, 0xe3, 0x62, 0x2a, 0x8c, 0x0b, 0xc8, 0xc0, 0x52, 0x4b, 0xd2, 0x4e, 0xd0, 0x90, 0x22, 0x64,
+	0x21, 0xfa, 0xcf, 0x44, 0xd5, 0x72, 0x47, 0x41, 0x1b, 0x75, 0xc5, 0x0a, 0x13, 0x90, 0x56, 0x55,
+	0x53, 0xc0, 0x2a, 0xb8, 0xde, 0xe8, 0xc8, 0x66, 0x3b, 0x09, 0x62, 0xa6, 0x82, 0x3e, 0xa3, 0x0a,
+	0x15, 0xb7, 0x9c, 0xed, 0x85, 0xea, 0xc6, 0x70, 0xe0, 0xad, 0xd9, 0xe5, 0x99, 0x01, 0x4c, 0x96,
+	0xad, 0x52, 0x67, 0xea, 0x39, 0xa3, 0x0a, 0xbe, 0x01, 0xe5, 0xe3, 0x7e, 0xda, 0x9d, 0x3c, 0x50,
+	0xcc, 0x68, 0x1b, 0xfd, 0x6f, 0x98, 0x0f, 0x73, 0x30, 0xef, 0x0b, 0x3d, 0x1c, 0x78, 0xb7, 0x6c,
+	0x6c, 0x96, 0x27, 0x26, 0xd0, 0xc8, 0xfb, 0x97, 0x6a, 0x9d, 0xd1, 0x36, 0x7c, 0xef, 0x80, 0xf5,
+	0xd9, 0xe9, 0x44, 0x87, 0x41, 0xc8, 0xba, 0x68, 0xd1, 0x40, 0xd4, 0x73, 0x43, 0xb8, 0xd9, 0x10,
+	0x23, 0x5b, 0x4c, 0xca, 0xd3, 0x1c, 0xcf, 0x74, 0x58, 0x63, 0x5d, 0xf8, 0x1a, 0xac, 0x32, 0x11,
+	0x06, 0x33, 0x5b, 0xa8, 0x64, 0x20, 0x0e, 0x72, 0x43, 0x6c, 0x58, 0x88, 0x0c, 0x4b, 0x4c, 0x56,
+	0x98, 0x08, 0x9f, 0x4c, 0x31, 0xc0, 0x0f, 0x0e, 0x40, 0x21, 0x4f, 0xb4, 0xe2, 0x8d, 0x13, 0xfb,
+	0xca, 0x94, 0x8c, 0xa5, 0xd2, 0xe6, 0x7a, 0x80, 0xad, 0xf9, 0xed, 0xa5, 0x87, 0xf7, 0xfd, 0x8c,
+	0xeb, 0xe6, 0xd7, 0xae, 0x2c, 0xd5, 0xc7, 0x3b, 0xd5, 0xbb, 0x29, 0xf0, 0x70, 0xe0, 0x79, 0x16,
+	0xe3, 0x77, 0xd6, 0x98, 0xac, 0x87, 0x99, 0x06, 0xc9, 0xee, 0xc2, 0xa7, 0xcf, 0x5e, 0x01, 0xbf,
+	0x73, 0xc0, 0x5a, 0x76, 0x04, 0xdc, 0x04, 0x25, 0xc5, 0x9a, 0x3c, 0xe6, 0x4c, 0xe8, 0xcb, 0x1b,
+	0x33, 0x16, 0xe0, 0x11, 0x00, 0x93, 0x1c, 0x34, 0xf7, 0x57, 0x57, 0xf5, 0x8a, 0x43, 0x75, 0xef,
+	0xf4, 0xdc, 0x75, 0xce, 0xce, 0x5d, 0xe7, 0xc7, 0xb9, 0xeb, 0x7c, 0xbc, 0x70, 0x0b, 0x67, 0x17,
+	0x6e, 0xe1, 0xdb, 0x85, 0x5b, 0x78, 0x71, 0xef, 0x8f, 0x6e, 0x3d, 0xfb, 0xb1, 0x30, 0xa6, 0x8d,
+	0xa2, 0xb9, 0xfd, 0x8f, 0x7e, 0x0e, 0x00, 0x36, 0x18, 0xef, 0x16, 0x48, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -229,6 +308,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributionProportions) > 0 {
+		for iNdEx := len(m.DistributionProportions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionProportions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.EndHyperInflation.Size()
+		i -= size
+		if _, err := m.EndHyperInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.HyperInflationStdDev.Size()
+		i -= size
+		if _, err := m.HyperInflationStdDev.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.HyperInflationPeak.Size()
+		i -= size
+		if _, err := m.HyperInflationPeak.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -284,6 +407,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionProportion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Proportion.Size()
+		i -= size
+		if _, err := m.Proportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -329,6 +492,33 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = m.HyperInflationPeak.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.HyperInflationStdDev.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.EndHyperInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.DistributionProportions) > 0 {
+		for _, e := range m.DistributionProportions {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionProportion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Proportion.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -672,6 +862,258 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperInflationPeak", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HyperInflationPeak.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperInflationStdDev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HyperInflationStdDev.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHyperInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndHyperInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionProportions = append(m.DistributionProportions, DistributionProportion{})
+			if err := m.DistributionProportions[len(m.DistributionProportions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -6,9 +6,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// EndHyperInflation is end of the hyper-inflationary period. The hyperinflationary regime ends at 13.5%.
-var EndHyperInflation = sdk.NewIntWithDecimal(250_000_000, 18)
-
 // NewMinter returns a new Minter object with the given inflation and annual
 // provisions values.
 func NewMinter(inflation, annualProvisions sdk.Dec) Minter {
@@ -45,13 +42,19 @@ func ValidateMinter(minter Minter) error {
 
 // NextInflationRate returns the new inflation rate for the next hour.
 func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec, totalSupply sdk.Int) sdk.Dec {
+	return m.nextInflationRate(params, bondedRatio, totalSupply, 1)
+}
+
+// nextInflationRate returns the inflation rate after the given number of
+// blocks, the bonded ratio and the total supply being kept constant.
+func (m Minter) nextInflationRate(params Params, bondedRatio sdk.Dec, totalSupply sdk.Int, blocks uint64) sdk.Dec {
 	// NOM staking is defined by an initial hyper-inflationary regime followed by an
 	// infinite regime stabilizing % staked around a goal.
 
 	// Initialize the inflation variable
 	inflation := sdk.NewDec(int64(0))
 
-	if totalSupply.GTE(EndHyperInflation) {
+	if totalSupply.GTE(params.EndHyperInflation) {
 		// Infinite stabilized regime
 		//
 		// The target annual inflation rate is recalculated for each previsions cycle. The
@@ -64,7 +67,7 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec, totalSuppl
 		inflationRateChangePerYear := sdk.OneDec().
 			Sub(bondedRatio.Quo(params.GoalBonded)).
 			Mul(params.InflationRateChange)
-		inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear)))
+		inflationRateChange := inflationRateChangePerYear.MulInt64(int64(blocks)).Quo(sdk.NewDec(int64(params.BlocksPerYear)))
 
 		// adjust the new annual inflation for this next cycle
 		inflation = m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
@@ -77,7 +80,7 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec, totalSuppl
 		return inflation
 	}
 
-	return globalInflationCurve.CalculateInflationDec(totalSupply)
+	return inflationCurveFor(params.HyperInflationPeak, params.HyperInflationStdDev).CalculateInflationDec(totalSupply)
 }
 
 // NextAnnualProvisions returns the annual provisions based on current total
@@ -17,7 +17,7 @@ func TestNextInflation(t *testing.T) {
 	// Governing Mechanism:
 	//    inflationRateChangePerYear = (1- BondedRatio/ GoalBonded) * MaxInflationRateChange
 
-	var stableSupply = EndHyperInflation
+	var stableSupply = params.EndHyperInflation
 	var dec50m, _ = sdk.NewDecFromStr("0.135335283236612691")
 	var dec150m, _ = sdk.NewDecFromStr("1.000000000000000000")
 	var dec200m, _ = sdk.NewDecFromStr("0.606530659712633423")
@@ -64,6 +64,28 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestNextInflationWithCurveParams(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	params.HyperInflationPeak = sdk.NewIntWithDecimal(100_000_000, 18)
+	params.HyperInflationStdDev = sdk.NewIntWithDecimal(25_000_000, 18)
+	params.EndHyperInflation = sdk.NewIntWithDecimal(200_000_000, 18)
+
+	// the curve follows the peak and the standard deviation of the params
+	var dec100m, _ = sdk.NewDecFromStr("1.000000000000000000")
+	require.Equal(t, dec100m, minter.NextInflationRate(params, sdk.ZeroDec(), params.HyperInflationPeak))
+	var oneStdDev = DefaultParams().HyperInflationPeak.Sub(DefaultParams().HyperInflationStdDev)
+	require.Equal(t,
+		minter.NextInflationRate(DefaultParams(), sdk.ZeroDec(), oneStdDev),
+		minter.NextInflationRate(params, sdk.ZeroDec(), params.HyperInflationPeak.Sub(params.HyperInflationStdDev)),
+	)
+
+	// the stabilized regime starts at the end of hyperinflation of the params
+	minter.Inflation = sdk.NewDecWithPrec(15, 2)
+	require.Equal(t, minter.Inflation, minter.NextInflationRate(params, params.GoalBonded, params.EndHyperInflation))
+	require.NotEqual(t, minter.Inflation, minter.NextInflationRate(params, params.GoalBonded, params.EndHyperInflation.SubRaw(1)))
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
 	params := DefaultParams()
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,47 +9,111 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store keys
 var (
-	KeyMintDenom           = []byte("MintDenom")
-	KeyInflationRateChange = []byte("InflationRateChange")
-	KeyInflationMax        = []byte("InflationMax")
-	KeyInflationMin        = []byte("InflationMin")
-	KeyGoalBonded          = []byte("GoalBonded")
-	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyMintDenom            = []byte("MintDenom")
+	KeyInflationRateChange  = []byte("InflationRateChange")
+	KeyInflationMax         = []byte("InflationMax")
+	KeyInflationMin         = []byte("InflationMin")
+	KeyGoalBonded           = []byte("GoalBonded")
+	KeyBlocksPerYear        = []byte("BlocksPerYear")
+	KeyHyperInflationPeak   = []byte("HyperInflationPeak")
+	KeyHyperInflationStdDev = []byte("HyperInflationStdDev")
+	KeyEndHyperInflation    = []byte("EndHyperInflation")
+
+	KeyDistributionProportions = []byte("DistributionProportions")
+)
+
+var (
+	// DefaultHyperInflationPeak is the default peak position of the hyperinflation bell curve,
+	// at 150M NOM.
+	DefaultHyperInflationPeak = sdk.NewIntWithDecimal(150_000_000, 18)
+	// DefaultHyperInflationStdDev is the default standard deviation of the hyperinflation bell
+	// curve, of 50M NOM.
+	DefaultHyperInflationStdDev = sdk.NewIntWithDecimal(50_000_000, 18)
+	// DefaultEndHyperInflation is the default end of the hyper-inflationary period, at 250M NOM.
+	// The hyperinflationary regime ends at 13.5%.
+	DefaultEndHyperInflation = sdk.NewIntWithDecimal(250_000_000, 18)
+
+	// maxHyperInflationStdDev bounds the standard deviation of the hyperinflation bell curve, so
+	// that its constants keep enough significant bits, see TestInflationConstants.
+	maxHyperInflationStdDev = sdk.NewIntWithDecimal(1, 29)
 )
 
+// DefaultDistributionProportions returns the default distribution of the minted
+// coins, all sent to the fee collector.
+func DefaultDistributionProportions() []DistributionProportion {
+	return FeeCollectorDistributionProportions(authtypes.FeeCollectorName)
+}
+
+// FeeCollectorDistributionProportions returns a distribution of the minted coins
+// sending them all to the given fee collector module account.
+func FeeCollectorDistributionProportions(feeCollectorName string) []DistributionProportion {
+	return []DistributionProportion{{Recipient: feeCollectorName, Proportion: sdk.OneDec()}}
+}
+
 // ParamTable for minting module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// ParamKeyTableWithRecipientValidation returns the parameter key table for mint
+// module, the distribution proportions being also validated by
+// validateRecipients, e.g. against the module accounts of the app.
+func ParamKeyTableWithRecipientValidation(validateRecipients func([]DistributionProportion) error) paramtypes.KeyTable {
+	table := paramtypes.NewKeyTable()
+	for _, pair := range (&Params{}).ParamSetPairs() {
+		if bytes.Equal(pair.Key, KeyDistributionProportions) {
+			pair.ValidatorFn = func(i interface{}) error {
+				if err := validateDistributionProportions(i); err != nil {
+					return err
+				}
+				return validateRecipients(i.([]DistributionProportion))
+			}
+		}
+		table = table.RegisterType(pair)
+	}
+
+	return table
+}
+
 func NewParams(
 	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64,
+	hyperInflationPeak, hyperInflationStdDev, endHyperInflation sdk.Int,
+	distributionProportions []DistributionProportion,
 ) Params {
 
 	return Params{
-		MintDenom:           mintDenom,
-		InflationRateChange: inflationRateChange,
-		InflationMax:        inflationMax,
-		InflationMin:        inflationMin,
-		GoalBonded:          goalBonded,
-		BlocksPerYear:       blocksPerYear,
+		MintDenom:               mintDenom,
+		InflationRateChange:     inflationRateChange,
+		InflationMax:            inflationMax,
+		InflationMin:            inflationMin,
+		GoalBonded:              goalBonded,
+		BlocksPerYear:           blocksPerYear,
+		HyperInflationPeak:      hyperInflationPeak,
+		HyperInflationStdDev:    hyperInflationStdDev,
+		EndHyperInflation:       endHyperInflation,
+		DistributionProportions: distributionProportions,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: sdk.NewDecWithPrec(13, 2),
-		InflationMax:        sdk.NewDecWithPrec(20, 2),
-		InflationMin:        sdk.NewDecWithPrec(7, 2),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:               sdk.DefaultBondDenom,
+		InflationRateChange:     sdk.NewDecWithPrec(13, 2),
+		InflationMax:            sdk.NewDecWithPrec(20, 2),
+		InflationMin:            sdk.NewDecWithPrec(7, 2),
+		GoalBonded:              sdk.NewDecWithPrec(67, 2),
+		BlocksPerYear:           uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		HyperInflationPeak:      DefaultHyperInflationPeak,
+		HyperInflationStdDev:    DefaultHyperInflationStdDev,
+		EndHyperInflation:       DefaultEndHyperInflation,
+		DistributionProportions: DefaultDistributionProportions(),
 	}
 }
 
@@ -72,6 +137,18 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateHyperInflationPeak(p.HyperInflationPeak); err != nil {
+		return err
+	}
+	if err := validateHyperInflationStdDev(p.HyperInflationStdDev); err != nil {
+		return err
+	}
+	if err := validateEndHyperInflation(p.EndHyperInflation); err != nil {
+		return err
+	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -98,6 +175,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyHyperInflationPeak, &p.HyperInflationPeak, validateHyperInflationPeak),
+		paramtypes.NewParamSetPair(KeyHyperInflationStdDev, &p.HyperInflationStdDev, validateHyperInflationStdDev),
+		paramtypes.NewParamSetPair(KeyEndHyperInflation, &p.EndHyperInflation, validateEndHyperInflation),
+		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
 	}
 }
 
@@ -193,3 +274,79 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateHyperInflationPeak(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("hyperinflation peak must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateHyperInflationStdDev(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("hyperinflation standard deviation must be positive: %s", v)
+	}
+	if v.GT(maxHyperInflationStdDev) {
+		return fmt.Errorf("hyperinflation standard deviation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateEndHyperInflation(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("end of hyperinflation cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.([]DistributionProportion)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return errors.New("distribution proportions cannot be empty")
+	}
+
+	total := sdk.ZeroDec()
+	recipients := make(map[string]bool, len(v))
+	for _, dp := range v {
+		if strings.TrimSpace(dp.Recipient) == "" {
+			return errors.New("distribution recipient cannot be blank")
+		}
+		if recipients[dp.Recipient] {
+			return fmt.Errorf("duplicate distribution recipient: %s", dp.Recipient)
+		}
+		recipients[dp.Recipient] = true
+
+		if dp.Proportion.IsNil() || !dp.Proportion.IsPositive() {
+			return fmt.Errorf("distribution proportion of %s must be positive: %s", dp.Recipient, dp.Proportion)
+		}
+		total = total.Add(dp.Proportion)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution proportions must sum to one: %s", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*Params)
+		expErr   bool
+	}{
+		{"default", func(*Params) {}, false},
+		{"zero hyperinflation peak", func(p *Params) { p.HyperInflationPeak = sdk.ZeroInt() }, true},
+		{"nil hyperinflation peak", func(p *Params) { p.HyperInflationPeak = sdk.Int{} }, true},
+		{"zero hyperinflation std dev", func(p *Params) { p.HyperInflationStdDev = sdk.ZeroInt() }, true},
+		{"too large hyperinflation std dev", func(p *Params) { p.HyperInflationStdDev = maxHyperInflationStdDev.AddRaw(1) }, true},
+		{"max hyperinflation std dev", func(p *Params) { p.HyperInflationStdDev = maxHyperInflationStdDev }, false},
+		{"zero end of hyperinflation", func(p *Params) { p.EndHyperInflation = sdk.ZeroInt() }, false},
+		{"negative end of hyperinflation", func(p *Params) { p.EndHyperInflation = sdk.NewInt(-1) }, true},
+		{"empty distribution proportions", func(p *Params) { p.DistributionProportions = nil }, true},
+		{"split distribution proportions", func(p *Params) {
+			p.DistributionProportions = []DistributionProportion{
+				{Recipient: "fee_collector", Proportion: sdk.NewDecWithPrec(7, 1)},
+				{Recipient: "distribution", Proportion: sdk.NewDecWithPrec(3, 1)},
+			}
+		}, false},
+		{"blank distribution recipient", func(p *Params) { p.DistributionProportions[0].Recipient = " " }, true},
+		{"nil distribution proportion", func(p *Params) { p.DistributionProportions[0].Proportion = sdk.Dec{} }, true},
+		{"duplicate distribution recipient", func(p *Params) {
+			p.DistributionProportions = []DistributionProportion{
+				{Recipient: "fee_collector", Proportion: sdk.NewDecWithPrec(5, 1)},
+				{Recipient: "fee_collector", Proportion: sdk.NewDecWithPrec(5, 1)},
+			}
+		}, true},
+		{"zero distribution proportion", func(p *Params) {
+			p.DistributionProportions = append(p.DistributionProportions,
+				DistributionProportion{Recipient: "distribution", Proportion: sdk.ZeroDec()})
+		}, true},
+		{"distribution proportions not summing to one", func(p *Params) {
+			p.DistributionProportions[0].Proportion = sdk.NewDecWithPrec(9, 1)
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
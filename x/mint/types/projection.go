@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxProjectionHorizon is the maximum number of blocks the minter can be
+	// simulated for by a projection.
+	MaxProjectionHorizon uint64 = 1_000_000
+
+	// MaxProjectionSteps is the maximum number of steps of a projection, which
+	// bounds the cost of the query. The minter is simulated block by block for
+	// horizons of at most MaxProjectionSteps blocks, and with steps of several
+	// blocks for longer horizons.
+	MaxProjectionSteps uint64 = 5_000
+
+	// MaxProjectionSamples is the maximum number of samples returned by a
+	// projection.
+	MaxProjectionSamples uint64 = 100
+)
+
+// Project simulates the minter forward for horizonBlocks blocks after the
+// given height, running the same steps as the BeginBlocker with the bonded
+// ratio kept constant and the minted coins added to the total supply. Horizons
+// longer than MaxProjectionSteps blocks are simulated in MaxProjectionSteps
+// steps of several blocks, the inflation and annual provisions being computed
+// once per step and the block provision minted for every block of the step. It
+// returns at most MaxProjectionSamples samples taken at a regular interval,
+// the last one being the state after the last simulated block. The minter
+// itself is left unchanged.
+func (m Minter) Project(params Params, bondedRatio sdk.Dec, totalSupply sdk.Int, height int64, horizonBlocks uint64) []ProjectionSample {
+	stride := ceilDiv(horizonBlocks, MaxProjectionSteps)
+	steps := ceilDiv(horizonBlocks, stride)
+	interval := ceilDiv(steps, MaxProjectionSamples)
+
+	samples := make([]ProjectionSample, 0, MaxProjectionSamples)
+	var blocks uint64
+	for i := uint64(1); i <= steps; i++ {
+		// the last step may be shorter
+		stepBlocks := stride
+		if horizonBlocks-blocks < stepBlocks {
+			stepBlocks = horizonBlocks - blocks
+		}
+		blocks += stepBlocks
+
+		m.Inflation = m.nextInflationRate(params, bondedRatio, totalSupply, stepBlocks)
+		m.AnnualProvisions = m.NextAnnualProvisions(params, totalSupply)
+		totalSupply = totalSupply.Add(m.BlockProvision(params).Amount.MulRaw(int64(stepBlocks)))
+
+		if i%interval == 0 || i == steps {
+			samples = append(samples, ProjectionSample{
+				Height:           height + int64(blocks),
+				TotalSupply:      totalSupply,
+				Inflation:        m.Inflation,
+				AnnualProvisions: m.AnnualProvisions,
+			})
+		}
+	}
+
+	return samples
+}
+
+// ceilDiv returns a divided by b, rounded up.
+func ceilDiv(a, b uint64) uint64 {
+	q := a / b
+	if a%b != 0 {
+		q++
+	}
+	return q
+}
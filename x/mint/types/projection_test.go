@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestProject(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+	// the projection crosses the end of the hyperinflation
+	totalSupply := params.EndHyperInflation.Sub(sdk.NewIntWithDecimal(1, 18))
+
+	tests := []struct {
+		horizon       uint64
+		expInterval   uint64
+		expNumSamples int
+	}{
+		{1, 1, 1},
+		{50, 1, 50},
+		{100, 1, 100},
+		{250, 3, 84},
+		{1000, 10, 100},
+	}
+	for _, tc := range tests {
+		samples := minter.Project(params, bondedRatio, totalSupply, 10, tc.horizon)
+		require.Len(t, samples, tc.expNumSamples, "horizon: %d", tc.horizon)
+
+		// replay the begin blocker steps and compare with every sample
+		expMinter, expSupply := minter, totalSupply
+		next := 0
+		for i := uint64(1); i <= tc.horizon; i++ {
+			expMinter.Inflation = expMinter.NextInflationRate(params, bondedRatio, expSupply)
+			expMinter.AnnualProvisions = expMinter.NextAnnualProvisions(params, expSupply)
+			expSupply = expSupply.Add(expMinter.BlockProvision(params).Amount)
+
+			if i%tc.expInterval != 0 && i != tc.horizon {
+				continue
+			}
+			sample := samples[next]
+			require.Equal(t, int64(10+i), sample.Height)
+			require.Equal(t, expSupply, sample.TotalSupply)
+			require.Equal(t, expMinter.Inflation, sample.Inflation)
+			require.Equal(t, expMinter.AnnualProvisions, sample.AnnualProvisions)
+			next++
+		}
+		require.Equal(t, tc.expNumSamples, next)
+		require.True(t, samples[len(samples)-1].TotalSupply.GT(totalSupply))
+	}
+
+	// the minter is left unchanged
+	require.Equal(t, DefaultInitialMinter(), minter)
+}
+
+func TestProjectLongHorizon(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+	totalSupply := params.EndHyperInflation.Sub(sdk.NewIntWithDecimal(1, 18))
+
+	// the horizon is simulated in steps of 5 blocks, the last one of 2 blocks
+	horizon := 4*MaxProjectionSteps + 2
+	samples := minter.Project(params, bondedRatio, totalSupply, 10, horizon)
+	require.Len(t, samples, 98)
+	for i, sample := range samples[:len(samples)-1] {
+		require.Equal(t, int64(10+(i+1)*41*5), sample.Height)
+	}
+	last := samples[len(samples)-1]
+	require.Equal(t, int64(10+horizon), last.Height)
+
+	// the projection stays close to the one simulated block by block
+	expMinter, expSupply := minter, totalSupply
+	for i := uint64(1); i <= horizon; i++ {
+		expMinter.Inflation = expMinter.NextInflationRate(params, bondedRatio, expSupply)
+		expMinter.AnnualProvisions = expMinter.NextAnnualProvisions(params, expSupply)
+		expSupply = expSupply.Add(expMinter.BlockProvision(params).Amount)
+	}
+	tolerance := sdk.NewDecWithPrec(1, 6)
+	require.True(t, last.TotalSupply.Sub(expSupply).Abs().ToDec().QuoInt(expSupply).LTE(tolerance))
+	require.True(t, last.Inflation.Sub(expMinter.Inflation).Abs().LTE(tolerance))
+}
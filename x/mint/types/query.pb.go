@@ -268,6 +268,163 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryProjectionRequest is the request type for the Query/Projection RPC
+// method.
+type QueryProjectionRequest struct {
+	// horizon_blocks is the number of blocks to simulate the minter for.
+	HorizonBlocks uint64 `protobuf:"varint,1,opt,name=horizon_blocks,json=horizonBlocks,proto3" json:"horizon_blocks,omitempty"`
+	// assumed_bonded_ratio is the bonded ratio assumed for every simulated block.
+	// The current bonded ratio is used when it is empty.
+	AssumedBondedRatio string `protobuf:"bytes,2,opt,name=assumed_bonded_ratio,json=assumedBondedRatio,proto3" json:"assumed_bonded_ratio,omitempty"`
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryProjectionRequest) GetHorizonBlocks() uint64 {
+	if m != nil {
+		return m.HorizonBlocks
+	}
+	return 0
+}
+
+func (m *QueryProjectionRequest) GetAssumedBondedRatio() string {
+	if m != nil {
+		return m.AssumedBondedRatio
+	}
+	return ""
+}
+
+// QueryProjectionResponse is the response type for the Query/Projection RPC
+// method.
+type QueryProjectionResponse struct {
+	// samples are the projected minter states, sampled at a regular interval of
+	// blocks up to the horizon.
+	Samples []ProjectionSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetSamples() []ProjectionSample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+// ProjectionSample is the projected state of the minter after a block.
+type ProjectionSample struct {
+	// height is the projected block height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// total_supply is the projected total supply of the staking token.
+	TotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_supply"`
+	// inflation is the projected minting inflation value.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// annual_provisions is the projected minting annual provisions value.
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+}
+
+func (m *ProjectionSample) Reset()         { *m = ProjectionSample{} }
+func (m *ProjectionSample) String() string { return proto.CompactTextString(m) }
+func (*ProjectionSample) ProtoMessage()    {}
+func (*ProjectionSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *ProjectionSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectionSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectionSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectionSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectionSample.Merge(m, src)
+}
+func (m *ProjectionSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectionSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectionSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectionSample proto.InternalMessageInfo
+
+func (m *ProjectionSample) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -275,40 +432,55 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryProjectionRequest)(nil), "cosmos.mint.v1beta1.QueryProjectionRequest")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "cosmos.mint.v1beta1.QueryProjectionResponse")
+	proto.RegisterType((*ProjectionSample)(nil), "cosmos.mint.v1beta1.ProjectionSample")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x05, 0xcb, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0xec, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe0, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe4, 0x62, 0x2b, 0x00, 0x8b, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xeb, 0x61, 0x71, 0xa6, 0x1e, 0x44, 0x93, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x50, 0x0d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x13, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x84, 0x59,
-	0x95, 0xc6, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0xcd, 0x87, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x90,
-	0xc7, 0x49, 0x0f, 0x64, 0xe6, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x0f, 0x42, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0xe4, 0x20, 0x84, 0x01, 0x4a, 0x72, 0x5c, 0x32, 0x60, 0x7b, 0x1c,
-	0xf3, 0xf2, 0x4a, 0x13, 0x73, 0x02, 0x8a, 0xf2, 0xcb, 0x32, 0x8b, 0x41, 0xe1, 0x04, 0x73, 0x47,
-	0x0d, 0x97, 0x2c, 0x0e, 0x79, 0xa8, 0x73, 0xa2, 0xb9, 0x04, 0x13, 0xc1, 0x72, 0xf1, 0x05, 0x70,
-	0x49, 0x32, 0x9d, 0x25, 0x90, 0x88, 0x66, 0x89, 0xd1, 0x51, 0x66, 0x2e, 0x56, 0xb0, 0xf5, 0x42,
-	0x0d, 0x8c, 0x5c, 0x6c, 0x90, 0x10, 0x14, 0x52, 0xc7, 0x1a, 0xbc, 0x98, 0xd1, 0x25, 0xa5, 0x41,
-	0x58, 0x21, 0xc4, 0x13, 0x4a, 0xca, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x92, 0x15, 0x92, 0xd6, 0xc7,
-	0x96, 0x2e, 0x20, 0x71, 0x25, 0xd4, 0xc3, 0xc8, 0xc5, 0x09, 0x8f, 0x0e, 0x21, 0x2d, 0xdc, 0x86,
-	0xa3, 0x47, 0xa6, 0x94, 0x36, 0x51, 0x6a, 0xa1, 0x6e, 0x51, 0x03, 0xbb, 0x45, 0x41, 0x48, 0x0e,
-	0xab, 0x5b, 0xe0, 0x31, 0x27, 0xb4, 0x92, 0x91, 0x4b, 0x00, 0x3d, 0x56, 0x84, 0x0c, 0x71, 0xdb,
-	0x84, 0x23, 0x86, 0xa5, 0x8c, 0x48, 0xd1, 0x02, 0x75, 0xa3, 0x1e, 0xd8, 0x8d, 0x1a, 0x42, 0x6a,
-	0x58, 0xdd, 0x88, 0x91, 0x1e, 0x9c, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x13, 0x6f, 0xda, 0xa8, 0x80, 0x18, 0x0c, 0x4e, 0x22, 0x49, 0x6c, 0xe0, 0xac, 0x69,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xed, 0x9c, 0x7b, 0x26, 0x04, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x73, 0xd2, 0x40,
+	0x18, 0x26, 0x80, 0x38, 0x2c, 0xd5, 0xc1, 0x2d, 0xb6, 0x4c, 0xda, 0x06, 0x8c, 0x53, 0x8a, 0x5f,
+	0x89, 0xe0, 0xc9, 0xa3, 0xa8, 0x87, 0xce, 0x78, 0xa0, 0xe9, 0x4d, 0x0f, 0xb8, 0xc0, 0x36, 0xc4,
+	0x26, 0xd9, 0x90, 0xdd, 0x74, 0xc4, 0xf1, 0xe0, 0x78, 0xf6, 0xd0, 0x19, 0xff, 0x84, 0xfe, 0x93,
+	0x1e, 0x3b, 0xe3, 0xa5, 0xe3, 0xa1, 0xe3, 0x80, 0x3f, 0xc4, 0xc9, 0x66, 0x01, 0x0b, 0xa1, 0xb6,
+	0xea, 0xa9, 0xe5, 0x7d, 0x9e, 0xf7, 0x7d, 0x9e, 0x7d, 0x3f, 0x00, 0x94, 0x3a, 0x84, 0x3a, 0x84,
+	0xea, 0x8e, 0xe5, 0x32, 0xfd, 0xa0, 0xd6, 0xc6, 0x0c, 0xd5, 0xf4, 0x7e, 0x80, 0xfd, 0x81, 0xe6,
+	0xf9, 0x84, 0x11, 0xb8, 0x1c, 0x11, 0xb4, 0x90, 0xa0, 0x09, 0x82, 0x5c, 0x30, 0x89, 0x49, 0x38,
+	0xae, 0x87, 0xff, 0x45, 0x54, 0x79, 0xdd, 0x24, 0xc4, 0xb4, 0xb1, 0x8e, 0x3c, 0x4b, 0x47, 0xae,
+	0x4b, 0x18, 0x62, 0x16, 0x71, 0xa9, 0x40, 0x95, 0x38, 0x25, 0x5e, 0x95, 0xe3, 0x6a, 0x01, 0xc0,
+	0x9d, 0x50, 0xb7, 0x89, 0x7c, 0xe4, 0x50, 0x03, 0xf7, 0x03, 0x4c, 0x99, 0xda, 0x04, 0xcb, 0x67,
+	0xa2, 0xd4, 0x23, 0x2e, 0xc5, 0xf0, 0x31, 0xc8, 0x78, 0x3c, 0x52, 0x94, 0xca, 0x52, 0x35, 0x57,
+	0x5f, 0xd3, 0x62, 0x6c, 0x6a, 0x51, 0x52, 0x23, 0x7d, 0x74, 0x5a, 0x4a, 0x18, 0x22, 0x41, 0x5d,
+	0x05, 0x37, 0x79, 0xc5, 0x6d, 0x77, 0xcf, 0xe6, 0x06, 0xc7, 0x52, 0x7b, 0x60, 0x65, 0x16, 0x10,
+	0x6a, 0x2f, 0x40, 0xd6, 0x1a, 0x07, 0xb9, 0xe0, 0x52, 0x43, 0x0b, 0x6b, 0x7e, 0x3f, 0x2d, 0x55,
+	0x4c, 0x8b, 0xf5, 0x82, 0xb6, 0xd6, 0x21, 0x8e, 0x2e, 0x1e, 0x18, 0xfd, 0x79, 0x40, 0xbb, 0xfb,
+	0x3a, 0x1b, 0x78, 0x98, 0x6a, 0xcf, 0x70, 0xc7, 0x98, 0x16, 0x50, 0x15, 0xb0, 0xce, 0x75, 0x9e,
+	0xb8, 0x6e, 0x80, 0xec, 0xa6, 0x4f, 0x0e, 0x2c, 0x1a, 0xf6, 0x69, 0xec, 0xe3, 0x3d, 0xd8, 0x58,
+	0x80, 0x0b, 0x3b, 0xaf, 0xc0, 0x0d, 0xc4, 0xb1, 0x96, 0x37, 0x01, 0xff, 0xd2, 0x56, 0x1e, 0xcd,
+	0x88, 0xa8, 0x7d, 0xd1, 0x85, 0xa6, 0x4f, 0xde, 0xe0, 0xce, 0x6f, 0xfd, 0x81, 0x9b, 0xe0, 0x7a,
+	0x8f, 0xf8, 0xd6, 0x3b, 0xe2, 0xb6, 0xda, 0x36, 0xe9, 0xec, 0x47, 0x9a, 0x69, 0xe3, 0x9a, 0x88,
+	0x36, 0x78, 0x10, 0x3e, 0x04, 0x05, 0x44, 0x69, 0xe0, 0xe0, 0x6e, 0xab, 0x4d, 0xdc, 0x2e, 0xee,
+	0xb6, 0xfc, 0xf0, 0xdd, 0xc5, 0x64, 0x59, 0xaa, 0x66, 0x0d, 0x28, 0xb0, 0x06, 0x87, 0x8c, 0x10,
+	0x51, 0x5f, 0x83, 0xd5, 0x39, 0x49, 0xf1, 0xd4, 0xe7, 0xe0, 0x2a, 0x45, 0x8e, 0x67, 0xe3, 0x50,
+	0x2c, 0x55, 0xcd, 0xd5, 0x37, 0xe3, 0x07, 0x3d, 0xc9, 0xdc, 0xe5, 0x6c, 0x31, 0xf2, 0x71, 0xae,
+	0xfa, 0x25, 0x09, 0xf2, 0xb3, 0x1c, 0xb8, 0x02, 0x32, 0x3d, 0x6c, 0x99, 0x3d, 0xc6, 0xdf, 0x91,
+	0x32, 0xc4, 0x27, 0xb8, 0x03, 0x96, 0x18, 0x61, 0xc8, 0x6e, 0xd1, 0xc0, 0xf3, 0xec, 0x41, 0x31,
+	0x79, 0xe9, 0xce, 0x6e, 0xbb, 0xcc, 0xc8, 0xf1, 0x1a, 0xbb, 0xbc, 0xc4, 0xd9, 0x05, 0x4a, 0xfd,
+	0xe3, 0x02, 0xc5, 0xcf, 0x3f, 0xfd, 0x7f, 0xe6, 0x5f, 0x3f, 0x49, 0x83, 0x2b, 0x7c, 0x1a, 0xf0,
+	0x83, 0x04, 0x32, 0xd1, 0x05, 0xc1, 0xad, 0xd8, 0xae, 0xcf, 0x9f, 0xab, 0x5c, 0xfd, 0x33, 0x31,
+	0x9a, 0xac, 0x7a, 0xfb, 0xe3, 0xb7, 0x9f, 0x9f, 0x93, 0x1b, 0x70, 0x4d, 0x8f, 0xfb, 0x5e, 0x88,
+	0x6e, 0x15, 0x7e, 0x92, 0x40, 0x76, 0x72, 0x8e, 0xf0, 0xee, 0xe2, 0xe2, 0xb3, 0xc7, 0x2c, 0xdf,
+	0xbb, 0x10, 0x57, 0x78, 0xa9, 0x70, 0x2f, 0x65, 0xa8, 0xc4, 0x7a, 0x99, 0x36, 0xfe, 0xab, 0x04,
+	0xf2, 0xb3, 0x57, 0x09, 0x6b, 0x8b, 0x95, 0x16, 0x5c, 0xb8, 0x5c, 0xbf, 0x4c, 0x8a, 0xf0, 0xa8,
+	0x71, 0x8f, 0x55, 0x58, 0x89, 0xf5, 0x38, 0xb7, 0x0f, 0xf0, 0x50, 0x02, 0x60, 0xba, 0xf2, 0xf0,
+	0x9c, 0x7e, 0xcc, 0x5d, 0xba, 0x7c, 0xff, 0x62, 0x64, 0xe1, 0x6c, 0x8b, 0x3b, 0xbb, 0x05, 0x4b,
+	0xf1, 0x93, 0x9c, 0x24, 0x34, 0x9e, 0x1e, 0x0d, 0x15, 0xe9, 0x78, 0xa8, 0x48, 0x3f, 0x86, 0x8a,
+	0x74, 0x38, 0x52, 0x12, 0xc7, 0x23, 0x25, 0x71, 0x32, 0x52, 0x12, 0x2f, 0xef, 0x9c, 0xbb, 0xae,
+	0x6f, 0xa3, 0x8a, 0x7c, 0x6b, 0xdb, 0x19, 0xfe, 0x6b, 0xf1, 0xe8, 0xd7, 0x00, 0xee, 0x2a, 0x4e,
+	0x7a, 0xb9, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +501,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// Projection simulates the minter forward from the current state and returns
+	// samples of the projected supply, inflation and annual provisions.
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -366,6 +541,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -374,6 +558,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// Projection simulates the minter forward from the current state and returns
+	// samples of the projected supply, inflation and annual provisions.
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -389,6 +576,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -448,6 +638,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -464,6 +672,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -637,6 +849,136 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssumedBondedRatio) > 0 {
+		i -= len(m.AssumedBondedRatio)
+		copy(dAtA[i:], m.AssumedBondedRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssumedBondedRatio)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HorizonBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HorizonBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for iNdEx := len(m.Samples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Samples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectionSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectionSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectionSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -708,6 +1050,55 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HorizonBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.HorizonBlocks))
+	}
+	l = len(m.AssumedBondedRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectionSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1504,359 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonBlocks", wireType)
+			}
+			m.HorizonBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HorizonBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssumedBondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssumedBondedRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, ProjectionSample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectionSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectionSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectionSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -413,6 +413,41 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	tstaking.Handle(msgEditValidator, false)
 }
 
+func TestMinCommissionRate(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, initBond)
+	validatorAddr := valAddrs[0]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set the minimum commission rate
+	minCommissionRate := sdk.NewDecWithPrec(5, 2)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = minCommissionRate
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// a validator cannot be created with a commission below the minimum
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(4, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(5, 2))
+	tstaking.CreateValidator(validatorAddr, PKs[0], initBond, false)
+
+	tstaking.Commission = types.NewCommissionRates(minCommissionRate, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(5, 2))
+	tstaking.CreateValidator(validatorAddr, PKs[0], initBond, true)
+
+	// nor be edited to a commission below the minimum
+	ctx = tstaking.TurnBlockTimeDiff(time.Hour * 25)
+	newRate := sdk.NewDecWithPrec(4, 2)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	_, err := staking.NewHandler(app.StakingKeeper)(ctx, msgEditValidator)
+	require.ErrorIs(t, err, types.ErrCommissionLTMinRate)
+
+	newRate = sdk.NewDecWithPrec(6, 2)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	tstaking.Handle(msgEditValidator, true)
+
+	validator := tstaking.CheckValidator(validatorAddr, -1, false)
+	require.Equal(t, newRate, validator.Commission.Rate)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 
@@ -1215,3 +1250,155 @@ func TestInvalidCoinDenom(t *testing.T) {
 	msgRedelegate = types.NewMsgBeginRedelegate(delAddr, valA, valB, oneCoin)
 	tstaking.Handle(msgRedelegate, true)
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction))
+	valAddr, delAddr := valAddrs[0], delAddrs[1]
+	ctx = ctx.WithBlockHeight(10)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set the unbonding time
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = 10 * time.Second
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// create the validator and delegate to it
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tstaking.Delegate(delAddr, valAddr, delTokens)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	notBondedBalance := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), tstaking.Denom)
+	bondedBalance := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), tstaking.Denom)
+
+	// undelegate all the tokens
+	tstaking.Undelegate(delAddr, valAddr, delTokens, true)
+	tstaking.CheckDelegator(delAddr, valAddr, false)
+
+	cancelAmt := delTokens.QuoRaw(4)
+	testCases := []struct {
+		name           string
+		creationHeight int64
+		amount         sdk.Coin
+		ok             bool
+	}{
+		{"invalid denom", 10, sdk.NewCoin("churros", cancelAmt), false},
+		{"invalid height", 0, sdk.NewCoin(tstaking.Denom, cancelAmt), false},
+		{"no entry at height", 11, sdk.NewCoin(tstaking.Denom, cancelAmt), false},
+		{"amount greater than balance", 10, sdk.NewCoin(tstaking.Denom, delTokens.AddRaw(1)), false},
+		{"partial cancellation", 10, sdk.NewCoin(tstaking.Denom, cancelAmt), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, tc.creationHeight, tc.amount)
+			tstaking.Handle(msg, tc.ok)
+		})
+	}
+
+	// the entry is reduced and the tokens are delegated back to the validator
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, delTokens.Sub(cancelAmt), ubd.Entries[0].Balance)
+	require.Equal(t, delTokens.Sub(cancelAmt), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, cancelAmt.ToDec(), delegation.Shares)
+
+	// the tokens are moved back to the bonded pool
+	require.Equal(t, notBondedBalance.Add(sdk.NewCoin(tstaking.Denom, delTokens.Sub(cancelAmt))),
+		app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), tstaking.Denom))
+	require.Equal(t, bondedBalance.Sub(sdk.NewCoin(tstaking.Denom, delTokens.Sub(cancelAmt))),
+		app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), tstaking.Denom))
+
+	// cancelling the remaining balance removes the unbonding delegation
+	msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 10, sdk.NewCoin(tstaking.Denom, delTokens.Sub(cancelAmt)))
+	tstaking.Handle(msg, true)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec(), delegation.Shares)
+
+	// a matured entry cannot be cancelled
+	tstaking.Undelegate(delAddr, valAddr, cancelAmt, true)
+	tstaking.Ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.UnbondingTime))
+	msg = types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 10, sdk.NewCoin(tstaking.Denom, cancelAmt))
+	tstaking.Handle(msg, false)
+}
+
+func TestCancelUnbondingDelegationSlashed(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction))
+	valAddr, delAddr := valAddrs[0], delAddrs[1]
+	ctx = ctx.WithBlockHeight(10)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create the validator and delegate to it
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tstaking.Delegate(delAddr, valAddr, delTokens)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tstaking.Undelegate(delAddr, valAddr, delTokens, true)
+
+	// slash the validator for an infraction committed before the unbonding
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	app.StakingKeeper.Slash(ctx, consAddr, 5, 20, sdk.NewDecWithPrec(5, 1))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	slashedBalance := ubd.Entries[0].Balance
+	require.Equal(t, delTokens.QuoRaw(2), slashedBalance)
+
+	// the slashed tokens cannot be delegated back
+	msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 10, sdk.NewCoin(tstaking.Denom, delTokens))
+	tstaking.Handle(msg, false)
+
+	// the remaining balance can
+	msg = types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 10, sdk.NewCoin(tstaking.Denom, slashedBalance))
+	tstaking.Handle(msg, true)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, slashedBalance, validator.TokensFromShares(delegation.Shares).TruncateInt())
+}
+
+func TestCancelUnbondingDelegationDistributionHooks(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr, delAddr := sdk.ValAddress(addrs[0]), addrs[1]
+
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tstaking.Delegate(delAddr, valAddr, delTokens)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the starting info of the delegator is removed with the delegation
+	tstaking.Undelegate(delAddr, valAddr, delTokens, true)
+	require.False(t, app.DistrKeeper.HasDelegatorStartingInfo(ctx, valAddr, delAddr))
+
+	// and set again when the unbonding is cancelled
+	msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 10, sdk.NewCoin(tstaking.Denom, delTokens))
+	tstaking.Handle(msg, true)
+
+	require.True(t, app.DistrKeeper.HasDelegatorStartingInfo(ctx, valAddr, delAddr))
+	startingInfo := app.DistrKeeper.GetDelegatorStartingInfo(ctx, valAddr, delAddr)
+	require.Equal(t, delTokens.ToDec(), startingInfo.Stake)
+	require.Equal(t, uint64(10), startingInfo.Height)
+}
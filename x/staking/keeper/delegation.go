@@ -962,3 +962,110 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferDelegation transfers at most wantShares of the delegation of fromAddr
+// to the validator to toAddr, and returns the shares actually transferred. No
+// tokens are moved between the pools, the validator tokens and shares are left
+// unchanged and only the ownership of the delegation shares changes.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	transferred := sdk.ZeroDec()
+	if !wantShares.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	transferred = sdk.MinDec(delFrom.Shares, wantShares)
+
+	// credit the shares to the receiving delegation, creating it if needed
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	// debit the shares from the sending delegation
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+
+	// If the sending delegation is the operator of the validator and the transfer
+	// decreases the validator's self-delegation below their minimum, we jail the
+	// validator.
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if delFrom.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	return transferred
+}
+
+// TransferUnbonding transfers at most wantAmt of the balance of the unbonding
+// delegation entries of fromAddr from the validator to toAddr, keeping their
+// creation height and completion time, and returns the amount actually
+// transferred. Entries are only transferred while toAddr has not reached the
+// maximum number of unbonding delegation entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+	if !wantAmt.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred
+	}
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	entries := make([]types.UnbondingDelegationEntry, 0, len(ubdFrom.Entries))
+	for _, entry := range ubdFrom.Entries {
+		amt := sdk.MinInt(entry.Balance, wantAmt.Sub(transferred))
+		if !amt.IsPositive() || k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			entries = append(entries, entry)
+			continue
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+		transferred = transferred.Add(amt)
+
+		if amt.LT(entry.Balance) {
+			entry.Balance = entry.Balance.Sub(amt)
+			entry.InitialBalance = sdk.MaxInt(entry.InitialBalance.Sub(amt), entry.Balance)
+			entries = append(entries, entry)
+		}
+	}
+
+	if len(entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	} else {
+		ubdFrom.Entries = entries
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+
+	return transferred
+}
@@ -981,3 +981,94 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], PKs[0], sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+
+	// nothing to transfer
+	require.True(t, app.StakingKeeper.TransferDelegation(ctx, addrs[1], addrs[2], valAddrs[0], sdk.ZeroDec()).IsZero())
+	require.True(t, app.StakingKeeper.TransferDelegation(ctx, addrs[2], addrs[1], valAddrs[0], sdk.NewDec(10)).IsZero())
+
+	// transfer part of the delegation
+	transferred := app.StakingKeeper.TransferDelegation(ctx, addrs[1], addrs[2], valAddrs[0], sdk.NewDec(40))
+	require.Equal(t, sdk.NewDec(40), transferred)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(60), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(40), delegation.Shares)
+
+	// transfer more than the remaining delegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrs[1], addrs[2], valAddrs[0], sdk.NewDec(100))
+	require.Equal(t, sdk.NewDec(60), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.False(t, found)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegation.Shares)
+
+	// the validator is left unchanged
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(200), validator.Tokens)
+	require.Equal(t, sdk.NewDec(200), validator.DelegatorShares)
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], PKs[0], sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+	tstaking.Undelegate(addrs[1], valAddrs[0], sdk.NewInt(30), true)
+	tstaking.Ctx = tstaking.Ctx.WithBlockHeight(1)
+	tstaking.Undelegate(addrs[1], valAddrs[0], sdk.NewInt(20), true)
+
+	// nothing to transfer
+	require.True(t, app.StakingKeeper.TransferUnbonding(ctx, addrs[1], addrs[2], valAddrs[0], sdk.ZeroInt()).IsZero())
+	require.True(t, app.StakingKeeper.TransferUnbonding(ctx, addrs[2], addrs[1], valAddrs[0], sdk.NewInt(10)).IsZero())
+
+	// transfer the first entry and part of the second one
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrs[1], addrs[2], valAddrs[0], sdk.NewInt(40))
+	require.Equal(t, sdk.NewInt(40), transferred)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, int64(1), ubd.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(10), ubd.Entries[0].Balance)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	require.Equal(t, int64(0), ubd.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(30), ubd.Entries[0].Balance)
+	require.Equal(t, int64(1), ubd.Entries[1].CreationHeight)
+	require.Equal(t, sdk.NewInt(10), ubd.Entries[1].Balance)
+
+	// transfer more than the remaining entries
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrs[1], addrs[2], valAddrs[0], sdk.NewInt(40))
+	require.Equal(t, sdk.NewInt(10), transferred)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[1], valAddrs[0])
+	require.False(t, found)
+
+	// the transferred entries complete for the receiving delegator
+	ctx = ctx.WithBlockTime(ubd.Entries[0].CompletionTime)
+	balances, err := app.StakingKeeper.CompleteUnbonding(ctx, addrs[2], valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(50), balances.AmountOf(app.StakingKeeper.BondDenom(ctx)))
+}
@@ -7,6 +7,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -134,6 +135,13 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	commission := validator.Commission
 	blockTime := ctx.BlockHeader().Time
 
+	if minCommissionRate := k.MinCommissionRate(ctx); newRate.LT(minCommissionRate) {
+		return commission, sdkerrors.Wrapf(
+			types.ErrCommissionLTMinRate,
+			"cannot set validator commission to less than minimum rate of %s", minCommissionRate,
+		)
+	}
+
 	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
 		return commission, err
 	}
@@ -0,0 +1,51 @@
+package v045
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Add the MinCommissionRate param, set to DefaultMinCommissionRate unless
+// it was already set, e.g. by the upgrade handler running the migration.
+// - Raise the commission rate of the validators below MinCommissionRate to it,
+// along with their max rate if it is below as well.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyMinCommissionRate) {
+		paramSpace.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	}
+
+	var minCommissionRate sdk.Dec
+	paramSpace.Get(ctx, types.KeyMinCommissionRate, &minCommissionRate)
+
+	migrateValidatorsCommission(ctx, ctx.KVStore(storeKey), cdc, minCommissionRate)
+
+	return nil
+}
+
+func migrateValidatorsCommission(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, minCommissionRate sdk.Dec) {
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+
+	var validators []types.Validator
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+		if validator.Commission.Rate.LT(minCommissionRate) {
+			validators = append(validators, validator)
+		}
+	}
+	iterator.Close()
+
+	for _, validator := range validators {
+		validator.Commission.Rate = minCommissionRate
+		if validator.Commission.MaxRate.LT(minCommissionRate) {
+			validator.Commission.MaxRate = minCommissionRate
+		}
+		validator.Commission.UpdateTime = ctx.BlockHeader().Time
+
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(cdc, &validator))
+	}
+}
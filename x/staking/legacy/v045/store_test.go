@@ -0,0 +1,101 @@
+package v045_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v045staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, stakingKey, tStakingKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramSpace.Has(ctx, types.KeyMinCommissionRate))
+	require.NoError(t, v045staking.MigrateStore(ctx, stakingKey, encCfg.Marshaler, paramSpace))
+
+	var minCommissionRate sdk.Dec
+	paramSpace.Get(ctx, types.KeyMinCommissionRate, &minCommissionRate)
+	require.Equal(t, types.DefaultMinCommissionRate, minCommissionRate)
+}
+
+func TestMigrateStoreRaisesCommission(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, stakingKey, tStakingKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	store := ctx.KVStore(stakingKey)
+
+	updateTime := time.Unix(1000, 0).UTC()
+	blockTime := time.Unix(2000, 0).UTC()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: blockTime})
+
+	testCases := []struct {
+		name       string
+		commission types.Commission
+		expected   types.Commission
+	}{
+		{
+			"zero commission",
+			types.NewCommissionWithTime(sdk.ZeroDec(), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+			types.NewCommissionWithTime(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(1, 2), blockTime),
+		},
+		{
+			"commission below the minimum",
+			types.NewCommissionWithTime(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+			types.NewCommissionWithTime(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), blockTime),
+		},
+		{
+			"commission equal to the minimum",
+			types.NewCommissionWithTime(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+			types.NewCommissionWithTime(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+		},
+		{
+			"commission above the minimum",
+			types.NewCommissionWithTime(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+			types.NewCommissionWithTime(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2), updateTime),
+		},
+	}
+
+	valAddrs := make([]sdk.ValAddress, len(testCases))
+	for i, tc := range testCases {
+		_, pk, addr := testdata.KeyTestPubAddr()
+		valAddrs[i] = sdk.ValAddress(addr)
+
+		validator := teststaking.NewValidator(t, valAddrs[i], pk)
+		validator.Commission = tc.commission
+		store.Set(types.GetValidatorKey(valAddrs[i]), types.MustMarshalValidator(encCfg.Marshaler, &validator))
+	}
+
+	// the minimum commission rate is set by the upgrade handler before the migration
+	paramSpace.Set(ctx, types.KeyMinCommissionRate, sdk.NewDecWithPrec(5, 2))
+	require.NoError(t, v045staking.MigrateStore(ctx, stakingKey, encCfg.Marshaler, paramSpace))
+
+	var minCommissionRate sdk.Dec
+	paramSpace.Get(ctx, types.KeyMinCommissionRate, &minCommissionRate)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), minCommissionRate)
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := types.MustUnmarshalValidator(encCfg.Marshaler, store.Get(types.GetValidatorKey(valAddrs[i])))
+			require.Equal(t, tc.expected, validator.Commission)
+			require.NoError(t, validator.Commission.Validate())
+		})
+	}
+}
@@ -21,6 +21,8 @@ const (
 	OpWeightMsgDelegate        = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -34,6 +36,8 @@ func WeightedOperations(
 		weightMsgDelegate        int
 		weightMsgUndelegate      int
 		weightMsgBeginRedelegate int
+
+		weightMsgCancelUnbondingDelegation int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -66,6 +70,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -87,6 +97,10 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, k),
+		),
 	}
 }
 
@@ -370,6 +384,70 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 	}
 }
 
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation with random values
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator is not ok"), nil, nil
+		}
+
+		if validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "invalid delegation exchange rate"), nil, nil
+		}
+
+		// get random account and its unbonding delegation from the validator
+		valAddr := validator.GetOperator()
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		ubd, found := k.GetUnbondingDelegation(ctx, simAccount.Address, valAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "account does not have any unbonding delegation"), nil, nil
+		}
+
+		// get random unbonding delegation entry
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry is mature"), nil, nil
+		}
+
+		if !entry.Balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry balance is zero"), nil, nil
+		}
+
+		cancelAmt, err := simtypes.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "invalid cancel amount"), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			simAccount.Address, valAddr, entry.CreationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // SimulateMsgBeginRedelegate generates a MsgBeginRedelegate with random values
 func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
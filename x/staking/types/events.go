@@ -2,13 +2,14 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding         = "complete_unbonding"
+	EventTypeCompleteRedelegation      = "complete_redelegation"
+	EventTypeCreateValidator           = "create_validator"
+	EventTypeEditValidator             = "edit_validator"
+	EventTypeDelegate                  = "delegate"
+	EventTypeUnbond                    = "unbond"
+	EventTypeRedelegate                = "redelegate"
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -18,5 +19,6 @@ const (
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyCreationHeight    = "creation_height"
 	AttributeValueCategory        = ModuleName
 )
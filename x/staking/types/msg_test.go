@@ -197,3 +197,31 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		creationHeight int64
+		amount         sdk.Coin
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"nil amount", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.Coin{}, false},
+		{"zero height", sdk.AccAddress(valAddr1), valAddr2, 0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"negative height", sdk.AccAddress(valAddr1), valAddr2, -1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.creationHeight, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -35,6 +35,9 @@ const (
 var (
 	// DefaultMinGlobalSelfDelegation is zero.
 	DefaultMinGlobalSelfDelegation = sdk.ZeroInt()
+
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = sdk.ZeroDec()
 )
 
 var (
@@ -45,6 +48,7 @@ var (
 	KeyHistoricalEntries       = []byte("HistoricalEntries")
 	KeyPowerReduction          = []byte("PowerReduction")
 	KeyMinGlobalSelfDelegation = []byte("MinGlobalSelfDelegation")
+	KeyMinCommissionRate       = []byte("MinCommissionRate")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -55,7 +59,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minGlobalSelfDelegation sdk.Int) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minGlobalSelfDelegation sdk.Int, minCommissionRate sdk.Dec,
+) Params {
 	return Params{
 		UnbondingTime:           unbondingTime,
 		MaxValidators:           maxValidators,
@@ -63,6 +70,7 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historica
 		HistoricalEntries:       historicalEntries,
 		BondDenom:               bondDenom,
 		MinGlobalSelfDelegation: minGlobalSelfDelegation,
+		MinCommissionRate:       minCommissionRate,
 	}
 }
 
@@ -75,6 +83,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinGlobalSelfDelegation, &p.MinGlobalSelfDelegation, validateMinGlobalSelfDelegation),
+		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
 	}
 }
 
@@ -87,6 +96,7 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinGlobalSelfDelegation,
+		DefaultMinCommissionRate,
 	)
 }
 
@@ -138,6 +148,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -231,3 +245,22 @@ func validateMinGlobalSelfDelegation(i interface{}) error {
 
 	return nil
 }
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum commission rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum commission rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum commission rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
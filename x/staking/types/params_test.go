@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -21,3 +22,27 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateMinCommissionRate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rate    sdk.Dec
+		expPass bool
+	}{
+		{"zero", sdk.ZeroDec(), true},
+		{"positive", sdk.NewDecWithPrec(5, 2), true},
+		{"one", sdk.OneDec(), true},
+		{"negative", sdk.NewDecWithPrec(-1, 2), false},
+		{"greater than one", sdk.NewDecWithPrec(101, 2), false},
+	}
+
+	for _, tc := range testCases {
+		params := types.DefaultParams()
+		params.MinCommissionRate = tc.rate
+		if tc.expPass {
+			require.NoError(t, params.Validate(), tc.name)
+		} else {
+			require.Error(t, params.Validate(), tc.name)
+		}
+	}
+}
@@ -844,6 +844,8 @@ type Params struct {
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
 	// min_global_self_delegation is the validators' self declared minimum self delegation.
 	MinGlobalSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_global_self_delegation,json=minGlobalSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_global_self_delegation" yaml:"min_global_self_delegation"`
 }
@@ -1140,122 +1142,123 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1832 bytes of a gzipped FileDescriptorProto
+	// 1849 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6c, 0x1b, 0x59,
-	0x19, 0xf7, 0x24, 0x5e, 0xc7, 0xfe, 0x9c, 0xc6, 0xc9, 0x6b, 0xda, 0x3a, 0xa6, 0x78, 0xdc, 0x61,
-	0xb5, 0x04, 0xb4, 0xeb, 0xd0, 0x2c, 0x5a, 0x44, 0x2e, 0x50, 0xc7, 0xe9, 0xc6, 0xda, 0xa5, 0x84,
-	0x71, 0x1a, 0x24, 0x58, 0x61, 0x3d, 0xcf, 0xbc, 0x38, 0x43, 0x3c, 0x33, 0x66, 0xde, 0x73, 0x89,
-	0xa5, 0x3d, 0x70, 0xdc, 0x2d, 0x42, 0x94, 0xdb, 0x5e, 0x2a, 0x55, 0xda, 0xeb, 0x4a, 0x5c, 0x10,
-	0x57, 0xae, 0x0b, 0x5c, 0xca, 0x0d, 0x21, 0x64, 0x50, 0x7b, 0x41, 0x9c, 0x90, 0x4f, 0xdc, 0x40,
-	0xef, 0xcf, 0xfc, 0xc9, 0x38, 0x6e, 0xeb, 0x6a, 0x0f, 0x2b, 0xc1, 0x25, 0xf1, 0xfb, 0xde, 0xf7,
-	0xfd, 0xbe, 0xf7, 0xfd, 0x7d, 0xdf, 0x1b, 0x78, 0xd5, 0xf2, 0xa9, 0xeb, 0xd3, 0x2d, 0xca, 0xf0,
-	0xa9, 0xe3, 0xf5, 0xb6, 0xee, 0xdd, 0xec, 0x12, 0x86, 0x6f, 0x86, 0xeb, 0xfa, 0x20, 0xf0, 0x99,
-	0x8f, 0xae, 0x4a, 0xae, 0x7a, 0x48, 0x55, 0x5c, 0x95, 0xf5, 0x9e, 0xdf, 0xf3, 0x05, 0xcb, 0x16,
-	0xff, 0x25, 0xb9, 0x2b, 0x1b, 0x3d, 0xdf, 0xef, 0xf5, 0xc9, 0x96, 0x58, 0x75, 0x87, 0xc7, 0x5b,
-	0xd8, 0x1b, 0xa9, 0xad, 0x6a, 0x7a, 0xcb, 0x1e, 0x06, 0x98, 0x39, 0xbe, 0xa7, 0xf6, 0xf5, 0xf4,
-	0x3e, 0x73, 0x5c, 0x42, 0x19, 0x76, 0x07, 0x21, 0xb6, 0x3c, 0x49, 0x47, 0x2a, 0x55, 0xc7, 0x52,
-	0xd8, 0xca, 0x94, 0x2e, 0xa6, 0x24, 0xb2, 0xc3, 0xf2, 0x9d, 0x10, 0xfb, 0x3a, 0x23, 0x9e, 0x4d,
-	0x02, 0xd7, 0xf1, 0xd8, 0x16, 0x1b, 0x0d, 0x08, 0x95, 0x7f, 0xe5, 0xae, 0xf1, 0xa1, 0x06, 0x2b,
-	0xfb, 0x0e, 0x65, 0x7e, 0xe0, 0x58, 0xb8, 0xdf, 0xf2, 0x8e, 0x7d, 0xf4, 0x16, 0xe4, 0x4e, 0x08,
-	0xb6, 0x49, 0x50, 0xd6, 0x6a, 0xda, 0x66, 0x71, 0xbb, 0x5c, 0x8f, 0x11, 0xea, 0x52, 0x76, 0x5f,
-	0xec, 0x37, 0xb2, 0x9f, 0x8e, 0xf5, 0x8c, 0xa9, 0xb8, 0xd1, 0xb7, 0x20, 0x77, 0x0f, 0xf7, 0x29,
-	0x61, 0xe5, 0x85, 0xda, 0xe2, 0x66, 0x71, 0xfb, 0x46, 0xfd, 0x62, 0xf7, 0xd5, 0x8f, 0x70, 0xdf,
-	0xb1, 0x31, 0xf3, 0x23, 0x00, 0x29, 0x66, 0xfc, 0x7a, 0x01, 0x4a, 0xbb, 0xbe, 0xeb, 0x3a, 0x94,
-	0x3a, 0xbe, 0x67, 0x62, 0x46, 0x28, 0x6a, 0x40, 0x36, 0xc0, 0x8c, 0x88, 0xa3, 0x14, 0x1a, 0x75,
-	0xce, 0xff, 0x97, 0xb1, 0xfe, 0x5a, 0xcf, 0x61, 0x27, 0xc3, 0x6e, 0xdd, 0xf2, 0x5d, 0xe5, 0x0c,
-	0xf5, 0xef, 0x0d, 0x6a, 0x9f, 0x2a, 0xfb, 0x9a, 0xc4, 0x32, 0x85, 0x2c, 0x7a, 0x0f, 0xf2, 0x2e,
-	0x3e, 0xeb, 0x08, 0x9c, 0x05, 0x81, 0x73, 0x6b, 0x3e, 0x9c, 0xc9, 0x58, 0x2f, 0x8d, 0xb0, 0xdb,
-	0xdf, 0x31, 0x42, 0x1c, 0xc3, 0x5c, 0x72, 0xf1, 0x19, 0x3f, 0x22, 0x1a, 0x40, 0x89, 0x53, 0xad,
-	0x13, 0xec, 0xf5, 0x88, 0x54, 0xb2, 0x28, 0x94, 0xec, 0xcf, 0xad, 0xe4, 0x6a, 0xac, 0x24, 0x01,
-	0x67, 0x98, 0x97, 0x5c, 0x7c, 0xb6, 0x2b, 0x08, 0x5c, 0xe3, 0x4e, 0xfe, 0xa3, 0x47, 0x7a, 0xe6,
-	0x1f, 0x8f, 0x74, 0xcd, 0xf8, 0x93, 0x06, 0x10, 0x7b, 0x0c, 0xbd, 0x07, 0xab, 0x56, 0xb4, 0x12,
-	0xb2, 0x54, 0xc5, 0xf0, 0xcb, 0xb3, 0x62, 0x91, 0xf2, 0x77, 0x23, 0xcf, 0x0f, 0xfd, 0x78, 0xac,
-	0x6b, 0x66, 0xc9, 0x4a, 0x85, 0xe2, 0x87, 0x50, 0x1c, 0x0e, 0x6c, 0xcc, 0x48, 0x87, 0x67, 0xa7,
-	0xf0, 0x64, 0x71, 0xbb, 0x52, 0x97, 0xa9, 0x5b, 0x0f, 0x53, 0xb7, 0x7e, 0x18, 0xa6, 0x6e, 0xa3,
-	0xca, 0xb1, 0x26, 0x63, 0x1d, 0x49, 0xb3, 0x12, 0xc2, 0xc6, 0x83, 0xbf, 0xe9, 0x9a, 0x09, 0x92,
-	0xc2, 0x05, 0x12, 0x36, 0xfd, 0x5e, 0x83, 0x62, 0x93, 0x50, 0x2b, 0x70, 0x06, 0xbc, 0x42, 0x50,
-	0x19, 0x96, 0x5c, 0xdf, 0x73, 0x4e, 0x55, 0x3e, 0x16, 0xcc, 0x70, 0x89, 0x2a, 0x90, 0x77, 0x6c,
-	0xe2, 0x31, 0x87, 0x8d, 0x64, 0x5c, 0xcd, 0x68, 0xcd, 0xa5, 0x7e, 0x4a, 0xba, 0xd4, 0x09, 0xa3,
-	0x61, 0x86, 0x4b, 0x74, 0x1b, 0x56, 0x29, 0xb1, 0x86, 0x81, 0xc3, 0x46, 0x1d, 0xcb, 0xf7, 0x18,
-	0xb6, 0x58, 0x39, 0x2b, 0x02, 0xf6, 0x85, 0xc9, 0x58, 0xbf, 0x26, 0xcf, 0x9a, 0xe6, 0x30, 0xcc,
-	0x52, 0x48, 0xda, 0x95, 0x14, 0xae, 0xc1, 0x26, 0x0c, 0x3b, 0x7d, 0x5a, 0x7e, 0x45, 0x6a, 0x50,
-	0xcb, 0x84, 0x2d, 0x9f, 0x2c, 0x41, 0x21, 0xca, 0x76, 0xae, 0xd9, 0x1f, 0x90, 0x80, 0xff, 0xee,
-	0x60, 0xdb, 0x0e, 0x08, 0xa5, 0x2a, 0xaf, 0x13, 0x9a, 0xd3, 0x1c, 0x86, 0x59, 0x0a, 0x49, 0xb7,
-	0x24, 0x05, 0x31, 0x1e, 0x66, 0x8f, 0x12, 0x8f, 0x0e, 0x69, 0x67, 0x30, 0xec, 0x9e, 0x92, 0x91,
-	0x8a, 0xc6, 0xfa, 0x54, 0x34, 0x6e, 0x79, 0xa3, 0xc6, 0x9b, 0x31, 0x7a, 0x5a, 0xce, 0xf8, 0xc3,
-	0x6f, 0xde, 0x58, 0x57, 0xa9, 0x61, 0x05, 0xa3, 0x01, 0xf3, 0xeb, 0x07, 0xc3, 0xee, 0x3b, 0x64,
-	0xc4, 0xc3, 0xaf, 0x58, 0x0f, 0x04, 0x27, 0xba, 0x0a, 0xb9, 0x1f, 0x63, 0xa7, 0x4f, 0x6c, 0xe1,
-	0xd0, 0xbc, 0xa9, 0x56, 0x68, 0x07, 0x72, 0x94, 0x61, 0x36, 0xa4, 0xc2, 0x8b, 0x2b, 0xdb, 0xc6,
-	0xac, 0x54, 0x6b, 0xf8, 0x9e, 0xdd, 0x16, 0x9c, 0xa6, 0x92, 0x40, 0xb7, 0x21, 0xc7, 0xfc, 0x53,
-	0xe2, 0x29, 0x17, 0xce, 0x55, 0xdf, 0x2d, 0x8f, 0x99, 0x4a, 0x9a, 0x7b, 0xc4, 0x26, 0x7d, 0xd2,
-	0x13, 0x8e, 0xa3, 0x27, 0x38, 0x20, 0xb4, 0x9c, 0x13, 0x88, 0xad, 0xb9, 0x8b, 0x50, 0x79, 0x2a,
-	0x8d, 0x67, 0x98, 0xa5, 0x88, 0xd4, 0x16, 0x14, 0xf4, 0x0e, 0x14, 0xed, 0x38, 0x51, 0xcb, 0x4b,
-	0x22, 0x04, 0x5f, 0x9a, 0x65, 0x7e, 0x22, 0xa7, 0x55, 0xdf, 0x4b, 0x4a, 0xf3, 0xe4, 0x18, 0x7a,
-	0x5d, 0xdf, 0xb3, 0x1d, 0xaf, 0xd7, 0x39, 0x21, 0x4e, 0xef, 0x84, 0x95, 0xf3, 0x35, 0x6d, 0x73,
-	0x31, 0x99, 0x1c, 0x69, 0x0e, 0xc3, 0x2c, 0x45, 0xa4, 0x7d, 0x41, 0x41, 0x36, 0xac, 0xc4, 0x5c,
-	0xa2, 0x50, 0x0b, 0xcf, 0x2d, 0xd4, 0x1b, 0xaa, 0x50, 0xaf, 0xa4, 0xb5, 0xc4, 0xb5, 0x7a, 0x29,
-	0x22, 0x72, 0x31, 0xb4, 0x0f, 0x10, 0xb7, 0x87, 0x32, 0x08, 0x0d, 0xc6, 0xf3, 0x7b, 0x8c, 0x32,
-	0x3c, 0x21, 0x8b, 0xde, 0x87, 0xcb, 0xae, 0xe3, 0x75, 0x28, 0xe9, 0x1f, 0x77, 0x94, 0x83, 0x39,
-	0x64, 0x51, 0x44, 0xef, 0xdd, 0xf9, 0xf2, 0x61, 0x32, 0xd6, 0x2b, 0xaa, 0x85, 0x4e, 0x43, 0x1a,
-	0xe6, 0x9a, 0xeb, 0x78, 0x6d, 0xd2, 0x3f, 0x6e, 0x46, 0xb4, 0x9d, 0xe5, 0x0f, 0x1e, 0xe9, 0x19,
-	0x55, 0xae, 0x19, 0xe3, 0x2d, 0x58, 0x3e, 0xc2, 0x7d, 0x55, 0x66, 0x84, 0xa2, 0xeb, 0x50, 0xc0,
-	0xe1, 0xa2, 0xac, 0xd5, 0x16, 0x37, 0x0b, 0x66, 0x4c, 0x90, 0x65, 0xfe, 0xb3, 0xbf, 0xd6, 0x34,
-	0xe3, 0x13, 0x0d, 0x72, 0xcd, 0xa3, 0x03, 0xec, 0x04, 0xa8, 0x05, 0x6b, 0x71, 0xe6, 0x9c, 0x2f,
-	0xf2, 0xeb, 0x93, 0xb1, 0x5e, 0x4e, 0x27, 0x57, 0x54, 0xe5, 0x71, 0x02, 0x87, 0x65, 0xde, 0x82,
-	0xb5, 0x7b, 0x61, 0xef, 0x88, 0xa0, 0x16, 0xd2, 0x50, 0x53, 0x2c, 0x86, 0xb9, 0x1a, 0xd1, 0x14,
-	0x54, 0xca, 0xcc, 0x3d, 0x58, 0x92, 0xa7, 0xa5, 0x68, 0x07, 0x5e, 0x19, 0xf0, 0x1f, 0xc2, 0xba,
-	0xe2, 0x76, 0x75, 0x66, 0xf2, 0x0a, 0x7e, 0x15, 0x3e, 0x29, 0x62, 0xfc, 0x6a, 0x01, 0xa0, 0x79,
-	0x74, 0x74, 0x18, 0x38, 0x83, 0x3e, 0x61, 0x9f, 0xa5, 0xe5, 0x87, 0x70, 0x25, 0x36, 0x8b, 0x06,
-	0x56, 0xca, 0xfa, 0xda, 0x64, 0xac, 0x5f, 0x4f, 0x5b, 0x9f, 0x60, 0x33, 0xcc, 0xcb, 0x11, 0xbd,
-	0x1d, 0x58, 0x17, 0xa2, 0xda, 0x94, 0x45, 0xa8, 0x8b, 0xb3, 0x51, 0x13, 0x6c, 0x49, 0xd4, 0x26,
-	0x65, 0x17, 0xbb, 0xb6, 0x0d, 0xc5, 0xd8, 0x25, 0x14, 0x35, 0x21, 0xcf, 0xd4, 0x6f, 0xe5, 0x61,
-	0x63, 0xb6, 0x87, 0x43, 0x31, 0xe5, 0xe5, 0x48, 0xd2, 0xf8, 0xb7, 0x06, 0x10, 0xe7, 0xec, 0xe7,
-	0x33, 0xc5, 0x78, 0x2b, 0x57, 0x8d, 0x77, 0xf1, 0xa5, 0x46, 0x35, 0x25, 0x9d, 0xf2, 0xe7, 0xcf,
-	0x17, 0xe0, 0xf2, 0xdd, 0xb0, 0xf3, 0x7c, 0xee, 0x7d, 0x70, 0x00, 0x4b, 0xc4, 0x63, 0x81, 0x23,
-	0x9c, 0xc0, 0xa3, 0xfd, 0xb5, 0x59, 0xd1, 0xbe, 0xc0, 0xa6, 0x3d, 0x8f, 0x05, 0x23, 0x15, 0xfb,
-	0x10, 0x26, 0xe5, 0x8d, 0x5f, 0x2e, 0x42, 0x79, 0x96, 0x24, 0xda, 0x85, 0x92, 0x15, 0x10, 0x41,
-	0x08, 0xef, 0x0f, 0x4d, 0xdc, 0x1f, 0x95, 0x78, 0xb2, 0x4c, 0x31, 0x18, 0xe6, 0x4a, 0x48, 0x51,
-	0xb7, 0x47, 0x0f, 0xf8, 0xd8, 0xc7, 0xd3, 0x8e, 0x73, 0xbd, 0xe0, 0x9c, 0x67, 0xa8, 0xeb, 0x23,
-	0x54, 0x72, 0x1e, 0x40, 0xde, 0x1f, 0x2b, 0x31, 0x55, 0x5c, 0x20, 0x3f, 0x81, 0x92, 0xe3, 0x39,
-	0xcc, 0xc1, 0xfd, 0x4e, 0x17, 0xf7, 0xb1, 0x67, 0xbd, 0xcc, 0xd4, 0x2c, 0x5b, 0xbe, 0x52, 0x9b,
-	0x82, 0x33, 0xcc, 0x15, 0x45, 0x69, 0x48, 0x02, 0xda, 0x87, 0xa5, 0x50, 0x55, 0xf6, 0xa5, 0xa6,
-	0x8d, 0x50, 0x3c, 0x31, 0xe0, 0xfd, 0x62, 0x11, 0xd6, 0x4c, 0x62, 0xff, 0x3f, 0x14, 0xf3, 0x85,
-	0xe2, 0x3b, 0x00, 0xb2, 0xdc, 0x79, 0x83, 0x7d, 0x89, 0x68, 0xf0, 0x86, 0x51, 0x90, 0x08, 0x4d,
-	0xca, 0x12, 0xf1, 0x18, 0x2f, 0xc0, 0x72, 0x32, 0x1e, 0xff, 0xa3, 0xb7, 0x12, 0x6a, 0xc5, 0x9d,
-	0x28, 0x2b, 0x3a, 0xd1, 0x57, 0x66, 0x75, 0xa2, 0xa9, 0xec, 0x7d, 0x76, 0x0b, 0xfa, 0x30, 0x0b,
-	0xb9, 0x03, 0x1c, 0x60, 0x97, 0x22, 0x6b, 0x6a, 0xd2, 0x94, 0x6f, 0xcd, 0x8d, 0xa9, 0xfc, 0x6c,
-	0xaa, 0xaf, 0x1d, 0xcf, 0x19, 0x34, 0x3f, 0xba, 0x60, 0xd0, 0xfc, 0x36, 0xac, 0xf0, 0xe7, 0x70,
-	0x64, 0xa3, 0xf4, 0xf6, 0xa5, 0xc6, 0x46, 0x8c, 0x72, 0x7e, 0x5f, 0xbe, 0x96, 0xa3, 0x47, 0x17,
-	0x45, 0xdf, 0x80, 0x22, 0xe7, 0x88, 0x1b, 0x33, 0x17, 0xbf, 0x1a, 0x3f, 0x4b, 0x13, 0x9b, 0x86,
-	0x09, 0x2e, 0x3e, 0xdb, 0x93, 0x0b, 0xf4, 0x2e, 0xa0, 0x93, 0xe8, 0xcb, 0x48, 0x27, 0x76, 0x27,
-	0x97, 0xff, 0xe2, 0x64, 0xac, 0x6f, 0x48, 0xf9, 0x69, 0x1e, 0xc3, 0x5c, 0x8b, 0x89, 0x21, 0xda,
-	0xd7, 0x01, 0xb8, 0x5d, 0x1d, 0x9b, 0x78, 0xbe, 0xab, 0x9e, 0x3b, 0x57, 0x26, 0x63, 0x7d, 0x4d,
-	0xa2, 0xc4, 0x7b, 0x86, 0x59, 0xe0, 0x8b, 0x26, 0xff, 0x8d, 0x1e, 0x68, 0x50, 0xe1, 0xb3, 0x6c,
-	0xaf, 0xef, 0x77, 0x71, 0x7f, 0x6a, 0x4a, 0x06, 0x01, 0xd3, 0x9e, 0xbb, 0x4e, 0x6f, 0xc4, 0x53,
-	0xf2, 0xc5, 0xc8, 0x86, 0x79, 0xcd, 0x75, 0xbc, 0xb7, 0xc5, 0x5e, 0x6a, 0x64, 0x8e, 0x8b, 0xed,
-	0x63, 0x0d, 0x50, 0xbc, 0x61, 0x12, 0x3a, 0xe0, 0x4f, 0x46, 0xfe, 0x36, 0x48, 0x1c, 0x51, 0x7b,
-	0xf6, 0xdb, 0x20, 0x96, 0x0f, 0xdf, 0x06, 0x89, 0xe2, 0xfd, 0x66, 0xdc, 0xb1, 0x17, 0x54, 0x6a,
-	0x29, 0x98, 0x2e, 0xa6, 0x24, 0xf1, 0xbe, 0x70, 0x42, 0xe9, 0xa9, 0x16, 0x9d, 0x31, 0xfe, 0xa8,
-	0xc1, 0xc6, 0x54, 0x92, 0x47, 0x87, 0xfd, 0x11, 0xa0, 0x20, 0xb1, 0x29, 0x42, 0x38, 0x52, 0x87,
-	0x9e, 0xbb, 0x66, 0xd6, 0x82, 0xa9, 0xab, 0xe0, 0xb3, 0xbb, 0x74, 0xb2, 0xc2, 0xe7, 0xbf, 0xd3,
-	0x60, 0x3d, 0xa9, 0x3e, 0x32, 0xe4, 0x0e, 0x2c, 0x27, 0xb5, 0x2b, 0x13, 0x5e, 0x7d, 0x11, 0x13,
-	0xd4, 0xe9, 0xcf, 0xc9, 0xa3, 0xef, 0xc5, 0x1d, 0x44, 0x7e, 0xce, 0xbb, 0xf9, 0xc2, 0xde, 0x08,
-	0xcf, 0x94, 0xee, 0x24, 0x59, 0x11, 0x8f, 0xff, 0x68, 0x90, 0x3d, 0xf0, 0xfd, 0x3e, 0xf2, 0x61,
-	0xcd, 0xf3, 0x59, 0x87, 0x27, 0x3b, 0xb1, 0x3b, 0xea, 0x3b, 0x80, 0x6c, 0xcd, 0xbb, 0xf3, 0x39,
-	0xe9, 0x9f, 0x63, 0x7d, 0x1a, 0xca, 0x2c, 0x79, 0x3e, 0x6b, 0x08, 0xca, 0xa1, 0xfc, 0x4a, 0xf0,
-	0x3e, 0x5c, 0x3a, 0xaf, 0x4c, 0x36, 0xee, 0xef, 0xcf, 0xad, 0xec, 0x3c, 0xcc, 0x64, 0xac, 0xaf,
-	0xc7, 0x45, 0x1c, 0x91, 0x0d, 0x73, 0xb9, 0x9b, 0xd0, 0xbe, 0x93, 0xe7, 0xf1, 0xfb, 0xd7, 0x23,
-	0x5d, 0xfb, 0xea, 0x6f, 0x35, 0x80, 0xf8, 0x63, 0x08, 0x7a, 0x1d, 0xae, 0x35, 0xbe, 0x7b, 0xa7,
-	0xd9, 0x69, 0x1f, 0xde, 0x3a, 0xbc, 0xdb, 0xee, 0xdc, 0xbd, 0xd3, 0x3e, 0xd8, 0xdb, 0x6d, 0xdd,
-	0x6e, 0xed, 0x35, 0x57, 0x33, 0x95, 0xd2, 0xfd, 0x87, 0xb5, 0xe2, 0x5d, 0x8f, 0x0e, 0x88, 0xe5,
-	0x1c, 0x3b, 0xc4, 0x46, 0xaf, 0xc1, 0xfa, 0x79, 0x6e, 0xbe, 0xda, 0x6b, 0xae, 0x6a, 0x95, 0xe5,
-	0xfb, 0x0f, 0x6b, 0x79, 0x39, 0x1e, 0x12, 0x1b, 0x6d, 0xc2, 0x95, 0x69, 0xbe, 0xd6, 0x9d, 0xb7,
-	0x57, 0x17, 0x2a, 0x97, 0xee, 0x3f, 0xac, 0x15, 0xa2, 0x39, 0x12, 0x19, 0x80, 0x92, 0x9c, 0x0a,
-	0x6f, 0xb1, 0x02, 0xf7, 0x1f, 0xd6, 0x72, 0xd2, 0x81, 0x95, 0xec, 0x07, 0x1f, 0x57, 0x33, 0x8d,
-	0xdb, 0x9f, 0x3e, 0xa9, 0x6a, 0x8f, 0x9f, 0x54, 0xb5, 0xbf, 0x3f, 0xa9, 0x6a, 0x0f, 0x9e, 0x56,
-	0x33, 0x8f, 0x9f, 0x56, 0x33, 0x7f, 0x7e, 0x5a, 0xcd, 0xfc, 0xe0, 0xf5, 0x67, 0xfa, 0xee, 0x2c,
-	0xfa, 0xce, 0x2e, 0xbc, 0xd8, 0xcd, 0x89, 0x9b, 0xe1, 0xcd, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x7a, 0x75, 0x88, 0x4d, 0x86, 0x17, 0x00, 0x00,
+	0x19, 0xf7, 0x24, 0xae, 0x13, 0x7f, 0x4e, 0xe2, 0xe4, 0x35, 0x6d, 0x1d, 0x53, 0x3c, 0xee, 0xb0,
+	0x5a, 0x02, 0xda, 0x75, 0x68, 0x16, 0x2d, 0x22, 0x17, 0xa8, 0xe3, 0x74, 0x63, 0xed, 0x52, 0xc2,
+	0x38, 0x0d, 0x12, 0xac, 0xb0, 0x9e, 0x67, 0x5e, 0x9c, 0x21, 0x9e, 0x19, 0x33, 0xef, 0xb9, 0xc4,
+	0xd2, 0x1e, 0xb8, 0xb1, 0x14, 0x21, 0xca, 0x6d, 0x2f, 0x95, 0x2a, 0xed, 0x75, 0x25, 0x2e, 0x88,
+	0x2b, 0xd7, 0x05, 0x2e, 0xe5, 0x86, 0x10, 0x32, 0xa8, 0xbd, 0x20, 0x4e, 0xc8, 0x27, 0x6e, 0xa0,
+	0xf7, 0x67, 0xfe, 0x64, 0x1c, 0xb7, 0x75, 0xb5, 0x87, 0x4a, 0x70, 0x49, 0xfc, 0xbe, 0xf7, 0x7d,
+	0xbf, 0xef, 0x7d, 0x7f, 0xdf, 0xf7, 0x06, 0x5e, 0xb3, 0x7c, 0xea, 0xfa, 0x74, 0x8b, 0x32, 0x7c,
+	0xea, 0x78, 0xdd, 0xad, 0x7b, 0x37, 0x3b, 0x84, 0xe1, 0x9b, 0xe1, 0xba, 0xd6, 0x0f, 0x7c, 0xe6,
+	0xa3, 0xab, 0x92, 0xab, 0x16, 0x52, 0x15, 0x57, 0x79, 0xbd, 0xeb, 0x77, 0x7d, 0xc1, 0xb2, 0xc5,
+	0x7f, 0x49, 0xee, 0xf2, 0x46, 0xd7, 0xf7, 0xbb, 0x3d, 0xb2, 0x25, 0x56, 0x9d, 0xc1, 0xf1, 0x16,
+	0xf6, 0x86, 0x6a, 0xab, 0x92, 0xde, 0xb2, 0x07, 0x01, 0x66, 0x8e, 0xef, 0xa9, 0x7d, 0x3d, 0xbd,
+	0xcf, 0x1c, 0x97, 0x50, 0x86, 0xdd, 0x7e, 0x88, 0x2d, 0x4f, 0xd2, 0x96, 0x4a, 0xd5, 0xb1, 0x14,
+	0xb6, 0x32, 0xa5, 0x83, 0x29, 0x89, 0xec, 0xb0, 0x7c, 0x27, 0xc4, 0xbe, 0xce, 0x88, 0x67, 0x93,
+	0xc0, 0x75, 0x3c, 0xb6, 0xc5, 0x86, 0x7d, 0x42, 0xe5, 0x5f, 0xb9, 0x6b, 0xfc, 0x4c, 0x83, 0x95,
+	0x7d, 0x87, 0x32, 0x3f, 0x70, 0x2c, 0xdc, 0x6b, 0x7a, 0xc7, 0x3e, 0x7a, 0x1b, 0x72, 0x27, 0x04,
+	0xdb, 0x24, 0x28, 0x69, 0x55, 0x6d, 0xb3, 0xb0, 0x5d, 0xaa, 0xc5, 0x08, 0x35, 0x29, 0xbb, 0x2f,
+	0xf6, 0xeb, 0xd9, 0x4f, 0x47, 0x7a, 0xc6, 0x54, 0xdc, 0xe8, 0x1b, 0x90, 0xbb, 0x87, 0x7b, 0x94,
+	0xb0, 0xd2, 0x5c, 0x75, 0x7e, 0xb3, 0xb0, 0x7d, 0xa3, 0x76, 0xb1, 0xfb, 0x6a, 0x47, 0xb8, 0xe7,
+	0xd8, 0x98, 0xf9, 0x11, 0x80, 0x14, 0x33, 0x7e, 0x3d, 0x07, 0xc5, 0x5d, 0xdf, 0x75, 0x1d, 0x4a,
+	0x1d, 0xdf, 0x33, 0x31, 0x23, 0x14, 0xd5, 0x21, 0x1b, 0x60, 0x46, 0xc4, 0x51, 0xf2, 0xf5, 0x1a,
+	0xe7, 0xff, 0xcb, 0x48, 0x7f, 0xbd, 0xeb, 0xb0, 0x93, 0x41, 0xa7, 0x66, 0xf9, 0xae, 0x72, 0x86,
+	0xfa, 0xf7, 0x26, 0xb5, 0x4f, 0x95, 0x7d, 0x0d, 0x62, 0x99, 0x42, 0x16, 0xbd, 0x0f, 0x8b, 0x2e,
+	0x3e, 0x6b, 0x0b, 0x9c, 0x39, 0x81, 0x73, 0x6b, 0x36, 0x9c, 0xf1, 0x48, 0x2f, 0x0e, 0xb1, 0xdb,
+	0xdb, 0x31, 0x42, 0x1c, 0xc3, 0x5c, 0x70, 0xf1, 0x19, 0x3f, 0x22, 0xea, 0x43, 0x91, 0x53, 0xad,
+	0x13, 0xec, 0x75, 0x89, 0x54, 0x32, 0x2f, 0x94, 0xec, 0xcf, 0xac, 0xe4, 0x6a, 0xac, 0x24, 0x01,
+	0x67, 0x98, 0xcb, 0x2e, 0x3e, 0xdb, 0x15, 0x04, 0xae, 0x71, 0x67, 0xf1, 0xa3, 0x47, 0x7a, 0xe6,
+	0x1f, 0x8f, 0x74, 0xcd, 0xf8, 0x93, 0x06, 0x10, 0x7b, 0x0c, 0xbd, 0x0f, 0xab, 0x56, 0xb4, 0x12,
+	0xb2, 0x54, 0xc5, 0xf0, 0x8b, 0xd3, 0x62, 0x91, 0xf2, 0x77, 0x7d, 0x91, 0x1f, 0xfa, 0xf1, 0x48,
+	0xd7, 0xcc, 0xa2, 0x95, 0x0a, 0xc5, 0xf7, 0xa1, 0x30, 0xe8, 0xdb, 0x98, 0x91, 0x36, 0xcf, 0x4e,
+	0xe1, 0xc9, 0xc2, 0x76, 0xb9, 0x26, 0x53, 0xb7, 0x16, 0xa6, 0x6e, 0xed, 0x30, 0x4c, 0xdd, 0x7a,
+	0x85, 0x63, 0x8d, 0x47, 0x3a, 0x92, 0x66, 0x25, 0x84, 0x8d, 0x07, 0x7f, 0xd3, 0x35, 0x13, 0x24,
+	0x85, 0x0b, 0x24, 0x6c, 0xfa, 0xbd, 0x06, 0x85, 0x06, 0xa1, 0x56, 0xe0, 0xf4, 0x79, 0x85, 0xa0,
+	0x12, 0x2c, 0xb8, 0xbe, 0xe7, 0x9c, 0xaa, 0x7c, 0xcc, 0x9b, 0xe1, 0x12, 0x95, 0x61, 0xd1, 0xb1,
+	0x89, 0xc7, 0x1c, 0x36, 0x94, 0x71, 0x35, 0xa3, 0x35, 0x97, 0xfa, 0x31, 0xe9, 0x50, 0x27, 0x8c,
+	0x86, 0x19, 0x2e, 0xd1, 0x6d, 0x58, 0xa5, 0xc4, 0x1a, 0x04, 0x0e, 0x1b, 0xb6, 0x2d, 0xdf, 0x63,
+	0xd8, 0x62, 0xa5, 0xac, 0x08, 0xd8, 0xe7, 0xc6, 0x23, 0xfd, 0x9a, 0x3c, 0x6b, 0x9a, 0xc3, 0x30,
+	0x8b, 0x21, 0x69, 0x57, 0x52, 0xb8, 0x06, 0x9b, 0x30, 0xec, 0xf4, 0x68, 0xe9, 0x92, 0xd4, 0xa0,
+	0x96, 0x09, 0x5b, 0x3e, 0x59, 0x80, 0x7c, 0x94, 0xed, 0x5c, 0xb3, 0xdf, 0x27, 0x01, 0xff, 0xdd,
+	0xc6, 0xb6, 0x1d, 0x10, 0x4a, 0x4b, 0x5a, 0x5a, 0x73, 0x9a, 0xc3, 0x30, 0x8b, 0x21, 0xe9, 0x96,
+	0xa4, 0x20, 0xc6, 0xc3, 0xec, 0x51, 0xe2, 0xd1, 0x01, 0x6d, 0xf7, 0x07, 0x9d, 0x53, 0x32, 0x54,
+	0xd1, 0x58, 0x9f, 0x88, 0xc6, 0x2d, 0x6f, 0x58, 0x7f, 0x2b, 0x46, 0x4f, 0xcb, 0x19, 0x7f, 0xf8,
+	0xcd, 0x9b, 0xeb, 0x2a, 0x35, 0xac, 0x60, 0xd8, 0x67, 0x7e, 0xed, 0x60, 0xd0, 0x79, 0x97, 0x0c,
+	0xcd, 0x62, 0xc4, 0x7a, 0x20, 0x38, 0xd1, 0x55, 0xc8, 0xfd, 0x10, 0x3b, 0x3d, 0x62, 0x0b, 0x87,
+	0x2e, 0x9a, 0x6a, 0x85, 0x76, 0x20, 0x47, 0x19, 0x66, 0x03, 0x2a, 0xbc, 0xb8, 0xb2, 0x6d, 0x4c,
+	0x4b, 0xb5, 0xba, 0xef, 0xd9, 0x2d, 0xc1, 0x69, 0x2a, 0x09, 0x74, 0x1b, 0x72, 0xcc, 0x3f, 0x25,
+	0x9e, 0x72, 0xe1, 0x4c, 0xf5, 0xdd, 0xf4, 0x98, 0xa9, 0xa4, 0xb9, 0x47, 0x6c, 0xd2, 0x23, 0x5d,
+	0xe1, 0x38, 0x7a, 0x82, 0x03, 0x42, 0x4b, 0x39, 0x81, 0xd8, 0x9c, 0xb9, 0x08, 0x95, 0xa7, 0xd2,
+	0x78, 0x86, 0x59, 0x8c, 0x48, 0x2d, 0x41, 0x41, 0xef, 0x42, 0xc1, 0x8e, 0x13, 0xb5, 0xb4, 0x20,
+	0x42, 0xf0, 0x85, 0x69, 0xe6, 0x27, 0x72, 0x5a, 0xf5, 0xbd, 0xa4, 0x34, 0x4f, 0x8e, 0x81, 0xd7,
+	0xf1, 0x3d, 0xdb, 0xf1, 0xba, 0xed, 0x13, 0xe2, 0x74, 0x4f, 0x58, 0x69, 0xb1, 0xaa, 0x6d, 0xce,
+	0x27, 0x93, 0x23, 0xcd, 0x61, 0x98, 0xc5, 0x88, 0xb4, 0x2f, 0x28, 0xc8, 0x86, 0x95, 0x98, 0x4b,
+	0x14, 0x6a, 0xfe, 0xb9, 0x85, 0x7a, 0x43, 0x15, 0xea, 0x95, 0xb4, 0x96, 0xb8, 0x56, 0x97, 0x23,
+	0x22, 0x17, 0x43, 0xfb, 0x00, 0x71, 0x7b, 0x28, 0x81, 0xd0, 0x60, 0x3c, 0xbf, 0xc7, 0x28, 0xc3,
+	0x13, 0xb2, 0xe8, 0x03, 0xb8, 0xec, 0x3a, 0x5e, 0x9b, 0x92, 0xde, 0x71, 0x5b, 0x39, 0x98, 0x43,
+	0x16, 0x44, 0xf4, 0xde, 0x9b, 0x2d, 0x1f, 0xc6, 0x23, 0xbd, 0xac, 0x5a, 0xe8, 0x24, 0xa4, 0x61,
+	0xae, 0xb9, 0x8e, 0xd7, 0x22, 0xbd, 0xe3, 0x46, 0x44, 0xdb, 0x59, 0xfa, 0xf0, 0x91, 0x9e, 0x51,
+	0xe5, 0x9a, 0x31, 0xde, 0x86, 0xa5, 0x23, 0xdc, 0x53, 0x65, 0x46, 0x28, 0xba, 0x0e, 0x79, 0x1c,
+	0x2e, 0x4a, 0x5a, 0x75, 0x7e, 0x33, 0x6f, 0xc6, 0x04, 0x59, 0xe6, 0x3f, 0xf9, 0x6b, 0x55, 0x33,
+	0x3e, 0xd1, 0x20, 0xd7, 0x38, 0x3a, 0xc0, 0x4e, 0x80, 0x9a, 0xb0, 0x16, 0x67, 0xce, 0xf9, 0x22,
+	0xbf, 0x3e, 0x1e, 0xe9, 0xa5, 0x74, 0x72, 0x45, 0x55, 0x1e, 0x27, 0x70, 0x58, 0xe6, 0x4d, 0x58,
+	0xbb, 0x17, 0xf6, 0x8e, 0x08, 0x6a, 0x2e, 0x0d, 0x35, 0xc1, 0x62, 0x98, 0xab, 0x11, 0x4d, 0x41,
+	0xa5, 0xcc, 0xdc, 0x83, 0x05, 0x79, 0x5a, 0x8a, 0x76, 0xe0, 0x52, 0x9f, 0xff, 0x10, 0xd6, 0x15,
+	0xb6, 0x2b, 0x53, 0x93, 0x57, 0xf0, 0xab, 0xf0, 0x49, 0x11, 0xe3, 0x57, 0x73, 0x00, 0x8d, 0xa3,
+	0xa3, 0xc3, 0xc0, 0xe9, 0xf7, 0x08, 0xfb, 0x2c, 0x2d, 0x3f, 0x84, 0x2b, 0xb1, 0x59, 0x34, 0xb0,
+	0x52, 0xd6, 0x57, 0xc7, 0x23, 0xfd, 0x7a, 0xda, 0xfa, 0x04, 0x9b, 0x61, 0x5e, 0x8e, 0xe8, 0xad,
+	0xc0, 0xba, 0x10, 0xd5, 0xa6, 0x2c, 0x42, 0x9d, 0x9f, 0x8e, 0x9a, 0x60, 0x4b, 0xa2, 0x36, 0x28,
+	0xbb, 0xd8, 0xb5, 0x2d, 0x28, 0xc4, 0x2e, 0xa1, 0xa8, 0x01, 0x8b, 0x4c, 0xfd, 0x56, 0x1e, 0x36,
+	0xa6, 0x7b, 0x38, 0x14, 0x53, 0x5e, 0x8e, 0x24, 0x8d, 0x7f, 0x6b, 0x00, 0x71, 0xce, 0xbe, 0x9a,
+	0x29, 0xc6, 0x5b, 0xb9, 0x6a, 0xbc, 0xf3, 0x2f, 0x35, 0xaa, 0x29, 0xe9, 0x94, 0x3f, 0x7f, 0x3e,
+	0x07, 0x97, 0xef, 0x86, 0x9d, 0xe7, 0x95, 0xf7, 0xc1, 0x01, 0x2c, 0x10, 0x8f, 0x05, 0x8e, 0x70,
+	0x02, 0x8f, 0xf6, 0x57, 0xa6, 0x45, 0xfb, 0x02, 0x9b, 0xf6, 0x3c, 0x16, 0x0c, 0x55, 0xec, 0x43,
+	0x98, 0x94, 0x37, 0x7e, 0x39, 0x0f, 0xa5, 0x69, 0x92, 0x68, 0x17, 0x8a, 0x56, 0x40, 0x04, 0x21,
+	0xbc, 0x3f, 0x34, 0x71, 0x7f, 0x94, 0xe3, 0xc9, 0x32, 0xc5, 0x60, 0x98, 0x2b, 0x21, 0x45, 0xdd,
+	0x1e, 0x5d, 0xe0, 0x63, 0x1f, 0x4f, 0x3b, 0xce, 0xf5, 0x82, 0x73, 0x9e, 0xa1, 0xae, 0x8f, 0x50,
+	0xc9, 0x79, 0x00, 0x79, 0x7f, 0xac, 0xc4, 0x54, 0x71, 0x81, 0xfc, 0x08, 0x8a, 0x8e, 0xe7, 0x30,
+	0x07, 0xf7, 0xda, 0x1d, 0xdc, 0xc3, 0x9e, 0xf5, 0x32, 0x53, 0xb3, 0x6c, 0xf9, 0x4a, 0x6d, 0x0a,
+	0xce, 0x30, 0x57, 0x14, 0xa5, 0x2e, 0x09, 0x68, 0x1f, 0x16, 0x42, 0x55, 0xd9, 0x97, 0x9a, 0x36,
+	0x42, 0xf1, 0xc4, 0x80, 0xf7, 0x8b, 0x79, 0x58, 0x33, 0x89, 0xfd, 0xff, 0x50, 0xcc, 0x16, 0x8a,
+	0x6f, 0x01, 0xc8, 0x72, 0xe7, 0x0d, 0xb6, 0x94, 0x7d, 0xa9, 0x86, 0x91, 0x97, 0x08, 0x0d, 0xca,
+	0x12, 0xf1, 0x18, 0xcd, 0xc1, 0x52, 0x32, 0x1e, 0xff, 0xa3, 0xb7, 0x12, 0x6a, 0xc6, 0x9d, 0x28,
+	0x2b, 0x3a, 0xd1, 0x97, 0xa6, 0x75, 0xa2, 0x89, 0xec, 0x7d, 0x76, 0x0b, 0xfa, 0xe9, 0x25, 0xc8,
+	0x1d, 0xe0, 0x00, 0xbb, 0x14, 0x59, 0x13, 0x93, 0xa6, 0x7c, 0x6b, 0x6e, 0x4c, 0xe4, 0x67, 0x43,
+	0x7d, 0xed, 0x78, 0xce, 0xa0, 0xf9, 0xd1, 0x05, 0x83, 0xe6, 0x37, 0x61, 0x85, 0x3f, 0x87, 0x23,
+	0x1b, 0xa5, 0xb7, 0x97, 0xeb, 0x1b, 0x31, 0xca, 0xf9, 0x7d, 0xf9, 0x5a, 0x8e, 0x1e, 0x5d, 0x14,
+	0x7d, 0x0d, 0x0a, 0x9c, 0x23, 0x6e, 0xcc, 0x5c, 0xfc, 0x6a, 0xfc, 0x2c, 0x4d, 0x6c, 0x1a, 0x26,
+	0xb8, 0xf8, 0x6c, 0x4f, 0x2e, 0xd0, 0x7b, 0x80, 0x4e, 0xa2, 0x2f, 0x23, 0xed, 0xd8, 0x9d, 0x5c,
+	0xfe, 0xf3, 0xe3, 0x91, 0xbe, 0x21, 0xe5, 0x27, 0x79, 0x0c, 0x73, 0x2d, 0x26, 0x86, 0x68, 0x5f,
+	0x05, 0xe0, 0x76, 0xb5, 0x6d, 0xe2, 0xf9, 0xae, 0x7a, 0xee, 0x5c, 0x19, 0x8f, 0xf4, 0x35, 0x89,
+	0x12, 0xef, 0x19, 0x66, 0x9e, 0x2f, 0x1a, 0xfc, 0x77, 0x38, 0x1d, 0xa7, 0x5e, 0xf5, 0xa5, 0xdc,
+	0xcc, 0xd3, 0xb1, 0x7c, 0xdb, 0x24, 0xa6, 0xe3, 0x14, 0xa4, 0x9c, 0x8e, 0xcf, 0x7f, 0x0d, 0x40,
+	0x0f, 0x34, 0x28, 0x73, 0xde, 0x6e, 0xcf, 0xef, 0xe0, 0xde, 0xc4, 0x8c, 0x0e, 0xe2, 0x14, 0xad,
+	0x99, 0xbb, 0xc4, 0x8d, 0xf8, 0x14, 0x17, 0x23, 0x1b, 0xe6, 0x35, 0xd7, 0xf1, 0xde, 0x11, 0x7b,
+	0xa9, 0x81, 0x3d, 0x2e, 0xf5, 0x8f, 0x35, 0x40, 0xf1, 0x86, 0x49, 0x68, 0xdf, 0xf7, 0xa8, 0x78,
+	0x99, 0x24, 0x8e, 0xa8, 0x3d, 0xfb, 0x65, 0x12, 0xcb, 0x87, 0x2f, 0x93, 0x58, 0x16, 0x7d, 0x3d,
+	0xbe, 0x2f, 0xe6, 0x54, 0x62, 0x2b, 0x98, 0x0e, 0xa6, 0x24, 0xf1, 0xba, 0x71, 0x42, 0xe9, 0x89,
+	0x0b, 0x22, 0x63, 0xfc, 0x51, 0x83, 0x8d, 0x89, 0x12, 0x8b, 0x0e, 0xfb, 0x03, 0x40, 0x41, 0x62,
+	0x53, 0x24, 0xd0, 0x50, 0x1d, 0x7a, 0xe6, 0x8a, 0x5d, 0x0b, 0xd2, 0x1b, 0x9f, 0xe1, 0x95, 0x97,
+	0x15, 0x3e, 0xff, 0x9d, 0x06, 0xeb, 0x49, 0xf5, 0x91, 0x21, 0x77, 0x60, 0x29, 0xa9, 0x5d, 0x99,
+	0xf0, 0xda, 0x8b, 0x98, 0xa0, 0x4e, 0x7f, 0x4e, 0x1e, 0x7d, 0x27, 0xee, 0x5f, 0xf2, 0x63, 0xe2,
+	0xcd, 0x17, 0xf6, 0x46, 0x78, 0xa6, 0x74, 0x1f, 0xcb, 0x8a, 0x78, 0xfc, 0x47, 0x83, 0xec, 0x81,
+	0xef, 0xf7, 0x90, 0x0f, 0x6b, 0x9e, 0xcf, 0xda, 0xbc, 0xd4, 0x88, 0xdd, 0x56, 0x5f, 0x21, 0xe4,
+	0xc5, 0xb0, 0x3b, 0x9b, 0x93, 0xfe, 0x39, 0xd2, 0x27, 0xa1, 0xcc, 0xa2, 0xe7, 0xb3, 0xba, 0xa0,
+	0x1c, 0x0a, 0x02, 0xfa, 0x00, 0x96, 0xcf, 0x2b, 0x93, 0xd7, 0xc6, 0x77, 0x67, 0x56, 0x76, 0x1e,
+	0x66, 0x3c, 0xd2, 0xd7, 0xe3, 0x16, 0x12, 0x91, 0x0d, 0x73, 0xa9, 0x93, 0xd0, 0xbe, 0xb3, 0xc8,
+	0xe3, 0xf7, 0xaf, 0x47, 0xba, 0xf6, 0xe5, 0xdf, 0x6a, 0x00, 0xf1, 0xa7, 0x18, 0xf4, 0x06, 0x5c,
+	0xab, 0x7f, 0xfb, 0x4e, 0xa3, 0xdd, 0x3a, 0xbc, 0x75, 0x78, 0xb7, 0xd5, 0xbe, 0x7b, 0xa7, 0x75,
+	0xb0, 0xb7, 0xdb, 0xbc, 0xdd, 0xdc, 0x6b, 0xac, 0x66, 0xca, 0xc5, 0xfb, 0x0f, 0xab, 0x85, 0xbb,
+	0x1e, 0xed, 0x13, 0xcb, 0x39, 0x76, 0x88, 0x8d, 0x5e, 0x87, 0xf5, 0xf3, 0xdc, 0x7c, 0xb5, 0xd7,
+	0x58, 0xd5, 0xca, 0x4b, 0xf7, 0x1f, 0x56, 0x17, 0xe5, 0x70, 0x4a, 0x6c, 0xb4, 0x09, 0x57, 0x26,
+	0xf9, 0x9a, 0x77, 0xde, 0x59, 0x9d, 0x2b, 0x2f, 0xdf, 0x7f, 0x58, 0xcd, 0x47, 0x53, 0x2c, 0x32,
+	0x00, 0x25, 0x39, 0x15, 0xde, 0x7c, 0x19, 0xee, 0x3f, 0xac, 0xe6, 0xa4, 0x03, 0xcb, 0xd9, 0x0f,
+	0x3f, 0xae, 0x64, 0xea, 0xb7, 0x3f, 0x7d, 0x52, 0xd1, 0x1e, 0x3f, 0xa9, 0x68, 0x7f, 0x7f, 0x52,
+	0xd1, 0x1e, 0x3c, 0xad, 0x64, 0x1e, 0x3f, 0xad, 0x64, 0xfe, 0xfc, 0xb4, 0x92, 0xf9, 0xde, 0x1b,
+	0xcf, 0xf4, 0xdd, 0x59, 0xf4, 0x95, 0x5f, 0x78, 0xb1, 0x93, 0x13, 0xf7, 0xd2, 0x5b, 0xff, 0x1d,
+	0x00, 0x37, 0x8e, 0xf0, 0xce, 0x04, 0x18, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1264,625 +1267,721 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9887 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x5c, 0xd7,
-		0x75, 0x18, 0xde, 0x7e, 0x00, 0xbb, 0x07, 0x0b, 0xe0, 0xe1, 0x02, 0x24, 0x97, 0x4b, 0x12, 0x80,
-		0x9e, 0xbe, 0x28, 0x4a, 0x02, 0x25, 0x4a, 0xa4, 0xa4, 0xa5, 0x6d, 0x19, 0x0b, 0x2c, 0x41, 0x90,
-		0xf8, 0xd2, 0x03, 0x40, 0xc9, 0xb2, 0xd3, 0x9d, 0x87, 0xdd, 0x8b, 0xc5, 0x13, 0x76, 0xdf, 0x7b,
-		0x7a, 0xef, 0x2d, 0x09, 0xd0, 0x76, 0x47, 0xfe, 0xa8, 0x6b, 0x2b, 0x93, 0xda, 0xae, 0x3b, 0x8d,
-		0x2d, 0x9b, 0xae, 0x1c, 0xa7, 0x75, 0xea, 0xb8, 0x8d, 0x13, 0xbb, 0x6e, 0xd3, 0x76, 0xa6, 0x76,
-		0x3b, 0x69, 0x6c, 0xf7, 0x63, 0xec, 0x36, 0xd3, 0xa6, 0x99, 0x94, 0x4e, 0x65, 0x4d, 0xeb, 0xba,
-		0x6e, 0xe3, 0xb0, 0x6e, 0x9b, 0x8e, 0xa7, 0xd3, 0xce, 0xfd, 0x7a, 0x5f, 0xbb, 0x8b, 0xdd, 0x85,
-		0x48, 0xdb, 0x69, 0xf2, 0x0b, 0x7b, 0xcf, 0x3d, 0xe7, 0xdc, 0x73, 0xcf, 0x3d, 0xe7, 0xdc, 0x73,
-		0xbf, 0x1e, 0xe0, 0x9f, 0x9c, 0x87, 0xa9, 0xaa, 0x69, 0x56, 0x6b, 0xf8, 0xb4, 0x65, 0x9b, 0xae,
-		0xb9, 0xd9, 0xd8, 0x3a, 0x5d, 0xc1, 0x4e, 0xd9, 0xd6, 0x2d, 0xd7, 0xb4, 0xa7, 0x29, 0x0c, 0x8d,
-		0x30, 0x8c, 0x69, 0x81, 0xa1, 0x2c, 0xc1, 0xe8, 0x05, 0xbd, 0x86, 0xe7, 0x3c, 0xc4, 0x35, 0xec,
-		0xa2, 0x27, 0x21, 0xb1, 0xa5, 0xd7, 0x70, 0x56, 0x9a, 0x8a, 0x9f, 0x1c, 0x3c, 0x73, 0xcf, 0x74,
-		0x84, 0x68, 0x3a, 0x4c, 0xb1, 0x4a, 0xc0, 0x2a, 0xa5, 0x50, 0x5e, 0x4f, 0xc0, 0x58, 0x8b, 0x5a,
-		0x84, 0x20, 0x61, 0x68, 0x75, 0xc2, 0x51, 0x3a, 0x99, 0x56, 0xe9, 0x6f, 0x94, 0x85, 0x01, 0x4b,
-		0x2b, 0xef, 0x68, 0x55, 0x9c, 0x8d, 0x51, 0xb0, 0x28, 0xa2, 0x09, 0x80, 0x0a, 0xb6, 0xb0, 0x51,
-		0xc1, 0x46, 0x79, 0x2f, 0x1b, 0x9f, 0x8a, 0x9f, 0x4c, 0xab, 0x01, 0x08, 0x7a, 0x10, 0x46, 0xad,
-		0xc6, 0x66, 0x4d, 0x2f, 0x97, 0x02, 0x68, 0x30, 0x15, 0x3f, 0x99, 0x54, 0x65, 0x56, 0x31, 0xe7,
-		0x23, 0xdf, 0x0f, 0x23, 0xd7, 0xb0, 0xb6, 0x13, 0x44, 0x1d, 0xa4, 0xa8, 0xc3, 0x04, 0x1c, 0x40,
-		0x9c, 0x85, 0x4c, 0x1d, 0x3b, 0x8e, 0x56, 0xc5, 0x25, 0x77, 0xcf, 0xc2, 0xd9, 0x04, 0xed, 0xfd,
-		0x54, 0x53, 0xef, 0xa3, 0x3d, 0x1f, 0xe4, 0x54, 0xeb, 0x7b, 0x16, 0x46, 0x33, 0x90, 0xc6, 0x46,
-		0xa3, 0xce, 0x38, 0x24, 0xdb, 0xe8, 0xaf, 0x68, 0x34, 0xea, 0x51, 0x2e, 0x29, 0x42, 0xc6, 0x59,
-		0x0c, 0x38, 0xd8, 0xbe, 0xaa, 0x97, 0x71, 0xb6, 0x9f, 0x32, 0xb8, 0xbf, 0x89, 0xc1, 0x1a, 0xab,
-		0x8f, 0xf2, 0x10, 0x74, 0x68, 0x16, 0xd2, 0x78, 0xd7, 0xc5, 0x86, 0xa3, 0x9b, 0x46, 0x76, 0x80,
-		0x32, 0xb9, 0xb7, 0xc5, 0x28, 0xe2, 0x5a, 0x25, 0xca, 0xc2, 0xa7, 0x43, 0xe7, 0x60, 0xc0, 0xb4,
-		0x5c, 0xdd, 0x34, 0x9c, 0x6c, 0x6a, 0x4a, 0x3a, 0x39, 0x78, 0xe6, 0x78, 0x4b, 0x43, 0x58, 0x61,
-		0x38, 0xaa, 0x40, 0x46, 0x0b, 0x20, 0x3b, 0x66, 0xc3, 0x2e, 0xe3, 0x52, 0xd9, 0xac, 0xe0, 0x92,
-		0x6e, 0x6c, 0x99, 0xd9, 0x34, 0x65, 0x30, 0xd9, 0xdc, 0x11, 0x8a, 0x38, 0x6b, 0x56, 0xf0, 0x82,
-		0xb1, 0x65, 0xaa, 0xc3, 0x4e, 0xa8, 0x8c, 0x0e, 0x43, 0xbf, 0xb3, 0x67, 0xb8, 0xda, 0x6e, 0x36,
-		0x43, 0x2d, 0x84, 0x97, 0x94, 0xdf, 0xec, 0x87, 0x91, 0x6e, 0x4c, 0xec, 0x3c, 0x24, 0xb7, 0x48,
-		0x2f, 0xb3, 0xb1, 0x5e, 0x74, 0xc0, 0x68, 0xc2, 0x4a, 0xec, 0x3f, 0xa0, 0x12, 0x67, 0x60, 0xd0,
-		0xc0, 0x8e, 0x8b, 0x2b, 0xcc, 0x22, 0xe2, 0x5d, 0xda, 0x14, 0x30, 0xa2, 0x66, 0x93, 0x4a, 0x1c,
-		0xc8, 0xa4, 0x9e, 0x83, 0x11, 0x4f, 0xa4, 0x92, 0xad, 0x19, 0x55, 0x61, 0x9b, 0xa7, 0x3b, 0x49,
-		0x32, 0x5d, 0x14, 0x74, 0x2a, 0x21, 0x53, 0x87, 0x71, 0xa8, 0x8c, 0xe6, 0x00, 0x4c, 0x03, 0x9b,
-		0x5b, 0xa5, 0x0a, 0x2e, 0xd7, 0xb2, 0xa9, 0x36, 0x5a, 0x5a, 0x21, 0x28, 0x4d, 0x5a, 0x32, 0x19,
-		0xb4, 0x5c, 0x43, 0x4f, 0xf9, 0xa6, 0x36, 0xd0, 0xc6, 0x52, 0x96, 0x98, 0x93, 0x35, 0x59, 0xdb,
-		0x06, 0x0c, 0xdb, 0x98, 0xd8, 0x3d, 0xae, 0xf0, 0x9e, 0xa5, 0xa9, 0x10, 0xd3, 0x1d, 0x7b, 0xa6,
-		0x72, 0x32, 0xd6, 0xb1, 0x21, 0x3b, 0x58, 0x44, 0x77, 0x83, 0x07, 0x28, 0x51, 0xb3, 0x02, 0x1a,
-		0x85, 0x32, 0x02, 0xb8, 0xac, 0xd5, 0x71, 0xee, 0x3a, 0x0c, 0x87, 0xd5, 0x83, 0xc6, 0x21, 0xe9,
-		0xb8, 0x9a, 0xed, 0x52, 0x2b, 0x4c, 0xaa, 0xac, 0x80, 0x64, 0x88, 0x63, 0xa3, 0x42, 0xa3, 0x5c,
-		0x52, 0x25, 0x3f, 0xd1, 0x5b, 0xfd, 0x0e, 0xc7, 0x69, 0x87, 0xef, 0x6b, 0x1e, 0xd1, 0x10, 0xe7,
-		0x68, 0xbf, 0x73, 0x4f, 0xc0, 0x50, 0xa8, 0x03, 0xdd, 0x36, 0xad, 0xbc, 0x0b, 0x0e, 0xb5, 0x64,
-		0x8d, 0x9e, 0x83, 0xf1, 0x86, 0xa1, 0x1b, 0x2e, 0xb6, 0x2d, 0x1b, 0x13, 0x8b, 0x65, 0x4d, 0x65,
-		0xff, 0xd3, 0x40, 0x1b, 0x9b, 0xdb, 0x08, 0x62, 0x33, 0x2e, 0xea, 0x58, 0xa3, 0x19, 0x78, 0x2a,
-		0x9d, 0xfa, 0xde, 0x80, 0xfc, 0xd2, 0x4b, 0x2f, 0xbd, 0x14, 0x53, 0xbe, 0xd6, 0x0f, 0xe3, 0xad,
-		0x7c, 0xa6, 0xa5, 0xfb, 0x1e, 0x86, 0x7e, 0xa3, 0x51, 0xdf, 0xc4, 0x36, 0x55, 0x52, 0x52, 0xe5,
-		0x25, 0x34, 0x03, 0xc9, 0x9a, 0xb6, 0x89, 0x6b, 0xd9, 0xc4, 0x94, 0x74, 0x72, 0xf8, 0xcc, 0x83,
-		0x5d, 0x79, 0xe5, 0xf4, 0x22, 0x21, 0x51, 0x19, 0x25, 0x7a, 0x0b, 0x24, 0x78, 0x88, 0x26, 0x1c,
-		0x4e, 0x75, 0xc7, 0x81, 0xf8, 0x92, 0x4a, 0xe9, 0xd0, 0x31, 0x48, 0x93, 0xbf, 0xcc, 0x36, 0xfa,
-		0xa9, 0xcc, 0x29, 0x02, 0x20, 0x76, 0x81, 0x72, 0x90, 0xa2, 0x6e, 0x52, 0xc1, 0x62, 0x6a, 0xf3,
-		0xca, 0xc4, 0xb0, 0x2a, 0x78, 0x4b, 0x6b, 0xd4, 0xdc, 0xd2, 0x55, 0xad, 0xd6, 0xc0, 0xd4, 0xe0,
-		0xd3, 0x6a, 0x86, 0x03, 0xaf, 0x10, 0x18, 0x9a, 0x84, 0x41, 0xe6, 0x55, 0xba, 0x51, 0xc1, 0xbb,
-		0x34, 0x7a, 0x26, 0x55, 0xe6, 0x68, 0x0b, 0x04, 0x42, 0x9a, 0x7f, 0xc1, 0x31, 0x0d, 0x61, 0x9a,
-		0xb4, 0x09, 0x02, 0xa0, 0xcd, 0x3f, 0x11, 0x0d, 0xdc, 0x27, 0x5a, 0x77, 0xaf, 0xc9, 0x97, 0xee,
-		0x87, 0x11, 0x8a, 0xf1, 0x18, 0x1f, 0x7a, 0xad, 0x96, 0x1d, 0x9d, 0x92, 0x4e, 0xa6, 0xd4, 0x61,
-		0x06, 0x5e, 0xe1, 0x50, 0xe5, 0x2b, 0x31, 0x48, 0xd0, 0xc0, 0x32, 0x02, 0x83, 0xeb, 0x6f, 0x5b,
-		0x2d, 0x96, 0xe6, 0x56, 0x36, 0x0a, 0x8b, 0x45, 0x59, 0x42, 0xc3, 0x00, 0x14, 0x70, 0x61, 0x71,
-		0x65, 0x66, 0x5d, 0x8e, 0x79, 0xe5, 0x85, 0xe5, 0xf5, 0x73, 0x8f, 0xcb, 0x71, 0x8f, 0x60, 0x83,
-		0x01, 0x12, 0x41, 0x84, 0xc7, 0xce, 0xc8, 0x49, 0x24, 0x43, 0x86, 0x31, 0x58, 0x78, 0xae, 0x38,
-		0x77, 0xee, 0x71, 0xb9, 0x3f, 0x0c, 0x79, 0xec, 0x8c, 0x3c, 0x80, 0x86, 0x20, 0x4d, 0x21, 0x85,
-		0x95, 0x95, 0x45, 0x39, 0xe5, 0xf1, 0x5c, 0x5b, 0x57, 0x17, 0x96, 0xe7, 0xe5, 0xb4, 0xc7, 0x73,
-		0x5e, 0x5d, 0xd9, 0x58, 0x95, 0xc1, 0xe3, 0xb0, 0x54, 0x5c, 0x5b, 0x9b, 0x99, 0x2f, 0xca, 0x83,
-		0x1e, 0x46, 0xe1, 0x6d, 0xeb, 0xc5, 0x35, 0x39, 0x13, 0x12, 0xeb, 0xb1, 0x33, 0xf2, 0x90, 0xd7,
-		0x44, 0x71, 0x79, 0x63, 0x49, 0x1e, 0x46, 0xa3, 0x30, 0xc4, 0x9a, 0x10, 0x42, 0x8c, 0x44, 0x40,
-		0xe7, 0x1e, 0x97, 0x65, 0x5f, 0x10, 0xc6, 0x65, 0x34, 0x04, 0x38, 0xf7, 0xb8, 0x8c, 0x94, 0x59,
-		0x48, 0x52, 0x33, 0x44, 0x08, 0x86, 0x17, 0x67, 0x0a, 0xc5, 0xc5, 0xd2, 0xca, 0xea, 0xfa, 0xc2,
-		0xca, 0xf2, 0xcc, 0xa2, 0x2c, 0xf9, 0x30, 0xb5, 0xf8, 0xcc, 0xc6, 0x82, 0x5a, 0x9c, 0x93, 0x63,
-		0x41, 0xd8, 0x6a, 0x71, 0x66, 0xbd, 0x38, 0x27, 0xc7, 0x95, 0x32, 0x8c, 0xb7, 0x0a, 0xa8, 0x2d,
-		0x5d, 0x28, 0x60, 0x0b, 0xb1, 0x36, 0xb6, 0x40, 0x79, 0x45, 0x6d, 0x41, 0xf9, 0x6e, 0x0c, 0xc6,
-		0x5a, 0x4c, 0x2a, 0x2d, 0x1b, 0x79, 0x1a, 0x92, 0xcc, 0x96, 0xd9, 0x34, 0xfb, 0x40, 0xcb, 0xd9,
-		0x89, 0x5a, 0x76, 0xd3, 0x54, 0x4b, 0xe9, 0x82, 0xa9, 0x46, 0xbc, 0x4d, 0xaa, 0x41, 0x58, 0x34,
-		0x19, 0xec, 0xcf, 0x35, 0x05, 0x7f, 0x36, 0x3f, 0x9e, 0xeb, 0x66, 0x7e, 0xa4, 0xb0, 0xde, 0x26,
-		0x81, 0x64, 0x8b, 0x49, 0xe0, 0x3c, 0x8c, 0x36, 0x31, 0xea, 0x3a, 0x18, 0xbf, 0x4f, 0x82, 0x6c,
-		0x3b, 0xe5, 0x74, 0x08, 0x89, 0xb1, 0x50, 0x48, 0x3c, 0x1f, 0xd5, 0xe0, 0x5d, 0xed, 0x07, 0xa1,
-		0x69, 0xac, 0x3f, 0x27, 0xc1, 0xe1, 0xd6, 0x29, 0x65, 0x4b, 0x19, 0xde, 0x02, 0xfd, 0x75, 0xec,
-		0x6e, 0x9b, 0x22, 0xad, 0xba, 0xaf, 0xc5, 0x64, 0x4d, 0xaa, 0xa3, 0x83, 0xcd, 0xa9, 0x82, 0xb3,
-		0x7d, 0xbc, 0x5d, 0x5e, 0xc8, 0xa4, 0x69, 0x92, 0xf4, 0x43, 0x31, 0x38, 0xd4, 0x92, 0x79, 0x4b,
-		0x41, 0x4f, 0x00, 0xe8, 0x86, 0xd5, 0x70, 0x59, 0xea, 0xc4, 0x22, 0x71, 0x9a, 0x42, 0x68, 0xf0,
-		0x22, 0x51, 0xb6, 0xe1, 0x7a, 0xf5, 0x71, 0x5a, 0x0f, 0x0c, 0x44, 0x11, 0x9e, 0xf4, 0x05, 0x4d,
-		0x50, 0x41, 0x27, 0xda, 0xf4, 0xb4, 0xc9, 0x30, 0x1f, 0x01, 0xb9, 0x5c, 0xd3, 0xb1, 0xe1, 0x96,
-		0x1c, 0xd7, 0xc6, 0x5a, 0x5d, 0x37, 0xaa, 0x74, 0xaa, 0x49, 0xe5, 0x93, 0x5b, 0x5a, 0xcd, 0xc1,
-		0xea, 0x08, 0xab, 0x5e, 0x13, 0xb5, 0x84, 0x82, 0x1a, 0x90, 0x1d, 0xa0, 0xe8, 0x0f, 0x51, 0xb0,
-		0x6a, 0x8f, 0x42, 0xf9, 0x68, 0x1a, 0x06, 0x03, 0x09, 0x38, 0xba, 0x0b, 0x32, 0x2f, 0x68, 0x57,
-		0xb5, 0x92, 0x58, 0x54, 0x31, 0x4d, 0x0c, 0x12, 0xd8, 0x2a, 0x5f, 0x58, 0x3d, 0x02, 0xe3, 0x14,
-		0xc5, 0x6c, 0xb8, 0xd8, 0x2e, 0x95, 0x6b, 0x9a, 0xe3, 0x50, 0xa5, 0xa5, 0x28, 0x2a, 0x22, 0x75,
-		0x2b, 0xa4, 0x6a, 0x56, 0xd4, 0xa0, 0xb3, 0x30, 0x46, 0x29, 0xea, 0x8d, 0x9a, 0xab, 0x5b, 0x35,
-		0x5c, 0x22, 0xcb, 0x3c, 0x87, 0x4e, 0x39, 0x9e, 0x64, 0xa3, 0x04, 0x63, 0x89, 0x23, 0x10, 0x89,
-		0x1c, 0x34, 0x07, 0x27, 0x28, 0x59, 0x15, 0x1b, 0xd8, 0xd6, 0x5c, 0x5c, 0xc2, 0x2f, 0x36, 0xb4,
-		0x9a, 0x53, 0xd2, 0x8c, 0x4a, 0x69, 0x5b, 0x73, 0xb6, 0xb3, 0xe3, 0x84, 0x41, 0x21, 0x96, 0x95,
-		0xd4, 0xa3, 0x04, 0x71, 0x9e, 0xe3, 0x15, 0x29, 0xda, 0x8c, 0x51, 0xb9, 0xa8, 0x39, 0xdb, 0x28,
-		0x0f, 0x87, 0x29, 0x17, 0xc7, 0xb5, 0x75, 0xa3, 0x5a, 0x2a, 0x6f, 0xe3, 0xf2, 0x4e, 0xa9, 0xe1,
-		0x6e, 0x3d, 0x99, 0x3d, 0x16, 0x6c, 0x9f, 0x4a, 0xb8, 0x46, 0x71, 0x66, 0x09, 0xca, 0x86, 0xbb,
-		0xf5, 0x24, 0x5a, 0x83, 0x0c, 0x19, 0x8c, 0xba, 0x7e, 0x1d, 0x97, 0xb6, 0x4c, 0x9b, 0xce, 0xa1,
-		0xc3, 0x2d, 0x42, 0x53, 0x40, 0x83, 0xd3, 0x2b, 0x9c, 0x60, 0xc9, 0xac, 0xe0, 0x7c, 0x72, 0x6d,
-		0xb5, 0x58, 0x9c, 0x53, 0x07, 0x05, 0x97, 0x0b, 0xa6, 0x4d, 0x0c, 0xaa, 0x6a, 0x7a, 0x0a, 0x1e,
-		0x64, 0x06, 0x55, 0x35, 0x85, 0x7a, 0xcf, 0xc2, 0x58, 0xb9, 0xcc, 0xfa, 0xac, 0x97, 0x4b, 0x7c,
-		0x31, 0xe6, 0x64, 0xe5, 0x90, 0xb2, 0xca, 0xe5, 0x79, 0x86, 0xc0, 0x6d, 0xdc, 0x41, 0x4f, 0xc1,
-		0x21, 0x5f, 0x59, 0x41, 0xc2, 0xd1, 0xa6, 0x5e, 0x46, 0x49, 0xcf, 0xc2, 0x98, 0xb5, 0xd7, 0x4c,
-		0x88, 0x42, 0x2d, 0x5a, 0x7b, 0x51, 0xb2, 0x27, 0x60, 0xdc, 0xda, 0xb6, 0x9a, 0xe9, 0x4e, 0x05,
-		0xe9, 0x90, 0xb5, 0x6d, 0x45, 0x09, 0xef, 0xa5, 0x2b, 0x73, 0x1b, 0x97, 0x35, 0x17, 0x57, 0xb2,
-		0x47, 0x82, 0xe8, 0x81, 0x0a, 0x34, 0x0d, 0x72, 0xb9, 0x5c, 0xc2, 0x86, 0xb6, 0x59, 0xc3, 0x25,
-		0xcd, 0xc6, 0x86, 0xe6, 0x64, 0x27, 0x29, 0x72, 0xc2, 0xb5, 0x1b, 0x58, 0x1d, 0x2e, 0x97, 0x8b,
-		0xb4, 0x72, 0x86, 0xd6, 0xa1, 0x53, 0x30, 0x6a, 0x6e, 0xbe, 0x50, 0x66, 0x16, 0x59, 0xb2, 0x6c,
-		0xbc, 0xa5, 0xef, 0x66, 0xef, 0xa1, 0xea, 0x1d, 0x21, 0x15, 0xd4, 0x1e, 0x57, 0x29, 0x18, 0x3d,
-		0x00, 0x72, 0xd9, 0xd9, 0xd6, 0x6c, 0x8b, 0x86, 0x64, 0xc7, 0xd2, 0xca, 0x38, 0x7b, 0x2f, 0x43,
-		0x65, 0xf0, 0x65, 0x01, 0x26, 0x1e, 0xe1, 0x5c, 0xd3, 0xb7, 0x5c, 0xc1, 0xf1, 0x7e, 0xe6, 0x11,
-		0x14, 0xc6, 0xb9, 0x9d, 0x04, 0x99, 0x68, 0x22, 0xd4, 0xf0, 0x49, 0x8a, 0x36, 0x6c, 0x6d, 0x5b,
-		0xc1, 0x76, 0xef, 0x86, 0x21, 0x82, 0xe9, 0x37, 0xfa, 0x00, 0x4b, 0xdc, 0xac, 0xed, 0x40, 0x8b,
-		0x8f, 0xc3, 0x61, 0x82, 0x54, 0xc7, 0xae, 0x56, 0xd1, 0x5c, 0x2d, 0x80, 0xfd, 0x10, 0xc5, 0x26,
-		0x6a, 0x5f, 0xe2, 0x95, 0x21, 0x39, 0xed, 0xc6, 0xe6, 0x9e, 0x67, 0x58, 0x0f, 0x33, 0x39, 0x09,
-		0x4c, 0x98, 0xd6, 0x1d, 0x4b, 0xce, 0x95, 0x3c, 0x64, 0x82, 0x76, 0x8f, 0xd2, 0xc0, 0x2c, 0x5f,
-		0x96, 0x48, 0x12, 0x34, 0xbb, 0x32, 0x47, 0xd2, 0x97, 0xe7, 0x8b, 0x72, 0x8c, 0xa4, 0x51, 0x8b,
-		0x0b, 0xeb, 0xc5, 0x92, 0xba, 0xb1, 0xbc, 0xbe, 0xb0, 0x54, 0x94, 0xe3, 0x81, 0xc4, 0xfe, 0x52,
-		0x22, 0x75, 0x9f, 0x7c, 0x3f, 0xc9, 0x1a, 0x86, 0xc3, 0x2b, 0x35, 0xf4, 0x26, 0x38, 0x22, 0xb6,
-		0x55, 0x1c, 0xec, 0x96, 0xae, 0xe9, 0x36, 0x75, 0xc8, 0xba, 0xc6, 0x26, 0x47, 0xcf, 0x7e, 0xc6,
-		0x39, 0xd6, 0x1a, 0x76, 0x9f, 0xd5, 0x6d, 0xe2, 0x6e, 0x75, 0xcd, 0x45, 0x8b, 0x30, 0x69, 0x98,
-		0x25, 0xc7, 0xd5, 0x8c, 0x8a, 0x66, 0x57, 0x4a, 0xfe, 0x86, 0x56, 0x49, 0x2b, 0x97, 0xb1, 0xe3,
-		0x98, 0x6c, 0x22, 0xf4, 0xb8, 0x1c, 0x37, 0xcc, 0x35, 0x8e, 0xec, 0xcf, 0x10, 0x33, 0x1c, 0x35,
-		0x62, 0xbe, 0xf1, 0x76, 0xe6, 0x7b, 0x0c, 0xd2, 0x75, 0xcd, 0x2a, 0x61, 0xc3, 0xb5, 0xf7, 0x68,
-		0x7e, 0x9e, 0x52, 0x53, 0x75, 0xcd, 0x2a, 0x92, 0xf2, 0x4f, 0x64, 0x99, 0x74, 0x29, 0x91, 0x4a,
-		0xc8, 0xc9, 0x4b, 0x89, 0x54, 0x52, 0xee, 0xbf, 0x94, 0x48, 0xf5, 0xcb, 0x03, 0x97, 0x12, 0xa9,
-		0x94, 0x9c, 0xbe, 0x94, 0x48, 0xa5, 0x65, 0x50, 0x5e, 0x8b, 0x43, 0x26, 0x98, 0xc1, 0x93, 0x05,
-		0x51, 0x99, 0xce, 0x61, 0x12, 0x8d, 0x72, 0x77, 0xef, 0x9b, 0xef, 0x4f, 0xcf, 0x92, 0xc9, 0x2d,
-		0xdf, 0xcf, 0xd2, 0x65, 0x95, 0x51, 0x92, 0xc4, 0x82, 0x98, 0x1f, 0x66, 0xe9, 0x49, 0x4a, 0xe5,
-		0x25, 0x34, 0x0f, 0xfd, 0x2f, 0x38, 0x94, 0x77, 0x3f, 0xe5, 0x7d, 0xcf, 0xfe, 0xbc, 0x2f, 0xad,
-		0x51, 0xe6, 0xe9, 0x4b, 0x6b, 0xa5, 0xe5, 0x15, 0x75, 0x69, 0x66, 0x51, 0xe5, 0xe4, 0xe8, 0x28,
-		0x24, 0x6a, 0xda, 0xf5, 0xbd, 0xf0, 0x34, 0x48, 0x41, 0xdd, 0x0e, 0xcb, 0x51, 0x48, 0x5c, 0xc3,
-		0xda, 0x4e, 0x78, 0xf2, 0xa1, 0xa0, 0x3b, 0xe8, 0x1e, 0xa7, 0x21, 0x49, 0xf5, 0x85, 0x00, 0xb8,
-		0xc6, 0xe4, 0x3e, 0x94, 0x82, 0xc4, 0xec, 0x8a, 0x4a, 0x5c, 0x44, 0x86, 0x0c, 0x83, 0x96, 0x56,
-		0x17, 0x8a, 0xb3, 0x45, 0x39, 0xa6, 0x9c, 0x85, 0x7e, 0xa6, 0x04, 0xe2, 0x3e, 0x9e, 0x1a, 0xe4,
-		0x3e, 0x5e, 0xe4, 0x3c, 0x24, 0x51, 0xbb, 0xb1, 0x54, 0x28, 0xaa, 0x72, 0xac, 0x69, 0xf0, 0x15,
-		0x07, 0x32, 0xc1, 0xcc, 0xfc, 0x27, 0xb3, 0x3c, 0xff, 0xaa, 0x04, 0x83, 0x81, 0x4c, 0x9b, 0xa4,
-		0x48, 0x5a, 0xad, 0x66, 0x5e, 0x2b, 0x69, 0x35, 0x5d, 0x73, 0xb8, 0x69, 0x00, 0x05, 0xcd, 0x10,
-		0x48, 0xb7, 0x43, 0xf7, 0x13, 0x72, 0x9a, 0xa4, 0xdc, 0xaf, 0x7c, 0x5a, 0x02, 0x39, 0x9a, 0xea,
-		0x46, 0xc4, 0x94, 0x7e, 0x9a, 0x62, 0x2a, 0x9f, 0x92, 0x60, 0x38, 0x9c, 0xdf, 0x46, 0xc4, 0xbb,
-		0xeb, 0xa7, 0x2a, 0xde, 0x1f, 0xc4, 0x60, 0x28, 0x94, 0xd5, 0x76, 0x2b, 0xdd, 0x8b, 0x30, 0xaa,
-		0x57, 0x70, 0xdd, 0x32, 0x5d, 0x6c, 0x94, 0xf7, 0x4a, 0x35, 0x7c, 0x15, 0xd7, 0xb2, 0x0a, 0x0d,
-		0x1a, 0xa7, 0xf7, 0xcf, 0x9b, 0xa7, 0x17, 0x7c, 0xba, 0x45, 0x42, 0x96, 0x1f, 0x5b, 0x98, 0x2b,
-		0x2e, 0xad, 0xae, 0xac, 0x17, 0x97, 0x67, 0xdf, 0x56, 0xda, 0x58, 0xbe, 0xbc, 0xbc, 0xf2, 0xec,
-		0xb2, 0x2a, 0xeb, 0x11, 0xb4, 0x3b, 0xe8, 0xf6, 0xab, 0x20, 0x47, 0x85, 0x42, 0x47, 0xa0, 0x95,
-		0x58, 0x72, 0x1f, 0x1a, 0x83, 0x91, 0xe5, 0x95, 0xd2, 0xda, 0xc2, 0x5c, 0xb1, 0x54, 0xbc, 0x70,
-		0xa1, 0x38, 0xbb, 0xbe, 0xc6, 0x76, 0x42, 0x3c, 0xec, 0xf5, 0x90, 0x83, 0x2b, 0xaf, 0xc4, 0x61,
-		0xac, 0x85, 0x24, 0x68, 0x86, 0xaf, 0x61, 0xd8, 0xb2, 0xea, 0xe1, 0x6e, 0xa4, 0x9f, 0x26, 0x59,
-		0xc4, 0xaa, 0x66, 0xbb, 0x7c, 0xc9, 0xf3, 0x00, 0x10, 0x2d, 0x19, 0xae, 0xbe, 0xa5, 0x63, 0x9b,
-		0xef, 0x30, 0xb1, 0x85, 0xcd, 0x88, 0x0f, 0x67, 0x9b, 0x4c, 0x0f, 0x01, 0xb2, 0x4c, 0x47, 0x77,
-		0xf5, 0xab, 0xb8, 0xa4, 0x1b, 0x62, 0x3b, 0x8a, 0x2c, 0x74, 0x12, 0xaa, 0x2c, 0x6a, 0x16, 0x0c,
-		0xd7, 0xc3, 0x36, 0x70, 0x55, 0x8b, 0x60, 0x93, 0x60, 0x1e, 0x57, 0x65, 0x51, 0xe3, 0x61, 0xdf,
-		0x05, 0x99, 0x8a, 0xd9, 0x20, 0xd9, 0x1f, 0xc3, 0x23, 0x73, 0x87, 0xa4, 0x0e, 0x32, 0x98, 0x87,
-		0xc2, 0xf3, 0x7a, 0x7f, 0x1f, 0x2c, 0xa3, 0x0e, 0x32, 0x18, 0x43, 0xb9, 0x1f, 0x46, 0xb4, 0x6a,
-		0xd5, 0x26, 0xcc, 0x05, 0x23, 0xb6, 0x52, 0x19, 0xf6, 0xc0, 0x14, 0x31, 0x77, 0x09, 0x52, 0x42,
-		0x0f, 0x64, 0xf2, 0x26, 0x9a, 0x28, 0x59, 0x6c, 0xf9, 0x1d, 0x3b, 0x99, 0x56, 0x53, 0x86, 0xa8,
-		0xbc, 0x0b, 0x32, 0xba, 0x53, 0xf2, 0xb7, 0xf5, 0x63, 0x53, 0xb1, 0x93, 0x29, 0x75, 0x50, 0x77,
-		0xbc, 0x2d, 0x51, 0xe5, 0x73, 0x31, 0x18, 0x0e, 0x1f, 0x4b, 0xa0, 0x39, 0x48, 0xd5, 0xcc, 0xb2,
-		0x46, 0x4d, 0x8b, 0x9d, 0x89, 0x9d, 0xec, 0x70, 0x92, 0x31, 0xbd, 0xc8, 0xf1, 0x55, 0x8f, 0x32,
-		0xf7, 0x2f, 0x25, 0x48, 0x09, 0x30, 0x3a, 0x0c, 0x09, 0x4b, 0x73, 0xb7, 0x29, 0xbb, 0x64, 0x21,
-		0x26, 0x4b, 0x2a, 0x2d, 0x13, 0xb8, 0x63, 0x69, 0x06, 0x35, 0x01, 0x0e, 0x27, 0x65, 0x32, 0xae,
-		0x35, 0xac, 0x55, 0xe8, 0x32, 0xc8, 0xac, 0xd7, 0xb1, 0xe1, 0x3a, 0x62, 0x5c, 0x39, 0x7c, 0x96,
-		0x83, 0xd1, 0x83, 0x30, 0xea, 0xda, 0x9a, 0x5e, 0x0b, 0xe1, 0x26, 0x28, 0xae, 0x2c, 0x2a, 0x3c,
-		0xe4, 0x3c, 0x1c, 0x15, 0x7c, 0x2b, 0xd8, 0xd5, 0xca, 0xdb, 0xb8, 0xe2, 0x13, 0xf5, 0xd3, 0xed,
-		0x8e, 0x23, 0x1c, 0x61, 0x8e, 0xd7, 0x0b, 0x5a, 0xe5, 0xdb, 0x12, 0x8c, 0x8a, 0x85, 0x5b, 0xc5,
-		0x53, 0xd6, 0x12, 0x80, 0x66, 0x18, 0xa6, 0x1b, 0x54, 0x57, 0xb3, 0x29, 0x37, 0xd1, 0x4d, 0xcf,
-		0x78, 0x44, 0x6a, 0x80, 0x41, 0xae, 0x0e, 0xe0, 0xd7, 0xb4, 0x55, 0xdb, 0x24, 0x0c, 0xf2, 0x33,
-		0x27, 0x7a, 0x70, 0xc9, 0x96, 0xfa, 0xc0, 0x40, 0x64, 0x85, 0x87, 0xc6, 0x21, 0xb9, 0x89, 0xab,
-		0xba, 0xc1, 0x77, 0x92, 0x59, 0x41, 0x6c, 0xc8, 0x24, 0xbc, 0x0d, 0x99, 0xc2, 0x9f, 0x87, 0xb1,
-		0xb2, 0x59, 0x8f, 0x8a, 0x5b, 0x90, 0x23, 0xdb, 0x0d, 0xce, 0x45, 0xe9, 0xf9, 0x87, 0x39, 0x52,
-		0xd5, 0xac, 0x69, 0x46, 0x75, 0xda, 0xb4, 0xab, 0xfe, 0xc1, 0x2b, 0xc9, 0x78, 0x9c, 0xc0, 0xf1,
-		0xab, 0xb5, 0xf9, 0xc7, 0x92, 0xf4, 0x4b, 0xb1, 0xf8, 0xfc, 0x6a, 0xe1, 0xf3, 0xb1, 0xdc, 0x3c,
-		0x23, 0x5c, 0x15, 0xca, 0x50, 0xf1, 0x56, 0x0d, 0x97, 0x49, 0x07, 0xe1, 0xfb, 0x0f, 0xc2, 0x78,
-		0xd5, 0xac, 0x9a, 0x94, 0xd3, 0x69, 0xf2, 0x8b, 0x9f, 0xdc, 0xa6, 0x3d, 0x68, 0xae, 0xe3, 0x31,
-		0x6f, 0x7e, 0x19, 0xc6, 0x38, 0x72, 0x89, 0x1e, 0x1d, 0xb1, 0x85, 0x0d, 0xda, 0x77, 0x57, 0x2d,
-		0xfb, 0xeb, 0xaf, 0xd3, 0xe9, 0x5b, 0x1d, 0xe5, 0xa4, 0xa4, 0x8e, 0xad, 0x7d, 0xf2, 0x2a, 0x1c,
-		0x0a, 0xf1, 0x63, 0x4e, 0x8a, 0xed, 0x0e, 0x1c, 0x7f, 0x8b, 0x73, 0x1c, 0x0b, 0x70, 0x5c, 0xe3,
-		0xa4, 0xf9, 0x59, 0x18, 0xea, 0x85, 0xd7, 0x3f, 0xe5, 0xbc, 0x32, 0x38, 0xc8, 0x64, 0x1e, 0x46,
-		0x28, 0x93, 0x72, 0xc3, 0x71, 0xcd, 0x3a, 0x8d, 0x80, 0xfb, 0xb3, 0xf9, 0xed, 0xd7, 0x99, 0xd7,
-		0x0c, 0x13, 0xb2, 0x59, 0x8f, 0x2a, 0x9f, 0x07, 0x7a, 0x5a, 0x56, 0xc1, 0xe5, 0x5a, 0x07, 0x0e,
-		0x5f, 0xe7, 0x82, 0x78, 0xf8, 0xf9, 0x2b, 0x30, 0x4e, 0x7e, 0xd3, 0x00, 0x15, 0x94, 0xa4, 0xf3,
-		0x16, 0x5c, 0xf6, 0xdb, 0xef, 0x63, 0x8e, 0x39, 0xe6, 0x31, 0x08, 0xc8, 0x14, 0x18, 0xc5, 0x2a,
-		0x76, 0x5d, 0x6c, 0x3b, 0x25, 0xad, 0xd6, 0x4a, 0xbc, 0xc0, 0x1e, 0x46, 0xf6, 0x13, 0x3f, 0x08,
-		0x8f, 0xe2, 0x3c, 0xa3, 0x9c, 0xa9, 0xd5, 0xf2, 0x1b, 0x70, 0xa4, 0x85, 0x55, 0x74, 0xc1, 0xf3,
-		0x15, 0xce, 0x73, 0xbc, 0xc9, 0x32, 0x08, 0xdb, 0x55, 0x10, 0x70, 0x6f, 0x2c, 0xbb, 0xe0, 0xf9,
-		0x49, 0xce, 0x13, 0x71, 0x5a, 0x31, 0xa4, 0x84, 0xe3, 0x25, 0x18, 0xbd, 0x8a, 0xed, 0x4d, 0xd3,
-		0xe1, 0xfb, 0x46, 0x5d, 0xb0, 0xfb, 0x14, 0x67, 0x37, 0xc2, 0x09, 0xe9, 0x46, 0x12, 0xe1, 0xf5,
-		0x14, 0xa4, 0xb6, 0xb4, 0x32, 0xee, 0x82, 0xc5, 0x0d, 0xce, 0x62, 0x80, 0xe0, 0x13, 0xd2, 0x19,
-		0xc8, 0x54, 0x4d, 0x3e, 0x47, 0x75, 0x26, 0xff, 0x34, 0x27, 0x1f, 0x14, 0x34, 0x9c, 0x85, 0x65,
-		0x5a, 0x8d, 0x1a, 0x99, 0xc0, 0x3a, 0xb3, 0xf8, 0x6b, 0x82, 0x85, 0xa0, 0xe1, 0x2c, 0x7a, 0x50,
-		0xeb, 0xab, 0x82, 0x85, 0x13, 0xd0, 0xe7, 0xd3, 0x30, 0x68, 0x1a, 0xb5, 0x3d, 0xd3, 0xe8, 0x46,
-		0x88, 0xcf, 0x70, 0x0e, 0xc0, 0x49, 0x08, 0x83, 0xf3, 0x90, 0xee, 0x76, 0x20, 0xfe, 0xfa, 0x0f,
-		0x84, 0x7b, 0x88, 0x11, 0x98, 0x87, 0x11, 0x11, 0xa0, 0x74, 0xd3, 0xe8, 0x82, 0xc5, 0xdf, 0xe0,
-		0x2c, 0x86, 0x03, 0x64, 0xbc, 0x1b, 0x2e, 0x76, 0xdc, 0x2a, 0xee, 0x86, 0xc9, 0xe7, 0x44, 0x37,
-		0x38, 0x09, 0x57, 0xe5, 0x26, 0x36, 0xca, 0xdb, 0xdd, 0x71, 0xf8, 0x15, 0xa1, 0x4a, 0x41, 0x43,
-		0x58, 0xcc, 0xc2, 0x50, 0x5d, 0xb3, 0x9d, 0x6d, 0xad, 0xd6, 0xd5, 0x70, 0xfc, 0x4d, 0xce, 0x23,
-		0xe3, 0x11, 0x71, 0x8d, 0x34, 0x8c, 0x5e, 0xd8, 0x7c, 0x5e, 0x68, 0x24, 0x40, 0xc6, 0x5d, 0xcf,
-		0x71, 0xe9, 0x26, 0x5b, 0x2f, 0xdc, 0x7e, 0x55, 0xb8, 0x1e, 0xa3, 0x5d, 0x0a, 0x72, 0x3c, 0x0f,
-		0x69, 0x47, 0xbf, 0xde, 0x15, 0x9b, 0x2f, 0x88, 0x91, 0xa6, 0x04, 0x84, 0xf8, 0x6d, 0x70, 0xb4,
-		0xe5, 0x34, 0xd1, 0x05, 0xb3, 0xbf, 0xc5, 0x99, 0x1d, 0x6e, 0x31, 0x55, 0xf0, 0x90, 0xd0, 0x2b,
-		0xcb, 0xbf, 0x2d, 0x42, 0x02, 0x8e, 0xf0, 0x5a, 0x25, 0xab, 0x06, 0x47, 0xdb, 0xea, 0x4d, 0x6b,
-		0xbf, 0x26, 0xb4, 0xc6, 0x68, 0x43, 0x5a, 0x5b, 0x87, 0xc3, 0x9c, 0x63, 0x6f, 0xe3, 0xfa, 0x45,
-		0x11, 0x58, 0x19, 0xf5, 0x46, 0x78, 0x74, 0xdf, 0x0e, 0x39, 0x4f, 0x9d, 0x22, 0x3d, 0x75, 0x4a,
-		0x75, 0xcd, 0xea, 0x82, 0xf3, 0xaf, 0x73, 0xce, 0x22, 0xe2, 0x7b, 0xf9, 0xad, 0xb3, 0xa4, 0x59,
-		0x84, 0xf9, 0x73, 0x90, 0x15, 0xcc, 0x1b, 0x86, 0x8d, 0xcb, 0x66, 0xd5, 0xd0, 0xaf, 0xe3, 0x4a,
-		0x17, 0xac, 0x7f, 0x23, 0x32, 0x54, 0x1b, 0x01, 0x72, 0xc2, 0x79, 0x01, 0x64, 0x2f, 0x57, 0x29,
-		0xe9, 0x75, 0xcb, 0xb4, 0xdd, 0x0e, 0x1c, 0xbf, 0x24, 0x46, 0xca, 0xa3, 0x5b, 0xa0, 0x64, 0xf9,
-		0x22, 0xb0, 0x93, 0xe7, 0x6e, 0x4d, 0xf2, 0xcb, 0x9c, 0xd1, 0x90, 0x4f, 0xc5, 0x03, 0x47, 0xd9,
-		0xac, 0x5b, 0x9a, 0xdd, 0x4d, 0xfc, 0xfb, 0x3b, 0x22, 0x70, 0x70, 0x12, 0x1e, 0x38, 0x48, 0x46,
-		0x47, 0x66, 0xfb, 0x2e, 0x38, 0x7c, 0x45, 0x04, 0x0e, 0x41, 0xc3, 0x59, 0x88, 0x84, 0xa1, 0x0b,
-		0x16, 0x7f, 0x57, 0xb0, 0x10, 0x34, 0x84, 0xc5, 0x33, 0xfe, 0x44, 0x6b, 0xe3, 0xaa, 0xee, 0xb8,
-		0x36, 0x4b, 0x8a, 0xf7, 0x67, 0xf5, 0xf7, 0x7e, 0x10, 0x4e, 0xc2, 0xd4, 0x00, 0x29, 0x89, 0x44,
-		0x7c, 0xdb, 0x95, 0xae, 0x99, 0x3a, 0x0b, 0xf6, 0x9b, 0x22, 0x12, 0x05, 0xc8, 0x88, 0x6c, 0x81,
-		0x0c, 0x91, 0xa8, 0xbd, 0x4c, 0x56, 0x0a, 0x5d, 0xb0, 0xfb, 0xfb, 0x11, 0xe1, 0xd6, 0x04, 0x2d,
-		0xe1, 0x19, 0xc8, 0x7f, 0x1a, 0xc6, 0x0e, 0xde, 0xeb, 0xca, 0x3a, 0xff, 0x41, 0x24, 0xff, 0xd9,
-		0x60, 0x94, 0x2c, 0x86, 0x8c, 0x44, 0xf2, 0x29, 0xd4, 0xe9, 0x9e, 0x51, 0xf6, 0x3d, 0x3f, 0xe2,
-		0xfd, 0x0d, 0xa7, 0x53, 0xf9, 0x45, 0x62, 0xe4, 0xe1, 0xa4, 0xa7, 0x33, 0xb3, 0xf7, 0xfd, 0xc8,
-		0xb3, 0xf3, 0x50, 0xce, 0x93, 0xbf, 0x00, 0x43, 0xa1, 0x84, 0xa7, 0x33, 0xab, 0xf7, 0x73, 0x56,
-		0x99, 0x60, 0xbe, 0x93, 0x3f, 0x0b, 0x09, 0x92, 0xbc, 0x74, 0x26, 0xff, 0x0b, 0x9c, 0x9c, 0xa2,
-		0xe7, 0xdf, 0x0c, 0x29, 0x91, 0xb4, 0x74, 0x26, 0xfd, 0x00, 0x27, 0xf5, 0x48, 0x08, 0xb9, 0x48,
-		0x58, 0x3a, 0x93, 0xff, 0x45, 0x41, 0x2e, 0x48, 0x08, 0x79, 0xf7, 0x2a, 0xfc, 0xea, 0xcf, 0x27,
-		0xf8, 0xa4, 0x23, 0x74, 0x77, 0x1e, 0x06, 0x78, 0xa6, 0xd2, 0x99, 0xfa, 0x43, 0xbc, 0x71, 0x41,
-		0x91, 0x7f, 0x02, 0x92, 0x5d, 0x2a, 0xfc, 0x17, 0x38, 0x29, 0xc3, 0xcf, 0xcf, 0xc2, 0x60, 0x20,
-		0x3b, 0xe9, 0x4c, 0xfe, 0x97, 0x38, 0x79, 0x90, 0x8a, 0x88, 0xce, 0xb3, 0x93, 0xce, 0x0c, 0x3e,
-		0x2c, 0x44, 0xe7, 0x14, 0x44, 0x6d, 0x22, 0x31, 0xe9, 0x4c, 0xfd, 0x11, 0xa1, 0x75, 0x41, 0x92,
-		0x7f, 0x1a, 0xd2, 0xde, 0x64, 0xd3, 0x99, 0xfe, 0xa3, 0x9c, 0xde, 0xa7, 0x21, 0x1a, 0x08, 0x4c,
-		0x76, 0x9d, 0x59, 0xfc, 0x65, 0xa1, 0x81, 0x00, 0x15, 0x71, 0xa3, 0x68, 0x02, 0xd3, 0x99, 0xd3,
-		0xc7, 0x84, 0x1b, 0x45, 0xf2, 0x17, 0x32, 0x9a, 0x34, 0xe6, 0x77, 0x66, 0xf1, 0x57, 0xc4, 0x68,
-		0x52, 0x7c, 0x22, 0x46, 0x34, 0x23, 0xe8, 0xcc, 0xe3, 0x17, 0x85, 0x18, 0x91, 0x84, 0x20, 0xbf,
-		0x0a, 0xa8, 0x39, 0x1b, 0xe8, 0xcc, 0xef, 0xe3, 0x9c, 0xdf, 0x68, 0x53, 0x32, 0x90, 0x7f, 0x16,
-		0x0e, 0xb7, 0xce, 0x04, 0x3a, 0x73, 0xfd, 0xc4, 0x8f, 0x22, 0x6b, 0xb7, 0x60, 0x22, 0x90, 0x5f,
-		0xf7, 0xa7, 0x94, 0x60, 0x16, 0xd0, 0x99, 0xed, 0x2b, 0x3f, 0x0a, 0x07, 0xee, 0x60, 0x12, 0x90,
-		0x9f, 0x01, 0xf0, 0x27, 0xe0, 0xce, 0xbc, 0x3e, 0xc5, 0x79, 0x05, 0x88, 0x88, 0x6b, 0xf0, 0xf9,
-		0xb7, 0x33, 0xfd, 0x0d, 0xe1, 0x1a, 0x9c, 0x82, 0xb8, 0x86, 0x98, 0x7a, 0x3b, 0x53, 0x7f, 0x5a,
-		0xb8, 0x86, 0x20, 0x21, 0x96, 0x1d, 0x98, 0xdd, 0x3a, 0x73, 0xf8, 0x8c, 0xb0, 0xec, 0x00, 0x55,
-		0x7e, 0x19, 0x46, 0x9b, 0x26, 0xc4, 0xce, 0xac, 0x7e, 0x89, 0xb3, 0x92, 0xa3, 0xf3, 0x61, 0x70,
-		0xf2, 0xe2, 0x93, 0x61, 0x67, 0x6e, 0x9f, 0x8d, 0x4c, 0x5e, 0x7c, 0x2e, 0xcc, 0x9f, 0x87, 0x94,
-		0xd1, 0xa8, 0xd5, 0x88, 0xf3, 0xa0, 0xfd, 0xef, 0x06, 0x66, 0xff, 0xf3, 0x8f, 0xb9, 0x76, 0x04,
-		0x41, 0xfe, 0x2c, 0x24, 0x71, 0x7d, 0x13, 0x57, 0x3a, 0x51, 0x7e, 0xff, 0xc7, 0x22, 0x60, 0x12,
-		0xec, 0xfc, 0xd3, 0x00, 0x6c, 0x6b, 0x84, 0x1e, 0x06, 0x76, 0xa0, 0xfd, 0x2f, 0x3f, 0xe6, 0x97,
-		0x71, 0x7c, 0x12, 0x9f, 0x01, 0xbb, 0xda, 0xb3, 0x3f, 0x83, 0x1f, 0x84, 0x19, 0xd0, 0x11, 0x79,
-		0x0a, 0x06, 0x5e, 0x70, 0x4c, 0xc3, 0xd5, 0xaa, 0x9d, 0xa8, 0xff, 0x2b, 0xa7, 0x16, 0xf8, 0x44,
-		0x61, 0x75, 0xd3, 0xc6, 0xae, 0x56, 0x75, 0x3a, 0xd1, 0xfe, 0x37, 0x4e, 0xeb, 0x11, 0x10, 0xe2,
-		0xb2, 0xe6, 0xb8, 0xdd, 0xf4, 0xfb, 0x0f, 0x05, 0xb1, 0x20, 0x20, 0x42, 0x93, 0xdf, 0x3b, 0x78,
-		0xaf, 0x13, 0xed, 0x0f, 0x85, 0xd0, 0x1c, 0x3f, 0xff, 0x66, 0x48, 0x93, 0x9f, 0xec, 0x86, 0x5d,
-		0x07, 0xe2, 0x3f, 0xe2, 0xc4, 0x3e, 0x05, 0x69, 0xd9, 0x71, 0x2b, 0xae, 0xde, 0x59, 0xd9, 0xb7,
-		0xf8, 0x48, 0x0b, 0xfc, 0xfc, 0x0c, 0x0c, 0x3a, 0x6e, 0xa5, 0xd2, 0xe0, 0xf9, 0x69, 0x07, 0xf2,
-		0xff, 0xfe, 0x63, 0x6f, 0xcb, 0xc2, 0xa3, 0x21, 0xa3, 0x7d, 0x6d, 0xc7, 0xb5, 0x4c, 0x7a, 0xe0,
-		0xd1, 0x89, 0xc3, 0x8f, 0x38, 0x87, 0x00, 0x49, 0x7e, 0x16, 0x32, 0xa4, 0x2f, 0x36, 0xb6, 0x30,
-		0x3d, 0x9d, 0xea, 0xc0, 0xe2, 0x7f, 0x70, 0x05, 0x84, 0x88, 0x0a, 0x3f, 0xf7, 0xf5, 0xd7, 0x26,
-		0xa4, 0x6f, 0xbd, 0x36, 0x21, 0xfd, 0xc1, 0x6b, 0x13, 0xd2, 0x47, 0xbe, 0x3b, 0xd1, 0xf7, 0xad,
-		0xef, 0x4e, 0xf4, 0xfd, 0xee, 0x77, 0x27, 0xfa, 0x5a, 0xef, 0x12, 0xc3, 0xbc, 0x39, 0x6f, 0xb2,
-		0xfd, 0xe1, 0xe7, 0x95, 0xaa, 0xee, 0x6e, 0x37, 0x36, 0xa7, 0xcb, 0x66, 0x9d, 0x6e, 0xe3, 0xfa,
-		0xbb, 0xb5, 0xde, 0x22, 0x07, 0xde, 0x1b, 0x87, 0xa3, 0x65, 0xd3, 0xa9, 0x9b, 0x4e, 0x89, 0xed,
-		0xf7, 0xb2, 0x02, 0xdf, 0xf1, 0xcd, 0x04, 0xab, 0xba, 0xd8, 0xf4, 0xbd, 0x08, 0xc3, 0xb4, 0xeb,
-		0x74, 0xbb, 0x8b, 0x5a, 0x5b, 0xc7, 0x00, 0xf1, 0x8d, 0x7f, 0x93, 0xa4, 0xbd, 0x1e, 0xf2, 0x08,
-		0xe9, 0xe9, 0xfd, 0x3a, 0x8c, 0xeb, 0x75, 0xab, 0x86, 0xe9, 0x36, 0x7f, 0xc9, 0xab, 0xeb, 0xcc,
-		0xef, 0x9b, 0x9c, 0xdf, 0x98, 0x4f, 0xbe, 0x20, 0xa8, 0xf3, 0x8b, 0x30, 0xaa, 0x95, 0xcb, 0xd8,
-		0x0a, 0xb1, 0xec, 0x30, 0x2c, 0x42, 0x40, 0x99, 0x53, 0x7a, 0xdc, 0x0a, 0x4f, 0xb7, 0x1b, 0x9a,
-		0xe7, 0xef, 0x0d, 0x68, 0xde, 0xc6, 0x55, 0x6c, 0x3c, 0x6c, 0x60, 0xf7, 0x9a, 0x69, 0xef, 0x70,
-		0xf5, 0x3e, 0xcc, 0x9a, 0xea, 0x67, 0x77, 0x9a, 0xe1, 0xfd, 0x71, 0x98, 0x60, 0x15, 0xa7, 0x37,
-		0x35, 0x07, 0x9f, 0xbe, 0xfa, 0xe8, 0x26, 0x76, 0xb5, 0x47, 0x4f, 0x97, 0x4d, 0xdd, 0xe0, 0x23,
-		0x31, 0xc6, 0xc7, 0x85, 0xd4, 0x4f, 0xf3, 0xfa, 0x5c, 0xcb, 0x6d, 0x7a, 0x65, 0x1e, 0x12, 0xb3,
-		0xa6, 0x6e, 0xa0, 0x71, 0x48, 0x56, 0xb0, 0x61, 0xd6, 0xf9, 0x2d, 0x3c, 0x56, 0x40, 0x77, 0x43,
-		0xbf, 0x56, 0x37, 0x1b, 0x86, 0xcb, 0x4e, 0x28, 0x0a, 0x83, 0x5f, 0xbf, 0x39, 0xd9, 0xf7, 0x7b,
-		0x37, 0x27, 0xe3, 0x0b, 0x86, 0xab, 0xf2, 0xaa, 0x7c, 0xe2, 0x7b, 0xaf, 0x4e, 0x4a, 0xca, 0x25,
-		0x18, 0x98, 0xc3, 0xe5, 0x83, 0xf0, 0x9a, 0xc3, 0xe5, 0x08, 0xaf, 0x07, 0x20, 0xb5, 0x60, 0xb8,
-		0xec, 0x9e, 0xe4, 0x09, 0x88, 0xeb, 0x06, 0xbb, 0x7a, 0x13, 0x69, 0x9f, 0xc0, 0x09, 0xea, 0x1c,
-		0x2e, 0x7b, 0xa8, 0x15, 0x5c, 0x8e, 0xa2, 0x12, 0xf6, 0x04, 0x5e, 0x98, 0xfb, 0xdd, 0xff, 0x30,
-		0xd1, 0xf7, 0xd2, 0x6b, 0x13, 0x7d, 0x6d, 0x47, 0x22, 0xe8, 0x03, 0x5c, 0xc5, 0x7c, 0x08, 0x9c,
-		0xca, 0x0e, 0x3b, 0x23, 0xf1, 0x86, 0xe1, 0x5f, 0xf4, 0x83, 0xc2, 0x71, 0x1c, 0x57, 0xdb, 0xd1,
-		0x8d, 0xaa, 0x37, 0x12, 0x5a, 0xc3, 0xdd, 0xbe, 0xce, 0x87, 0xe2, 0x30, 0x1f, 0x0a, 0x8e, 0xb3,
-		0xff, 0x68, 0xe4, 0xda, 0x7b, 0x57, 0xae, 0xc3, 0x98, 0x2b, 0xff, 0x3c, 0x0e, 0x68, 0xcd, 0xd5,
-		0x76, 0xf0, 0x4c, 0xc3, 0xdd, 0x36, 0x6d, 0xfd, 0x3a, 0x8b, 0x65, 0x18, 0xa0, 0xae, 0xed, 0x96,
-		0x5c, 0x73, 0x07, 0x1b, 0x0e, 0x55, 0xcd, 0xe0, 0x99, 0xa3, 0xd3, 0x2d, 0xec, 0x63, 0x9a, 0x0c,
-		0x5d, 0xe1, 0xc1, 0xcf, 0x7f, 0x67, 0xf2, 0xfe, 0xce, 0x5a, 0xa0, 0xc8, 0x24, 0xb9, 0xde, 0x5d,
-		0xa7, 0x8c, 0xd1, 0x15, 0x60, 0x97, 0x2c, 0x4a, 0x35, 0xdd, 0x71, 0xf9, 0xcd, 0xed, 0xb3, 0xd3,
-		0xad, 0xfb, 0x3e, 0xdd, 0x2c, 0xe6, 0xf4, 0x15, 0xad, 0xa6, 0x57, 0x34, 0xd7, 0xb4, 0x9d, 0x8b,
-		0x7d, 0x6a, 0x9a, 0xb2, 0x5a, 0xd4, 0x1d, 0x17, 0xad, 0x43, 0xba, 0x82, 0x8d, 0x3d, 0xc6, 0x36,
-		0xfe, 0xc6, 0xd8, 0xa6, 0x08, 0x27, 0xca, 0xf5, 0x39, 0x40, 0x5a, 0x10, 0x4f, 0x3c, 0x55, 0x62,
-		0x37, 0x2e, 0xdb, 0xb0, 0x0f, 0x71, 0xa6, 0x2f, 0x2b, 0x46, 0xb5, 0x28, 0x28, 0x77, 0x1f, 0x80,
-		0xdf, 0x26, 0xca, 0xc2, 0x80, 0x56, 0xa9, 0xd8, 0xd8, 0x71, 0xe8, 0x01, 0x60, 0x5a, 0x15, 0xc5,
-		0xfc, 0xe8, 0xbf, 0xfa, 0xf2, 0xc3, 0x43, 0x21, 0x8e, 0x85, 0x0c, 0xc0, 0x55, 0x8f, 0xf4, 0xd4,
-		0xa7, 0x25, 0x18, 0x6d, 0x6a, 0x11, 0x29, 0x30, 0x31, 0xb3, 0xb1, 0x7e, 0x71, 0x45, 0x5d, 0x78,
-		0x7e, 0x66, 0x7d, 0x61, 0x65, 0xb9, 0xc4, 0x1e, 0x01, 0x2c, 0xaf, 0xad, 0x16, 0x67, 0x17, 0x2e,
-		0x2c, 0x14, 0xe7, 0xe4, 0x3e, 0x34, 0x09, 0xc7, 0x5a, 0xe0, 0xcc, 0x15, 0x17, 0x8b, 0xf3, 0x33,
-		0xeb, 0x45, 0x59, 0x42, 0x77, 0xc1, 0x89, 0x96, 0x4c, 0x3c, 0x94, 0x58, 0x1b, 0x14, 0xb5, 0xe8,
-		0xa1, 0xc4, 0x0b, 0x17, 0xda, 0x7a, 0xd1, 0x43, 0xfb, 0xda, 0xcf, 0xae, 0xe7, 0x2e, 0x61, 0x7f,
-		0x7a, 0x4f, 0x0c, 0x8e, 0x46, 0xa7, 0x0c, 0xcd, 0xd8, 0x6b, 0xf3, 0x0e, 0xb4, 0x4d, 0x34, 0xbb,
-		0x08, 0xf1, 0x19, 0x63, 0x0f, 0x1d, 0x65, 0xf9, 0x74, 0xa9, 0x61, 0xd7, 0x78, 0x0c, 0x1a, 0x20,
-		0xe5, 0x0d, 0xbb, 0x46, 0x62, 0x93, 0xb8, 0xfa, 0x2f, 0x9d, 0xcc, 0xf0, 0xfb, 0xfc, 0x79, 0xf9,
-		0xe3, 0xaf, 0x4e, 0xf6, 0x7d, 0xf1, 0xd5, 0xc9, 0xbe, 0x1f, 0x7e, 0x66, 0xb2, 0xef, 0xa5, 0xdf,
-		0x9f, 0xea, 0x2b, 0xec, 0x44, 0xbb, 0xf7, 0xd5, 0x8e, 0xb3, 0x69, 0x6a, 0xc6, 0xd8, 0xa3, 0x81,
-		0x68, 0x55, 0x7a, 0x3e, 0x49, 0x3b, 0x27, 0x0e, 0x50, 0x27, 0xa2, 0x07, 0xa8, 0xcf, 0xe2, 0x5a,
-		0xed, 0xb2, 0x61, 0x5e, 0xa3, 0xa3, 0xea, 0xeb, 0xe0, 0x63, 0x31, 0x98, 0x68, 0x9a, 0x36, 0x79,
-		0x86, 0xd1, 0xee, 0x41, 0x6c, 0x1e, 0x52, 0x73, 0x22, 0x71, 0xc9, 0xc2, 0x80, 0x83, 0xcb, 0xa6,
-		0x51, 0x61, 0x9e, 0x1e, 0x57, 0x45, 0x91, 0x74, 0xdb, 0xd0, 0x0c, 0xd3, 0xe1, 0xb7, 0xf0, 0x59,
-		0xa1, 0xf0, 0x49, 0xa9, 0xb7, 0x7c, 0x61, 0x48, 0xb4, 0x24, 0xba, 0xf9, 0x68, 0xc7, 0x23, 0xe5,
-		0x1d, 0xd2, 0x4b, 0xaf, 0x13, 0xa1, 0x63, 0xe5, 0x6e, 0xb5, 0xf2, 0x8b, 0x31, 0x98, 0x8c, 0x6a,
-		0x85, 0xa4, 0x6d, 0x8e, 0xab, 0xd5, 0xad, 0x76, 0x6a, 0x39, 0x0f, 0xe9, 0x75, 0x81, 0xd3, 0xb3,
-		0x5e, 0x6e, 0xf4, 0xa8, 0x97, 0x61, 0xaf, 0x29, 0xa1, 0x98, 0x33, 0x5d, 0x2a, 0xc6, 0xeb, 0xc7,
-		0x81, 0x34, 0xf3, 0xf9, 0x04, 0x9c, 0xa0, 0xcf, 0xb4, 0xec, 0xba, 0x6e, 0xb8, 0xa7, 0xcb, 0xf6,
-		0x9e, 0xe5, 0xd2, 0xc4, 0xcd, 0xdc, 0xe2, 0x7a, 0x19, 0xf5, 0xab, 0xa7, 0x59, 0x75, 0x1b, 0xcf,
-		0xd9, 0x82, 0xe4, 0x2a, 0xa1, 0x23, 0x1a, 0x71, 0x4d, 0x57, 0xab, 0x71, 0x4d, 0xb1, 0x02, 0x81,
-		0xb2, 0xa7, 0x5d, 0x31, 0x06, 0xd5, 0xc5, 0xab, 0xae, 0x1a, 0xd6, 0xb6, 0xd8, 0x0d, 0xf9, 0x38,
-		0x75, 0xa8, 0x14, 0x01, 0xd0, 0xcb, 0xf0, 0xe3, 0x90, 0xd4, 0x1a, 0xec, 0x2a, 0x47, 0x9c, 0x78,
-		0x1a, 0x2d, 0x28, 0x97, 0x61, 0x80, 0x1f, 0x28, 0x23, 0x19, 0xe2, 0x3b, 0x78, 0x8f, 0xb6, 0x93,
-		0x51, 0xc9, 0x4f, 0x34, 0x0d, 0x49, 0x2a, 0x3c, 0x9f, 0x40, 0xb2, 0xd3, 0x4d, 0xd2, 0x4f, 0x53,
-		0x21, 0x55, 0x86, 0xa6, 0x5c, 0x82, 0xd4, 0x9c, 0x59, 0xd7, 0x0d, 0x33, 0xcc, 0x2d, 0xcd, 0xb8,
-		0x51, 0x99, 0xad, 0x06, 0xcf, 0x37, 0x54, 0x56, 0x40, 0x87, 0xa1, 0x9f, 0xbd, 0x98, 0xe0, 0xd7,
-		0x51, 0x78, 0x49, 0x99, 0x85, 0x01, 0xca, 0x7b, 0xc5, 0x42, 0x88, 0xbf, 0xb5, 0xe3, 0x4f, 0x33,
-		0x68, 0x6a, 0xca, 0xd9, 0xc7, 0x7c, 0x61, 0x11, 0x24, 0x2a, 0x9a, 0xab, 0xf1, 0x7e, 0xd3, 0xdf,
-		0xca, 0x5b, 0x20, 0xc5, 0x99, 0x38, 0xe8, 0x0c, 0xc4, 0x4d, 0xcb, 0xe1, 0x17, 0x4a, 0x72, 0xed,
-		0xba, 0xb2, 0x62, 0x15, 0x12, 0x24, 0x53, 0x51, 0x09, 0x72, 0x41, 0x6d, 0x1b, 0x54, 0x9f, 0x0c,
-		0x04, 0xd5, 0xc0, 0x90, 0x07, 0x7e, 0xb2, 0x21, 0x6d, 0x32, 0x07, 0xcf, 0x58, 0x3e, 0x13, 0x83,
-		0x89, 0x40, 0xed, 0x55, 0x6c, 0x3b, 0xba, 0x69, 0xf0, 0xf9, 0x9c, 0x59, 0x0b, 0x0a, 0x08, 0xc9,
-		0xeb, 0xdb, 0x98, 0xcb, 0x9b, 0x21, 0x3e, 0x63, 0x59, 0x28, 0x07, 0x29, 0x5a, 0x2e, 0x9b, 0xcc,
-		0x5e, 0x12, 0xaa, 0x57, 0x26, 0x75, 0x8e, 0xb9, 0xe5, 0x5e, 0xd3, 0x6c, 0xef, 0x51, 0xa1, 0x28,
-		0x2b, 0x4f, 0x41, 0x7a, 0xd6, 0x34, 0x1c, 0x6c, 0x38, 0x0d, 0xea, 0x83, 0x9b, 0x35, 0xb3, 0xbc,
-		0xc3, 0x39, 0xb0, 0x02, 0x51, 0xb8, 0x66, 0x59, 0x94, 0x32, 0xa1, 0x92, 0x9f, 0x2c, 0x37, 0x2c,
-		0xac, 0xb5, 0x55, 0xd1, 0x53, 0xbd, 0xab, 0x88, 0x77, 0xd2, 0xd3, 0xd1, 0xff, 0x91, 0xe0, 0x78,
-		0xb3, 0x43, 0xed, 0xe0, 0x3d, 0xa7, 0x57, 0x7f, 0x7a, 0x0e, 0xd2, 0xab, 0xf4, 0x65, 0xff, 0x65,
-		0xbc, 0x87, 0x72, 0x30, 0x80, 0x2b, 0x67, 0xce, 0x9e, 0x7d, 0xf4, 0x29, 0x66, 0xed, 0x17, 0xfb,
-		0x54, 0x01, 0x40, 0x13, 0x90, 0x76, 0x70, 0xd9, 0x3a, 0x73, 0xf6, 0xdc, 0xce, 0xa3, 0xcc, 0xbc,
-		0x48, 0x06, 0xe4, 0x81, 0xf2, 0x29, 0xd2, 0xeb, 0xef, 0x7d, 0x66, 0x52, 0x2a, 0x24, 0x21, 0xee,
-		0x34, 0xea, 0x77, 0xd4, 0x46, 0x5e, 0x49, 0xc2, 0x54, 0x90, 0x92, 0x46, 0x2a, 0x2f, 0x2b, 0xe1,
-		0x3a, 0x90, 0x03, 0x3a, 0xa0, 0x18, 0x6d, 0x92, 0xd9, 0x7d, 0x35, 0xa9, 0xfc, 0x86, 0x04, 0x19,
-		0x2f, 0x55, 0x5a, 0xc3, 0x2e, 0x3a, 0x1f, 0xcc, 0x7f, 0xb8, 0xdb, 0x1c, 0x9b, 0x8e, 0xb6, 0xe5,
-		0xa7, 0x74, 0x6a, 0x00, 0x1d, 0x3d, 0x41, 0x0d, 0xd1, 0x32, 0x1d, 0xfe, 0xd0, 0xac, 0x03, 0xa9,
-		0x87, 0x8c, 0x1e, 0x02, 0x44, 0x23, 0x5c, 0xe9, 0xaa, 0xe9, 0xea, 0x46, 0xb5, 0x64, 0x99, 0xd7,
-		0xf8, 0xf3, 0xdd, 0xb8, 0x2a, 0xd3, 0x9a, 0x2b, 0xb4, 0x62, 0x95, 0xc0, 0x89, 0xd0, 0x69, 0x8f,
-		0x4b, 0x38, 0xbd, 0x23, 0x41, 0x40, 0x14, 0xd1, 0x79, 0x18, 0xb0, 0x1a, 0x9b, 0x25, 0x11, 0x31,
-		0x06, 0xcf, 0x1c, 0x6f, 0xe5, 0xff, 0xc2, 0x3e, 0x78, 0x04, 0xe8, 0xb7, 0x1a, 0x9b, 0xc4, 0x5a,
-		0xee, 0x82, 0x4c, 0x0b, 0x61, 0x06, 0xaf, 0xfa, 0x72, 0xd0, 0x0f, 0x4a, 0xf0, 0x1e, 0x94, 0x2c,
-		0x5b, 0x37, 0x6d, 0xdd, 0xdd, 0xa3, 0xf9, 0x6b, 0x5c, 0x95, 0x45, 0xc5, 0x2a, 0x87, 0x2b, 0x3b,
-		0x30, 0xb2, 0x46, 0xd7, 0xb7, 0xbe, 0xe4, 0x67, 0x7d, 0xf9, 0xa4, 0xce, 0xf2, 0xb5, 0x95, 0x2c,
-		0xd6, 0x24, 0x59, 0xe1, 0x99, 0xb6, 0xd6, 0xf9, 0x44, 0xef, 0xd6, 0x19, 0xce, 0x10, 0xff, 0xf0,
-		0x68, 0xc8, 0x39, 0x99, 0x71, 0x06, 0xc3, 0x57, 0xb7, 0x86, 0xd9, 0x29, 0x9b, 0xc8, 0xed, 0x3f,
-		0xa9, 0xe6, 0x3a, 0x84, 0xd1, 0x5c, 0x47, 0x17, 0x52, 0x9e, 0x82, 0xa1, 0x55, 0xcd, 0x76, 0xd7,
-		0xb0, 0x7b, 0x11, 0x6b, 0x15, 0x6c, 0x87, 0x67, 0xdd, 0x21, 0x31, 0xeb, 0x22, 0x48, 0xd0, 0xa9,
-		0x95, 0xcd, 0x3a, 0xf4, 0xb7, 0xb2, 0x0d, 0x09, 0x7a, 0x33, 0xd4, 0x9b, 0x91, 0x39, 0x05, 0x9b,
-		0x91, 0x49, 0x2c, 0xdd, 0x73, 0xb1, 0x23, 0xd2, 0x5b, 0x5a, 0x40, 0x8f, 0x8b, 0x79, 0x35, 0xbe,
-		0xff, 0xbc, 0xca, 0x0d, 0x91, 0xcf, 0xae, 0x35, 0x18, 0x28, 0x90, 0x50, 0xbc, 0x30, 0xe7, 0x09,
-		0x22, 0xf9, 0x82, 0xa0, 0x25, 0x18, 0xb1, 0x34, 0xdb, 0xa5, 0x8f, 0x64, 0xb6, 0x69, 0x2f, 0xb8,
-		0xad, 0x4f, 0x36, 0x7b, 0x5e, 0xa8, 0xb3, 0xbc, 0x95, 0x21, 0x2b, 0x08, 0x54, 0xfe, 0x63, 0x02,
-		0xfa, 0xb9, 0x32, 0xde, 0x0c, 0x03, 0x5c, 0xad, 0xdc, 0x3a, 0x4f, 0x4c, 0x37, 0x4f, 0x4c, 0xd3,
-		0xde, 0x04, 0xc2, 0xf9, 0x09, 0x1a, 0x74, 0x1f, 0xa4, 0xca, 0xdb, 0x9a, 0x6e, 0x94, 0xf4, 0x8a,
-		0xd8, 0x6a, 0x78, 0xed, 0xe6, 0xe4, 0xc0, 0x2c, 0x81, 0x2d, 0xcc, 0xa9, 0x03, 0xb4, 0x72, 0xa1,
-		0x42, 0x32, 0x81, 0x6d, 0xac, 0x57, 0xb7, 0x5d, 0xee, 0x61, 0xbc, 0x84, 0x9e, 0x84, 0x04, 0x31,
-		0x08, 0xfe, 0x84, 0x32, 0xd7, 0xb4, 0xe1, 0xe3, 0x25, 0x7b, 0x85, 0x14, 0x69, 0xf8, 0x23, 0xdf,
-		0x99, 0x94, 0x54, 0x4a, 0x81, 0x66, 0x61, 0xa8, 0xa6, 0x39, 0x6e, 0x89, 0xce, 0x60, 0xa4, 0xf9,
-		0x24, 0x5f, 0x6f, 0x37, 0x29, 0x84, 0x2b, 0x96, 0x8b, 0x3e, 0x48, 0xa8, 0x18, 0xa8, 0x82, 0x4e,
-		0x82, 0x4c, 0x99, 0x94, 0xcd, 0x7a, 0x5d, 0x77, 0x59, 0x6e, 0xd5, 0x4f, 0xf5, 0x3e, 0x4c, 0xe0,
-		0xb3, 0x14, 0x4c, 0x33, 0xac, 0x63, 0x90, 0xa6, 0x8f, 0xb6, 0x28, 0x0a, 0xbb, 0x8e, 0x9c, 0x22,
-		0x00, 0x5a, 0x79, 0x3f, 0x8c, 0xf8, 0xf1, 0x91, 0xa1, 0xa4, 0x18, 0x17, 0x1f, 0x4c, 0x11, 0x1f,
-		0x81, 0x71, 0x03, 0xef, 0xd2, 0x0b, 0xd2, 0x21, 0xec, 0x34, 0xc5, 0x46, 0xa4, 0xee, 0x4a, 0x98,
-		0xe2, 0x5e, 0x18, 0x2e, 0x0b, 0xe5, 0x33, 0x5c, 0xa0, 0xb8, 0x43, 0x1e, 0x94, 0xa2, 0x1d, 0x85,
-		0x94, 0x66, 0x59, 0x0c, 0x61, 0x90, 0xc7, 0x47, 0xcb, 0xa2, 0x55, 0xa7, 0x60, 0x94, 0xf6, 0xd1,
-		0xc6, 0x4e, 0xa3, 0xe6, 0x72, 0x26, 0x19, 0x8a, 0x33, 0x42, 0x2a, 0x54, 0x06, 0xa7, 0xb8, 0x77,
-		0xc3, 0x10, 0xbe, 0xaa, 0x57, 0xb0, 0x51, 0xc6, 0x0c, 0x6f, 0x88, 0xe2, 0x65, 0x04, 0x90, 0x22,
-		0x3d, 0x00, 0x5e, 0xdc, 0x2b, 0x89, 0x98, 0x3c, 0xcc, 0xf8, 0x09, 0xf8, 0x0c, 0x03, 0x2b, 0x59,
-		0x48, 0xcc, 0x69, 0xae, 0x46, 0x12, 0x0c, 0x77, 0x97, 0x4d, 0x34, 0x19, 0x95, 0xfc, 0x54, 0xbe,
-		0x17, 0x83, 0xc4, 0x15, 0xd3, 0xc5, 0xe8, 0xb1, 0x40, 0x02, 0x38, 0xdc, 0xca, 0x9e, 0xd7, 0xf4,
-		0xaa, 0x81, 0x2b, 0x4b, 0x4e, 0x35, 0xf0, 0x85, 0x05, 0xdf, 0x9c, 0x62, 0x21, 0x73, 0x1a, 0x87,
-		0xa4, 0x6d, 0x36, 0x8c, 0x8a, 0xb8, 0xc9, 0x4b, 0x0b, 0xa8, 0x08, 0x29, 0xcf, 0x4a, 0x12, 0x9d,
-		0xac, 0x64, 0x84, 0x58, 0x09, 0xb1, 0x61, 0x0e, 0x50, 0x07, 0x36, 0xb9, 0xb1, 0x14, 0x20, 0xed,
-		0x05, 0x2f, 0x6e, 0x6d, 0xdd, 0x19, 0xac, 0x4f, 0x46, 0x26, 0x13, 0x6f, 0xec, 0x3d, 0xe5, 0x31,
-		0x8b, 0x93, 0xbd, 0x0a, 0xae, 0xbd, 0x90, 0x59, 0xf1, 0xaf, 0x3d, 0x0c, 0xd0, 0x7e, 0xf9, 0x66,
-		0xc5, 0xbe, 0xf8, 0x70, 0x1c, 0xd2, 0x8e, 0x5e, 0x35, 0x34, 0xb7, 0x61, 0x63, 0x6e, 0x79, 0x3e,
-		0x40, 0xf9, 0xaa, 0x04, 0xfd, 0xcc, 0x92, 0x03, 0x7a, 0x93, 0x5a, 0xeb, 0x2d, 0xd6, 0x4e, 0x6f,
-		0xf1, 0x83, 0xeb, 0x6d, 0x06, 0xc0, 0x13, 0xc6, 0xe1, 0x8f, 0xf0, 0x5b, 0x64, 0x0c, 0x4c, 0xc4,
-		0x35, 0xbd, 0xca, 0x1d, 0x35, 0x40, 0xa4, 0xfc, 0x7b, 0x89, 0x24, 0xb1, 0xbc, 0x1e, 0xcd, 0xc0,
-		0x90, 0x90, 0xab, 0xb4, 0x55, 0xd3, 0xaa, 0xdc, 0x76, 0x4e, 0xb4, 0x15, 0xee, 0x42, 0x4d, 0xab,
-		0xaa, 0x83, 0x5c, 0x1e, 0x52, 0x68, 0x3d, 0x0e, 0xb1, 0x36, 0xe3, 0x10, 0x1a, 0xf8, 0xf8, 0xc1,
-		0x06, 0x3e, 0x34, 0x44, 0x89, 0xe8, 0x10, 0x7d, 0x29, 0x46, 0x17, 0x33, 0x96, 0xe9, 0x68, 0xb5,
-		0x9f, 0x84, 0x47, 0x1c, 0x83, 0xb4, 0x65, 0xd6, 0x4a, 0xac, 0x86, 0xdd, 0x70, 0x4f, 0x59, 0x66,
-		0x4d, 0x6d, 0x1a, 0xf6, 0xe4, 0x6d, 0x72, 0x97, 0xfe, 0xdb, 0xa0, 0xb5, 0x81, 0xa8, 0xd6, 0x6c,
-		0xc8, 0x30, 0x55, 0xf0, 0xb9, 0xec, 0x11, 0xa2, 0x03, 0x3a, 0x39, 0x4a, 0xcd, 0x73, 0x2f, 0x13,
-		0x9b, 0x61, 0xaa, 0x1c, 0x8f, 0x50, 0xb0, 0xd0, 0xdf, 0x6a, 0x15, 0x1c, 0x34, 0x4b, 0x95, 0xe3,
-		0x29, 0x7f, 0x55, 0x02, 0x58, 0x24, 0x9a, 0xa5, 0xfd, 0x25, 0xb3, 0x90, 0x43, 0x45, 0x28, 0x85,
-		0x5a, 0x9e, 0x68, 0x37, 0x68, 0xbc, 0xfd, 0x8c, 0x13, 0x94, 0x7b, 0x16, 0x86, 0x7c, 0x63, 0x74,
-		0xb0, 0x10, 0x66, 0x62, 0x9f, 0xac, 0x7a, 0x0d, 0xbb, 0x6a, 0xe6, 0x6a, 0xa0, 0xa4, 0xfc, 0x63,
-		0x09, 0xd2, 0x54, 0xa6, 0x25, 0xec, 0x6a, 0xa1, 0x31, 0x94, 0x0e, 0x3e, 0x86, 0x27, 0x00, 0x18,
-		0x1b, 0x47, 0xbf, 0x8e, 0xb9, 0x65, 0xa5, 0x29, 0x64, 0x4d, 0xbf, 0x8e, 0xd1, 0x39, 0x4f, 0xe1,
-		0xf1, 0xfd, 0x15, 0x2e, 0xb2, 0x6e, 0xae, 0xf6, 0x23, 0x30, 0x40, 0x3f, 0x5a, 0xb5, 0xeb, 0xf0,
-		0x44, 0xba, 0xdf, 0x68, 0xd4, 0xd7, 0x77, 0x1d, 0xe5, 0x05, 0x18, 0x58, 0xdf, 0x65, 0x7b, 0x23,
-		0xc7, 0x20, 0x6d, 0x9b, 0x26, 0x9f, 0x93, 0x59, 0x2e, 0x94, 0x22, 0x00, 0x3a, 0x05, 0x89, 0xfd,
-		0x80, 0x98, 0xbf, 0x1f, 0xe0, 0x6f, 0x68, 0xc4, 0xbb, 0xda, 0xd0, 0x38, 0xf5, 0x6f, 0x25, 0x18,
-		0x0c, 0xc4, 0x07, 0xf4, 0x28, 0x1c, 0x2a, 0x2c, 0xae, 0xcc, 0x5e, 0x2e, 0x2d, 0xcc, 0x95, 0x2e,
-		0x2c, 0xce, 0xcc, 0xfb, 0x6f, 0xb8, 0x72, 0x87, 0x5f, 0xbe, 0x31, 0x85, 0x02, 0xb8, 0x1b, 0x06,
-		0xdd, 0x51, 0x42, 0xa7, 0x61, 0x3c, 0x4c, 0x32, 0x53, 0x58, 0x2b, 0x2e, 0xaf, 0xcb, 0x52, 0xee,
-		0xd0, 0xcb, 0x37, 0xa6, 0x46, 0x03, 0x14, 0x33, 0x9b, 0x0e, 0x36, 0xdc, 0x66, 0x82, 0xd9, 0x95,
-		0xa5, 0xa5, 0x85, 0x75, 0x39, 0xd6, 0x44, 0xc0, 0x03, 0xf6, 0x03, 0x30, 0x1a, 0x26, 0x58, 0x5e,
-		0x58, 0x94, 0xe3, 0x39, 0xf4, 0xf2, 0x8d, 0xa9, 0xe1, 0x00, 0xf6, 0xb2, 0x5e, 0xcb, 0xa5, 0x3e,
-		0xf8, 0xd9, 0x89, 0xbe, 0x5f, 0xf9, 0xe5, 0x09, 0x89, 0xf4, 0x6c, 0x28, 0x14, 0x23, 0xd0, 0x43,
-		0x70, 0x64, 0x6d, 0x61, 0x7e, 0xb9, 0x38, 0x57, 0x5a, 0x5a, 0x9b, 0x17, 0x7b, 0xd0, 0xa2, 0x77,
-		0x23, 0x2f, 0xdf, 0x98, 0x1a, 0xe4, 0x5d, 0x6a, 0x87, 0xbd, 0xaa, 0x16, 0xaf, 0xac, 0xac, 0x17,
-		0x65, 0x89, 0x61, 0xaf, 0xda, 0xf8, 0xaa, 0xe9, 0xb2, 0xaf, 0xda, 0x3d, 0x02, 0x47, 0x5b, 0x60,
-		0x7b, 0x1d, 0x1b, 0x7d, 0xf9, 0xc6, 0xd4, 0xd0, 0xaa, 0x8d, 0x99, 0xff, 0x50, 0x8a, 0x69, 0xc8,
-		0x36, 0x53, 0xac, 0xac, 0xae, 0xac, 0xcd, 0x2c, 0xca, 0x53, 0x39, 0xf9, 0xe5, 0x1b, 0x53, 0x19,
-		0x11, 0x0c, 0xe9, 0x46, 0xbf, 0xd7, 0xb3, 0x3b, 0xb9, 0xe2, 0xf9, 0xc0, 0x69, 0xb8, 0xa7, 0xcd,
-		0x19, 0x93, 0x38, 0x9d, 0x38, 0xd0, 0x29, 0x53, 0xdb, 0x7d, 0xf6, 0x5c, 0x87, 0xed, 0xe7, 0xce,
-		0x4b, 0xa7, 0x83, 0x9f, 0x60, 0xe5, 0xf6, 0x5d, 0xdc, 0x29, 0x1f, 0x92, 0x60, 0xf8, 0xa2, 0xee,
-		0xb8, 0xa6, 0xad, 0x97, 0xb5, 0x1a, 0x7d, 0xb9, 0x75, 0xae, 0xdb, 0xd8, 0x1a, 0x71, 0xf5, 0xa7,
-		0xa1, 0xff, 0xaa, 0x56, 0x63, 0x41, 0x2d, 0x4e, 0x3f, 0x3d, 0xd3, 0xe6, 0xc8, 0xc7, 0x0b, 0x6d,
-		0x82, 0x01, 0x23, 0x53, 0x7e, 0x2d, 0x06, 0x23, 0xd4, 0x19, 0x1c, 0xf6, 0x51, 0x32, 0xb2, 0xc6,
-		0x2a, 0x40, 0xc2, 0xd6, 0x5c, 0xbe, 0x69, 0x58, 0x98, 0xe6, 0xa7, 0x8f, 0xf7, 0x75, 0x71, 0x96,
-		0x36, 0x87, 0xcb, 0x2a, 0xa5, 0x45, 0xef, 0x80, 0x54, 0x5d, 0xdb, 0x2d, 0x51, 0x3e, 0x6c, 0xe5,
-		0x32, 0xd3, 0x1b, 0x9f, 0x5b, 0x37, 0x27, 0x47, 0xf6, 0xb4, 0x7a, 0x2d, 0xaf, 0x08, 0x3e, 0x8a,
-		0x3a, 0x50, 0xd7, 0x76, 0x89, 0x88, 0xc8, 0x82, 0x11, 0x02, 0x2d, 0x6f, 0x6b, 0x46, 0x15, 0xb3,
-		0x46, 0xe8, 0x16, 0x68, 0xe1, 0x62, 0xcf, 0x8d, 0x1c, 0xf6, 0x1b, 0x09, 0xb0, 0x53, 0xd4, 0xa1,
-		0xba, 0xb6, 0x3b, 0x4b, 0x01, 0xa4, 0xc5, 0x7c, 0xea, 0xe3, 0xaf, 0x4e, 0xf6, 0xd1, 0x13, 0xdd,
-		0x6f, 0x4b, 0x00, 0xbe, 0xc6, 0xd0, 0x3b, 0x40, 0x2e, 0x7b, 0x25, 0x4a, 0x2b, 0xce, 0x26, 0xef,
-		0x6f, 0x37, 0x16, 0x11, 0x7d, 0xb3, 0xb9, 0xf9, 0x5b, 0x37, 0x27, 0x25, 0x75, 0xa4, 0x1c, 0x19,
-		0x8a, 0xb7, 0xc3, 0x60, 0xc3, 0xaa, 0x68, 0x2e, 0x2e, 0xd1, 0x75, 0x5c, 0xac, 0xe3, 0x3c, 0x3f,
-		0x41, 0x78, 0xdd, 0xba, 0x39, 0x89, 0x58, 0xb7, 0x02, 0xc4, 0x0a, 0x9d, 0xfd, 0x81, 0x41, 0x08,
-		0x41, 0xa0, 0x4f, 0xdf, 0x90, 0x60, 0x70, 0x2e, 0x70, 0xa7, 0x32, 0x0b, 0x03, 0x75, 0xd3, 0xd0,
-		0x77, 0xb8, 0x3d, 0xa6, 0x55, 0x51, 0x44, 0x39, 0x48, 0xb1, 0xc7, 0xac, 0xee, 0x9e, 0xd8, 0x0a,
-		0x15, 0x65, 0x42, 0x75, 0x0d, 0x6f, 0x3a, 0xba, 0x18, 0x0d, 0x55, 0x14, 0xd1, 0x05, 0x90, 0x1d,
-		0x5c, 0x6e, 0xd8, 0xba, 0xbb, 0x57, 0x2a, 0x9b, 0x86, 0xab, 0x95, 0x5d, 0xf6, 0x2c, 0xb2, 0x70,
-		0xec, 0xd6, 0xcd, 0xc9, 0x23, 0x4c, 0xd6, 0x28, 0x86, 0xa2, 0x8e, 0x08, 0xd0, 0x2c, 0x83, 0x90,
-		0x16, 0x2a, 0xd8, 0xd5, 0xf4, 0x9a, 0x93, 0x65, 0x97, 0x13, 0x44, 0x31, 0xd0, 0x97, 0x2f, 0x0c,
-		0x04, 0x37, 0xb6, 0x2e, 0x80, 0x6c, 0x5a, 0xd8, 0x0e, 0x25, 0xa2, 0x52, 0xb4, 0xe5, 0x28, 0x86,
-		0xa2, 0x8e, 0x08, 0x90, 0x48, 0x52, 0x5d, 0x32, 0xcc, 0x62, 0xa1, 0x68, 0x35, 0x36, 0xfd, 0xfd,
-		0xb0, 0xf1, 0xa6, 0xd1, 0x98, 0x31, 0xf6, 0x0a, 0x8f, 0xf9, 0xdc, 0xa3, 0x74, 0xca, 0x37, 0xbf,
-		0xfc, 0xf0, 0x38, 0x37, 0x0d, 0x7f, 0x7f, 0xea, 0x32, 0xde, 0x23, 0xc3, 0xcf, 0x51, 0x57, 0x29,
-		0x26, 0x49, 0x3b, 0x5f, 0xd0, 0xf4, 0x9a, 0x78, 0xde, 0xaf, 0xf2, 0x12, 0xca, 0x43, 0xbf, 0xe3,
-		0x6a, 0x6e, 0xc3, 0xe1, 0x27, 0xbd, 0x4a, 0x3b, 0x53, 0x2b, 0x98, 0x46, 0x65, 0x8d, 0x62, 0xaa,
-		0x9c, 0x02, 0x5d, 0x80, 0x7e, 0x7e, 0x84, 0x9e, 0xec, 0xd9, 0xbf, 0xe9, 0x5d, 0x09, 0x46, 0x4d,
-		0x34, 0x52, 0xc1, 0x35, 0x5c, 0x65, 0x69, 0xd5, 0xb6, 0x46, 0x56, 0x1f, 0xf4, 0x6b, 0x7c, 0x85,
-		0x85, 0x9e, 0x9d, 0x90, 0x6b, 0x2a, 0xca, 0x4f, 0x51, 0x47, 0x3c, 0xd0, 0x1a, 0x85, 0xa0, 0xcb,
-		0xa1, 0xcb, 0xbf, 0xfc, 0x93, 0x95, 0x77, 0xb7, 0xeb, 0x7e, 0xc0, 0xa6, 0xc5, 0xfe, 0x44, 0xf0,
-		0xea, 0xf0, 0x05, 0x90, 0x1b, 0xc6, 0xa6, 0x69, 0xd0, 0x37, 0xb8, 0x3c, 0xbf, 0x27, 0xeb, 0xbb,
-		0x78, 0xd0, 0x38, 0xa2, 0x18, 0x8a, 0x3a, 0xe2, 0x81, 0x2e, 0xb2, 0x55, 0x40, 0x05, 0x86, 0x7d,
-		0x2c, 0xea, 0xa8, 0xe9, 0x8e, 0x8e, 0x7a, 0x17, 0x77, 0xd4, 0x43, 0xd1, 0x56, 0x7c, 0x5f, 0x1d,
-		0xf2, 0x80, 0x84, 0x0c, 0x5d, 0x04, 0xf0, 0xc3, 0x03, 0xdd, 0xa7, 0x18, 0x6c, 0x3f, 0xf0, 0x7e,
-		0x8c, 0x11, 0xeb, 0x3d, 0x9f, 0x16, 0xbd, 0x0b, 0xc6, 0xea, 0xba, 0x51, 0x72, 0x70, 0x6d, 0xab,
-		0xc4, 0x15, 0x4c, 0x58, 0xd2, 0x8f, 0x2a, 0x15, 0x16, 0x7b, 0xb3, 0x87, 0x5b, 0x37, 0x27, 0x73,
-		0x3c, 0x84, 0x36, 0xb3, 0x54, 0xd4, 0xd1, 0xba, 0x6e, 0xac, 0xe1, 0xda, 0xd6, 0x9c, 0x07, 0xcb,
-		0x67, 0x3e, 0xf8, 0xea, 0x64, 0x1f, 0x77, 0xd7, 0x3e, 0xe5, 0x1c, 0xdd, 0x3b, 0xe7, 0x6e, 0x86,
-		0x1d, 0xb2, 0x26, 0xd1, 0x44, 0x81, 0x5f, 0x35, 0xf0, 0x01, 0xcc, 0xcd, 0x5f, 0xfa, 0xfd, 0x29,
-		0x49, 0xf9, 0x82, 0x04, 0xfd, 0x73, 0x57, 0x56, 0x35, 0xdd, 0x46, 0x0b, 0x30, 0xea, 0x5b, 0x4e,
-		0xd8, 0xc9, 0x8f, 0xdf, 0xba, 0x39, 0x99, 0x8d, 0x1a, 0x97, 0xe7, 0xe5, 0xbe, 0x01, 0x0b, 0x37,
-		0x5f, 0x68, 0xb7, 0x70, 0x0d, 0xb1, 0x6a, 0x42, 0x51, 0x9a, 0x97, 0xb5, 0x91, 0x6e, 0x16, 0x61,
-		0x80, 0x49, 0xeb, 0xa0, 0x3c, 0x24, 0x2d, 0xf2, 0x83, 0x1f, 0x0c, 0x4c, 0xb4, 0x35, 0x5e, 0x8a,
-		0xef, 0x6d, 0x64, 0x12, 0x12, 0xe5, 0xa3, 0x31, 0x80, 0xb9, 0x2b, 0x57, 0xd6, 0x6d, 0xdd, 0xaa,
-		0x61, 0xf7, 0x76, 0xf6, 0x7c, 0x1d, 0x0e, 0x05, 0x56, 0x49, 0x76, 0x39, 0xd2, 0xfb, 0xa9, 0x5b,
-		0x37, 0x27, 0x8f, 0x47, 0x7b, 0x1f, 0x40, 0x53, 0xd4, 0x31, 0x7f, 0xbd, 0x64, 0x97, 0x5b, 0x72,
-		0xad, 0x38, 0xae, 0xc7, 0x35, 0xde, 0x9e, 0x6b, 0x00, 0x2d, 0xc8, 0x75, 0xce, 0x71, 0x5b, 0xab,
-		0x76, 0x0d, 0x06, 0x7d, 0x95, 0x38, 0x68, 0x0e, 0x52, 0x2e, 0xff, 0xcd, 0x35, 0xac, 0xb4, 0xd7,
-		0xb0, 0x20, 0xe3, 0x5a, 0xf6, 0x28, 0x95, 0x3f, 0x96, 0x00, 0x7c, 0x9b, 0xfd, 0xd9, 0x34, 0x31,
-		0x12, 0xca, 0x79, 0xe0, 0x8d, 0x1f, 0x28, 0x55, 0xe3, 0xd4, 0x11, 0x7d, 0xfe, 0x7c, 0x0c, 0xc6,
-		0x36, 0x44, 0xe4, 0xf9, 0x99, 0xd7, 0xc1, 0x2a, 0x0c, 0x60, 0xc3, 0xb5, 0x75, 0xaa, 0x04, 0x32,
-		0xda, 0x8f, 0xb4, 0x1b, 0xed, 0x16, 0x7d, 0xa2, 0x9f, 0x95, 0x12, 0x9b, 0xee, 0x9c, 0x4d, 0x44,
-		0x1b, 0x1f, 0x8e, 0x43, 0xb6, 0x1d, 0x25, 0x9a, 0x85, 0x91, 0xb2, 0x8d, 0xd9, 0xc5, 0xab, 0xe0,
-		0xce, 0x5f, 0x21, 0xe7, 0x67, 0x96, 0x11, 0x04, 0x45, 0x1d, 0x16, 0x10, 0x3e, 0x7b, 0x54, 0x81,
-		0xa4, 0x7d, 0xc4, 0xec, 0xe8, 0xfd, 0xad, 0xee, 0xf2, 0x3c, 0x85, 0x4f, 0x1f, 0xa2, 0x91, 0x30,
-		0x03, 0x36, 0x7f, 0x0c, 0xfb, 0x50, 0x3a, 0x81, 0xbc, 0x08, 0x23, 0xba, 0xa1, 0xbb, 0xba, 0x56,
-		0x2b, 0x6d, 0x6a, 0x35, 0xcd, 0x28, 0x1f, 0x24, 0x6b, 0x66, 0x21, 0x9f, 0x37, 0x1b, 0x61, 0xa7,
-		0xa8, 0xc3, 0x1c, 0x52, 0x60, 0x00, 0x74, 0x11, 0x06, 0x44, 0x53, 0x89, 0x03, 0x65, 0x1b, 0x82,
-		0x3c, 0x90, 0xe0, 0xfd, 0x42, 0x1c, 0x46, 0x55, 0x5c, 0xf9, 0xb3, 0xa1, 0xe8, 0x6d, 0x28, 0x96,
-		0x00, 0x98, 0xbb, 0x93, 0x00, 0x7b, 0x80, 0xd1, 0x20, 0x01, 0x23, 0xcd, 0x38, 0xcc, 0x39, 0x6e,
-		0x60, 0x3c, 0x6e, 0xc6, 0x20, 0x13, 0x1c, 0x8f, 0x3f, 0xa5, 0xb3, 0x12, 0x5a, 0xf0, 0x23, 0x51,
-		0x82, 0x7f, 0x8c, 0xb7, 0x4d, 0x24, 0x6a, 0xb2, 0xde, 0xfd, 0x43, 0xd0, 0x87, 0x12, 0xd0, 0xbf,
-		0xaa, 0xd9, 0x5a, 0xdd, 0x41, 0xe5, 0xa6, 0x4c, 0x53, 0x6c, 0x3f, 0x36, 0x7d, 0x72, 0x9d, 0xef,
-		0x76, 0x74, 0x48, 0x34, 0x3f, 0xde, 0x22, 0xd1, 0x7c, 0x2b, 0x0c, 0x93, 0xe5, 0x70, 0xe0, 0x0a,
-		0x03, 0xd1, 0xf6, 0x50, 0xe1, 0xa8, 0xcf, 0x25, 0x5c, 0xcf, 0x56, 0xcb, 0x57, 0x82, 0x77, 0x18,
-		0x06, 0x09, 0x86, 0x1f, 0x98, 0x09, 0xf9, 0x61, 0x7f, 0x59, 0x1a, 0xa8, 0x54, 0x54, 0xa8, 0x6b,
-		0xbb, 0x45, 0x56, 0x40, 0x8b, 0x80, 0xb6, 0xbd, 0x9d, 0x91, 0x92, 0xaf, 0x4e, 0x42, 0x7f, 0xe2,
-		0xd6, 0xcd, 0xc9, 0xa3, 0x8c, 0xbe, 0x19, 0x47, 0x51, 0x47, 0x7d, 0xa0, 0xe0, 0xf6, 0x38, 0x00,
-		0xe9, 0x57, 0x89, 0x5d, 0xe1, 0x66, 0xcb, 0x9d, 0x43, 0xb7, 0x6e, 0x4e, 0x8e, 0x32, 0x2e, 0x7e,
-		0x9d, 0xa2, 0xa6, 0x49, 0x61, 0x8e, 0xde, 0xee, 0xfe, 0x88, 0x04, 0x39, 0x92, 0xcb, 0x56, 0x6b,
-		0xe6, 0xa6, 0x56, 0x6b, 0xca, 0x92, 0xe9, 0x27, 0xbf, 0x0b, 0x6b, 0x3d, 0xfb, 0xe9, 0x5d, 0x7e,
-		0x96, 0xdc, 0x9a, 0xb3, 0xa2, 0x1e, 0xa9, 0xeb, 0xc6, 0x3c, 0xad, 0x8b, 0xa4, 0xcc, 0xbe, 0xb3,
-		0x7d, 0x56, 0x02, 0xe4, 0x57, 0xa8, 0xd8, 0xb1, 0xc8, 0x92, 0x91, 0xac, 0x0d, 0x02, 0x22, 0x4a,
-		0xfb, 0xaf, 0x0d, 0x7c, 0x7a, 0xb1, 0x36, 0x08, 0x38, 0xef, 0x53, 0x7e, 0xc4, 0x8e, 0x75, 0xba,
-		0x62, 0xcd, 0xad, 0x36, 0x1a, 0xa2, 0xfb, 0x94, 0x7f, 0x26, 0xc1, 0xd1, 0x26, 0x23, 0xf7, 0x84,
-		0xfd, 0x73, 0x80, 0xec, 0x40, 0x25, 0xff, 0xd8, 0x23, 0x13, 0xba, 0x67, 0x9f, 0x19, 0xb5, 0x9b,
-		0xa6, 0x82, 0xdb, 0x37, 0xe9, 0xb0, 0x3b, 0xfc, 0xff, 0x48, 0x82, 0xf1, 0x60, 0xf3, 0x5e, 0x47,
-		0x96, 0x21, 0x13, 0x6c, 0x9d, 0x77, 0xe1, 0x9e, 0x6e, 0xba, 0xc0, 0xa5, 0x0f, 0xd1, 0xa3, 0x67,
-		0xfc, 0x08, 0xc2, 0xb6, 0xf3, 0x1e, 0xed, 0x5a, 0x1b, 0x42, 0xa6, 0x68, 0x24, 0x49, 0xd0, 0xf1,
-		0xf8, 0xbf, 0x12, 0x24, 0x56, 0x4d, 0xb3, 0x86, 0x4c, 0x18, 0x35, 0x4c, 0xb7, 0x44, 0x8c, 0x1d,
-		0x57, 0x82, 0x57, 0xe9, 0xd3, 0x85, 0xd9, 0xde, 0x94, 0xf4, 0xfd, 0x9b, 0x93, 0xcd, 0xac, 0xd4,
-		0x11, 0xc3, 0x74, 0x0b, 0x14, 0xc2, 0x6f, 0xd3, 0xbf, 0x0b, 0x86, 0xc2, 0x8d, 0xb1, 0xc0, 0xfd,
-		0x6c, 0xcf, 0x8d, 0x85, 0xd9, 0xdc, 0xba, 0x39, 0x39, 0xee, 0x3b, 0xb1, 0x07, 0x56, 0xd4, 0xcc,
-		0x66, 0xa0, 0x75, 0x76, 0xe3, 0xec, 0x87, 0xaf, 0x4e, 0x4a, 0xa7, 0xbe, 0x22, 0x01, 0xf8, 0x9b,
-		0x21, 0xe8, 0x21, 0x38, 0x52, 0x58, 0x59, 0x9e, 0x2b, 0xad, 0xad, 0xcf, 0xac, 0x6f, 0xac, 0x85,
-		0xaf, 0x9d, 0x8b, 0x1d, 0x7b, 0xc7, 0xc2, 0x65, 0x7d, 0x4b, 0xc7, 0x15, 0x74, 0x1f, 0x8c, 0x87,
-		0xb1, 0x49, 0xa9, 0x38, 0x27, 0x4b, 0xb9, 0xcc, 0xcb, 0x37, 0xa6, 0x52, 0x2c, 0x3d, 0xc4, 0x15,
-		0x74, 0x12, 0x0e, 0x35, 0xe3, 0x2d, 0x2c, 0xcf, 0xcb, 0xb1, 0xdc, 0xd0, 0xcb, 0x37, 0xa6, 0xd2,
-		0x5e, 0x1e, 0x89, 0x14, 0x40, 0x41, 0x4c, 0xce, 0x2f, 0x9e, 0x83, 0x97, 0x6f, 0x4c, 0xf5, 0x33,
-		0x05, 0xe6, 0x12, 0x1f, 0xfc, 0xec, 0x44, 0xdf, 0x6d, 0xbf, 0x9c, 0xfe, 0x47, 0x03, 0x6d, 0x37,
-		0xe2, 0xab, 0xd8, 0xc0, 0x8e, 0xee, 0x1c, 0x68, 0x23, 0xbe, 0xab, 0xcd, 0x7d, 0xe5, 0x77, 0x92,
-		0x90, 0x99, 0x67, 0xad, 0x90, 0x81, 0xc0, 0xe8, 0x4d, 0xd0, 0x6f, 0xd1, 0x99, 0xcd, 0x3b, 0xd9,
-		0x6b, 0x63, 0xf0, 0x6c, 0xfe, 0xf3, 0xae, 0x97, 0xb1, 0xd9, 0xd0, 0xe1, 0xf7, 0x4b, 0xd8, 0xb5,
-		0x37, 0xff, 0x22, 0x57, 0xa6, 0xa7, 0x2d, 0x28, 0x16, 0x9e, 0xf9, 0x6e, 0x4f, 0x94, 0x9f, 0xc2,
-		0xae, 0xaa, 0xac, 0x13, 0x08, 0xbb, 0xb0, 0xf6, 0x7e, 0x09, 0x0e, 0x51, 0x2c, 0x3f, 0x37, 0xa0,
-		0x98, 0x62, 0xfd, 0x71, 0xaa, 0x5d, 0x17, 0x16, 0x35, 0xc7, 0xbf, 0x7e, 0xc2, 0xae, 0x98, 0xdd,
-		0xc3, 0xe7, 0xe6, 0xe3, 0x81, 0xc6, 0xa3, 0x6c, 0x15, 0x75, 0xac, 0xd6, 0x44, 0xe9, 0xa0, 0xf9,
-		0xd0, 0x1d, 0xc3, 0x44, 0x6f, 0xbb, 0xff, 0xc1, 0xfb, 0x86, 0x97, 0x60, 0xd0, 0x8f, 0x25, 0x0e,
-		0xff, 0xe7, 0x34, 0xdd, 0xcf, 0x1d, 0x41, 0x62, 0xf4, 0x01, 0x09, 0x0e, 0xf9, 0x09, 0x46, 0x90,
-		0x2d, 0xfb, 0x27, 0x3e, 0x0f, 0xf6, 0xb0, 0x36, 0x8b, 0x2a, 0xa7, 0x25, 0x5f, 0x45, 0x1d, 0x6f,
-		0x34, 0x93, 0x92, 0x55, 0xe1, 0x50, 0x30, 0xb2, 0x3a, 0x59, 0xf1, 0x55, 0xca, 0xee, 0x43, 0x73,
-		0x98, 0x01, 0xfb, 0xc7, 0x22, 0x96, 0x69, 0xbb, 0xb8, 0x42, 0xf7, 0x08, 0x53, 0xaa, 0x57, 0x56,
-		0x96, 0x01, 0x35, 0x0f, 0x6e, 0xf4, 0x4e, 0xa5, 0xff, 0x64, 0x06, 0x8d, 0x43, 0x32, 0x78, 0xeb,
-		0x90, 0x15, 0xf2, 0xa9, 0x0f, 0xf2, 0xe9, 0xf3, 0xb6, 0xfb, 0xfc, 0x77, 0x62, 0x70, 0x2a, 0x78,
-		0x62, 0xf5, 0x62, 0x03, 0xdb, 0x7b, 0x9e, 0x8b, 0x5a, 0x5a, 0x55, 0x37, 0x82, 0x0f, 0x33, 0x8e,
-		0x06, 0x27, 0x7c, 0x8a, 0x2b, 0xf4, 0xa4, 0x7c, 0x50, 0x82, 0xc1, 0x55, 0xad, 0x8a, 0x55, 0xfc,
-		0x62, 0x03, 0x3b, 0x6e, 0x8b, 0x8b, 0xef, 0x87, 0xa1, 0xdf, 0xdc, 0xda, 0x12, 0xc7, 0xec, 0x09,
-		0x95, 0x97, 0x48, 0x9f, 0x6b, 0x7a, 0x5d, 0x67, 0x37, 0xd4, 0x12, 0x2a, 0x2b, 0xa0, 0x49, 0x18,
-		0x2c, 0x9b, 0x0d, 0x83, 0xbb, 0x5c, 0x36, 0x21, 0x3e, 0xff, 0xd2, 0x30, 0x98, 0xcb, 0x11, 0x25,
-		0xda, 0xf8, 0x2a, 0xb6, 0x1d, 0xf6, 0xc1, 0xcb, 0x94, 0x2a, 0x8a, 0xca, 0xd3, 0x90, 0x61, 0x92,
-		0xf0, 0xc9, 0xf8, 0x28, 0xa4, 0xe8, 0xe5, 0x2f, 0x5f, 0x9e, 0x01, 0x52, 0xbe, 0xcc, 0xae, 0xcf,
-		0x33, 0xfe, 0x4c, 0x24, 0x56, 0x28, 0x14, 0xda, 0x6a, 0xf9, 0x64, 0xe7, 0xa8, 0xc1, 0x74, 0xe8,
-		0x69, 0xf8, 0xb7, 0x92, 0x70, 0x88, 0x9f, 0x27, 0x6a, 0x96, 0x7e, 0x7a, 0xdb, 0x75, 0xc5, 0x73,
-		0x0e, 0xe0, 0x89, 0xb9, 0x66, 0xe9, 0xca, 0x1e, 0x24, 0x2e, 0xba, 0xae, 0x85, 0x4e, 0x41, 0xd2,
-		0x6e, 0xd4, 0xb0, 0xd8, 0x9f, 0xf2, 0x4e, 0x10, 0x34, 0x4b, 0x9f, 0x26, 0x08, 0x6a, 0xa3, 0x86,
-		0x55, 0x86, 0x82, 0x8a, 0x30, 0xb9, 0xd5, 0xa8, 0xd5, 0xf6, 0x4a, 0x15, 0x4c, 0xff, 0xa7, 0x97,
-		0xf7, 0x5f, 0x31, 0xf0, 0xae, 0xa5, 0x89, 0x2f, 0x69, 0x12, 0xc5, 0x1c, 0xa7, 0x68, 0x73, 0x14,
-		0x4b, 0xfc, 0x47, 0x8c, 0xa2, 0xc0, 0x51, 0x7e, 0x2f, 0x06, 0x29, 0xc1, 0x9a, 0xde, 0x67, 0xc7,
-		0x35, 0x5c, 0x76, 0x4d, 0x71, 0xbe, 0xe3, 0x95, 0x11, 0x82, 0x78, 0x95, 0x0f, 0x5e, 0xfa, 0x62,
-		0x9f, 0x4a, 0x0a, 0x04, 0xe6, 0xbd, 0x32, 0x20, 0x30, 0xab, 0x41, 0xc6, 0x33, 0x61, 0x99, 0x62,
-		0x21, 0x79, 0xb1, 0x4f, 0xa5, 0x25, 0x94, 0x85, 0x7e, 0xe2, 0x34, 0x2e, 0x1b, 0x2d, 0x02, 0xe7,
-		0x65, 0x74, 0x18, 0x92, 0x96, 0xe6, 0x96, 0xd9, 0x05, 0x40, 0x52, 0xc1, 0x8a, 0xe8, 0x09, 0xe8,
-		0x67, 0x0f, 0xc5, 0xa3, 0xff, 0x30, 0x87, 0x28, 0x83, 0x7d, 0x91, 0x8f, 0xc8, 0xbd, 0xaa, 0xb9,
-		0x2e, 0xb6, 0x0d, 0xc2, 0x90, 0xa1, 0x23, 0x04, 0x89, 0x4d, 0xb3, 0xb2, 0xc7, 0xff, 0x89, 0x0f,
-		0xfd, 0xcd, 0xff, 0x6b, 0x08, 0xb5, 0x87, 0x12, 0xad, 0x64, 0xff, 0xbb, 0x2c, 0x23, 0x80, 0x05,
-		0x82, 0x54, 0x84, 0x31, 0xad, 0x52, 0xd1, 0xd9, 0xff, 0xd3, 0x29, 0x6d, 0xea, 0x34, 0x78, 0x38,
-		0xf4, 0x3f, 0xd3, 0xb5, 0x1b, 0x0b, 0xe4, 0x13, 0x14, 0x38, 0x7e, 0x21, 0x0d, 0x03, 0x16, 0x13,
-		0x4a, 0x39, 0x0f, 0xa3, 0x4d, 0x92, 0x12, 0xf9, 0x76, 0x74, 0xa3, 0x22, 0x9e, 0x5e, 0x90, 0xdf,
-		0x04, 0x46, 0xbf, 0xa1, 0xc9, 0x4e, 0xce, 0xe8, 0xef, 0xc2, 0x7b, 0xdb, 0xbf, 0xd0, 0x19, 0x0e,
-		0xbc, 0xd0, 0xd1, 0x2c, 0xbd, 0x90, 0xa6, 0xfc, 0xf9, 0xbb, 0x9c, 0x99, 0xe6, 0x77, 0x39, 0x55,
-		0x6c, 0x88, 0x89, 0x99, 0x54, 0x69, 0x96, 0xee, 0x50, 0x73, 0xf4, 0xbf, 0xe9, 0xe9, 0x9c, 0x0f,
-		0xfc, 0xa6, 0xcf, 0x74, 0x12, 0xf3, 0x33, 0xab, 0x0b, 0x9e, 0x1d, 0x7f, 0x2d, 0x06, 0xc7, 0x03,
-		0x76, 0x1c, 0x40, 0x6e, 0x36, 0xe7, 0x5c, 0x6b, 0x8b, 0xef, 0xe2, 0xb9, 0xf4, 0x65, 0x48, 0x10,
-		0x7c, 0xd4, 0xe1, 0x7f, 0x7a, 0x64, 0xbf, 0xf8, 0xcd, 0x7f, 0xa8, 0x84, 0xcf, 0xd8, 0x42, 0xa3,
-		0x42, 0x99, 0x14, 0x3e, 0xd0, 0xbd, 0xfe, 0x64, 0xff, 0x73, 0xa6, 0xce, 0xed, 0x53, 0x63, 0x54,
-		0x87, 0xaf, 0x9f, 0x6d, 0xfb, 0x9c, 0x96, 0x05, 0xd3, 0xfd, 0xf3, 0xab, 0x1e, 0x22, 0x75, 0xbb,
-		0xd7, 0x0a, 0xfb, 0x8d, 0x60, 0x97, 0x99, 0xda, 0x2e, 0x1c, 0x7e, 0x86, 0xb4, 0xed, 0x2f, 0xea,
-		0x45, 0xc8, 0x3f, 0xec, 0x9d, 0x3d, 0x4a, 0xfc, 0x1f, 0x03, 0x8a, 0x73, 0x45, 0xf0, 0xe5, 0xe3,
-		0x6b, 0xc7, 0xfb, 0xa6, 0xdb, 0x4e, 0x25, 0xd3, 0x81, 0x69, 0x44, 0x0d, 0x50, 0x2a, 0xbf, 0x2a,
-		0xc1, 0x91, 0xa6, 0xa6, 0x79, 0x8c, 0x9f, 0x6f, 0xf1, 0xb0, 0xe2, 0x40, 0x49, 0xcf, 0x7c, 0x0b,
-		0x61, 0xef, 0xef, 0x28, 0x2c, 0x93, 0x22, 0x24, 0xed, 0x5b, 0xe0, 0x50, 0x58, 0x58, 0xa1, 0xa6,
-		0x7b, 0x61, 0x38, 0xbc, 0x7f, 0xcd, 0xd5, 0x35, 0x14, 0xda, 0xc1, 0x56, 0x4a, 0x51, 0x3d, 0x7b,
-		0x7d, 0x2d, 0x42, 0xda, 0x43, 0xe5, 0xd9, 0x71, 0xd7, 0x5d, 0xf5, 0x29, 0x95, 0x8f, 0x4a, 0x30,
-		0x15, 0x6e, 0x21, 0x90, 0x27, 0xf5, 0x26, 0xec, 0x6d, 0x1b, 0xe2, 0xef, 0x49, 0x70, 0xd7, 0x3e,
-		0x32, 0x71, 0x05, 0x5c, 0x87, 0xf1, 0xc0, 0x26, 0x81, 0x08, 0xe1, 0x62, 0xd8, 0x4f, 0x75, 0xce,
-		0x50, 0xbd, 0x35, 0xf1, 0x31, 0xa2, 0x94, 0xcf, 0x7f, 0x67, 0x72, 0xac, 0xb9, 0xce, 0x51, 0xc7,
-		0x9a, 0x17, 0xf6, 0xb7, 0xd1, 0x3e, 0x5e, 0x91, 0xe0, 0x81, 0x70, 0x57, 0x5b, 0xa4, 0xba, 0x3f,
-		0xad, 0x71, 0xf8, 0x77, 0x12, 0x9c, 0xea, 0x46, 0x38, 0x3e, 0x20, 0x9b, 0x30, 0xe6, 0x27, 0xe1,
-		0xd1, 0xf1, 0xe8, 0x29, 0xb5, 0x67, 0x56, 0x8a, 0x3c, 0x6e, 0x77, 0x40, 0xf1, 0x16, 0x77, 0xac,
-		0xe0, 0x90, 0x7b, 0x4a, 0x0e, 0xef, 0x3d, 0x0b, 0x25, 0x87, 0x76, 0x9f, 0x5b, 0x8c, 0x45, 0xac,
-		0xc5, 0x58, 0xf8, 0x59, 0xbb, 0x72, 0x95, 0xc7, 0xad, 0x16, 0xdb, 0x73, 0x6f, 0x87, 0xb1, 0x16,
-		0xa6, 0xcc, 0xbd, 0xba, 0x07, 0x4b, 0x56, 0x51, 0xb3, 0xb1, 0x2a, 0x7b, 0x30, 0x49, 0xdb, 0x6d,
-		0xa1, 0xe8, 0x3b, 0xdd, 0xe5, 0x3a, 0x8f, 0x2d, 0x2d, 0x9b, 0xe6, 0x7d, 0x5f, 0x80, 0x7e, 0x36,
-		0xce, 0xbc, 0xbb, 0x07, 0x30, 0x14, 0xce, 0x40, 0xf9, 0xa4, 0x88, 0x65, 0x73, 0x42, 0xec, 0xd6,
-		0x3e, 0xd4, 0x4d, 0x5f, 0x6f, 0x93, 0x0f, 0x05, 0x94, 0xf1, 0x6d, 0x11, 0xd5, 0x5a, 0x4b, 0xc7,
-		0xd5, 0x51, 0xbe, 0x6d, 0x51, 0x8d, 0xe9, 0xe6, 0xce, 0x86, 0xaf, 0x5f, 0x16, 0xe1, 0xcb, 0xeb,
-		0x53, 0x87, 0xf0, 0xf5, 0xd3, 0x51, 0xbd, 0x17, 0xc8, 0x3a, 0x88, 0xf9, 0x27, 0x31, 0x90, 0xfd,
-		0x50, 0x82, 0xa3, 0xb4, 0x6f, 0xc1, 0x3d, 0x8a, 0x5e, 0x55, 0xfe, 0x10, 0x20, 0xc7, 0x2e, 0x97,
-		0x5a, 0x7a, 0xb7, 0xec, 0xd8, 0xe5, 0x2b, 0xa1, 0xf9, 0xe5, 0x21, 0x40, 0x95, 0xd0, 0x4e, 0x14,
-		0xc5, 0x66, 0x77, 0xfa, 0xe4, 0x4a, 0x60, 0xa3, 0xa3, 0xc5, 0x70, 0x26, 0x6e, 0xc3, 0x70, 0x7e,
-		0x4b, 0x82, 0x5c, 0xab, 0x2e, 0xf3, 0xe1, 0xd3, 0xe1, 0x70, 0xe8, 0xfc, 0x20, 0x3a, 0x82, 0x0f,
-		0x75, 0xb3, 0xcb, 0x13, 0x71, 0xa3, 0x43, 0x36, 0xbe, 0xd3, 0x79, 0xc0, 0x64, 0xd8, 0x42, 0x9b,
-		0x33, 0xeb, 0x9f, 0x9a, 0xfb, 0x7c, 0xb9, 0x29, 0xae, 0xfe, 0x89, 0xc8, 0xbd, 0x77, 0x61, 0xa2,
-		0x8d, 0xd4, 0x77, 0x7a, 0xde, 0xdb, 0x6e, 0x3b, 0x98, 0xb7, 0x3b, 0x7d, 0x7f, 0x9c, 0x7b, 0x42,
-		0xf8, 0xbe, 0x78, 0x60, 0x2d, 0xd6, 0xea, 0xc1, 0x99, 0xf2, 0x36, 0x38, 0xd6, 0x92, 0x8a, 0xcb,
-		0x96, 0x87, 0xc4, 0xb6, 0xee, 0xb8, 0x5c, 0xac, 0xfb, 0xda, 0x89, 0x15, 0xa1, 0xa6, 0x34, 0x0a,
-		0x02, 0x99, 0xb2, 0x5e, 0x35, 0xcd, 0x1a, 0x17, 0x43, 0xb9, 0x0c, 0xa3, 0x01, 0x18, 0x6f, 0xe4,
-		0x1c, 0x24, 0x2c, 0x93, 0x7f, 0x4c, 0x61, 0xf0, 0xcc, 0xf1, 0xb6, 0x1b, 0xfb, 0xa6, 0x59, 0xe3,
-		0xdd, 0xa6, 0xf8, 0xca, 0x38, 0x20, 0xc6, 0x8c, 0xee, 0xf1, 0x8b, 0x26, 0xd6, 0x60, 0x2c, 0x04,
-		0xe5, 0x8d, 0xbc, 0xa1, 0xf3, 0x83, 0x33, 0xdf, 0x3f, 0x04, 0x49, 0xca, 0x15, 0x7d, 0x42, 0x0a,
-		0x7d, 0xed, 0x68, 0xba, 0x1d, 0x9b, 0xd6, 0x6b, 0xe2, 0xdc, 0xe9, 0xae, 0xf1, 0x79, 0xce, 0x76,
-		0xea, 0xbd, 0xff, 0xfa, 0xf5, 0x8f, 0xc5, 0xee, 0x41, 0xca, 0xe9, 0x36, 0xab, 0xf1, 0x80, 0xbf,
-		0x7c, 0x2e, 0xf4, 0x52, 0xff, 0xe1, 0xee, 0x9a, 0x12, 0x92, 0x4d, 0x77, 0x8b, 0xce, 0x05, 0x3b,
-		0x4f, 0x05, 0x3b, 0x8b, 0x1e, 0xeb, 0x2c, 0xd8, 0xe9, 0x77, 0x86, 0x9d, 0xe6, 0xdd, 0xe8, 0x77,
-		0x24, 0x18, 0x6f, 0xb5, 0xa4, 0x43, 0x4f, 0x76, 0x27, 0x45, 0x73, 0x4a, 0x91, 0x7b, 0xea, 0x00,
-		0x94, 0xbc, 0x2b, 0xf3, 0xb4, 0x2b, 0x33, 0xe8, 0xe9, 0x03, 0x74, 0xe5, 0x74, 0x70, 0xeb, 0xff,
-		0x7f, 0x4b, 0x70, 0x62, 0xdf, 0x15, 0x12, 0x9a, 0xe9, 0x4e, 0xca, 0x7d, 0x72, 0xa7, 0x5c, 0xe1,
-		0x8d, 0xb0, 0xe0, 0x3d, 0x7e, 0x86, 0xf6, 0xf8, 0x32, 0x5a, 0x38, 0x48, 0x8f, 0x5b, 0x9e, 0xaf,
-		0xa0, 0xdf, 0x0e, 0xdf, 0x83, 0xdc, 0xdf, 0x9c, 0x9a, 0x16, 0x1e, 0x1d, 0x1c, 0xa3, 0x39, 0xa9,
-		0x55, 0x9e, 0xa3, 0x5d, 0x50, 0xd1, 0xea, 0x1b, 0x1c, 0xb4, 0xd3, 0xef, 0x0c, 0x07, 0xfe, 0x77,
-		0xa3, 0xff, 0x25, 0xb5, 0xbe, 0xd6, 0xf8, 0xc4, 0xbe, 0x22, 0xb6, 0x5f, 0x54, 0xe5, 0x9e, 0xec,
-		0x9d, 0x90, 0x77, 0xb2, 0x4e, 0x3b, 0x59, 0x45, 0xf8, 0x76, 0x77, 0xb2, 0xe5, 0x20, 0xa2, 0x6f,
-		0x48, 0x30, 0xde, 0x6a, 0x4d, 0xd2, 0xc1, 0x2d, 0xf7, 0x59, 0x64, 0x75, 0x70, 0xcb, 0xfd, 0x16,
-		0x40, 0xca, 0x9b, 0x68, 0xe7, 0xcf, 0xa1, 0xc7, 0xdb, 0x75, 0x7e, 0xdf, 0x51, 0x24, 0xbe, 0xb8,
-		0x6f, 0x92, 0xdf, 0xc1, 0x17, 0xbb, 0x59, 0xc7, 0x74, 0xf0, 0xc5, 0xae, 0xd6, 0x18, 0x9d, 0x7d,
-		0xd1, 0xeb, 0x59, 0x97, 0xc3, 0xe8, 0xa0, 0xaf, 0x49, 0x30, 0x14, 0xca, 0x88, 0xd1, 0xa3, 0xfb,
-		0x0a, 0xda, 0x6a, 0xc1, 0x90, 0x3b, 0xd3, 0x0b, 0x09, 0xef, 0xcb, 0x02, 0xed, 0xcb, 0x2c, 0x9a,
-		0x39, 0x48, 0x5f, 0xc2, 0xc7, 0xa8, 0xdf, 0x92, 0x60, 0xac, 0x45, 0x96, 0xd9, 0xc1, 0x0b, 0xdb,
-		0x27, 0xcd, 0xb9, 0x27, 0x7b, 0x27, 0xe4, 0xbd, 0xba, 0x40, 0x7b, 0xf5, 0x56, 0xf4, 0x96, 0x83,
-		0xf4, 0x2a, 0x30, 0x3f, 0xdf, 0xf4, 0xaf, 0x64, 0x05, 0xda, 0x41, 0xe7, 0x7a, 0x14, 0x4c, 0x74,
-		0xe8, 0x89, 0x9e, 0xe9, 0x78, 0x7f, 0x9e, 0xa5, 0xfd, 0x79, 0x06, 0xad, 0xbc, 0xb1, 0xfe, 0x34,
-		0x4f, 0xeb, 0x5f, 0x6a, 0x7e, 0xaf, 0xb8, 0xbf, 0x15, 0xb5, 0x4c, 0x56, 0x73, 0x8f, 0xf5, 0x44,
-		0xc3, 0x3b, 0xf5, 0x24, 0xed, 0xd4, 0x19, 0xf4, 0x48, 0xbb, 0x4e, 0x05, 0xae, 0x02, 0xea, 0xc6,
-		0x96, 0x79, 0xfa, 0x9d, 0x2c, 0x05, 0x7e, 0x37, 0x7a, 0x8f, 0xb8, 0xf3, 0x74, 0x72, 0xdf, 0x76,
-		0x03, 0x79, 0x6c, 0xee, 0x81, 0x2e, 0x30, 0xb9, 0x5c, 0xf7, 0x50, 0xb9, 0x26, 0xd0, 0xf1, 0x76,
-		0x72, 0x91, 0x5c, 0x16, 0x7d, 0x48, 0xf2, 0x6e, 0x6e, 0x9e, 0xda, 0x9f, 0x77, 0x30, 0xd9, 0xcd,
-		0x3d, 0xd8, 0x15, 0x2e, 0x97, 0xe4, 0x3e, 0x2a, 0xc9, 0x14, 0x9a, 0x68, 0x2b, 0x09, 0x4b, 0x7d,
-		0x6f, 0xf7, 0xa5, 0x82, 0xff, 0x79, 0x18, 0x26, 0xdb, 0xb4, 0xe8, 0xee, 0x76, 0x38, 0xe3, 0xda,
-		0xe7, 0xd9, 0x6e, 0xc7, 0x67, 0xb9, 0xb7, 0xfb, 0x73, 0xb3, 0x5d, 0x1e, 0x88, 0x7d, 0x23, 0x01,
-		0x68, 0xc9, 0xa9, 0xce, 0xda, 0x98, 0xfd, 0xeb, 0x4b, 0xee, 0xe5, 0x91, 0xf7, 0x68, 0xd2, 0x1b,
-		0x7a, 0x8f, 0xb6, 0x14, 0x7a, 0xe1, 0x15, 0xeb, 0xed, 0x15, 0x69, 0xd7, 0xcf, 0xbc, 0xe2, 0x3f,
-		0x91, 0x67, 0x5e, 0xad, 0x6f, 0x81, 0x27, 0x6e, 0xdf, 0x73, 0x91, 0xe4, 0x41, 0x9f, 0xcc, 0xf0,
-		0xd7, 0x9b, 0xfd, 0xfb, 0xbc, 0xde, 0xcc, 0xb6, 0x7d, 0xa2, 0xc9, 0xa9, 0xd1, 0x59, 0xf1, 0xf1,
-		0xd5, 0x81, 0xee, 0x2e, 0xc9, 0xf2, 0xaf, 0xb3, 0xb2, 0xeb, 0x98, 0xc7, 0x21, 0xd7, 0x6c, 0x4a,
-		0x9e, 0x43, 0x7f, 0x38, 0x0e, 0xf2, 0x92, 0x53, 0x2d, 0x56, 0x74, 0xf7, 0x0e, 0xd9, 0xd9, 0xd3,
-		0xed, 0x9f, 0xdf, 0xa0, 0x5b, 0x37, 0x27, 0x87, 0x99, 0x3e, 0xf7, 0xd1, 0x62, 0x1d, 0x46, 0x22,
-		0x8f, 0x9e, 0xb9, 0x55, 0xcd, 0x1d, 0xe4, 0xed, 0x75, 0x84, 0x95, 0x42, 0x5f, 0x4b, 0x04, 0x6c,
-		0x1b, 0xed, 0xb6, 0x36, 0x64, 0x66, 0x4c, 0x17, 0xef, 0xe4, 0x5b, 0x45, 0x36, 0x5e, 0x39, 0xc8,
-		0x46, 0x07, 0xc4, 0x1b, 0xad, 0xd7, 0x24, 0x18, 0x5c, 0x72, 0x44, 0x0a, 0x88, 0x7f, 0x46, 0x5f,
-		0x49, 0x3d, 0xe1, 0x7d, 0xb1, 0x3c, 0xde, 0x9d, 0xbd, 0x06, 0xbf, 0x62, 0xde, 0xa7, 0x1c, 0x82,
-		0xb1, 0x40, 0x1f, 0xbd, 0xbe, 0x7f, 0x33, 0x46, 0x63, 0x62, 0x01, 0x57, 0x75, 0xc3, 0xcb, 0x1c,
-		0xf1, 0x9f, 0xd6, 0xf7, 0x1f, 0xbe, 0x8e, 0x13, 0x07, 0xd1, 0xf1, 0x0e, 0x0d, 0x0a, 0x11, 0x5d,
-		0x7a, 0x1b, 0x5d, 0x4b, 0xcd, 0x2f, 0x93, 0xa4, 0x1e, 0x3e, 0xfa, 0x13, 0x79, 0x7f, 0xa4, 0xbc,
-		0x2e, 0xc1, 0xd0, 0x92, 0x53, 0xdd, 0x30, 0x2a, 0xff, 0x5f, 0xdb, 0xed, 0x16, 0x1c, 0x0a, 0xf5,
-		0xf2, 0x0e, 0xa9, 0xf3, 0xcc, 0x2b, 0x09, 0x88, 0x2f, 0x39, 0x55, 0xf4, 0x22, 0x8c, 0x44, 0x13,
-		0x84, 0xb6, 0x79, 0x5f, 0xf3, 0x0c, 0xd0, 0x7e, 0x6d, 0xd6, 0x7e, 0xb6, 0x40, 0x3b, 0x30, 0x14,
-		0x9e, 0x29, 0x4e, 0xee, 0xc3, 0x24, 0x84, 0x99, 0x7b, 0xa4, 0x5b, 0x4c, 0xaf, 0xb1, 0x77, 0x40,
-		0xca, 0x0b, 0x74, 0x77, 0xef, 0x43, 0x2d, 0x90, 0xda, 0x67, 0xb2, 0x2d, 0xc2, 0x09, 0xd1, 0x5e,
-		0x34, 0x94, 0xec, 0xa7, 0xbd, 0x08, 0xee, 0xbe, 0xda, 0x6b, 0xe7, 0x56, 0x9b, 0x00, 0x01, 0x1f,
-		0xb8, 0x77, 0x1f, 0x0e, 0x3e, 0x5a, 0xee, 0xe1, 0xae, 0xd0, 0xbc, 0x03, 0xa6, 0xdb, 0x9c, 0x78,
-		0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0xcf, 0x45, 0x09, 0x6e, 0x98, 0x00, 0x00,
+		// 11411 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x90, 0x5b, 0xd7,
+		0x75, 0x18, 0xbe, 0x0f, 0x1f, 0x0b, 0xe0, 0x2c, 0x16, 0xfb, 0xf6, 0xee, 0x92, 0x04, 0x41, 0x6a,
+		0x77, 0xf5, 0x24, 0x51, 0x14, 0x25, 0x2d, 0xa5, 0x95, 0x48, 0x89, 0x2b, 0xdb, 0x0a, 0xb0, 0x00,
+		0x97, 0x20, 0xf7, 0x4b, 0x0f, 0xbb, 0xd4, 0x47, 0x9c, 0x1f, 0xe6, 0x2d, 0x70, 0x17, 0x0b, 0x11,
+		0x78, 0x0f, 0x7e, 0xef, 0x81, 0xe2, 0x2a, 0x1f, 0xa3, 0x38, 0xfe, 0x25, 0xb6, 0x5c, 0xd7, 0x76,
+		0x93, 0x49, 0x64, 0x27, 0x74, 0xec, 0x24, 0xad, 0x53, 0xc7, 0x69, 0xbe, 0xdc, 0x34, 0x69, 0x3b,
+		0xd3, 0xa4, 0x33, 0x69, 0x62, 0x37, 0xed, 0xd8, 0x6d, 0xda, 0xa6, 0x99, 0x94, 0x6e, 0x6d, 0x8f,
+		0xad, 0x38, 0x6e, 0xe3, 0xa8, 0xee, 0x34, 0x1d, 0x4f, 0xc7, 0x9d, 0xfb, 0xf5, 0xbe, 0xf0, 0xbd,
+		0x22, 0x13, 0xa5, 0xe9, 0x5f, 0xc0, 0x3d, 0xf7, 0x9c, 0x73, 0xcf, 0x3d, 0xf7, 0xdc, 0x73, 0xcf,
+		0xfd, 0x7c, 0xf0, 0x8d, 0x32, 0x2c, 0xd4, 0x0c, 0xa3, 0xd6, 0xc0, 0x67, 0x5b, 0xa6, 0x61, 0x1b,
+		0xbb, 0xed, 0xbd, 0xb3, 0x55, 0x6c, 0x55, 0xcc, 0x7a, 0xcb, 0x36, 0xcc, 0x45, 0x0a, 0x43, 0x53,
+		0x0c, 0x63, 0x51, 0x60, 0x28, 0xeb, 0x30, 0x7d, 0xb1, 0xde, 0xc0, 0x79, 0x07, 0xb1, 0x84, 0x6d,
+		0xf4, 0x24, 0x44, 0xf6, 0xea, 0x0d, 0x9c, 0x96, 0x16, 0xc2, 0xa7, 0x27, 0x96, 0xee, 0x5d, 0x0c,
+		0x10, 0x2d, 0xfa, 0x29, 0xb6, 0x08, 0x58, 0xa5, 0x14, 0xca, 0x6b, 0x51, 0x98, 0xe9, 0x92, 0x8b,
+		0x10, 0x44, 0x74, 0xad, 0x49, 0x38, 0x4a, 0xa7, 0x13, 0x2a, 0xfd, 0x8f, 0xd2, 0x10, 0x6b, 0x69,
+		0x95, 0x6b, 0x5a, 0x0d, 0xa7, 0x43, 0x14, 0x2c, 0x92, 0x68, 0x0e, 0xa0, 0x8a, 0x5b, 0x58, 0xaf,
+		0x62, 0xbd, 0x72, 0x90, 0x0e, 0x2f, 0x84, 0x4f, 0x27, 0x54, 0x0f, 0x04, 0x3d, 0x08, 0xd3, 0xad,
+		0xf6, 0x6e, 0xa3, 0x5e, 0x29, 0x7b, 0xd0, 0x60, 0x21, 0x7c, 0x3a, 0xaa, 0xca, 0x2c, 0x23, 0xef,
+		0x22, 0xdf, 0x0f, 0x53, 0x2f, 0x61, 0xed, 0x9a, 0x17, 0x75, 0x82, 0xa2, 0xa6, 0x08, 0xd8, 0x83,
+		0xb8, 0x02, 0xc9, 0x26, 0xb6, 0x2c, 0xad, 0x86, 0xcb, 0xf6, 0x41, 0x0b, 0xa7, 0x23, 0xb4, 0xf6,
+		0x0b, 0x1d, 0xb5, 0x0f, 0xd6, 0x7c, 0x82, 0x53, 0x6d, 0x1f, 0xb4, 0x30, 0xca, 0x42, 0x02, 0xeb,
+		0xed, 0x26, 0xe3, 0x10, 0xed, 0xa1, 0xbf, 0x82, 0xde, 0x6e, 0x06, 0xb9, 0xc4, 0x09, 0x19, 0x67,
+		0x11, 0xb3, 0xb0, 0x79, 0xbd, 0x5e, 0xc1, 0xe9, 0x71, 0xca, 0xe0, 0xfe, 0x0e, 0x06, 0x25, 0x96,
+		0x1f, 0xe4, 0x21, 0xe8, 0xd0, 0x0a, 0x24, 0xf0, 0x0d, 0x1b, 0xeb, 0x56, 0xdd, 0xd0, 0xd3, 0x31,
+		0xca, 0xe4, 0xbe, 0x2e, 0xad, 0x88, 0x1b, 0xd5, 0x20, 0x0b, 0x97, 0x0e, 0x9d, 0x87, 0x98, 0xd1,
+		0xb2, 0xeb, 0x86, 0x6e, 0xa5, 0xe3, 0x0b, 0xd2, 0xe9, 0x89, 0xa5, 0x93, 0x5d, 0x0d, 0x61, 0x93,
+		0xe1, 0xa8, 0x02, 0x19, 0x15, 0x41, 0xb6, 0x8c, 0xb6, 0x59, 0xc1, 0xe5, 0x8a, 0x51, 0xc5, 0xe5,
+		0xba, 0xbe, 0x67, 0xa4, 0x13, 0x94, 0xc1, 0x7c, 0x67, 0x45, 0x28, 0xe2, 0x8a, 0x51, 0xc5, 0x45,
+		0x7d, 0xcf, 0x50, 0x53, 0x96, 0x2f, 0x8d, 0x8e, 0xc2, 0xb8, 0x75, 0xa0, 0xdb, 0xda, 0x8d, 0x74,
+		0x92, 0x5a, 0x08, 0x4f, 0xa1, 0x25, 0x88, 0xe1, 0x6a, 0x9d, 0x14, 0x97, 0x4e, 0x2d, 0x48, 0xa7,
+		0x53, 0x4b, 0xe9, 0x4e, 0x1d, 0xb3, 0x7c, 0x55, 0x20, 0x2a, 0xbf, 0x39, 0x0e, 0x53, 0xc3, 0x98,
+		0xe5, 0x53, 0x10, 0xdd, 0x23, 0x9a, 0x49, 0x87, 0x46, 0xd1, 0x1b, 0xa3, 0xf1, 0x2b, 0x7e, 0xfc,
+		0x90, 0x8a, 0xcf, 0xc2, 0x84, 0x8e, 0x2d, 0x1b, 0x57, 0x99, 0x15, 0x85, 0x87, 0xb4, 0x43, 0x60,
+		0x44, 0x9d, 0x66, 0x18, 0x39, 0x94, 0x19, 0x3e, 0x07, 0x53, 0x8e, 0x48, 0x65, 0x53, 0xd3, 0x6b,
+		0xc2, 0x9e, 0xcf, 0x0e, 0x92, 0x64, 0xb1, 0x20, 0xe8, 0x54, 0x42, 0xa6, 0xa6, 0xb0, 0x2f, 0x8d,
+		0xf2, 0x00, 0x86, 0x8e, 0x8d, 0xbd, 0x72, 0x15, 0x57, 0x1a, 0xe9, 0x78, 0x0f, 0x2d, 0x6d, 0x12,
+		0x94, 0x0e, 0x2d, 0x19, 0x0c, 0x5a, 0x69, 0xa0, 0x0b, 0xae, 0x79, 0xc6, 0x7a, 0x58, 0xd7, 0x3a,
+		0xeb, 0x98, 0x1d, 0x16, 0xba, 0x03, 0x29, 0x13, 0x93, 0xbe, 0x82, 0xab, 0xbc, 0x66, 0x09, 0x2a,
+		0xc4, 0xe2, 0xc0, 0x9a, 0xa9, 0x9c, 0x8c, 0x55, 0x6c, 0xd2, 0xf4, 0x26, 0xd1, 0x3d, 0xe0, 0x00,
+		0xca, 0xd4, 0xac, 0x80, 0x7a, 0xae, 0xa4, 0x00, 0x6e, 0x68, 0x4d, 0x9c, 0x79, 0x19, 0x52, 0x7e,
+		0xf5, 0xa0, 0x59, 0x88, 0x5a, 0xb6, 0x66, 0xda, 0xd4, 0x0a, 0xa3, 0x2a, 0x4b, 0x20, 0x19, 0xc2,
+		0x58, 0xaf, 0x52, 0xcf, 0x18, 0x55, 0xc9, 0x5f, 0xf4, 0x5d, 0x6e, 0x85, 0xc3, 0xb4, 0xc2, 0xa7,
+		0x3a, 0x5b, 0xd4, 0xc7, 0x39, 0x58, 0xef, 0xcc, 0x13, 0x30, 0xe9, 0xab, 0xc0, 0xb0, 0x45, 0x2b,
+		0xbf, 0x1f, 0x81, 0x23, 0x5d, 0x79, 0xa3, 0xe7, 0x60, 0xb6, 0xad, 0xd7, 0x75, 0x1b, 0x9b, 0x2d,
+		0x13, 0x13, 0x93, 0x65, 0x65, 0xa5, 0xbf, 0x16, 0xeb, 0x61, 0x74, 0x3b, 0x5e, 0x6c, 0xc6, 0x45,
+		0x9d, 0x69, 0x77, 0x02, 0xd1, 0xf3, 0x30, 0x41, 0xec, 0x43, 0x33, 0x35, 0xca, 0x90, 0xf5, 0xc6,
+		0xa5, 0xe1, 0xaa, 0xbc, 0x98, 0x77, 0x29, 0x73, 0xe1, 0xf7, 0x4a, 0x21, 0xd5, 0xcb, 0x0b, 0x3d,
+		0x01, 0xf1, 0x3d, 0xac, 0xd9, 0x6d, 0x13, 0x5b, 0xe9, 0x25, 0xaa, 0xca, 0x13, 0x9d, 0x9d, 0x94,
+		0x21, 0x94, 0xb0, 0xad, 0x3a, 0xc8, 0xa8, 0x09, 0xc9, 0xeb, 0xd8, 0xac, 0xef, 0xd5, 0x2b, 0x4c,
+		0xa8, 0x30, 0x75, 0x3e, 0x4f, 0x0e, 0x29, 0xd4, 0x55, 0x0f, 0x69, 0xc9, 0xd6, 0x6c, 0xbc, 0x0c,
+		0x3b, 0x1b, 0x57, 0x0b, 0x6a, 0xf1, 0x62, 0xb1, 0x90, 0x67, 0x62, 0xfa, 0xd8, 0x67, 0x7e, 0x4c,
+		0x82, 0x09, 0x4f, 0x4d, 0x88, 0x3b, 0xd4, 0xdb, 0xcd, 0x5d, 0x6c, 0xf2, 0xf6, 0xe2, 0x29, 0x74,
+		0x02, 0x12, 0x7b, 0xed, 0x46, 0x83, 0x19, 0x1d, 0x1b, 0x4b, 0xe3, 0x04, 0x40, 0x0c, 0x8e, 0xf8,
+		0x38, 0xee, 0x46, 0xa8, 0x8f, 0x23, 0xff, 0x51, 0x06, 0xe2, 0xc2, 0x28, 0xd3, 0xd1, 0x05, 0xe9,
+		0x74, 0x5c, 0x75, 0xd2, 0x2c, 0xaf, 0x85, 0x35, 0x1b, 0x57, 0xd3, 0xe3, 0x22, 0x8f, 0xa5, 0x2f,
+		0x47, 0xe2, 0x11, 0x39, 0xaa, 0x3c, 0x0e, 0xd3, 0x1d, 0x55, 0x41, 0x53, 0x30, 0x91, 0x2f, 0xac,
+		0xac, 0x65, 0xd5, 0xec, 0x76, 0x71, 0x73, 0x43, 0x1e, 0x43, 0x29, 0xf0, 0xd4, 0x4e, 0x96, 0xce,
+		0x24, 0xe2, 0xaf, 0xc7, 0xe4, 0x57, 0x5e, 0x79, 0xe5, 0x95, 0x90, 0xf2, 0xdb, 0xe3, 0x30, 0xdb,
+		0xcd, 0x09, 0x76, 0xf5, 0xc7, 0x6e, 0xa5, 0xc3, 0xbe, 0x4a, 0x67, 0x21, 0xda, 0xd0, 0x76, 0x71,
+		0x23, 0x1d, 0xa1, 0x8d, 0xf0, 0xe0, 0x50, 0x6e, 0x76, 0x71, 0x8d, 0x90, 0xa8, 0x8c, 0x12, 0xbd,
+		0x83, 0xab, 0x26, 0x4a, 0x39, 0x9c, 0x19, 0x8e, 0x03, 0x71, 0x8e, 0x5c, 0x8d, 0x27, 0x20, 0x41,
+		0x7e, 0x99, 0xde, 0xc7, 0x99, 0xde, 0x09, 0x80, 0xea, 0x3d, 0x03, 0x71, 0xea, 0xf7, 0xaa, 0xd8,
+		0x69, 0x13, 0x91, 0x26, 0x9e, 0xa2, 0x8a, 0xf7, 0xb4, 0x76, 0xc3, 0x2e, 0x5f, 0xd7, 0x1a, 0x6d,
+		0x4c, 0x3d, 0x58, 0x42, 0x4d, 0x72, 0xe0, 0x55, 0x02, 0x43, 0xf3, 0x30, 0xc1, 0xdc, 0x64, 0x5d,
+		0xaf, 0xe2, 0x1b, 0x74, 0x08, 0x8d, 0xaa, 0xcc, 0x73, 0x16, 0x09, 0x84, 0x14, 0xff, 0xa2, 0x65,
+		0xe8, 0xc2, 0xd7, 0xd0, 0x22, 0x08, 0x80, 0x16, 0xff, 0x44, 0x70, 0xf4, 0xbe, 0xab, 0x7b, 0xf5,
+		0x3a, 0x9c, 0xe3, 0xfd, 0x30, 0x45, 0x31, 0x1e, 0xe3, 0x5d, 0x59, 0x6b, 0xa4, 0xa7, 0xa9, 0x19,
+		0xa4, 0x18, 0x78, 0x93, 0x43, 0x95, 0x5f, 0x0f, 0x41, 0x84, 0x8e, 0x14, 0x53, 0x30, 0xb1, 0xfd,
+		0xfc, 0x56, 0xa1, 0x9c, 0xdf, 0xdc, 0xc9, 0xad, 0x15, 0x64, 0x89, 0x34, 0x3d, 0x05, 0x5c, 0x5c,
+		0xdb, 0xcc, 0x6e, 0xcb, 0x21, 0x27, 0x5d, 0xdc, 0xd8, 0x3e, 0xff, 0xb8, 0x1c, 0x76, 0x08, 0x76,
+		0x18, 0x20, 0xe2, 0x45, 0x78, 0x6c, 0x49, 0x8e, 0x22, 0x19, 0x92, 0x8c, 0x41, 0xf1, 0xb9, 0x42,
+		0xfe, 0xfc, 0xe3, 0xf2, 0xb8, 0x1f, 0xf2, 0xd8, 0x92, 0x1c, 0x43, 0x93, 0x90, 0xa0, 0x90, 0xdc,
+		0xe6, 0xe6, 0x9a, 0x1c, 0x77, 0x78, 0x96, 0xb6, 0xd5, 0xe2, 0xc6, 0xaa, 0x9c, 0x70, 0x78, 0xae,
+		0xaa, 0x9b, 0x3b, 0x5b, 0x32, 0x38, 0x1c, 0xd6, 0x0b, 0xa5, 0x52, 0x76, 0xb5, 0x20, 0x4f, 0x38,
+		0x18, 0xb9, 0xe7, 0xb7, 0x0b, 0x25, 0x39, 0xe9, 0x13, 0xeb, 0xb1, 0x25, 0x79, 0xd2, 0x29, 0xa2,
+		0xb0, 0xb1, 0xb3, 0x2e, 0xa7, 0xd0, 0x34, 0x4c, 0xb2, 0x22, 0x84, 0x10, 0x53, 0x01, 0xd0, 0xf9,
+		0xc7, 0x65, 0xd9, 0x15, 0x84, 0x71, 0x99, 0xf6, 0x01, 0xce, 0x3f, 0x2e, 0x23, 0x65, 0x05, 0xa2,
+		0xd4, 0x0c, 0x11, 0x82, 0xd4, 0x5a, 0x36, 0x57, 0x58, 0x2b, 0x6f, 0x6e, 0x91, 0x4e, 0x93, 0x5d,
+		0x93, 0x25, 0x17, 0xa6, 0x16, 0xb6, 0x0a, 0xd9, 0xed, 0x42, 0x5e, 0x0e, 0x7b, 0x61, 0xcf, 0xec,
+		0x14, 0xd5, 0x42, 0x5e, 0x0e, 0x29, 0x15, 0x98, 0xed, 0x36, 0x42, 0x76, 0xed, 0x42, 0x1e, 0x5b,
+		0x08, 0xf5, 0xb0, 0x05, 0xca, 0x2b, 0x68, 0x0b, 0xca, 0x97, 0x43, 0x30, 0xd3, 0x25, 0x4a, 0xe8,
+		0x5a, 0xc8, 0xd3, 0x10, 0x65, 0xb6, 0xcc, 0x3c, 0xf5, 0x03, 0x5d, 0xc3, 0x0d, 0x6a, 0xd9, 0x1d,
+		0xb1, 0x13, 0xa5, 0xf3, 0xc6, 0x9b, 0xe1, 0x1e, 0xf1, 0x26, 0x61, 0xd1, 0x61, 0xb0, 0xdf, 0xd3,
+		0x31, 0x9a, 0xb3, 0x80, 0xe7, 0xfc, 0x30, 0x01, 0x0f, 0x85, 0x8d, 0x36, 0xaa, 0x47, 0xbb, 0x8c,
+		0xea, 0x4f, 0xc1, 0x74, 0x07, 0xa3, 0xa1, 0x47, 0xd7, 0x1f, 0x92, 0x20, 0xdd, 0x4b, 0x39, 0x03,
+		0x5c, 0x62, 0xc8, 0xe7, 0x12, 0x9f, 0x0a, 0x6a, 0xf0, 0xee, 0xde, 0x8d, 0xd0, 0xd1, 0xd6, 0x9f,
+		0x94, 0xe0, 0x68, 0xf7, 0x79, 0x45, 0x57, 0x19, 0xde, 0x01, 0xe3, 0x4d, 0x6c, 0xef, 0x1b, 0x22,
+		0x4e, 0x3e, 0xd5, 0x25, 0xfa, 0x22, 0xd9, 0xc1, 0xc6, 0xe6, 0x54, 0xe8, 0x42, 0x50, 0xd6, 0xf9,
+		0x5e, 0xb3, 0x9c, 0x0e, 0x49, 0xdf, 0x17, 0x82, 0x23, 0x5d, 0x99, 0x77, 0x15, 0xf4, 0x2e, 0x80,
+		0xba, 0xde, 0x6a, 0xdb, 0x2c, 0x16, 0x66, 0x9e, 0x38, 0x41, 0x21, 0xd4, 0x79, 0x11, 0x2f, 0xdb,
+		0xb6, 0x9d, 0x7c, 0x36, 0x4a, 0x02, 0x03, 0x51, 0x84, 0x27, 0x5d, 0x41, 0x23, 0x54, 0xd0, 0xb9,
+		0x1e, 0x35, 0xed, 0x30, 0xcc, 0x47, 0x40, 0xae, 0x34, 0xea, 0x58, 0xb7, 0xcb, 0x96, 0x6d, 0x62,
+		0xad, 0x59, 0xd7, 0x6b, 0x6c, 0xb4, 0x5d, 0x8e, 0xee, 0x69, 0x0d, 0x0b, 0xab, 0x53, 0x2c, 0xbb,
+		0x24, 0x72, 0x09, 0x05, 0x35, 0x20, 0xd3, 0x43, 0x31, 0xee, 0xa3, 0x60, 0xd9, 0x0e, 0x85, 0xf2,
+		0x8b, 0x09, 0x98, 0xf0, 0xcc, 0xc2, 0xd0, 0xdd, 0x90, 0x7c, 0x51, 0xbb, 0xae, 0x95, 0xc5, 0xcc,
+		0x9a, 0x69, 0x62, 0x82, 0xc0, 0xb6, 0x18, 0x08, 0x3d, 0x02, 0xb3, 0x14, 0xc5, 0x68, 0xdb, 0xd8,
+		0x2c, 0x57, 0x1a, 0x9a, 0x65, 0x51, 0xa5, 0xc5, 0x29, 0x2a, 0x22, 0x79, 0x9b, 0x24, 0x6b, 0x45,
+		0xe4, 0xa0, 0x73, 0x30, 0x43, 0x29, 0x9a, 0xed, 0x86, 0x5d, 0x6f, 0x35, 0x70, 0x99, 0xcc, 0xf5,
+		0xad, 0x34, 0x78, 0x25, 0x9b, 0x26, 0x18, 0xeb, 0x1c, 0x81, 0x48, 0x64, 0xa1, 0x3c, 0xdc, 0x45,
+		0xc9, 0x6a, 0x58, 0xc7, 0xa6, 0x66, 0xe3, 0x32, 0x7e, 0x57, 0x5b, 0x6b, 0x58, 0x65, 0x4d, 0xaf,
+		0x96, 0xf7, 0x35, 0x6b, 0x3f, 0x3d, 0x4b, 0x18, 0xe4, 0x42, 0x69, 0x49, 0x3d, 0x4e, 0x10, 0x57,
+		0x39, 0x5e, 0x81, 0xa2, 0x65, 0xf5, 0xea, 0x25, 0xcd, 0xda, 0x47, 0xcb, 0x70, 0x94, 0x72, 0xb1,
+		0x6c, 0xb3, 0xae, 0xd7, 0xca, 0x95, 0x7d, 0x5c, 0xb9, 0x56, 0x6e, 0xdb, 0x7b, 0x4f, 0xa6, 0x4f,
+		0x78, 0xcb, 0xa7, 0x12, 0x96, 0x28, 0xce, 0x0a, 0x41, 0xd9, 0xb1, 0xf7, 0x9e, 0x44, 0x25, 0x48,
+		0x92, 0xc6, 0x68, 0xd6, 0x5f, 0xc6, 0xe5, 0x3d, 0xc3, 0xa4, 0x63, 0x68, 0xaa, 0x8b, 0x6b, 0xf2,
+		0x68, 0x70, 0x71, 0x93, 0x13, 0xac, 0x1b, 0x55, 0xbc, 0x1c, 0x2d, 0x6d, 0x15, 0x0a, 0x79, 0x75,
+		0x42, 0x70, 0xb9, 0x68, 0x98, 0xc4, 0xa0, 0x6a, 0x86, 0xa3, 0xe0, 0x09, 0x66, 0x50, 0x35, 0x43,
+		0xa8, 0xf7, 0x1c, 0xcc, 0x54, 0x2a, 0xac, 0xce, 0xf5, 0x4a, 0x99, 0xcf, 0xc8, 0xad, 0xb4, 0xec,
+		0x53, 0x56, 0xa5, 0xb2, 0xca, 0x10, 0xb8, 0x8d, 0x5b, 0xe8, 0x02, 0x1c, 0x71, 0x95, 0xe5, 0x25,
+		0x9c, 0xee, 0xa8, 0x65, 0x90, 0xf4, 0x1c, 0xcc, 0xb4, 0x0e, 0x3a, 0x09, 0x91, 0xaf, 0xc4, 0xd6,
+		0x41, 0x90, 0xec, 0x3e, 0xba, 0xca, 0x62, 0xe2, 0x0a, 0x0d, 0xf5, 0x8e, 0x79, 0xb1, 0x3d, 0x19,
+		0x68, 0x11, 0xe4, 0x4a, 0xa5, 0x8c, 0x75, 0x6d, 0xb7, 0x81, 0xcb, 0x9a, 0x89, 0x75, 0xcd, 0x4a,
+		0xcf, 0x53, 0xe4, 0x88, 0x6d, 0xb6, 0xb1, 0x9a, 0xaa, 0x54, 0x0a, 0x34, 0x33, 0x4b, 0xf3, 0xd0,
+		0x19, 0x98, 0x36, 0x76, 0x5f, 0xac, 0x30, 0xc3, 0x2a, 0xb7, 0x4c, 0xbc, 0x57, 0xbf, 0x91, 0xbe,
+		0x97, 0x6a, 0x69, 0x8a, 0x64, 0x50, 0xb3, 0xda, 0xa2, 0x60, 0xf4, 0x00, 0xc8, 0x15, 0x6b, 0x5f,
+		0x33, 0x5b, 0xd4, 0xb3, 0x5a, 0x2d, 0xad, 0x82, 0xd3, 0xf7, 0x31, 0x54, 0x06, 0xdf, 0x10, 0x60,
+		0x62, 0xd8, 0xd6, 0x4b, 0xf5, 0x3d, 0x5b, 0x70, 0xbc, 0x9f, 0x19, 0x36, 0x85, 0x71, 0x6e, 0xa7,
+		0x41, 0x6e, 0xed, 0xb7, 0xfc, 0x05, 0x9f, 0xa6, 0x68, 0xa9, 0xd6, 0x7e, 0xcb, 0x5b, 0xee, 0x3d,
+		0x30, 0xd9, 0xda, 0xf7, 0x16, 0xfa, 0x00, 0x8b, 0xbf, 0x5a, 0xfb, 0x9e, 0x12, 0x1f, 0x87, 0xa3,
+		0x04, 0xa9, 0x89, 0x6d, 0xad, 0xaa, 0xd9, 0x9a, 0x07, 0xfb, 0x21, 0x8a, 0x3d, 0xdb, 0xda, 0x6f,
+		0xad, 0xf3, 0x4c, 0x9f, 0x9c, 0x66, 0x7b, 0xf7, 0xc0, 0xb1, 0x8f, 0x87, 0x99, 0x9c, 0x04, 0x26,
+		0x2c, 0xe4, 0xd0, 0xd3, 0x8f, 0x3b, 0x36, 0xd9, 0x52, 0x96, 0x21, 0xe9, 0xb5, 0x7b, 0x94, 0x00,
+		0x66, 0xf9, 0xb2, 0x44, 0x82, 0xa0, 0x95, 0xcd, 0x3c, 0x09, 0x5f, 0x5e, 0x28, 0xc8, 0x21, 0x12,
+		0x46, 0xad, 0x15, 0xb7, 0x0b, 0x65, 0x75, 0x67, 0x63, 0xbb, 0xb8, 0x5e, 0x90, 0xc3, 0x9e, 0xc0,
+		0xfe, 0x72, 0x24, 0x7e, 0x46, 0x7e, 0xf0, 0x72, 0x24, 0x7e, 0x4a, 0xbe, 0x9f, 0xaa, 0xa7, 0xc3,
+		0x28, 0x95, 0x6f, 0x85, 0x21, 0xe5, 0x9f, 0x96, 0xa3, 0xb7, 0xc1, 0x31, 0xb1, 0xee, 0x66, 0x61,
+		0xbb, 0xfc, 0x52, 0xdd, 0xa4, 0x9d, 0xb5, 0xa9, 0xb1, 0x81, 0xd3, 0x31, 0xca, 0x59, 0x8e, 0x55,
+		0xc2, 0xf6, 0xb3, 0x75, 0x93, 0x74, 0xc5, 0xa6, 0x66, 0xa3, 0x35, 0x98, 0xd7, 0x8d, 0xb2, 0x65,
+		0x6b, 0x7a, 0x55, 0x33, 0xab, 0x65, 0x77, 0xc5, 0xb3, 0xac, 0x55, 0x2a, 0xd8, 0xb2, 0x0c, 0x36,
+		0x48, 0x3a, 0x5c, 0x4e, 0xea, 0x46, 0x89, 0x23, 0xbb, 0xa3, 0x47, 0x96, 0xa3, 0x06, 0xfa, 0x44,
+		0xb8, 0x57, 0x9f, 0x38, 0x01, 0x89, 0xa6, 0xd6, 0x2a, 0x63, 0xdd, 0x36, 0x0f, 0x68, 0xec, 0x1e,
+		0x57, 0xe3, 0x4d, 0xad, 0x55, 0x20, 0x69, 0x74, 0x15, 0x4e, 0xb9, 0xa8, 0xe5, 0x06, 0xae, 0x69,
+		0x95, 0x83, 0x32, 0x0d, 0xd4, 0xe9, 0x1a, 0x51, 0xb9, 0x62, 0xe8, 0x7b, 0x8d, 0x7a, 0xc5, 0xb6,
+		0xd2, 0x13, 0x8e, 0xff, 0x53, 0x5c, 0x8a, 0x35, 0x4a, 0x70, 0xd9, 0x32, 0x74, 0x1a, 0x9f, 0xaf,
+		0x08, 0x6c, 0x9f, 0xd9, 0x24, 0xdf, 0x12, 0x66, 0xe3, 0x6f, 0xfa, 0x88, 0x1c, 0xbd, 0x1c, 0x89,
+		0x47, 0xe5, 0xf1, 0xcb, 0x91, 0xf8, 0xb8, 0x1c, 0xbb, 0x1c, 0x89, 0xc7, 0xe5, 0xc4, 0xe5, 0x48,
+		0x3c, 0x21, 0x83, 0x72, 0x73, 0x12, 0x92, 0xde, 0xe9, 0x06, 0x99, 0xbd, 0x55, 0xe8, 0x80, 0x2b,
+		0x51, 0x97, 0x7c, 0x4f, 0xdf, 0xc9, 0xc9, 0xe2, 0x0a, 0x19, 0x89, 0x97, 0xc7, 0x59, 0x6c, 0xaf,
+		0x32, 0x4a, 0x12, 0x05, 0x91, 0x4e, 0x86, 0x59, 0x2c, 0x15, 0x57, 0x79, 0x0a, 0xad, 0xc2, 0xf8,
+		0x8b, 0x16, 0xe5, 0x3d, 0x4e, 0x79, 0xdf, 0xdb, 0x9f, 0xf7, 0xe5, 0x12, 0x65, 0x9e, 0xb8, 0x5c,
+		0x2a, 0x6f, 0x6c, 0xaa, 0xeb, 0xd9, 0x35, 0x95, 0x93, 0xa3, 0xe3, 0x10, 0x69, 0x68, 0x2f, 0x1f,
+		0xf8, 0xc7, 0x6c, 0x0a, 0x42, 0x8b, 0x30, 0xd5, 0xd6, 0xd9, 0x5c, 0x9d, 0xb4, 0x31, 0xc1, 0x9a,
+		0xf2, 0x62, 0xa5, 0xdc, 0xdc, 0x35, 0x82, 0x3f, 0xa4, 0x5d, 0x1d, 0x87, 0x08, 0x59, 0x94, 0xf6,
+		0x8f, 0xac, 0x14, 0x84, 0x4e, 0x43, 0xb2, 0x8a, 0x77, 0xdb, 0xb5, 0xb2, 0x89, 0xab, 0x5a, 0xc5,
+		0xf6, 0x8f, 0x27, 0x13, 0x34, 0x4b, 0xa5, 0x39, 0xe8, 0x0a, 0x24, 0x48, 0x1b, 0xe9, 0xb4, 0x8d,
+		0xa7, 0xa9, 0x0a, 0x1e, 0xee, 0xaf, 0x02, 0xde, 0xc4, 0x82, 0x48, 0x75, 0xe9, 0xd1, 0x25, 0x88,
+		0xd9, 0x9a, 0x59, 0xc3, 0xb6, 0x95, 0x9e, 0x59, 0x08, 0x9f, 0x4e, 0x2d, 0x2d, 0x0e, 0xc3, 0x6a,
+		0x9b, 0x92, 0xd0, 0x99, 0xb2, 0x20, 0x47, 0xcf, 0x82, 0xcc, 0x97, 0x62, 0xcb, 0x7c, 0x9a, 0x6b,
+		0xa5, 0x67, 0xa9, 0x01, 0x3e, 0xd4, 0x9f, 0x25, 0x5f, 0xc9, 0xcd, 0x33, 0x22, 0x75, 0x0a, 0xfb,
+		0xd2, 0xfe, 0x7e, 0x71, 0x64, 0x94, 0x7e, 0xb1, 0x03, 0x53, 0xfc, 0x7f, 0xd9, 0x6a, 0xb7, 0x5a,
+		0x86, 0x69, 0xa7, 0x8f, 0x2e, 0x48, 0x83, 0x05, 0x12, 0xcc, 0x18, 0x8d, 0x9a, 0xda, 0xf3, 0xa5,
+		0xef, 0x5c, 0x77, 0xcb, 0xbc, 0x00, 0x29, 0xbf, 0x32, 0xbc, 0x0b, 0xe1, 0xe1, 0x21, 0x17, 0xc2,
+		0xc9, 0xb4, 0x44, 0x4c, 0xd4, 0xc8, 0xd0, 0xc4, 0x12, 0x99, 0x1f, 0x0f, 0x41, 0xca, 0x5f, 0x31,
+		0xb4, 0x0a, 0x48, 0xb4, 0x58, 0x5d, 0xb7, 0x4d, 0xa3, 0xda, 0xae, 0xe0, 0x6a, 0x5a, 0x1a, 0x50,
+		0xce, 0x34, 0xa7, 0x29, 0x3a, 0x24, 0x5e, 0x46, 0x9e, 0x5e, 0x10, 0x1a, 0x92, 0x51, 0xde, 0xed,
+		0x1f, 0x67, 0x61, 0x46, 0x30, 0x20, 0xcc, 0x5e, 0xd2, 0x4c, 0x9d, 0x84, 0xc8, 0x2c, 0x68, 0x47,
+		0x9e, 0xac, 0x67, 0x59, 0x0e, 0xca, 0x82, 0x30, 0x97, 0xb2, 0x89, 0x9b, 0x06, 0x59, 0xef, 0x8a,
+		0x0c, 0x28, 0x36, 0xc5, 0x09, 0x54, 0x86, 0xaf, 0x9c, 0x85, 0x28, 0x75, 0x3f, 0x08, 0x80, 0x3b,
+		0x20, 0x79, 0x0c, 0xc5, 0x21, 0xb2, 0xb2, 0xa9, 0x92, 0xe1, 0x51, 0x86, 0x24, 0x83, 0x96, 0xb7,
+		0x8a, 0x85, 0x95, 0x82, 0x1c, 0x52, 0xce, 0xc1, 0x38, 0xf3, 0x29, 0x64, 0xe8, 0x74, 0xbc, 0x8a,
+		0x3c, 0xc6, 0x93, 0x9c, 0x87, 0x24, 0x72, 0x77, 0xd6, 0x73, 0x05, 0x55, 0x0e, 0x29, 0x3b, 0x30,
+		0x15, 0xe8, 0x87, 0xe8, 0x08, 0x4c, 0xab, 0x85, 0xed, 0xc2, 0x06, 0x59, 0x1c, 0x28, 0xef, 0x6c,
+		0x5c, 0xd9, 0xd8, 0x7c, 0x96, 0xac, 0xac, 0xf9, 0xc0, 0x62, 0x1c, 0x96, 0xd0, 0x2c, 0xc8, 0x2e,
+		0xb8, 0xb4, 0xb9, 0xa3, 0x52, 0x69, 0xfe, 0x56, 0x08, 0xe4, 0x60, 0xa7, 0x44, 0xc7, 0x60, 0x66,
+		0x3b, 0xab, 0xae, 0x16, 0xb6, 0xcb, 0x6c, 0xc1, 0xc3, 0x61, 0x3d, 0x0b, 0xb2, 0x37, 0xe3, 0x62,
+		0x91, 0xae, 0xe7, 0xcc, 0xc3, 0x09, 0x2f, 0xb4, 0xf0, 0xdc, 0x76, 0x61, 0xa3, 0x44, 0x0b, 0xcf,
+		0x6e, 0xac, 0x92, 0xa0, 0x20, 0xc0, 0x4f, 0x2c, 0xb1, 0x84, 0x89, 0xa8, 0x7e, 0x7e, 0x85, 0xb5,
+		0xbc, 0x1c, 0x09, 0x82, 0x37, 0x37, 0x0a, 0x9b, 0x17, 0xe5, 0x68, 0xb0, 0x74, 0xba, 0xec, 0x32,
+		0x8e, 0x32, 0x70, 0x34, 0x08, 0x2d, 0x17, 0x36, 0xb6, 0xd5, 0xe7, 0xe5, 0x58, 0xb0, 0xe0, 0x52,
+		0x41, 0xbd, 0x5a, 0x5c, 0x29, 0xc8, 0x71, 0x74, 0x14, 0x90, 0x5f, 0xa2, 0xed, 0x4b, 0x9b, 0x79,
+		0x39, 0xd1, 0x6d, 0xc4, 0x42, 0xf2, 0x8c, 0xf2, 0x69, 0x09, 0x92, 0xde, 0x25, 0x10, 0x9f, 0x53,
+		0x91, 0xde, 0x6a, 0x83, 0xad, 0xf2, 0x85, 0x10, 0x4c, 0x78, 0xd6, 0x42, 0xc8, 0x24, 0x56, 0x6b,
+		0x34, 0x8c, 0x97, 0xca, 0x5a, 0xa3, 0xae, 0x59, 0x7c, 0x3c, 0x04, 0x0a, 0xca, 0x12, 0xc8, 0xb0,
+		0xe3, 0xcf, 0xf0, 0xa1, 0xcb, 0xf8, 0xa1, 0x43, 0x97, 0xd8, 0x5b, 0x30, 0x74, 0x89, 0xca, 0xe3,
+		0xca, 0x1f, 0x86, 0x40, 0x0e, 0xae, 0x8e, 0x04, 0xf4, 0x26, 0xf5, 0xd2, 0x9b, 0xb7, 0x7e, 0xa1,
+		0x51, 0xea, 0x17, 0x1c, 0xd5, 0xc3, 0x3d, 0x47, 0xf5, 0x2e, 0x83, 0x55, 0xe4, 0xad, 0x3c, 0x58,
+		0x79, 0xcd, 0xf5, 0xdf, 0x49, 0x90, 0xf2, 0x2f, 0xe6, 0xf8, 0x34, 0xa6, 0x8c, 0xa2, 0x31, 0x7f,
+		0x8b, 0xdc, 0xdd, 0xab, 0x45, 0xfe, 0x52, 0xea, 0xf5, 0x91, 0x30, 0x4c, 0xfa, 0xd6, 0x7e, 0x86,
+		0x95, 0xee, 0x5d, 0x30, 0x5d, 0xaf, 0xe2, 0x66, 0xcb, 0xb0, 0xc9, 0xc9, 0x83, 0x72, 0x03, 0x5f,
+		0xc7, 0x0d, 0xaa, 0x86, 0x54, 0x97, 0xdd, 0x55, 0x5f, 0x09, 0x8b, 0x45, 0x97, 0x6e, 0x8d, 0x90,
+		0x2d, 0xcf, 0x14, 0xf3, 0x85, 0xf5, 0xad, 0xcd, 0xed, 0xc2, 0xc6, 0xca, 0xf3, 0xc2, 0x93, 0xab,
+		0x72, 0x3d, 0x80, 0xe6, 0x53, 0xf8, 0x3d, 0x6f, 0x8d, 0x49, 0xe7, 0x16, 0xc8, 0xc1, 0xda, 0x10,
+		0x87, 0xde, 0xa5, 0x3e, 0xf2, 0x18, 0x9a, 0x81, 0xa9, 0x8d, 0xcd, 0x72, 0xa9, 0x98, 0x2f, 0x94,
+		0x0b, 0x17, 0x2f, 0x16, 0x56, 0xb6, 0x4b, 0x6c, 0xa3, 0xc1, 0xc1, 0xde, 0x96, 0x43, 0xde, 0xb6,
+		0xf9, 0x68, 0x18, 0x66, 0xba, 0x48, 0x82, 0xb2, 0x7c, 0x89, 0x90, 0xad, 0x5a, 0x3e, 0x3c, 0x8c,
+		0xf4, 0x8b, 0x64, 0x76, 0xbf, 0xa5, 0x99, 0x36, 0x5f, 0x51, 0x7c, 0x00, 0x88, 0x7a, 0x75, 0x9b,
+		0x84, 0xf7, 0x26, 0xdf, 0xc0, 0x61, 0x21, 0xc8, 0x94, 0x0b, 0x67, 0x7b, 0x38, 0x0f, 0x01, 0x6a,
+		0x19, 0x56, 0xdd, 0xae, 0x5f, 0x27, 0x07, 0x21, 0xc4, 0x6e, 0x0f, 0xe9, 0xb8, 0x11, 0x55, 0x16,
+		0x39, 0x45, 0xdd, 0x76, 0xb0, 0x75, 0x5c, 0xd3, 0x02, 0xd8, 0x64, 0xfa, 0x11, 0x56, 0x65, 0x91,
+		0xe3, 0x60, 0xdf, 0x0d, 0xc9, 0xaa, 0xd1, 0x26, 0xab, 0x32, 0x0c, 0x8f, 0xb8, 0x64, 0x49, 0x9d,
+		0x60, 0x30, 0x07, 0x85, 0x2f, 0x9b, 0xb9, 0xdb, 0x4c, 0x49, 0x75, 0x82, 0xc1, 0x18, 0xca, 0xfd,
+		0x30, 0xa5, 0xd5, 0x6a, 0x26, 0x61, 0x2e, 0x18, 0xb1, 0x85, 0xc0, 0x94, 0x03, 0xa6, 0x88, 0x99,
+		0xcb, 0x10, 0x17, 0x7a, 0x20, 0xf3, 0x5f, 0xa2, 0x89, 0x72, 0x8b, 0xad, 0x6e, 0x87, 0xc8, 0xce,
+		0x93, 0x2e, 0x32, 0xef, 0x86, 0x64, 0xdd, 0x2a, 0xbb, 0xc7, 0x20, 0x42, 0x0b, 0xa1, 0xd3, 0x71,
+		0x75, 0xa2, 0x6e, 0x39, 0xbb, 0xa2, 0xca, 0x27, 0x01, 0xc0, 0x35, 0x36, 0xf4, 0x61, 0x09, 0x52,
+		0x6c, 0x80, 0x69, 0x99, 0xd8, 0xc2, 0x7a, 0x45, 0x4c, 0x0b, 0x1f, 0xe8, 0x63, 0xa2, 0xcc, 0xcd,
+		0x6d, 0x71, 0x82, 0xdc, 0xd3, 0xef, 0x95, 0xa4, 0xd7, 0xa4, 0xc8, 0x6b, 0x92, 0xf4, 0x33, 0xd2,
+		0x24, 0x8a, 0x17, 0x9e, 0xdb, 0x5a, 0x2b, 0xae, 0x14, 0xb7, 0xd3, 0x5f, 0x8d, 0xd1, 0x74, 0x71,
+		0x9d, 0xa7, 0xbf, 0x16, 0xf3, 0xe7, 0xbf, 0x1e, 0xfb, 0x55, 0x29, 0x1c, 0x7f, 0x3d, 0xa6, 0x4e,
+		0xee, 0x79, 0xf9, 0xa1, 0x86, 0xf7, 0x04, 0x45, 0xa8, 0xd7, 0x44, 0xd2, 0x95, 0xa6, 0xc0, 0xcf,
+		0x4d, 0xe4, 0x1e, 0xa0, 0x82, 0x8c, 0x53, 0x41, 0x26, 0xd0, 0xf8, 0xca, 0xda, 0x66, 0xa9, 0x90,
+		0xa7, 0x62, 0x24, 0x50, 0x64, 0x73, 0xab, 0xb0, 0x91, 0xfe, 0x9a, 0x28, 0xd2, 0x3d, 0x6c, 0xf1,
+		0x9a, 0x04, 0xc7, 0xc4, 0x2e, 0x2b, 0x1f, 0x6b, 0xb1, 0x5e, 0x31, 0xaa, 0x22, 0xba, 0x4d, 0x2d,
+		0x3d, 0xda, 0xaf, 0x70, 0x95, 0x93, 0x52, 0x95, 0x14, 0x38, 0x61, 0xee, 0xe1, 0x0e, 0x95, 0x64,
+		0x37, 0xf2, 0x5c, 0x96, 0x09, 0x34, 0xbe, 0x95, 0x5d, 0xb9, 0x52, 0xc8, 0xbb, 0xd2, 0x1c, 0x31,
+		0xbb, 0x71, 0x41, 0x3f, 0x00, 0x53, 0x64, 0xb5, 0x95, 0xd8, 0x46, 0xbd, 0xca, 0xb6, 0xbd, 0x23,
+		0xbd, 0xf6, 0x4b, 0x5d, 0x89, 0xc8, 0xf2, 0xeb, 0x55, 0x87, 0x22, 0xf7, 0x80, 0x47, 0x94, 0x04,
+		0x8a, 0x6c, 0x6c, 0x6e, 0x14, 0x84, 0x18, 0x74, 0x8b, 0xf8, 0x79, 0x57, 0x8c, 0x54, 0xdb, 0x47,
+		0x8a, 0x7e, 0x00, 0x64, 0xb1, 0x3c, 0xe4, 0xa8, 0x24, 0xda, 0x6b, 0xcb, 0xd7, 0x15, 0x80, 0x2f,
+		0x32, 0x39, 0xca, 0x38, 0xe5, 0x91, 0x60, 0x16, 0x4d, 0xad, 0x15, 0x36, 0x56, 0xb7, 0x2f, 0x95,
+		0xb7, 0xd4, 0x02, 0xdd, 0xb9, 0x4b, 0x7f, 0x55, 0x14, 0x3f, 0xd5, 0xf4, 0x13, 0xa2, 0x77, 0x4b,
+		0x30, 0xc1, 0x42, 0x20, 0xb6, 0x26, 0xc5, 0x16, 0x15, 0x4e, 0xf5, 0x2b, 0x9b, 0x46, 0x40, 0x14,
+		0x3b, 0x77, 0x81, 0x16, 0x1b, 0x16, 0x06, 0x71, 0x0c, 0xa1, 0xb5, 0xc2, 0x6a, 0x76, 0xe5, 0xf9,
+		0x72, 0xae, 0x50, 0xda, 0x26, 0x9e, 0x6c, 0x53, 0x65, 0x36, 0x0a, 0x28, 0x9a, 0x5d, 0x5b, 0xdb,
+		0x7c, 0xd6, 0x55, 0x04, 0xbc, 0xe8, 0xb0, 0x51, 0xde, 0x09, 0x93, 0x3e, 0x73, 0x27, 0x41, 0x31,
+		0x0d, 0xa6, 0x49, 0x0d, 0x4a, 0x85, 0x8d, 0x15, 0x6f, 0x10, 0x9f, 0x04, 0xc7, 0xbc, 0x65, 0x89,
+		0xa4, 0x84, 0xf1, 0xcb, 0x21, 0xe2, 0x46, 0xb9, 0x00, 0xce, 0x5e, 0x62, 0x58, 0x79, 0x02, 0xe2,
+		0xc2, 0x7c, 0x49, 0x68, 0x4e, 0x23, 0xec, 0xc0, 0xc4, 0x20, 0x0e, 0xd4, 0x76, 0x65, 0x89, 0x4c,
+		0x83, 0x98, 0x4d, 0xcb, 0x21, 0xe5, 0x2a, 0x1c, 0xe9, 0x6a, 0x7a, 0xe8, 0x1e, 0x98, 0x17, 0xfb,
+		0x97, 0x2c, 0xe8, 0x2f, 0x17, 0x36, 0x56, 0x36, 0xf3, 0x64, 0x9a, 0xe4, 0xf2, 0x04, 0xe0, 0x36,
+		0xc8, 0xa4, 0x14, 0xf6, 0x29, 0x87, 0x94, 0x22, 0xa4, 0xfc, 0x06, 0x84, 0x4e, 0xc0, 0xb1, 0x9d,
+		0xed, 0x8b, 0x4f, 0x96, 0xaf, 0x66, 0xd7, 0x8a, 0xf9, 0x6c, 0x60, 0x42, 0x04, 0xc0, 0xad, 0x48,
+		0x0e, 0x11, 0x41, 0x89, 0x75, 0xc9, 0x61, 0x25, 0x12, 0x97, 0x64, 0x49, 0x29, 0xc1, 0x54, 0xc0,
+		0x14, 0xd0, 0x49, 0x48, 0xf3, 0x19, 0x4a, 0x37, 0xa9, 0x66, 0x20, 0x68, 0x1c, 0x6c, 0xae, 0x96,
+		0x2f, 0xac, 0x15, 0xd7, 0x8b, 0xdb, 0x54, 0xbe, 0x4b, 0x00, 0x6e, 0x1b, 0x93, 0x31, 0xeb, 0x72,
+		0x69, 0x73, 0xa3, 0x7c, 0x91, 0x4c, 0xf4, 0xb6, 0x3d, 0xac, 0x12, 0xc0, 0xda, 0x54, 0x96, 0xc8,
+		0x7c, 0xa4, 0xb3, 0xe1, 0xe5, 0xd0, 0x99, 0x71, 0x32, 0x62, 0xbd, 0x7f, 0xe3, 0xcc, 0x78, 0xfc,
+		0xfd, 0x1b, 0xf2, 0x87, 0xc8, 0xef, 0x87, 0x36, 0xe4, 0x0f, 0x6f, 0x5c, 0x1e, 0x8f, 0x7f, 0x2d,
+		0x26, 0xbf, 0x1e, 0x53, 0xfe, 0x2c, 0x0c, 0xc8, 0xb5, 0x2c, 0x67, 0xcd, 0xe3, 0x39, 0x88, 0x3b,
+		0x8b, 0x28, 0xec, 0x94, 0xe6, 0xdb, 0xfa, 0x18, 0xa4, 0x20, 0xf3, 0x80, 0x02, 0x8b, 0x2a, 0x0e,
+		0x37, 0x32, 0x63, 0x6e, 0xd6, 0xf5, 0x7a, 0xb3, 0xdd, 0x2c, 0x8b, 0x95, 0x85, 0x81, 0x33, 0x66,
+		0x4e, 0xc0, 0xd3, 0x94, 0x85, 0x76, 0xc3, 0xc7, 0x22, 0x3a, 0x90, 0x05, 0x23, 0xe0, 0xe9, 0xcc,
+		0x5f, 0x48, 0x90, 0xee, 0x25, 0xec, 0xa1, 0x16, 0x3d, 0x36, 0x60, 0xd6, 0xb8, 0x8e, 0x4d, 0xb3,
+		0x5e, 0xa5, 0xfb, 0x18, 0x4e, 0x28, 0x14, 0x19, 0x1c, 0x0a, 0xcd, 0x78, 0x08, 0x39, 0xd8, 0x42,
+		0x39, 0x32, 0x62, 0xdd, 0x20, 0xce, 0x5a, 0x70, 0x8a, 0x0e, 0xe6, 0x34, 0x49, 0x49, 0x04, 0x8f,
+		0xcb, 0xc4, 0x40, 0xc9, 0xec, 0x23, 0x24, 0x87, 0xdd, 0x78, 0x4b, 0xf9, 0x64, 0x08, 0x52, 0xfe,
+		0x63, 0x91, 0x28, 0x0f, 0xf1, 0x86, 0xc1, 0x8f, 0x1c, 0xb1, 0xd6, 0x3e, 0x3d, 0xe0, 0x24, 0xe5,
+		0xe2, 0x1a, 0xc7, 0x57, 0x1d, 0xca, 0xcc, 0xbf, 0x96, 0x20, 0x2e, 0xc0, 0xe8, 0x28, 0x44, 0x5a,
+		0x9a, 0xbd, 0x4f, 0xd9, 0x45, 0x73, 0x21, 0x59, 0x52, 0x69, 0x9a, 0xc0, 0xad, 0x96, 0xc6, 0x8e,
+		0x5b, 0x71, 0x38, 0x49, 0x93, 0x98, 0xa7, 0x81, 0xb5, 0x2a, 0xdd, 0x81, 0x33, 0x9a, 0x4d, 0xac,
+		0xdb, 0x96, 0x88, 0x79, 0x38, 0x7c, 0x85, 0x83, 0xc9, 0xe9, 0x5c, 0xdb, 0xd4, 0xea, 0x0d, 0x1f,
+		0x6e, 0x84, 0xe2, 0xca, 0x22, 0xc3, 0x41, 0x5e, 0x86, 0xe3, 0x82, 0x6f, 0x15, 0xdb, 0x5a, 0x65,
+		0x1f, 0x57, 0x5d, 0xa2, 0x71, 0xba, 0xd3, 0x7e, 0x8c, 0x23, 0xe4, 0x79, 0xbe, 0xa0, 0x55, 0x3e,
+		0x1f, 0x82, 0x69, 0xb1, 0x67, 0x58, 0x75, 0x94, 0xb5, 0x0e, 0xa0, 0xe9, 0xba, 0x61, 0x7b, 0xd5,
+		0xd5, 0x19, 0xe6, 0x75, 0xd0, 0x2d, 0x66, 0x1d, 0x22, 0xd5, 0xc3, 0x20, 0xf3, 0xa7, 0x12, 0x80,
+		0x9b, 0xd5, 0x53, 0x6f, 0xf3, 0x30, 0xc1, 0x0f, 0xbd, 0xd2, 0x93, 0xd3, 0x6c, 0x69, 0x0d, 0x18,
+		0x88, 0xec, 0x2e, 0x92, 0x55, 0xb7, 0x5d, 0x5c, 0xab, 0xeb, 0xfc, 0x14, 0x13, 0x4b, 0x88, 0xc3,
+		0x00, 0x11, 0xf7, 0x94, 0x9f, 0x0a, 0x71, 0x0b, 0x37, 0x35, 0xdd, 0xae, 0x57, 0x78, 0xaf, 0x39,
+		0x3f, 0x92, 0xf0, 0x8b, 0x25, 0x4e, 0xad, 0x3a, 0x7c, 0x94, 0xd3, 0x10, 0x17, 0x50, 0xc7, 0x3f,
+		0x8e, 0xa1, 0x18, 0x84, 0x4b, 0x05, 0x32, 0x42, 0x50, 0x37, 0x55, 0xcc, 0x96, 0xe4, 0xd0, 0x99,
+		0x4f, 0x86, 0x20, 0x26, 0xba, 0xf1, 0x0c, 0x4c, 0x15, 0xf2, 0xc5, 0x80, 0xab, 0x9d, 0x81, 0x94,
+		0x00, 0x32, 0x7f, 0x26, 0xbf, 0x27, 0xe6, 0x05, 0x6e, 0xa9, 0x9b, 0xdb, 0x9b, 0x4b, 0xf2, 0x57,
+		0x3b, 0x81, 0x8f, 0xc9, 0x5f, 0x8b, 0xa1, 0x69, 0x48, 0x0a, 0xe0, 0xd2, 0x23, 0x4b, 0x8f, 0xc9,
+		0xaf, 0x07, 0x41, 0x8f, 0xcb, 0x7f, 0x42, 0x57, 0x75, 0x04, 0xe8, 0xd1, 0xf2, 0x36, 0xf1, 0x97,
+		0x9b, 0x1b, 0x6b, 0xcf, 0xcb, 0x92, 0x37, 0x63, 0xc9, 0x93, 0x11, 0x42, 0x77, 0xc1, 0x31, 0x91,
+		0x71, 0xe1, 0xc2, 0x85, 0x0b, 0x4f, 0x78, 0x32, 0x6f, 0x7e, 0x60, 0x3c, 0x98, 0xfd, 0xa4, 0x27,
+		0xfb, 0x63, 0x9d, 0xd9, 0x17, 0x3c, 0xd9, 0x3f, 0xfd, 0x81, 0x71, 0x34, 0x03, 0x13, 0x22, 0x7b,
+		0x3d, 0xfb, 0x9c, 0xfc, 0x9d, 0xef, 0x7c, 0xe7, 0x3b, 0xb1, 0xdc, 0x0f, 0xc0, 0x4c, 0xc5, 0x68,
+		0x06, 0x9b, 0x26, 0x27, 0x07, 0x8e, 0x24, 0x58, 0x97, 0xa4, 0x17, 0x1e, 0xe6, 0x48, 0x35, 0xa3,
+		0xa1, 0xe9, 0xb5, 0x45, 0xc3, 0xac, 0xb9, 0x27, 0xf4, 0x49, 0x78, 0x69, 0x79, 0xce, 0xe9, 0xb7,
+		0x76, 0xff, 0x42, 0x92, 0x7e, 0x26, 0x14, 0x5e, 0xdd, 0xca, 0x7d, 0x2a, 0x94, 0x59, 0x65, 0x84,
+		0x5b, 0xa2, 0xe1, 0x55, 0xbc, 0xd7, 0xc0, 0x15, 0xd2, 0x3a, 0xf0, 0xf5, 0x07, 0x61, 0xb6, 0x66,
+		0xd4, 0x0c, 0xca, 0xe9, 0x2c, 0xf9, 0xc7, 0x84, 0x40, 0x09, 0x07, 0x9a, 0x19, 0x78, 0x1f, 0x60,
+		0x79, 0x03, 0x66, 0x38, 0x72, 0x99, 0x46, 0xbb, 0x6c, 0xd7, 0x14, 0xf5, 0x3d, 0x79, 0x93, 0xfe,
+		0x95, 0xaf, 0xd0, 0x65, 0x0a, 0x75, 0x9a, 0x93, 0x92, 0x3c, 0xb6, 0xb1, 0xba, 0xac, 0xc2, 0x11,
+		0x1f, 0x3f, 0x36, 0xd3, 0xc0, 0xe6, 0x00, 0x8e, 0xbf, 0xc3, 0x39, 0xce, 0x78, 0x38, 0x96, 0x38,
+		0xe9, 0xf2, 0x0a, 0x4c, 0x8e, 0xc2, 0xeb, 0x5f, 0x70, 0x5e, 0x49, 0xec, 0x65, 0xb2, 0x0a, 0x53,
+		0x94, 0x49, 0xa5, 0x6d, 0xd9, 0x46, 0x93, 0x4e, 0xe3, 0xfa, 0xb3, 0xf9, 0xdd, 0xaf, 0x30, 0xf7,
+		0x96, 0x22, 0x64, 0x2b, 0x0e, 0xd5, 0xf2, 0x32, 0xd0, 0xa8, 0x9d, 0x1c, 0x27, 0x1d, 0xc0, 0xe1,
+		0xf7, 0xb8, 0x20, 0x0e, 0xfe, 0xf2, 0x55, 0x98, 0x25, 0xff, 0xe9, 0x2c, 0xcb, 0x2b, 0xc9, 0xe0,
+		0x63, 0x3a, 0xe9, 0x2f, 0xfc, 0x10, 0xf3, 0xa0, 0x33, 0x0e, 0x03, 0x8f, 0x4c, 0x9e, 0x56, 0xac,
+		0x61, 0xdb, 0xc6, 0xa6, 0x55, 0xd6, 0x1a, 0xdd, 0xc4, 0xf3, 0x9c, 0x73, 0x48, 0x7f, 0xe4, 0x1b,
+		0xfe, 0x56, 0x5c, 0x65, 0x94, 0xd9, 0x46, 0x63, 0x79, 0x07, 0x8e, 0x75, 0xb1, 0x8a, 0x21, 0x78,
+		0x7e, 0x94, 0xf3, 0x9c, 0xed, 0xb0, 0x0c, 0xc2, 0x76, 0x0b, 0x04, 0xdc, 0x69, 0xcb, 0x21, 0x78,
+		0xfe, 0x24, 0xe7, 0x89, 0x38, 0xad, 0x68, 0x52, 0xc2, 0xf1, 0x32, 0x4c, 0x5f, 0xc7, 0xe6, 0xae,
+		0x61, 0xf1, 0xb3, 0x25, 0x43, 0xb0, 0xfb, 0x29, 0xce, 0x6e, 0x8a, 0x13, 0xd2, 0xc3, 0x26, 0x84,
+		0xd7, 0x05, 0x88, 0xef, 0x69, 0x15, 0x3c, 0x04, 0x8b, 0x9b, 0x9c, 0x45, 0x8c, 0xe0, 0x13, 0xd2,
+		0x2c, 0x24, 0x6b, 0x06, 0x9f, 0x68, 0x0f, 0x26, 0xff, 0x18, 0x27, 0x9f, 0x10, 0x34, 0x9c, 0x45,
+		0xcb, 0x68, 0xb5, 0x1b, 0x64, 0x16, 0x3e, 0x98, 0xc5, 0x4f, 0x0b, 0x16, 0x82, 0x86, 0xb3, 0x18,
+		0x41, 0xad, 0x1f, 0x17, 0x2c, 0x2c, 0x8f, 0x3e, 0x9f, 0x26, 0x47, 0x4e, 0x1b, 0x07, 0x86, 0x3e,
+		0x8c, 0x10, 0x9f, 0xe0, 0x1c, 0x80, 0x93, 0x10, 0x06, 0x4f, 0x41, 0x62, 0xd8, 0x86, 0xf8, 0xbb,
+		0xdf, 0x10, 0xdd, 0x43, 0xb4, 0xc0, 0x2a, 0x4c, 0x09, 0x07, 0x45, 0x36, 0x6c, 0x06, 0xb3, 0xf8,
+		0x7b, 0x9c, 0x45, 0xca, 0x43, 0xc6, 0xab, 0x61, 0x63, 0xcb, 0xae, 0xe1, 0x61, 0x98, 0x7c, 0x52,
+		0x54, 0x83, 0x93, 0x70, 0x55, 0xee, 0x62, 0xbd, 0xb2, 0x3f, 0x1c, 0x87, 0x9f, 0x17, 0xaa, 0x14,
+		0x34, 0x84, 0xc5, 0x0a, 0x4c, 0x36, 0x35, 0xd3, 0xda, 0xd7, 0x1a, 0x43, 0x35, 0xc7, 0xdf, 0xe7,
+		0x3c, 0x92, 0x0e, 0x11, 0xd7, 0x48, 0x5b, 0x1f, 0x85, 0xcd, 0xa7, 0x84, 0x46, 0xda, 0xba, 0x8f,
+		0xd1, 0x16, 0xcc, 0x5a, 0x36, 0x8d, 0x7c, 0x47, 0xe1, 0xf6, 0x0b, 0xa2, 0xeb, 0x31, 0xda, 0x75,
+		0x2f, 0xc7, 0xa7, 0x20, 0x61, 0xd5, 0x5f, 0x1e, 0x8a, 0xcd, 0xa7, 0x45, 0x4b, 0x53, 0x02, 0x42,
+		0xfc, 0x3c, 0x1c, 0xef, 0x3a, 0x4c, 0x0c, 0xc1, 0xec, 0x17, 0x39, 0xb3, 0xa3, 0x5d, 0x86, 0x0a,
+		0xee, 0x12, 0x46, 0x65, 0xf9, 0x0f, 0x84, 0x4b, 0xc0, 0x01, 0x5e, 0x5b, 0x64, 0xe9, 0xd3, 0xd2,
+		0xf6, 0x46, 0xd3, 0xda, 0x2f, 0x09, 0xad, 0x31, 0x5a, 0x9f, 0xd6, 0xb6, 0xe1, 0x28, 0xe7, 0x38,
+		0x5a, 0xbb, 0xfe, 0xb2, 0x70, 0xac, 0x8c, 0x7a, 0xc7, 0xdf, 0xba, 0xdf, 0x0d, 0x19, 0x47, 0x9d,
+		0x62, 0x8d, 0xcd, 0x2a, 0x93, 0x13, 0x2a, 0x83, 0x39, 0xff, 0x0a, 0xe7, 0x2c, 0x3c, 0xbe, 0xb3,
+		0x48, 0x67, 0xad, 0x6b, 0x2d, 0xc2, 0xfc, 0x39, 0x48, 0x0b, 0xe6, 0x6d, 0xdd, 0xc4, 0x15, 0xa3,
+		0xa6, 0xd7, 0x5f, 0xc6, 0xd5, 0x21, 0x58, 0xff, 0x6a, 0xa0, 0xa9, 0x76, 0x3c, 0xe4, 0x84, 0x73,
+		0x11, 0x64, 0x27, 0x56, 0x29, 0xd7, 0x9b, 0x74, 0x3f, 0xa2, 0x3f, 0xc7, 0x5f, 0x13, 0x2d, 0xe5,
+		0xd0, 0x15, 0x29, 0xd9, 0x72, 0x01, 0xd8, 0xe9, 0xf4, 0x61, 0x4d, 0xf2, 0x33, 0x9c, 0xd1, 0xa4,
+		0x4b, 0xc5, 0x1d, 0x47, 0xc5, 0x68, 0xb6, 0x34, 0x73, 0x18, 0xff, 0xf7, 0x0f, 0x85, 0xe3, 0xe0,
+		0x24, 0xdc, 0x71, 0x90, 0x88, 0x8e, 0x8c, 0xf6, 0x43, 0x70, 0xf8, 0x75, 0xe1, 0x38, 0x04, 0x0d,
+		0x67, 0x21, 0x02, 0x86, 0x21, 0x58, 0xfc, 0x23, 0xc1, 0x42, 0xd0, 0x10, 0x16, 0xcf, 0xb8, 0x03,
+		0xad, 0x89, 0x6b, 0x75, 0xcb, 0xe6, 0xf7, 0x47, 0xfa, 0xb3, 0xfa, 0x8d, 0x6f, 0xf8, 0x83, 0x30,
+		0xd5, 0x43, 0x4a, 0x3c, 0x11, 0x5f, 0x18, 0xa3, 0x0b, 0xbf, 0x83, 0x05, 0xfb, 0x4d, 0xe1, 0x89,
+		0x3c, 0x64, 0x44, 0x36, 0x4f, 0x84, 0x48, 0xd4, 0x5e, 0x21, 0x53, 0xba, 0x21, 0xd8, 0xfd, 0xe3,
+		0x80, 0x70, 0x25, 0x41, 0x4b, 0x78, 0x7a, 0xe2, 0x9f, 0xb6, 0x7e, 0x0d, 0x1f, 0x0c, 0x65, 0x9d,
+		0xff, 0x24, 0x10, 0xff, 0xec, 0x30, 0x4a, 0xe6, 0x43, 0xa6, 0x02, 0xf1, 0x14, 0x1a, 0x74, 0xb9,
+		0x2c, 0xfd, 0x83, 0xdf, 0xe2, 0xf5, 0xf5, 0x87, 0x53, 0xcb, 0x6b, 0x20, 0x73, 0x88, 0x1b, 0xc0,
+		0x0e, 0x64, 0xf6, 0x43, 0xdf, 0x72, 0xec, 0xdc, 0x17, 0xf3, 0x2c, 0x5f, 0x84, 0x49, 0x5f, 0xc0,
+		0x33, 0x98, 0xd5, 0x7b, 0x38, 0xab, 0xa4, 0x37, 0xde, 0x59, 0x3e, 0x07, 0x11, 0x12, 0xbc, 0x0c,
+		0x26, 0xff, 0xff, 0x39, 0x39, 0x45, 0x5f, 0x7e, 0x3b, 0xc4, 0x45, 0xd0, 0x32, 0x98, 0xf4, 0x87,
+		0x39, 0xa9, 0x43, 0x42, 0xc8, 0x45, 0xc0, 0x32, 0x98, 0xfc, 0x47, 0x04, 0xb9, 0x20, 0x21, 0xe4,
+		0xc3, 0xab, 0xf0, 0xb7, 0xde, 0x1f, 0x61, 0xe4, 0x82, 0x64, 0x99, 0x9c, 0x8e, 0x67, 0x91, 0xca,
+		0x60, 0xea, 0xf7, 0xf1, 0xc2, 0x05, 0xc5, 0xf2, 0x13, 0x10, 0x1d, 0x52, 0xe1, 0x1f, 0xe0, 0xa4,
+		0x0c, 0x7f, 0x79, 0x05, 0x26, 0x3c, 0xd1, 0xc9, 0x60, 0xf2, 0xbf, 0xcd, 0xc9, 0xbd, 0x54, 0x44,
+		0x74, 0x1e, 0x9d, 0x0c, 0x66, 0xf0, 0x41, 0x21, 0x3a, 0xa7, 0x20, 0x6a, 0x13, 0x81, 0xc9, 0x60,
+		0xea, 0x0f, 0x09, 0xad, 0x0b, 0x92, 0xe5, 0xa7, 0x21, 0xe1, 0x0c, 0x36, 0x83, 0xe9, 0x3f, 0xcc,
+		0xe9, 0x5d, 0x1a, 0xa2, 0x81, 0xb6, 0x3e, 0x02, 0x8b, 0xbf, 0x23, 0x34, 0xe0, 0xa1, 0x22, 0xdd,
+		0x28, 0x18, 0xc0, 0x0c, 0xe6, 0xf4, 0xa3, 0xa2, 0x1b, 0x05, 0xe2, 0x17, 0xd2, 0x9a, 0xd4, 0xe7,
+		0x0f, 0x66, 0xf1, 0x63, 0xa2, 0x35, 0x29, 0x3e, 0x11, 0x23, 0x18, 0x11, 0x0c, 0xe6, 0xf1, 0x13,
+		0x42, 0x8c, 0x40, 0x40, 0xb0, 0xbc, 0x05, 0xa8, 0x33, 0x1a, 0x18, 0xcc, 0xef, 0x35, 0xce, 0x6f,
+		0xba, 0x23, 0x18, 0x58, 0x7e, 0x16, 0x8e, 0x76, 0x8f, 0x04, 0x06, 0x73, 0xfd, 0xc8, 0xb7, 0x02,
+		0x73, 0x37, 0x6f, 0x20, 0xb0, 0xbc, 0x0d, 0xb3, 0xdd, 0xa2, 0x80, 0xc1, 0x6c, 0x3f, 0xfa, 0x2d,
+		0xbf, 0xe3, 0xf6, 0x06, 0x01, 0xcb, 0x59, 0x00, 0x77, 0x00, 0x1e, 0xcc, 0xeb, 0xa7, 0x38, 0x2f,
+		0x0f, 0x11, 0xe9, 0x1a, 0x7c, 0xfc, 0x1d, 0x4c, 0x7f, 0x53, 0x74, 0x0d, 0x4e, 0x41, 0xba, 0x86,
+		0x18, 0x7a, 0x07, 0x53, 0x7f, 0x4c, 0x74, 0x0d, 0x41, 0x42, 0x2c, 0xdb, 0x33, 0xba, 0x0d, 0xe6,
+		0xf0, 0x09, 0x61, 0xd9, 0x1e, 0xaa, 0xe5, 0x0d, 0x98, 0xee, 0x18, 0x10, 0x07, 0xb3, 0xfa, 0x19,
+		0xce, 0x4a, 0x0e, 0x8e, 0x87, 0xde, 0xc1, 0x8b, 0x0f, 0x86, 0x83, 0xb9, 0xfd, 0x6c, 0x60, 0xf0,
+		0xe2, 0x63, 0xe1, 0xf2, 0x53, 0x10, 0xd7, 0xdb, 0x8d, 0x06, 0xe9, 0x3c, 0xa8, 0xff, 0xfd, 0xc1,
+		0xf4, 0x9f, 0x7c, 0x9b, 0x6b, 0x47, 0x10, 0x2c, 0x9f, 0x83, 0x28, 0x6e, 0xee, 0xe2, 0xea, 0x20,
+		0xca, 0xaf, 0x7f, 0x5b, 0x38, 0x4c, 0x82, 0xbd, 0xfc, 0x34, 0x00, 0x5b, 0x1a, 0xa1, 0x67, 0x70,
+		0x07, 0xd0, 0xfe, 0xe9, 0xb7, 0xf9, 0x85, 0x1d, 0x97, 0xc4, 0x65, 0xc0, 0xae, 0xff, 0xf4, 0x67,
+		0xf0, 0x0d, 0x3f, 0x03, 0xda, 0x22, 0x17, 0x20, 0x46, 0xb6, 0xde, 0x6c, 0xad, 0x36, 0x88, 0xfa,
+		0xbf, 0x72, 0x6a, 0x81, 0x4f, 0x14, 0xd6, 0x34, 0x4c, 0x6c, 0x6b, 0x35, 0x6b, 0x10, 0xed, 0x7f,
+		0xe3, 0xb4, 0x0e, 0x01, 0x21, 0xae, 0x68, 0x96, 0x3d, 0x4c, 0xbd, 0xff, 0x4c, 0x10, 0x0b, 0x02,
+		0x22, 0x34, 0xf9, 0x7f, 0x0d, 0x1f, 0x0c, 0xa2, 0xfd, 0xa6, 0x10, 0x9a, 0xe3, 0x2f, 0xbf, 0x1d,
+		0x12, 0xe4, 0x2f, 0xbb, 0x85, 0x37, 0x80, 0xf8, 0xcf, 0x39, 0xb1, 0x4b, 0x41, 0x4a, 0xb6, 0xec,
+		0xaa, 0x5d, 0x1f, 0xac, 0xec, 0x37, 0x78, 0x4b, 0x0b, 0xfc, 0xe5, 0x2c, 0x4c, 0x58, 0x76, 0xb5,
+		0xda, 0xe6, 0xf1, 0xe9, 0x00, 0xf2, 0xff, 0xfe, 0x6d, 0x67, 0xc9, 0xc2, 0xa1, 0x21, 0xad, 0xfd,
+		0xd2, 0x35, 0xbb, 0x65, 0xd0, 0x53, 0x1b, 0x83, 0x38, 0x7c, 0x8b, 0x73, 0xf0, 0x90, 0x2c, 0xaf,
+		0x40, 0x92, 0xd4, 0x45, 0x6c, 0x7e, 0x0f, 0x62, 0xf1, 0x3f, 0xb8, 0x02, 0x7c, 0x44, 0xb9, 0xef,
+		0xf9, 0xbd, 0x2f, 0xcd, 0x49, 0x9f, 0xff, 0xd2, 0x9c, 0xf4, 0x9f, 0xbf, 0x34, 0x27, 0x7d, 0xe8,
+		0xcb, 0x73, 0x63, 0x9f, 0xff, 0xf2, 0xdc, 0xd8, 0x1f, 0x7e, 0x79, 0x6e, 0xac, 0xfb, 0x2a, 0x31,
+		0xac, 0x1a, 0xab, 0x06, 0x5b, 0x1f, 0x7e, 0x41, 0xa9, 0xd5, 0xed, 0xfd, 0xf6, 0xee, 0x62, 0xc5,
+		0x68, 0xd2, 0x65, 0x5c, 0x77, 0xb5, 0xd6, 0x99, 0xe4, 0xc0, 0xbb, 0xc3, 0x70, 0xbc, 0x62, 0x58,
+		0x4d, 0xc3, 0x2a, 0xb3, 0xf5, 0x5e, 0x96, 0x60, 0x0c, 0x51, 0xd2, 0x9b, 0x35, 0xc4, 0xa2, 0xef,
+		0x25, 0x48, 0xd1, 0xaa, 0xd3, 0xe5, 0x2e, 0x6a, 0x6d, 0x03, 0x1d, 0xc4, 0x67, 0xff, 0x7d, 0x94,
+		0xd6, 0x7a, 0xd2, 0x21, 0xa4, 0x87, 0xe6, 0xb7, 0x61, 0xb6, 0xde, 0x6c, 0x35, 0x30, 0xdd, 0x8f,
+		0x29, 0x3b, 0x79, 0x83, 0xf9, 0x7d, 0x8e, 0xf3, 0x9b, 0x71, 0xc9, 0x8b, 0x82, 0x7a, 0x79, 0x0d,
+		0xa6, 0xc9, 0xdd, 0x8d, 0x96, 0x8f, 0xe5, 0x80, 0x66, 0x11, 0x02, 0xca, 0x9c, 0xd2, 0xe1, 0x96,
+		0x7b, 0xba, 0x57, 0xd3, 0xbc, 0x70, 0x9f, 0x47, 0xf3, 0x26, 0xae, 0x61, 0xfd, 0x61, 0x1d, 0xdb,
+		0x2f, 0x19, 0xe6, 0x35, 0xae, 0xde, 0x87, 0x59, 0x51, 0xe3, 0xf4, 0xe7, 0x31, 0x78, 0x4f, 0x18,
+		0xe6, 0x58, 0xc6, 0xd9, 0x5d, 0xcd, 0xc2, 0x67, 0xaf, 0x3f, 0xba, 0x8b, 0x6d, 0xed, 0xd1, 0xb3,
+		0x15, 0xa3, 0xae, 0xf3, 0x96, 0x98, 0xe1, 0xed, 0x42, 0xf2, 0x17, 0x79, 0x7e, 0xa6, 0xeb, 0x32,
+		0xbd, 0xb2, 0x0a, 0x91, 0x15, 0xa3, 0x4e, 0x4f, 0x63, 0x57, 0xb1, 0x6e, 0x34, 0xf9, 0x4d, 0x3d,
+		0x96, 0x40, 0xf7, 0xc0, 0xb8, 0xd6, 0x34, 0xda, 0xba, 0xcd, 0x76, 0x92, 0x72, 0x13, 0xbf, 0x77,
+		0x6b, 0x7e, 0xec, 0x8f, 0x6e, 0xcd, 0x87, 0x8b, 0xba, 0xad, 0xf2, 0xac, 0xe5, 0xc8, 0xeb, 0x1f,
+		0x9f, 0x97, 0x94, 0xcb, 0x10, 0xcb, 0xe3, 0xca, 0x61, 0x78, 0xe5, 0x71, 0x25, 0xc0, 0xeb, 0x01,
+		0x88, 0x17, 0x75, 0x9b, 0xdd, 0xa5, 0xbc, 0x0b, 0xc2, 0x75, 0x9d, 0x5d, 0xc1, 0x09, 0x94, 0x4f,
+		0xe0, 0x04, 0x35, 0x8f, 0x2b, 0x0e, 0x6a, 0x15, 0x57, 0xd2, 0x52, 0x27, 0x7b, 0x02, 0xcf, 0xe5,
+		0xff, 0xf0, 0xbf, 0xcc, 0x8d, 0xbd, 0xf2, 0xa5, 0xb9, 0xb1, 0x9e, 0x2d, 0xe1, 0xed, 0x03, 0x5c,
+		0xc5, 0xbc, 0x09, 0xac, 0xea, 0x35, 0xb6, 0x47, 0xe2, 0x34, 0xc3, 0xbf, 0x1a, 0x07, 0x85, 0xe3,
+		0x58, 0xb6, 0x76, 0xad, 0xae, 0xd7, 0x9c, 0x96, 0xd0, 0xda, 0xf6, 0xfe, 0xcb, 0xbc, 0x29, 0x8e,
+		0xf2, 0xa6, 0xe0, 0x38, 0xfd, 0x5b, 0x23, 0xd3, 0xbb, 0x77, 0x65, 0x06, 0xb4, 0xb9, 0xf2, 0xfb,
+		0x61, 0x40, 0x25, 0x5b, 0xbb, 0x86, 0xb3, 0x6d, 0x7b, 0xdf, 0x30, 0xeb, 0x2f, 0x33, 0x5f, 0x86,
+		0x01, 0x9a, 0xda, 0x8d, 0xb2, 0x6d, 0x5c, 0xc3, 0xba, 0x38, 0x42, 0x7c, 0x7c, 0xb1, 0x8b, 0x7d,
+		0x2c, 0x92, 0xa6, 0xcb, 0x3d, 0xf8, 0xa9, 0x2f, 0xce, 0xdf, 0x3f, 0x58, 0x0b, 0x14, 0x99, 0x04,
+		0xd7, 0x37, 0xb6, 0x29, 0x63, 0x74, 0x15, 0xd8, 0x31, 0xdf, 0x72, 0xa3, 0x6e, 0xd9, 0xfc, 0xec,
+		0xe9, 0xb9, 0xc5, 0xee, 0x75, 0x5f, 0xec, 0x14, 0x73, 0x91, 0x1f, 0xb1, 0x30, 0x4c, 0xeb, 0xd2,
+		0x98, 0x9a, 0xa0, 0xac, 0xd6, 0xea, 0x96, 0x8d, 0xb6, 0x21, 0x51, 0xc5, 0xfa, 0x01, 0x63, 0x1b,
+		0x7e, 0x73, 0x6c, 0xe3, 0x84, 0x13, 0xe5, 0xfa, 0x1c, 0x20, 0xcd, 0x8b, 0x27, 0xde, 0xa7, 0x61,
+		0x67, 0xbd, 0x7a, 0xb0, 0xf7, 0x71, 0xa6, 0x77, 0x4a, 0xa6, 0xb5, 0x20, 0x28, 0x73, 0x0a, 0xc0,
+		0x2d, 0x93, 0x3c, 0x2d, 0xa5, 0x55, 0xab, 0x26, 0xb6, 0xd8, 0xe9, 0x88, 0x84, 0x2a, 0x92, 0xcb,
+		0xd3, 0xff, 0xe6, 0x33, 0x0f, 0x4f, 0xfa, 0x38, 0xe6, 0x92, 0x00, 0xd7, 0x1d, 0xd2, 0x33, 0x1f,
+		0x93, 0x60, 0xba, 0xa3, 0x44, 0xa4, 0xc0, 0x5c, 0x76, 0x67, 0xfb, 0xd2, 0xa6, 0x5a, 0x7c, 0x81,
+		0x1d, 0x3e, 0xe1, 0xc7, 0x63, 0x4a, 0x5b, 0x85, 0x15, 0xf6, 0xc0, 0xc5, 0x18, 0x39, 0x25, 0xdf,
+		0x05, 0x27, 0x5f, 0x20, 0xfb, 0xa4, 0xdb, 0xe4, 0x18, 0xfd, 0xdd, 0x70, 0x57, 0x57, 0x26, 0x0e,
+		0x4a, 0xa8, 0x07, 0x8a, 0x5a, 0x70, 0x50, 0xc2, 0xb9, 0x8b, 0x3d, 0x7b, 0xd1, 0x43, 0x7d, 0xed,
+		0xe7, 0x86, 0xd3, 0x5d, 0xfc, 0xfd, 0xe9, 0x07, 0x43, 0x70, 0x9c, 0xb9, 0x56, 0x77, 0xc8, 0xd0,
+		0xf4, 0x83, 0x1e, 0x0f, 0x86, 0xf5, 0xf0, 0x66, 0x97, 0x20, 0x9c, 0xd5, 0x0f, 0xd0, 0x71, 0x16,
+		0x4f, 0x97, 0xdb, 0x66, 0x83, 0xfb, 0xa0, 0x18, 0x49, 0xef, 0x98, 0x0d, 0xff, 0xad, 0x93, 0x24,
+		0xbf, 0x75, 0xb2, 0x2c, 0xbf, 0xf6, 0xf1, 0xf9, 0xb1, 0x5f, 0xfe, 0xf8, 0xfc, 0xd8, 0x37, 0x3f,
+		0x31, 0x3f, 0xf6, 0xca, 0x1f, 0x2f, 0x8c, 0xe5, 0xae, 0x05, 0xab, 0xf7, 0x5b, 0x03, 0x47, 0xd3,
+		0x78, 0x56, 0x3f, 0xa0, 0x8e, 0x68, 0x4b, 0x7a, 0x21, 0x4a, 0x2b, 0x27, 0x36, 0x50, 0xe7, 0x82,
+		0x1b, 0xa8, 0xcf, 0xe2, 0x46, 0xe3, 0x8a, 0x6e, 0xbc, 0xa4, 0x6f, 0xfb, 0x74, 0xf0, 0xa3, 0x21,
+		0x98, 0xeb, 0x18, 0x36, 0x79, 0x84, 0xd1, 0xeb, 0xe5, 0xb4, 0x65, 0x88, 0xe7, 0x39, 0x0a, 0xb1,
+		0x37, 0x0b, 0x57, 0x0c, 0xbd, 0xca, 0x7a, 0x7a, 0x58, 0x15, 0x49, 0x52, 0x6d, 0x5d, 0xd3, 0x0d,
+		0x8b, 0xdf, 0xd4, 0x67, 0x89, 0xdc, 0x4f, 0x4a, 0xa3, 0xc5, 0x0b, 0x93, 0xa2, 0x24, 0x51, 0xcd,
+		0x47, 0x07, 0x6e, 0x29, 0x5f, 0x23, 0xb5, 0x74, 0x2a, 0xe1, 0xdb, 0x56, 0x1e, 0x56, 0x2b, 0x3f,
+		0x11, 0x82, 0xf9, 0xa0, 0x56, 0x48, 0xd8, 0x66, 0xd9, 0x5a, 0xb3, 0xd5, 0x4b, 0x2d, 0x4f, 0x41,
+		0x62, 0x5b, 0xe0, 0x8c, 0xac, 0x97, 0x9b, 0x23, 0xea, 0x25, 0xe5, 0x14, 0x25, 0x14, 0xb3, 0x34,
+		0xa4, 0x62, 0x9c, 0x7a, 0x1c, 0x4a, 0x33, 0x9f, 0x8a, 0xc0, 0x5d, 0xf4, 0x29, 0x17, 0xb3, 0x59,
+		0xd7, 0xed, 0xb3, 0x15, 0xf3, 0xa0, 0x65, 0xd3, 0xc0, 0xcd, 0xd8, 0xe3, 0x7a, 0x99, 0x76, 0xb3,
+		0x17, 0x59, 0x76, 0x8f, 0x9e, 0xb3, 0x07, 0xd1, 0x2d, 0x42, 0x47, 0x34, 0x62, 0x1b, 0xb6, 0xd6,
+		0xe0, 0x9a, 0x62, 0x09, 0x02, 0x65, 0xcf, 0xbf, 0x84, 0x18, 0xb4, 0x2e, 0x5e, 0x7e, 0x69, 0x60,
+		0x6d, 0x8f, 0xdd, 0xa2, 0x0f, 0xd3, 0x0e, 0x15, 0x27, 0x00, 0x7a, 0x61, 0x7e, 0x16, 0xa2, 0x5a,
+		0x9b, 0x9d, 0xb9, 0x09, 0x93, 0x9e, 0x46, 0x13, 0xca, 0x15, 0x88, 0xf1, 0x0d, 0x65, 0x72, 0xe8,
+		0xe4, 0x1a, 0x3e, 0xa0, 0xe5, 0x24, 0x55, 0xf2, 0x17, 0x2d, 0x42, 0x94, 0x0a, 0xcf, 0x07, 0x90,
+		0xf4, 0x62, 0x87, 0xf4, 0x8b, 0x54, 0x48, 0x95, 0xa1, 0x29, 0x97, 0x21, 0x9e, 0x37, 0x9a, 0x75,
+		0xdd, 0xf0, 0x73, 0x4b, 0x30, 0x6e, 0x54, 0xe6, 0x56, 0xdb, 0x16, 0x17, 0xcc, 0x68, 0x82, 0x5c,
+		0xd7, 0x64, 0xaf, 0x2a, 0xf0, 0x73, 0x43, 0x3c, 0xa5, 0xac, 0x40, 0x8c, 0xf2, 0xde, 0x6c, 0x39,
+		0x4f, 0x15, 0x49, 0x9e, 0xa7, 0x8a, 0x38, 0xfb, 0x90, 0x2b, 0x2c, 0x82, 0x48, 0x55, 0xb3, 0x35,
+		0x5e, 0x6f, 0xfa, 0x5f, 0x79, 0x07, 0xc4, 0x39, 0x13, 0x0b, 0x2d, 0x41, 0xd8, 0x68, 0x89, 0x63,
+		0x71, 0x99, 0x5e, 0x55, 0xd9, 0x6c, 0xe5, 0x22, 0x24, 0x52, 0x51, 0x09, 0x72, 0x4e, 0xed, 0xe9,
+		0x54, 0x9f, 0xf4, 0x38, 0x55, 0x4f, 0x93, 0x7b, 0xfe, 0xb2, 0x26, 0xed, 0x30, 0x07, 0xc7, 0x58,
+		0x3e, 0x11, 0x82, 0x39, 0x4f, 0xee, 0x75, 0x6c, 0x92, 0x55, 0x15, 0x3e, 0x9e, 0x33, 0x6b, 0x41,
+		0x1e, 0x21, 0x79, 0x7e, 0x0f, 0x73, 0x79, 0x3b, 0x84, 0xb3, 0xad, 0x16, 0x79, 0x5c, 0x88, 0xa6,
+		0x2b, 0x06, 0xb3, 0x97, 0x88, 0xea, 0xa4, 0x49, 0x9e, 0x65, 0xec, 0xd9, 0x2f, 0x69, 0xa6, 0xf3,
+		0xf0, 0x90, 0x48, 0x2b, 0x17, 0x20, 0xb1, 0x62, 0xe8, 0x16, 0xd6, 0xad, 0x36, 0xed, 0x83, 0xbb,
+		0x0d, 0xa3, 0x72, 0x8d, 0x73, 0x60, 0x09, 0xa2, 0x70, 0xad, 0xd5, 0xa2, 0x94, 0x11, 0x95, 0xfc,
+		0x65, 0xb1, 0x61, 0xae, 0xd4, 0x53, 0x45, 0x17, 0x46, 0x57, 0x11, 0xaf, 0xa4, 0xa3, 0xa3, 0xff,
+		0x2d, 0xc1, 0xc9, 0xce, 0x0e, 0x75, 0x0d, 0x1f, 0x58, 0xa3, 0xf6, 0xa7, 0xe7, 0x20, 0xb1, 0x45,
+		0x9f, 0x80, 0xbc, 0x82, 0x0f, 0x50, 0x86, 0x9c, 0x14, 0x5c, 0x3a, 0x77, 0xee, 0xd1, 0x0b, 0xcc,
+		0xda, 0x2f, 0x8d, 0xa9, 0x02, 0x80, 0xe6, 0x20, 0x61, 0xe1, 0x4a, 0x6b, 0xe9, 0xdc, 0xf9, 0x6b,
+		0x8f, 0x32, 0xf3, 0x22, 0x11, 0x90, 0x03, 0x5a, 0x8e, 0x93, 0x5a, 0xbf, 0xfe, 0x89, 0x79, 0x29,
+		0x17, 0x85, 0xb0, 0xd5, 0x6e, 0xde, 0x51, 0x1b, 0xf9, 0x68, 0x14, 0x16, 0xbc, 0x94, 0xd4, 0x53,
+		0x39, 0x51, 0x09, 0xd7, 0x81, 0xec, 0xd1, 0x01, 0xc5, 0xe8, 0x11, 0xcc, 0xf6, 0xd5, 0xa4, 0xf2,
+		0xab, 0x12, 0x24, 0x9d, 0x50, 0x89, 0x9c, 0xbe, 0x7f, 0xca, 0x1b, 0xff, 0xf0, 0x6e, 0x73, 0x62,
+		0x31, 0x58, 0x96, 0x1b, 0xd2, 0xa9, 0x1e, 0x74, 0x72, 0xad, 0xa4, 0x65, 0x1a, 0x2d, 0xc3, 0xe2,
+		0x8f, 0xd1, 0x0c, 0x20, 0x75, 0x90, 0xc9, 0x5d, 0x07, 0xea, 0xe1, 0xca, 0xd7, 0x0d, 0x9b, 0x9c,
+		0x9b, 0x68, 0x19, 0x2f, 0xf1, 0x27, 0xbe, 0xc2, 0xaa, 0x4c, 0x73, 0xae, 0xd2, 0x8c, 0x2d, 0x02,
+		0x27, 0x42, 0x27, 0x1c, 0x2e, 0xfe, 0xf0, 0x8e, 0x38, 0x01, 0x91, 0x24, 0x2f, 0xe0, 0xb4, 0xda,
+		0xbb, 0x65, 0xe1, 0x31, 0xc8, 0x1b, 0x42, 0x5d, 0xfa, 0xbf, 0xb0, 0x0f, 0xee, 0x01, 0xc6, 0x5b,
+		0xed, 0x5d, 0x62, 0x2d, 0x77, 0x43, 0xb2, 0x8b, 0x30, 0x13, 0xd7, 0x5d, 0x39, 0xe8, 0xcb, 0xa3,
+		0xbc, 0x06, 0xe5, 0x96, 0x59, 0x37, 0xcc, 0xba, 0x7d, 0x40, 0xe3, 0xd7, 0xb0, 0x2a, 0x8b, 0x8c,
+		0x2d, 0x0e, 0x57, 0xae, 0xc1, 0x54, 0x89, 0xce, 0x6f, 0x5d, 0xc9, 0xcf, 0xb9, 0xf2, 0x49, 0x83,
+		0xe5, 0xeb, 0x29, 0x59, 0xa8, 0x43, 0xb2, 0xdc, 0x33, 0x3d, 0xad, 0xf3, 0x89, 0xd1, 0xad, 0xd3,
+		0x1f, 0x21, 0xfe, 0xd9, 0x71, 0x38, 0x19, 0xcc, 0xf4, 0xb9, 0xaf, 0x61, 0x0d, 0x73, 0x50, 0x34,
+		0x91, 0xe9, 0x3f, 0xa8, 0x66, 0x06, 0xb8, 0xd1, 0xcc, 0xc0, 0x2e, 0xa4, 0x5c, 0x80, 0x49, 0x72,
+		0x47, 0xa5, 0x84, 0xed, 0x4b, 0x58, 0xab, 0x62, 0xd3, 0x3f, 0xea, 0x4e, 0x8a, 0x51, 0x17, 0x41,
+		0x84, 0x0e, 0xad, 0x6c, 0xd4, 0xa1, 0xff, 0x95, 0x7d, 0x88, 0x10, 0x52, 0x77, 0x44, 0xe6, 0x14,
+		0x34, 0x41, 0xa0, 0xbb, 0x07, 0x36, 0xbf, 0xfe, 0x97, 0x54, 0x59, 0x02, 0x3d, 0x2e, 0xc6, 0xd5,
+		0x70, 0xff, 0x71, 0x95, 0x1b, 0x22, 0x1f, 0x5d, 0x1b, 0x10, 0xcb, 0x11, 0x57, 0x5c, 0xcc, 0x3b,
+		0x82, 0x48, 0xae, 0x20, 0x68, 0x1d, 0xa6, 0x5a, 0x9a, 0x69, 0xd3, 0xc7, 0x32, 0xf6, 0x69, 0x2d,
+		0xb8, 0xad, 0xcf, 0x77, 0xf6, 0x3c, 0x5f, 0x65, 0x79, 0x29, 0x93, 0x2d, 0x2f, 0x50, 0xf9, 0x6a,
+		0x04, 0xc6, 0xb9, 0x32, 0xde, 0x0e, 0x31, 0xae, 0x56, 0x6e, 0x9d, 0x77, 0x2d, 0x76, 0x0e, 0x4c,
+		0x8b, 0xce, 0x00, 0xc2, 0xf9, 0x09, 0x1a, 0x74, 0x0a, 0xe2, 0x95, 0x7d, 0xad, 0xae, 0x97, 0xeb,
+		0x55, 0xb1, 0xd4, 0xf0, 0xa5, 0x5b, 0xf3, 0xb1, 0x15, 0x02, 0x2b, 0xe6, 0xd5, 0x18, 0xcd, 0x2c,
+		0x56, 0x49, 0x24, 0xb0, 0x8f, 0xeb, 0xb5, 0x7d, 0x9b, 0xf7, 0x30, 0x9e, 0x22, 0xcf, 0x0e, 0x13,
+		0x83, 0xe0, 0x67, 0xb2, 0x33, 0x1d, 0x0b, 0x3e, 0x4e, 0xb0, 0x97, 0x8b, 0x93, 0x82, 0x3f, 0xf4,
+		0xc5, 0x79, 0x49, 0xa5, 0x14, 0x68, 0x05, 0x26, 0x1b, 0x9a, 0x65, 0x97, 0xe9, 0x08, 0x46, 0x8a,
+		0x8f, 0xf2, 0xf9, 0x76, 0x87, 0x42, 0xb8, 0x62, 0xb9, 0xe8, 0x13, 0x84, 0x8a, 0x81, 0xaa, 0xe4,
+		0xf9, 0x18, 0xca, 0x84, 0x1c, 0x3f, 0xae, 0xdb, 0x2c, 0xb6, 0x1a, 0xa7, 0x7a, 0x4f, 0x11, 0xf8,
+		0x0a, 0x05, 0xd3, 0x08, 0xeb, 0x04, 0x24, 0xe8, 0x8b, 0x30, 0x14, 0x85, 0xdd, 0xa9, 0x8a, 0x13,
+		0x00, 0xcd, 0xbc, 0x1f, 0xa6, 0x5c, 0xff, 0xc8, 0x50, 0xe2, 0x8c, 0x8b, 0x0b, 0xa6, 0x88, 0x8f,
+		0xc0, 0xac, 0x8e, 0x6f, 0xd8, 0xe5, 0x20, 0x76, 0x82, 0x62, 0x23, 0x92, 0x77, 0xd5, 0x4f, 0x71,
+		0x1f, 0xa4, 0x2a, 0x42, 0xf9, 0x0c, 0x17, 0x28, 0xee, 0xa4, 0x03, 0xa5, 0x68, 0xc7, 0x21, 0xae,
+		0xb5, 0x5a, 0x0c, 0x61, 0x82, 0xfb, 0xc7, 0x56, 0x8b, 0x66, 0x9d, 0x81, 0x69, 0x5a, 0x47, 0x13,
+		0x5b, 0xe4, 0xb4, 0x3f, 0xc3, 0x49, 0x52, 0x9c, 0x29, 0x92, 0xa1, 0x32, 0x38, 0xc5, 0xbd, 0x07,
+		0x26, 0xf1, 0xf5, 0x7a, 0x15, 0xeb, 0x15, 0xcc, 0xf0, 0x26, 0x29, 0x5e, 0x52, 0x00, 0x29, 0xd2,
+		0x03, 0xe0, 0xf8, 0xbd, 0xb2, 0xf0, 0xc9, 0x29, 0xc6, 0x4f, 0xc0, 0xb3, 0x0c, 0xac, 0xa4, 0x21,
+		0x92, 0xd7, 0x6c, 0x8d, 0x04, 0x18, 0xf6, 0x0d, 0x36, 0xd0, 0x24, 0x55, 0xf2, 0x57, 0x79, 0x3d,
+		0x04, 0x91, 0xab, 0x86, 0x8d, 0xd1, 0x63, 0x9e, 0x00, 0x30, 0xd5, 0xcd, 0x9e, 0x4b, 0xf5, 0x9a,
+		0x8e, 0xab, 0xeb, 0x56, 0xcd, 0xf3, 0x0a, 0xa3, 0x6b, 0x4e, 0x21, 0x9f, 0x39, 0xcd, 0x42, 0xd4,
+		0x34, 0xda, 0x7a, 0x55, 0x9c, 0xb8, 0xa6, 0x09, 0x54, 0x80, 0xb8, 0x63, 0x25, 0x91, 0x41, 0x56,
+		0x32, 0x45, 0xac, 0x84, 0xd8, 0x30, 0x07, 0xa8, 0xb1, 0x5d, 0x6e, 0x2c, 0x39, 0x48, 0x38, 0xce,
+		0x2b, 0x1d, 0x1d, 0xc1, 0x60, 0x5d, 0x32, 0x32, 0x98, 0x38, 0x6d, 0xef, 0x28, 0x8f, 0x59, 0x9c,
+		0xec, 0x64, 0x70, 0xed, 0xf9, 0xcc, 0x8a, 0xbf, 0x08, 0x19, 0xa3, 0xf5, 0x72, 0xcd, 0x8a, 0xbd,
+		0x0a, 0x79, 0x92, 0x1c, 0xcc, 0xaa, 0xe9, 0xf4, 0x36, 0x01, 0xb7, 0x3c, 0x17, 0xa0, 0xfc, 0x96,
+		0x04, 0xe3, 0xcc, 0x92, 0x3d, 0x7a, 0x93, 0xba, 0xeb, 0x2d, 0xd4, 0x4b, 0x6f, 0xe1, 0xc3, 0xeb,
+		0x2d, 0x0b, 0xe0, 0x08, 0x63, 0xf1, 0x87, 0xfa, 0xba, 0x44, 0x0c, 0x4c, 0xc4, 0x52, 0xbd, 0xc6,
+		0x3b, 0xaa, 0x87, 0x48, 0xf9, 0x4f, 0x12, 0x24, 0x9c, 0x7c, 0x94, 0x85, 0x49, 0x21, 0x57, 0x79,
+		0xaf, 0xa1, 0xd5, 0xb8, 0xed, 0xdc, 0xd5, 0x53, 0xb8, 0x8b, 0x0d, 0xad, 0xa6, 0x4e, 0x70, 0x79,
+		0x48, 0xa2, 0x7b, 0x3b, 0x84, 0x7a, 0xb4, 0x83, 0xaf, 0xe1, 0xc3, 0x87, 0x6b, 0x78, 0x5f, 0x13,
+		0x45, 0x82, 0x4d, 0xf4, 0x6b, 0x21, 0x3a, 0x99, 0x69, 0x19, 0x96, 0xd6, 0xf8, 0xcb, 0xe8, 0x11,
+		0x27, 0x20, 0xd1, 0x32, 0x1a, 0x65, 0x96, 0xc3, 0x6e, 0x22, 0xc4, 0x5b, 0x46, 0x43, 0xed, 0x68,
+		0xf6, 0xe8, 0x6d, 0xea, 0x2e, 0xe3, 0xb7, 0x41, 0x6b, 0xb1, 0xa0, 0xd6, 0x4c, 0x48, 0x32, 0x55,
+		0xf0, 0xb1, 0xec, 0x11, 0xa2, 0x03, 0xf2, 0x2f, 0x2d, 0x75, 0x8e, 0xbd, 0x4c, 0x6c, 0x86, 0xa9,
+		0x8e, 0xef, 0x3b, 0x14, 0xcc, 0xf5, 0xa7, 0x43, 0xbd, 0x28, 0x98, 0xd9, 0xa9, 0x1c, 0x4f, 0xf9,
+		0x71, 0x09, 0x60, 0x8d, 0x68, 0x96, 0xd6, 0x97, 0x8c, 0x42, 0x16, 0x15, 0xa1, 0xec, 0x2b, 0x79,
+		0xae, 0x57, 0xa3, 0xf1, 0xf2, 0x93, 0x96, 0x57, 0xee, 0x15, 0x98, 0x74, 0x8d, 0xd1, 0xc2, 0x42,
+		0x98, 0xb9, 0x3e, 0x51, 0x35, 0xb9, 0x5a, 0x94, 0xbc, 0xee, 0x49, 0x29, 0xff, 0x5c, 0x82, 0x04,
+		0x95, 0x89, 0xbc, 0x4f, 0xe6, 0x6b, 0x43, 0xe9, 0xf0, 0x6d, 0x78, 0x17, 0x00, 0x63, 0x43, 0xb6,
+		0xa9, 0xb9, 0x65, 0x25, 0x28, 0x84, 0x6c, 0x3e, 0xa3, 0xf3, 0x8e, 0xc2, 0xc3, 0xfd, 0x15, 0x2e,
+		0xa2, 0x6e, 0xae, 0xf6, 0x63, 0x10, 0xa3, 0xf7, 0x6c, 0x6f, 0x58, 0x3c, 0x90, 0x26, 0xaf, 0x59,
+		0x6e, 0xdf, 0xb0, 0x94, 0x17, 0x21, 0xb6, 0x7d, 0x83, 0xad, 0x8d, 0x9c, 0x80, 0x84, 0x69, 0x18,
+		0x7c, 0x4c, 0x66, 0xb1, 0x50, 0x9c, 0x00, 0xe8, 0x10, 0x24, 0xd6, 0x03, 0x42, 0xee, 0x7a, 0x80,
+		0xbb, 0xa0, 0x11, 0x1e, 0x6a, 0x41, 0xe3, 0xcc, 0x7f, 0x90, 0x60, 0xc2, 0xe3, 0x1f, 0xd0, 0xa3,
+		0x70, 0x24, 0xb7, 0xb6, 0xb9, 0x72, 0xa5, 0x5c, 0xcc, 0x97, 0x2f, 0xae, 0x65, 0x3d, 0xf7, 0x03,
+		0x33, 0x47, 0x5f, 0xbd, 0xb9, 0x80, 0x3c, 0xb8, 0x3b, 0x3a, 0x5d, 0x51, 0x42, 0x67, 0x61, 0xd6,
+		0x4f, 0x92, 0xcd, 0x95, 0xc8, 0xad, 0x74, 0x29, 0x73, 0xe4, 0xd5, 0x9b, 0x0b, 0xd3, 0x1e, 0x8a,
+		0xec, 0xae, 0x85, 0x75, 0xbb, 0x93, 0x60, 0x65, 0x73, 0x7d, 0x9d, 0x5c, 0xd2, 0xec, 0x20, 0xe0,
+		0x0e, 0xfb, 0x01, 0x98, 0xf6, 0x13, 0x6c, 0x14, 0xd7, 0xe4, 0x70, 0x06, 0xbd, 0x7a, 0x73, 0x21,
+		0xe5, 0xc1, 0xde, 0xa8, 0x37, 0x32, 0xf1, 0xf7, 0xfe, 0xec, 0xdc, 0xd8, 0xcf, 0xff, 0xdc, 0x9c,
+		0x44, 0x6a, 0x36, 0xe9, 0xf3, 0x11, 0xe8, 0x21, 0x38, 0x56, 0x2a, 0xae, 0x6e, 0x14, 0xf2, 0xe5,
+		0xf5, 0xd2, 0x6a, 0xe0, 0x9e, 0x67, 0x66, 0xea, 0xd5, 0x9b, 0x0b, 0x13, 0xbc, 0x4a, 0xbd, 0xb0,
+		0xb7, 0xd4, 0xc2, 0xd5, 0x4d, 0xb2, 0xa2, 0xcd, 0xb0, 0xb7, 0x4c, 0x7c, 0xdd, 0xb0, 0xd9, 0xe7,
+		0x0f, 0x1e, 0x81, 0xe3, 0x5d, 0xb0, 0x9d, 0x8a, 0x4d, 0xbf, 0x7a, 0x73, 0x61, 0x72, 0xcb, 0xc4,
+		0xac, 0xff, 0x50, 0x8a, 0x45, 0x48, 0x77, 0x52, 0x6c, 0x6e, 0x6d, 0x96, 0xb2, 0x6b, 0xf2, 0x42,
+		0x46, 0x7e, 0xf5, 0xe6, 0x42, 0x52, 0x38, 0x43, 0xba, 0xd0, 0xef, 0xd4, 0xec, 0x4e, 0xce, 0x78,
+		0xde, 0xf3, 0x08, 0xdc, 0xdb, 0x63, 0x8f, 0x89, 0xa7, 0x0f, 0xb7, 0xcb, 0xd4, 0x73, 0x9d, 0x3d,
+		0x33, 0x60, 0xf9, 0x79, 0xf0, 0xd4, 0xe9, 0xf0, 0x3b, 0x58, 0x99, 0xbe, 0x93, 0x3b, 0xe5, 0x7d,
+		0x12, 0xa4, 0x2e, 0xd5, 0x2d, 0xdb, 0x30, 0xeb, 0x15, 0xad, 0x41, 0xaf, 0xd8, 0x9d, 0x1f, 0xd6,
+		0xb7, 0x06, 0xba, 0xfa, 0xd3, 0x30, 0x7e, 0x5d, 0x6b, 0x30, 0xa7, 0x16, 0xa6, 0xcf, 0xd3, 0xf6,
+		0xd8, 0xf2, 0x71, 0x5c, 0x9b, 0x60, 0xc0, 0xc8, 0x94, 0x5f, 0x0a, 0xc1, 0x14, 0xed, 0x0c, 0x16,
+		0x7b, 0x5c, 0xdd, 0xa6, 0x37, 0x31, 0x23, 0xa6, 0x66, 0xf3, 0x45, 0xc3, 0xdc, 0x22, 0xdf, 0x7d,
+		0x3c, 0x35, 0xc4, 0x5e, 0x1a, 0xd9, 0xa0, 0xa4, 0xb4, 0xe8, 0x9d, 0x10, 0x27, 0x9b, 0x75, 0x94,
+		0x0f, 0x9b, 0xb9, 0x64, 0x47, 0xe3, 0xf3, 0xc6, 0xad, 0xf9, 0xa9, 0x03, 0xad, 0xd9, 0x58, 0x56,
+		0x04, 0x1f, 0x45, 0x8d, 0x35, 0xb5, 0x1b, 0x44, 0x44, 0xd4, 0xa2, 0xf7, 0x61, 0xcb, 0x95, 0x7d,
+		0x4d, 0xaf, 0x61, 0x56, 0x08, 0x5d, 0x02, 0xcd, 0x5d, 0x1a, 0xb9, 0x90, 0xa3, 0x6e, 0x21, 0x1e,
+		0x76, 0x8a, 0x3a, 0xd9, 0xd4, 0x6e, 0xac, 0x50, 0x00, 0x29, 0x71, 0x39, 0x4e, 0xb6, 0x55, 0xe8,
+		0x8e, 0xee, 0x17, 0x24, 0x00, 0x57, 0x63, 0xe8, 0x9d, 0x20, 0x57, 0x9c, 0x14, 0xa5, 0x15, 0x7b,
+		0x93, 0xf7, 0xf7, 0x6a, 0x8b, 0x80, 0xbe, 0xd9, 0xd8, 0xfc, 0xf9, 0x5b, 0xf3, 0x92, 0x3a, 0x55,
+		0x09, 0x34, 0xc5, 0x77, 0xc3, 0x44, 0xbb, 0x55, 0xd5, 0x6c, 0x5c, 0xa6, 0xf3, 0xb8, 0xd0, 0xc0,
+		0x71, 0x7e, 0x8e, 0xf0, 0x7a, 0xe3, 0xd6, 0x3c, 0x62, 0xd5, 0xf2, 0x10, 0x2b, 0x74, 0xf4, 0x07,
+		0x06, 0x21, 0x04, 0x9e, 0x3a, 0x7d, 0x96, 0x3e, 0x8b, 0xef, 0x9e, 0xa9, 0x4c, 0x43, 0xac, 0x69,
+		0xe8, 0xf5, 0x6b, 0xdc, 0x1e, 0x13, 0xaa, 0x48, 0x92, 0xa5, 0x50, 0xf6, 0x22, 0x87, 0x7d, 0x20,
+		0x96, 0x42, 0x45, 0x9a, 0x50, 0xbd, 0x84, 0x77, 0xad, 0xba, 0x68, 0x0d, 0x55, 0x24, 0xd1, 0x45,
+		0xf2, 0x0a, 0x6f, 0xa5, 0x4d, 0xd6, 0x70, 0xc8, 0x8b, 0x47, 0x36, 0x79, 0x98, 0x87, 0xde, 0xbe,
+		0xca, 0x9d, 0x78, 0xe3, 0xd6, 0xfc, 0x31, 0x26, 0x6b, 0x10, 0x43, 0x51, 0xa7, 0x04, 0x68, 0x85,
+		0x41, 0x48, 0x09, 0x55, 0x6c, 0x6b, 0xf5, 0x06, 0xbb, 0x1c, 0x9c, 0x50, 0x45, 0xd2, 0x53, 0x97,
+		0x4f, 0xc7, 0xbc, 0x0b, 0x5b, 0x17, 0x41, 0x36, 0x5a, 0xd8, 0xf4, 0x05, 0xa2, 0x52, 0xb0, 0xe4,
+		0x20, 0x86, 0xa2, 0x4e, 0x09, 0x90, 0x08, 0x52, 0x6d, 0x90, 0x9d, 0x29, 0x61, 0xb9, 0xd5, 0xde,
+		0x75, 0xd7, 0xc3, 0x66, 0x3b, 0x5a, 0x23, 0xab, 0x1f, 0xe4, 0x1e, 0x73, 0xb9, 0x07, 0xe9, 0x94,
+		0xcf, 0x7d, 0xe6, 0xe1, 0x59, 0x6e, 0x1a, 0xee, 0xfa, 0x14, 0x59, 0x9c, 0x9a, 0x72, 0x50, 0xb7,
+		0x28, 0x26, 0x09, 0x3b, 0x5f, 0xd4, 0xea, 0x0d, 0xf1, 0xc0, 0x94, 0xca, 0x53, 0x68, 0x19, 0xc6,
+		0x2d, 0x5b, 0xb3, 0xdb, 0x16, 0xdf, 0xe9, 0x55, 0x7a, 0x99, 0x5a, 0xce, 0xd0, 0xab, 0x25, 0x8a,
+		0xa9, 0x72, 0x0a, 0x74, 0x11, 0xc6, 0xf9, 0x16, 0x7a, 0x74, 0xe4, 0xfe, 0x4d, 0xcf, 0x4a, 0x30,
+		0x6a, 0xa2, 0x91, 0x2a, 0x6e, 0xe0, 0x1a, 0x0b, 0xab, 0xf6, 0x35, 0x32, 0xfb, 0xa0, 0x2f, 0xf6,
+		0xe7, 0x8a, 0x23, 0x77, 0x42, 0xae, 0xa9, 0x20, 0x3f, 0x45, 0x9d, 0x72, 0x40, 0x25, 0x0a, 0x41,
+		0x57, 0x7c, 0x87, 0x7f, 0xf9, 0xd3, 0x57, 0xf7, 0xf4, 0xaa, 0xbe, 0xc7, 0xa6, 0xc5, 0xfa, 0x84,
+		0x87, 0x9a, 0x18, 0x47, 0x5b, 0xdf, 0x35, 0x74, 0x7a, 0x59, 0x9a, 0xc7, 0xf7, 0x64, 0x7e, 0x17,
+		0xf6, 0x1a, 0x47, 0x10, 0x43, 0x51, 0xa7, 0x1c, 0xd0, 0x25, 0x0a, 0x41, 0x55, 0x48, 0xb9, 0x58,
+		0xb4, 0xa3, 0x26, 0x06, 0x76, 0xd4, 0xbb, 0x79, 0x47, 0x3d, 0x12, 0x2c, 0xc5, 0xed, 0xab, 0x93,
+		0x0e, 0x90, 0x90, 0xa1, 0x4b, 0x00, 0xae, 0x7b, 0xa0, 0xeb, 0x14, 0x13, 0x4b, 0xca, 0x60, 0x1f,
+		0x23, 0xe6, 0x7b, 0x2e, 0x2d, 0xfa, 0x3e, 0x98, 0x69, 0xd6, 0xf5, 0xb2, 0x85, 0x1b, 0x7b, 0x65,
+		0xae, 0x60, 0xc2, 0x92, 0x3e, 0xbc, 0x9c, 0x5b, 0x1b, 0xcd, 0x1e, 0xde, 0xb8, 0x35, 0x9f, 0xe1,
+		0x2e, 0xb4, 0x93, 0xa5, 0xa2, 0x4e, 0x37, 0xeb, 0x7a, 0x09, 0x37, 0xf6, 0xf2, 0x0e, 0x6c, 0x39,
+		0xf9, 0xde, 0x8f, 0xcf, 0x8f, 0xf1, 0xee, 0x3a, 0xa6, 0x9c, 0xa7, 0x6b, 0xe7, 0xbc, 0x9b, 0x61,
+		0x8b, 0xcc, 0x49, 0x34, 0x91, 0xe0, 0x47, 0x0d, 0x5c, 0x00, 0xeb, 0xe6, 0xaf, 0xfc, 0xf1, 0x82,
+		0x44, 0xde, 0x97, 0x1b, 0xcf, 0x5f, 0xdd, 0xd2, 0xea, 0x26, 0x2a, 0xc2, 0xb4, 0x6b, 0x39, 0xfe,
+		0x4e, 0x7e, 0xf2, 0x8d, 0x5b, 0xf3, 0xe9, 0xa0, 0x71, 0x39, 0xbd, 0xdc, 0x35, 0x60, 0xd1, 0xcd,
+		0x8b, 0xbd, 0x26, 0xae, 0x3e, 0x56, 0x1d, 0x28, 0x4a, 0xe7, 0xb4, 0x36, 0x50, 0xcd, 0x02, 0xc4,
+		0x98, 0xb4, 0xe4, 0x82, 0x7e, 0xb4, 0x45, 0xfe, 0xf0, 0x8d, 0x81, 0xb9, 0x9e, 0xc6, 0x4b, 0xf1,
+		0x9d, 0x85, 0x4c, 0x42, 0xa2, 0x7c, 0x38, 0x04, 0x90, 0xbf, 0x7a, 0x75, 0xdb, 0xac, 0xb7, 0x1a,
+		0xd8, 0xbe, 0x9d, 0x35, 0xdf, 0x86, 0x23, 0x6e, 0xb5, 0x2c, 0xb3, 0x12, 0xa8, 0xfd, 0xc2, 0x1b,
+		0xb7, 0xe6, 0x4f, 0x06, 0x6b, 0xef, 0x41, 0x53, 0xd4, 0x19, 0x77, 0xbe, 0x64, 0x56, 0xba, 0x72,
+		0xad, 0x5a, 0xb6, 0xc3, 0x35, 0xdc, 0x9b, 0xab, 0x07, 0xcd, 0xcb, 0x35, 0x6f, 0xd9, 0xdd, 0x55,
+		0x5b, 0x82, 0x09, 0x57, 0x25, 0xe4, 0x8d, 0xf4, 0xb8, 0xcd, 0xff, 0x73, 0x0d, 0x2b, 0xbd, 0x35,
+		0x2c, 0xc8, 0xb8, 0x96, 0x1d, 0x4a, 0xe5, 0x2f, 0x24, 0x00, 0xd7, 0x66, 0xdf, 0x9a, 0x26, 0x46,
+		0x5c, 0x39, 0x77, 0xbc, 0xe1, 0x43, 0x85, 0x6a, 0x9c, 0x3a, 0xa0, 0xcf, 0xf7, 0x87, 0xc8, 0x3b,
+		0x5f, 0xdc, 0xf3, 0xbc, 0xe5, 0x75, 0xb0, 0x05, 0x31, 0xac, 0xdb, 0x66, 0x9d, 0x2a, 0x81, 0xb4,
+		0xf6, 0x23, 0xbd, 0x5a, 0xbb, 0x4b, 0x9d, 0xe8, 0xf3, 0xd2, 0x62, 0xd1, 0x9d, 0xb3, 0x09, 0x68,
+		0xe3, 0x83, 0x61, 0x48, 0xf7, 0xa2, 0x44, 0x2b, 0x30, 0x55, 0x31, 0x31, 0x05, 0x94, 0xbd, 0x2b,
+		0x7f, 0xb9, 0x8c, 0x1b, 0x59, 0x06, 0x10, 0x14, 0x35, 0x25, 0x20, 0x7c, 0xf4, 0xa8, 0x01, 0x09,
+		0xfb, 0x88, 0xd9, 0x11, 0xac, 0x21, 0xe3, 0x3c, 0x85, 0x0f, 0x1f, 0xa2, 0x10, 0x3f, 0x03, 0x36,
+		0x7e, 0xa4, 0x5c, 0x28, 0x1d, 0x40, 0xde, 0x05, 0x53, 0x75, 0xbd, 0x6e, 0xd7, 0xb5, 0x46, 0x79,
+		0x57, 0x6b, 0x68, 0xe4, 0x51, 0xb0, 0xd1, 0xa3, 0x66, 0xe6, 0xf2, 0x79, 0xb1, 0x01, 0x76, 0x8a,
+		0x9a, 0xe2, 0x90, 0x1c, 0x03, 0x90, 0xc7, 0x8e, 0x45, 0x51, 0x91, 0x43, 0x45, 0x1b, 0x82, 0xdc,
+		0x13, 0xe0, 0x7d, 0x20, 0x0c, 0xd3, 0x2a, 0xae, 0xfe, 0xbf, 0xa6, 0x18, 0xad, 0x29, 0xd6, 0x01,
+		0x58, 0x77, 0x27, 0x0e, 0x36, 0x1d, 0x39, 0x94, 0xc3, 0x48, 0x30, 0x0e, 0x79, 0xcb, 0xf6, 0xb4,
+		0xc7, 0xad, 0x10, 0x24, 0xbd, 0xed, 0xf1, 0x37, 0x74, 0x54, 0x42, 0x45, 0xd7, 0x13, 0x45, 0xf8,
+		0x07, 0x7b, 0x7a, 0x78, 0xa2, 0x0e, 0xeb, 0xed, 0xef, 0x82, 0x7e, 0x24, 0x0a, 0xe3, 0x5b, 0x9a,
+		0xa9, 0x35, 0x2d, 0x54, 0xe9, 0x88, 0x34, 0xc5, 0xf2, 0x63, 0xc7, 0x77, 0xf6, 0xf8, 0x6a, 0xc7,
+		0x80, 0x40, 0xf3, 0xb5, 0x2e, 0x81, 0xe6, 0x77, 0x01, 0x79, 0x3c, 0xca, 0xb3, 0x8b, 0x46, 0xb5,
+		0x3d, 0x99, 0x3b, 0xee, 0x72, 0xf1, 0xe7, 0xb3, 0xd9, 0xf2, 0x55, 0xef, 0x19, 0x86, 0x09, 0x82,
+		0xe1, 0x3a, 0x66, 0x42, 0x7e, 0xd4, 0x9d, 0x96, 0x7a, 0x32, 0x15, 0x95, 0x9c, 0xea, 0x2d, 0xb0,
+		0x04, 0x5a, 0x03, 0xb4, 0xef, 0xac, 0x8c, 0x94, 0x5d, 0x75, 0x12, 0xfa, 0xbb, 0xde, 0xb8, 0x35,
+		0x7f, 0x9c, 0xd1, 0x77, 0xe2, 0x28, 0xea, 0xb4, 0x0b, 0x14, 0xdc, 0x1e, 0x07, 0x20, 0xf5, 0x2a,
+		0xb3, 0x23, 0xdc, 0x6c, 0xba, 0x73, 0xe4, 0x8d, 0x5b, 0xf3, 0xd3, 0x8c, 0x8b, 0x9b, 0xa7, 0xa8,
+		0x09, 0x92, 0xc8, 0x93, 0xff, 0x22, 0x3a, 0x0e, 0xcc, 0xea, 0xd3, 0xe3, 0x23, 0x47, 0xc7, 0x6c,
+		0x6e, 0xe3, 0x89, 0x8e, 0x03, 0x2c, 0x59, 0x74, 0xec, 0x5f, 0x0d, 0x40, 0x1f, 0x92, 0x20, 0x43,
+		0x70, 0x6b, 0x0d, 0x63, 0x57, 0x6b, 0x74, 0xc4, 0xe8, 0xf4, 0xa3, 0x64, 0xb9, 0xd2, 0xc8, 0x5e,
+		0xe2, 0x6e, 0x57, 0x8a, 0xee, 0x9c, 0x15, 0xf5, 0x58, 0xb3, 0xae, 0xaf, 0xd2, 0xbc, 0x40, 0xc0,
+		0xee, 0x76, 0xf5, 0x9f, 0x95, 0x00, 0xb9, 0x19, 0x2a, 0xb6, 0x5a, 0x64, 0xc2, 0x4a, 0x66, 0x26,
+		0x1e, 0x11, 0xa5, 0xfe, 0x33, 0x13, 0x97, 0x5e, 0xcc, 0x4c, 0x5c, 0x5a, 0xf2, 0x0d, 0x23, 0xe1,
+		0x0f, 0x43, 0x83, 0x0e, 0x78, 0xf3, 0x3e, 0x13, 0x1c, 0x20, 0xc6, 0x94, 0x7f, 0x29, 0xc1, 0xf1,
+		0x8e, 0x2e, 0xe6, 0x08, 0xfb, 0xff, 0x01, 0x32, 0x3d, 0x99, 0xfc, 0x93, 0x13, 0x4c, 0xe8, 0x91,
+		0x7b, 0xec, 0xb4, 0x19, 0xcc, 0xb8, 0x8d, 0x43, 0x1e, 0xbb, 0x41, 0xf0, 0xcf, 0x24, 0x98, 0xf5,
+		0x16, 0xef, 0x54, 0x64, 0x03, 0x92, 0xde, 0xd2, 0x79, 0x15, 0xee, 0x1d, 0xa6, 0x0a, 0x5c, 0x7a,
+		0x1f, 0x3d, 0x7a, 0xc6, 0xf5, 0x5f, 0x6c, 0x31, 0xf1, 0xd1, 0xa1, 0xb5, 0x21, 0x64, 0x0a, 0xfa,
+		0xb1, 0x08, 0x6d, 0x8f, 0xef, 0x48, 0x10, 0xd9, 0x32, 0x8c, 0x06, 0x32, 0x60, 0x5a, 0x37, 0xec,
+		0x32, 0xe9, 0x6a, 0xb8, 0xea, 0x3d, 0xc8, 0x9f, 0xc8, 0xad, 0x8c, 0xa6, 0xa4, 0xaf, 0xdf, 0x9a,
+		0xef, 0x64, 0xa5, 0x4e, 0xe9, 0x86, 0x9d, 0xa3, 0x10, 0x7e, 0x96, 0xff, 0xfb, 0x60, 0xd2, 0x5f,
+		0x18, 0x1b, 0x36, 0x9e, 0x1d, 0xb9, 0x30, 0x3f, 0x9b, 0x37, 0x6e, 0xcd, 0xcf, 0xba, 0x2e, 0xc4,
+		0x01, 0x2b, 0x6a, 0x72, 0xd7, 0x53, 0x3a, 0x3b, 0xef, 0xf6, 0xcd, 0x8f, 0xcf, 0x4b, 0x67, 0x7e,
+		0x5d, 0x02, 0x70, 0x97, 0x62, 0xc8, 0x0e, 0x40, 0x6e, 0x73, 0x23, 0x5f, 0x2e, 0x6d, 0x67, 0xb7,
+		0x77, 0x4a, 0xfe, 0x43, 0xef, 0x62, 0xbf, 0xc0, 0x6a, 0xe1, 0x0a, 0xfd, 0xda, 0x05, 0x3a, 0x05,
+		0xb3, 0x7e, 0x6c, 0x92, 0x22, 0x6f, 0x28, 0x66, 0x92, 0xaf, 0xde, 0x5c, 0x88, 0xb3, 0xe0, 0x14,
+		0x93, 0xd3, 0x16, 0x47, 0x3a, 0xf1, 0xc8, 0xc3, 0xf8, 0xa1, 0xcc, 0xe4, 0xab, 0x37, 0x17, 0x12,
+		0x4e, 0x14, 0x8b, 0x14, 0x40, 0x5e, 0x4c, 0xce, 0x2f, 0x9c, 0x81, 0x57, 0x6f, 0x2e, 0x8c, 0x33,
+		0x05, 0x66, 0x22, 0x64, 0x57, 0xe0, 0xb6, 0x1f, 0x8d, 0xff, 0xf3, 0x58, 0xcf, 0x6d, 0x80, 0x1a,
+		0xd6, 0xb1, 0x55, 0xb7, 0x0e, 0xb5, 0x0d, 0x30, 0xd4, 0xd6, 0x82, 0xf2, 0x07, 0x51, 0x48, 0xae,
+		0xb2, 0x52, 0xd8, 0x17, 0x36, 0xdf, 0x46, 0xbe, 0x77, 0x42, 0xc6, 0x55, 0x67, 0x5f, 0xb1, 0x87,
+		0xc1, 0xb3, 0xd1, 0xd7, 0x39, 0xdc, 0x46, 0x53, 0xc8, 0xe2, 0xa7, 0x5b, 0xd8, 0xa1, 0x3b, 0xf7,
+		0x18, 0x59, 0x32, 0x57, 0x1c, 0xd9, 0x3d, 0xf3, 0xb5, 0xa6, 0x20, 0x3f, 0x85, 0x1d, 0x94, 0xd9,
+		0x26, 0x10, 0x76, 0x5c, 0xee, 0x3d, 0x12, 0x1c, 0xa1, 0x58, 0x6e, 0x64, 0x42, 0x31, 0xc5, 0xec,
+		0xe7, 0x4c, 0xaf, 0x2a, 0xac, 0x69, 0x96, 0x7b, 0xf8, 0x85, 0x1d, 0x70, 0xbb, 0x97, 0x47, 0x06,
+		0x27, 0x3d, 0x85, 0x07, 0xd9, 0x2a, 0xea, 0x4c, 0xa3, 0x83, 0xd2, 0x42, 0xab, 0xbe, 0x13, 0x8e,
+		0x91, 0xd1, 0xf6, 0x1e, 0x3c, 0xa4, 0xe8, 0x32, 0x4c, 0xb8, 0xbe, 0xc4, 0xe2, 0xdf, 0x43, 0x1e,
+		0x7e, 0xec, 0xf0, 0x12, 0xa3, 0x1f, 0x96, 0xe0, 0x88, 0x1b, 0xde, 0x78, 0xd9, 0xb2, 0xef, 0x46,
+		0x3f, 0x38, 0xc2, 0xcc, 0x30, 0xa8, 0x9c, 0xae, 0x7c, 0x15, 0x75, 0xd6, 0x81, 0xe7, 0x3d, 0x82,
+		0x6c, 0x91, 0x0f, 0x1c, 0x7a, 0xcb, 0x17, 0x0f, 0x7b, 0x0f, 0xef, 0x9a, 0xfd, 0x0c, 0xd8, 0xa7,
+		0x4f, 0x5b, 0x86, 0x69, 0xe3, 0x6a, 0x3a, 0xce, 0x1f, 0x79, 0xe2, 0x69, 0x65, 0x03, 0x50, 0x67,
+		0xe3, 0x06, 0x4f, 0x74, 0xba, 0x17, 0x76, 0xc8, 0x99, 0x05, 0xef, 0x99, 0x47, 0x96, 0x58, 0x8e,
+		0xbf, 0x97, 0x0f, 0x9f, 0xb7, 0xbd, 0xcf, 0x7f, 0x31, 0x04, 0x67, 0xbc, 0xfb, 0x65, 0xef, 0x6a,
+		0x63, 0xf3, 0xc0, 0xe9, 0xa2, 0x2d, 0xad, 0x56, 0xd7, 0xbd, 0xd7, 0x42, 0x8e, 0x7b, 0x07, 0x7c,
+		0x8a, 0x2b, 0xf4, 0xa4, 0xbc, 0x57, 0x82, 0x89, 0x2d, 0xad, 0x86, 0x55, 0xfc, 0xae, 0x36, 0xb6,
+		0xec, 0x2e, 0xc7, 0xee, 0xc9, 0x91, 0xf8, 0xbd, 0x3d, 0xb1, 0xc9, 0x1f, 0x51, 0x79, 0x8a, 0xd4,
+		0xb9, 0x51, 0x27, 0x07, 0x11, 0xc2, 0x14, 0xcc, 0x12, 0xe4, 0x89, 0xc9, 0x8a, 0xd1, 0xd6, 0x79,
+		0x97, 0x4b, 0x47, 0xc4, 0xe3, 0x33, 0x6d, 0x9d, 0x75, 0x39, 0xa2, 0x44, 0x13, 0x93, 0xc3, 0x78,
+		0x98, 0x7f, 0xd4, 0x57, 0x24, 0x95, 0xa7, 0x21, 0xc9, 0x24, 0xe1, 0x83, 0xf1, 0x71, 0x88, 0xd3,
+		0xa3, 0x67, 0xae, 0x3c, 0x31, 0x92, 0xbe, 0xc2, 0x0e, 0xef, 0x33, 0xfe, 0x4c, 0x24, 0x96, 0xc8,
+		0xe5, 0x7a, 0x6a, 0xf9, 0xf4, 0x60, 0xaf, 0xc1, 0x74, 0xe8, 0x68, 0xf8, 0x77, 0xa2, 0x70, 0x84,
+		0xc5, 0xff, 0x67, 0xb5, 0x56, 0xfd, 0xec, 0xbe, 0x6d, 0x8b, 0xcb, 0x24, 0xc0, 0xc0, 0x8b, 0x5a,
+		0xab, 0xae, 0x1c, 0x40, 0xe4, 0x92, 0x6d, 0xb7, 0xd0, 0x19, 0x88, 0x9a, 0xed, 0x06, 0x16, 0xab,
+		0x63, 0xce, 0xfe, 0x85, 0xd6, 0xaa, 0x2f, 0x12, 0x04, 0xb5, 0xdd, 0xc0, 0x2a, 0x43, 0x41, 0x05,
+		0x98, 0x27, 0x9f, 0x3d, 0x3e, 0x20, 0x1f, 0x16, 0x37, 0xaa, 0xb8, 0xec, 0x7c, 0xb7, 0x13, 0xdf,
+		0x68, 0x69, 0xe2, 0x31, 0x72, 0xa2, 0x98, 0x93, 0x14, 0x2d, 0x4f, 0xb1, 0xc4, 0x37, 0x3b, 0x0b,
+		0x02, 0x47, 0xf9, 0xa3, 0x10, 0xc4, 0x05, 0x6b, 0x7a, 0x9a, 0x1e, 0x37, 0x70, 0xc5, 0x36, 0xc4,
+		0xee, 0x92, 0x93, 0x46, 0x08, 0xc2, 0x35, 0xde, 0x78, 0x89, 0x4b, 0x63, 0x2a, 0x49, 0x10, 0x98,
+		0x73, 0xc7, 0x81, 0xc0, 0xc8, 0xd5, 0x87, 0x59, 0x88, 0xb4, 0x0c, 0x31, 0x8d, 0xbd, 0x34, 0xa6,
+		0xd2, 0x14, 0x4a, 0xc3, 0x38, 0xe9, 0x34, 0x36, 0x6b, 0x2d, 0x02, 0xe7, 0x69, 0x74, 0x94, 0xac,
+		0xb9, 0xda, 0x15, 0x76, 0xfc, 0x90, 0x64, 0xb0, 0x24, 0x7a, 0x02, 0xc6, 0xd9, 0x35, 0xf5, 0xe0,
+		0x27, 0x7d, 0x89, 0x32, 0xd8, 0x7b, 0x80, 0x44, 0xee, 0x2d, 0xcd, 0xb6, 0xb1, 0xa9, 0x13, 0x86,
+		0x0c, 0x9d, 0x1c, 0x91, 0xd8, 0x35, 0xaa, 0x07, 0xfc, 0x33, 0xc3, 0xf4, 0x3f, 0xff, 0xae, 0x29,
+		0xb5, 0x87, 0x32, 0xcd, 0x64, 0x9f, 0xd8, 0x4f, 0x0a, 0x60, 0x8e, 0x20, 0x15, 0x60, 0x46, 0xab,
+		0xb2, 0xf7, 0x40, 0xc9, 0x6c, 0xbd, 0x4e, 0x9d, 0x07, 0xf9, 0x70, 0x59, 0xef, 0xb6, 0x40, 0x2e,
+		0x41, 0x8e, 0xe3, 0xe7, 0x12, 0x10, 0x6b, 0x31, 0xa1, 0x94, 0xa7, 0x60, 0xba, 0x43, 0x52, 0x22,
+		0xdf, 0xb5, 0xba, 0x5e, 0x15, 0x17, 0x3f, 0xc8, 0x7f, 0x02, 0xa3, 0x2f, 0xad, 0xb2, 0x7d, 0x3b,
+		0xfa, 0x3f, 0xf7, 0xee, 0xde, 0xf7, 0x83, 0x52, 0x9e, 0xfb, 0x41, 0x5a, 0xab, 0x9e, 0x4b, 0x50,
+		0xfe, 0xfc, 0x56, 0x50, 0xb6, 0xf3, 0x56, 0x50, 0x0d, 0xeb, 0x62, 0x60, 0x26, 0x59, 0x5a, 0xab,
+		0x6e, 0x51, 0x73, 0x74, 0x9f, 0x7e, 0xb5, 0x9e, 0xf2, 0xfc, 0xa7, 0x97, 0x84, 0x22, 0xab, 0xd9,
+		0xad, 0xa2, 0x63, 0xc7, 0xbf, 0x1d, 0x82, 0x93, 0x1e, 0x3b, 0xf6, 0x20, 0x77, 0x9a, 0x73, 0xa6,
+		0xbb, 0xc5, 0x0f, 0x71, 0x59, 0xfb, 0x0a, 0x44, 0x08, 0x3e, 0x1a, 0xf0, 0xd5, 0xd1, 0xf4, 0x2f,
+		0x7f, 0xee, 0x9f, 0x2a, 0x0b, 0x52, 0xcf, 0x56, 0xa1, 0x4c, 0x72, 0x3f, 0x3c, 0xbc, 0xfe, 0x64,
+		0xf7, 0x49, 0x59, 0xeb, 0xf6, 0xa9, 0x31, 0xa8, 0xc3, 0xaf, 0x9c, 0xeb, 0x79, 0x99, 0x97, 0x39,
+		0xd3, 0xfe, 0xf1, 0xd5, 0x08, 0x9e, 0xba, 0xd7, 0x5d, 0x89, 0x7e, 0x2d, 0x38, 0x64, 0xa4, 0x76,
+		0x03, 0x8e, 0x3e, 0x43, 0xca, 0x76, 0x97, 0x14, 0x84, 0xcb, 0x3f, 0xea, 0xec, 0x7c, 0x32, 0xcb,
+		0x76, 0x77, 0x35, 0xc1, 0x95, 0x8f, 0xcf, 0x1d, 0x4f, 0x2d, 0xf6, 0x1c, 0x4a, 0x16, 0x3d, 0xc3,
+		0x88, 0xea, 0xa1, 0x54, 0x7e, 0x41, 0x82, 0x63, 0x1d, 0x45, 0x73, 0x1f, 0xbf, 0xda, 0xe5, 0x5a,
+		0xc7, 0xa1, 0x82, 0x9e, 0xd5, 0x2e, 0xc2, 0xde, 0x3f, 0x50, 0x58, 0x26, 0x85, 0x4f, 0xda, 0x77,
+		0xc0, 0x11, 0xbf, 0xb0, 0x42, 0x4d, 0xf7, 0x41, 0xca, 0xbf, 0x7a, 0xce, 0xd5, 0x35, 0xe9, 0x5b,
+		0x3f, 0x57, 0xca, 0x41, 0x3d, 0x3b, 0x75, 0x2d, 0x40, 0xc2, 0x41, 0xe5, 0xd1, 0xf1, 0xd0, 0x55,
+		0x75, 0x29, 0x95, 0x0f, 0x4b, 0xb0, 0xe0, 0x2f, 0xc1, 0x13, 0x27, 0x8d, 0x26, 0xec, 0x6d, 0x6b,
+		0xe2, 0xd7, 0x25, 0xb8, 0xbb, 0x8f, 0x4c, 0x5c, 0x01, 0x2f, 0xc3, 0xac, 0x67, 0x91, 0x40, 0xb8,
+		0x70, 0xd1, 0xec, 0x67, 0x06, 0x47, 0xa8, 0xce, 0x9c, 0xf8, 0x04, 0x51, 0xca, 0xa7, 0xbe, 0x38,
+		0x3f, 0xd3, 0x99, 0x67, 0xa9, 0x33, 0x9d, 0x13, 0xfb, 0xdb, 0x68, 0x1f, 0x1f, 0x95, 0xe0, 0x01,
+		0x7f, 0x55, 0xbb, 0x84, 0xba, 0x7f, 0x55, 0xed, 0xf0, 0x1f, 0x25, 0x38, 0x33, 0x8c, 0x70, 0xbc,
+		0x41, 0x76, 0x61, 0xc6, 0x0d, 0xc2, 0x83, 0xed, 0x31, 0x52, 0x68, 0xcf, 0xac, 0x14, 0x39, 0xdc,
+		0xee, 0x80, 0xe2, 0x5b, 0xbc, 0x63, 0x79, 0x9b, 0xdc, 0x51, 0xb2, 0x7f, 0xe5, 0x5b, 0x28, 0xd9,
+		0xb7, 0xf6, 0xdd, 0xa5, 0x2d, 0x42, 0x5d, 0xda, 0xc2, 0x8d, 0xda, 0x95, 0xeb, 0x70, 0xac, 0xa3,
+		0x44, 0xae, 0xb9, 0xef, 0x86, 0x99, 0x2e, 0xa6, 0xcc, 0x7b, 0xf5, 0x08, 0x96, 0x4c, 0xbe, 0x07,
+		0x18, 0x84, 0x29, 0x07, 0x30, 0x4f, 0xcb, 0xed, 0xa2, 0xe8, 0x3b, 0x5d, 0xe5, 0x26, 0x2c, 0xf4,
+		0x2e, 0x9a, 0xd7, 0xbd, 0x08, 0xe3, 0xac, 0x9d, 0x79, 0x75, 0x0f, 0x61, 0x28, 0x9c, 0x81, 0xf2,
+		0x93, 0xc2, 0x97, 0xe5, 0x85, 0xd8, 0xdd, 0xfb, 0xd0, 0x30, 0x75, 0xbd, 0x4d, 0x7d, 0xc8, 0xa3,
+		0x8c, 0x2f, 0x08, 0xaf, 0xd6, 0x5d, 0x3a, 0xae, 0x8e, 0xca, 0x6d, 0xf3, 0x6a, 0x4c, 0x37, 0x77,
+		0xd6, 0x7d, 0xfd, 0x9c, 0x70, 0x5f, 0x4e, 0x9d, 0x06, 0xb8, 0xaf, 0xbf, 0x1a, 0xd5, 0x3b, 0x8e,
+		0x6c, 0x80, 0x98, 0x7f, 0x1d, 0x1d, 0xd9, 0x37, 0x25, 0x38, 0x4e, 0xeb, 0xe6, 0x5d, 0xa3, 0x18,
+		0x55, 0xe5, 0x0f, 0x01, 0x22, 0x9b, 0x72, 0x5d, 0x7b, 0xb7, 0x6c, 0x99, 0x95, 0xab, 0xbe, 0xf1,
+		0xe5, 0x21, 0x40, 0x55, 0xcb, 0x0e, 0x62, 0xb3, 0x13, 0x85, 0x72, 0xd5, 0xb2, 0xfd, 0xd8, 0xfe,
+		0xe6, 0x8c, 0xdc, 0x86, 0xe6, 0xfc, 0xbc, 0x04, 0x99, 0x6e, 0x55, 0xe6, 0xcd, 0x57, 0x87, 0xa3,
+		0xbe, 0xfd, 0x83, 0x60, 0x0b, 0x3e, 0x34, 0xcc, 0x2a, 0x4f, 0xa0, 0x1b, 0x1d, 0x31, 0xf1, 0x9d,
+		0x8e, 0x03, 0xe6, 0xfd, 0x16, 0xda, 0x19, 0x59, 0xff, 0x95, 0x75, 0x9f, 0xcf, 0x74, 0xf8, 0xd5,
+		0xbf, 0x16, 0xb1, 0xf7, 0x0d, 0x98, 0xeb, 0x21, 0xf5, 0x9d, 0x1e, 0xf7, 0xf6, 0x7b, 0x36, 0xe6,
+		0xed, 0x0e, 0xdf, 0x1f, 0xe7, 0x3d, 0xc1, 0x7f, 0x5a, 0xdd, 0x33, 0x17, 0xeb, 0x76, 0xdd, 0x4d,
+		0x79, 0x1e, 0x4e, 0x74, 0xa5, 0xe2, 0xb2, 0x2d, 0x43, 0x84, 0x6c, 0xd5, 0xa6, 0x25, 0xbf, 0xed,
+		0x04, 0xc5, 0x0a, 0x50, 0x53, 0x1a, 0x05, 0x81, 0x4c, 0x59, 0x93, 0xed, 0x24, 0x2e, 0x86, 0x72,
+		0x05, 0xa6, 0x3d, 0x30, 0x5e, 0xc8, 0x79, 0xb2, 0x40, 0x64, 0x34, 0x9c, 0x3b, 0xe1, 0xbd, 0x16,
+		0xf6, 0x0d, 0xa3, 0xc1, 0xab, 0x4d, 0xf1, 0x95, 0x59, 0x40, 0x8c, 0x19, 0x5d, 0xe3, 0x17, 0x45,
+		0x94, 0x60, 0xc6, 0x07, 0xe5, 0x85, 0xbc, 0xa9, 0xfd, 0x83, 0xa5, 0xaf, 0x1f, 0x81, 0x28, 0xe5,
+		0x8a, 0x3e, 0x22, 0xf9, 0xde, 0x5a, 0x5a, 0xec, 0xc5, 0xa6, 0xfb, 0x9c, 0x38, 0x73, 0x76, 0x68,
+		0x7c, 0x1e, 0xb3, 0x9d, 0x79, 0xf7, 0xbf, 0xfd, 0xca, 0x8f, 0x86, 0xee, 0x45, 0xca, 0xd9, 0x1e,
+		0xb3, 0x71, 0x4f, 0x7f, 0xf9, 0xa4, 0xef, 0x9d, 0x80, 0x87, 0x87, 0x2b, 0x4a, 0x48, 0xb6, 0x38,
+		0x2c, 0x3a, 0x17, 0xec, 0x29, 0x2a, 0xd8, 0x39, 0xf4, 0xd8, 0x60, 0xc1, 0xce, 0x7e, 0xaf, 0xbf,
+		0xd3, 0x7c, 0x3f, 0xfa, 0x03, 0x09, 0x66, 0xbb, 0x4d, 0xe9, 0xd0, 0x93, 0xc3, 0x49, 0xd1, 0x19,
+		0x52, 0x64, 0x2e, 0x1c, 0x82, 0x92, 0x57, 0x65, 0x95, 0x56, 0x25, 0x8b, 0x9e, 0x3e, 0x44, 0x55,
+		0xce, 0x7a, 0x97, 0xfe, 0xff, 0x97, 0x04, 0x77, 0xf5, 0x9d, 0x21, 0xa1, 0xec, 0x70, 0x52, 0xf6,
+		0x89, 0x9d, 0x32, 0xb9, 0x37, 0xc3, 0x82, 0xd7, 0xf8, 0x19, 0x5a, 0xe3, 0x2b, 0xa8, 0x78, 0x98,
+		0x1a, 0x77, 0xdd, 0x5f, 0x41, 0xbf, 0xeb, 0x3f, 0x85, 0xd9, 0xdf, 0x9c, 0x3a, 0x26, 0x1e, 0x99,
+		0xb3, 0x43, 0xe3, 0xf3, 0x2a, 0x3c, 0x47, 0xab, 0xa0, 0xa2, 0xad, 0x37, 0xd9, 0x68, 0x67, 0xbf,
+		0xd7, 0xef, 0xf8, 0xbf, 0x1f, 0xfd, 0x4f, 0xa9, 0xfb, 0xa1, 0xca, 0x27, 0xfa, 0x8a, 0xd8, 0x7b,
+		0x52, 0x95, 0x79, 0x72, 0x74, 0x42, 0x5e, 0xc9, 0x26, 0xad, 0x64, 0x0d, 0xe1, 0xdb, 0x5d, 0xc9,
+		0xae, 0x8d, 0x88, 0x3e, 0x2b, 0xc1, 0x6c, 0xb7, 0x39, 0xc9, 0x80, 0x6e, 0xd9, 0x67, 0x92, 0x35,
+		0xa0, 0x5b, 0xf6, 0x9b, 0x00, 0x29, 0x6f, 0xa3, 0x95, 0x3f, 0x8f, 0x1e, 0xef, 0x55, 0xf9, 0xbe,
+		0xad, 0x48, 0xfa, 0x62, 0xdf, 0x20, 0x7f, 0x40, 0x5f, 0x1c, 0x66, 0x1e, 0x33, 0xa0, 0x2f, 0x0e,
+		0x35, 0xc7, 0x18, 0xdc, 0x17, 0x9d, 0x9a, 0x0d, 0xd9, 0x8c, 0x16, 0xfa, 0x6d, 0x09, 0x26, 0x7d,
+		0x11, 0x31, 0x7a, 0xb4, 0xaf, 0xa0, 0xdd, 0x26, 0x0c, 0x99, 0xa5, 0x51, 0x48, 0x78, 0x5d, 0x8a,
+		0xb4, 0x2e, 0x2b, 0x28, 0x7b, 0x98, 0xba, 0xf8, 0xb7, 0x51, 0x3f, 0x2f, 0xc1, 0x4c, 0x97, 0x28,
+		0x73, 0x40, 0x2f, 0xec, 0x1d, 0x34, 0x67, 0x9e, 0x1c, 0x9d, 0x90, 0xd7, 0xea, 0x22, 0xad, 0xd5,
+		0x77, 0xa1, 0x77, 0x1c, 0xa6, 0x56, 0x9e, 0xf1, 0xf9, 0x96, 0x7b, 0x24, 0xcb, 0x53, 0x0e, 0x3a,
+		0x3f, 0xa2, 0x60, 0xa2, 0x42, 0x4f, 0x8c, 0x4c, 0xc7, 0xeb, 0xf3, 0x2c, 0xad, 0xcf, 0x33, 0x68,
+		0xf3, 0xcd, 0xd5, 0xa7, 0x73, 0x58, 0xff, 0xb5, 0xce, 0xdb, 0x92, 0xfd, 0xad, 0xa8, 0x6b, 0xb0,
+		0x9a, 0x79, 0x6c, 0x24, 0x1a, 0x5e, 0xa9, 0x27, 0x69, 0xa5, 0x96, 0xd0, 0x23, 0xbd, 0x2a, 0xe5,
+		0x39, 0x88, 0x58, 0xd7, 0xf7, 0x8c, 0xb3, 0xdf, 0xcb, 0x42, 0xe0, 0xef, 0x47, 0x3f, 0x28, 0xce,
+		0x3c, 0x9d, 0xee, 0x5b, 0xae, 0x27, 0x8e, 0xcd, 0x3c, 0x30, 0x04, 0x26, 0x97, 0xeb, 0x5e, 0x2a,
+		0xd7, 0x1c, 0x3a, 0xd9, 0x4b, 0x2e, 0x12, 0xcb, 0xa2, 0xf7, 0x49, 0xce, 0xb9, 0xd1, 0x33, 0xfd,
+		0x79, 0x7b, 0x83, 0xdd, 0xcc, 0x83, 0x43, 0xe1, 0x72, 0x49, 0x4e, 0x51, 0x49, 0x16, 0xd0, 0x5c,
+		0x4f, 0x49, 0x58, 0xe8, 0x7b, 0xbb, 0x0f, 0x15, 0xfc, 0x69, 0x06, 0xe6, 0x7b, 0x94, 0x68, 0xdf,
+		0x18, 0xb0, 0xc7, 0xd5, 0xe7, 0xd2, 0xf0, 0xc0, 0x4b, 0xc1, 0xb7, 0xfb, 0xb1, 0xdb, 0x21, 0x37,
+		0xc4, 0x3e, 0x1b, 0x01, 0xb4, 0x6e, 0xd5, 0x56, 0x4c, 0xcc, 0xbe, 0x1e, 0xce, 0x7b, 0x79, 0xe0,
+		0x36, 0x9c, 0xf4, 0xa6, 0x6e, 0xc3, 0xad, 0xfb, 0xee, 0x97, 0x85, 0x46, 0xbb, 0xc3, 0x3a, 0xf4,
+		0x25, 0xb3, 0xf0, 0x5f, 0xca, 0x25, 0xb3, 0xee, 0x67, 0xd0, 0x23, 0xb7, 0xef, 0xb2, 0x4a, 0xf4,
+		0xb0, 0x17, 0x76, 0xf8, 0xdd, 0xd1, 0xf1, 0x3e, 0x77, 0x47, 0xd3, 0x3d, 0x2f, 0x88, 0x72, 0x6a,
+		0x74, 0x4e, 0x3c, 0xfd, 0x1a, 0x1b, 0xee, 0x90, 0x2c, 0xc3, 0xe6, 0xc7, 0x31, 0x4f, 0x42, 0xa6,
+		0xd3, 0x94, 0x9c, 0x0e, 0xfd, 0xc1, 0x30, 0xc8, 0xeb, 0x56, 0x8d, 0x7c, 0xb2, 0xf4, 0x0e, 0xd9,
+		0xd9, 0xd3, 0xbd, 0x2f, 0xff, 0xa0, 0x37, 0x6e, 0xcd, 0xa7, 0x98, 0x3e, 0xfb, 0x68, 0xb1, 0x09,
+		0x53, 0xc1, 0xc3, 0xd9, 0xcc, 0xaa, 0xf2, 0x87, 0xb9, 0xf9, 0xdd, 0x71, 0x28, 0x3b, 0xe5, 0xbf,
+		0x84, 0x8d, 0x6e, 0x74, 0x37, 0x64, 0x66, 0x4c, 0x97, 0xee, 0xe4, 0x4d, 0x49, 0xd6, 0x5e, 0x19,
+		0x48, 0x07, 0x1b, 0xc4, 0x69, 0xad, 0x2f, 0x49, 0x30, 0xb1, 0x6e, 0x89, 0x10, 0x10, 0xbf, 0x45,
+		0xef, 0x68, 0x3d, 0xe1, 0xbc, 0x97, 0x1e, 0x1e, 0xce, 0x5e, 0xbd, 0x6f, 0xa8, 0x8f, 0x29, 0x47,
+		0x60, 0xc6, 0x53, 0x47, 0xa7, 0xee, 0x9f, 0x0b, 0x51, 0x9f, 0x98, 0xc3, 0xb5, 0xba, 0xee, 0x44,
+		0x8e, 0xf8, 0x6f, 0xea, 0xed, 0x13, 0x57, 0xc7, 0x91, 0xc3, 0xe8, 0xf8, 0x1a, 0x64, 0x3a, 0x75,
+		0xe9, 0x2c, 0x74, 0xad, 0x77, 0xde, 0x8b, 0x92, 0x46, 0x78, 0x72, 0x28, 0x70, 0xfb, 0x49, 0xf9,
+		0x8a, 0x04, 0x93, 0xeb, 0x56, 0x6d, 0x47, 0xaf, 0xfe, 0x5f, 0x6d, 0xb7, 0x7b, 0x70, 0xc4, 0x57,
+		0xcb, 0x3b, 0xa5, 0xce, 0xdf, 0x08, 0xc1, 0x49, 0xe2, 0xd1, 0xc9, 0xa5, 0x81, 0xc6, 0x5f, 0x9f,
+		0x9b, 0x9b, 0x87, 0xd5, 0x6e, 0xb7, 0xab, 0x7e, 0x91, 0x51, 0xaf, 0xfa, 0xf1, 0x26, 0x3a, 0x05,
+		0xf7, 0xf6, 0xd3, 0x9c, 0x68, 0xb1, 0xa5, 0x4f, 0x47, 0x21, 0xbc, 0x6e, 0xd5, 0xc8, 0xbd, 0xbd,
+		0x60, 0x0c, 0xd6, 0x33, 0xb4, 0xee, 0x1c, 0x64, 0x33, 0x4b, 0xc3, 0xe3, 0x3a, 0xc6, 0x72, 0x0d,
+		0x26, 0xfd, 0x83, 0xf1, 0xe9, 0x3e, 0x4c, 0x7c, 0x98, 0x99, 0x47, 0x86, 0xc5, 0x74, 0x0a, 0x7b,
+		0x27, 0xc4, 0x79, 0xed, 0x31, 0xba, 0xa7, 0x0f, 0xb5, 0x40, 0xca, 0x3c, 0x38, 0x04, 0x92, 0xc3,
+		0xfd, 0x5d, 0x30, 0x15, 0xf4, 0xd6, 0xfd, 0xb4, 0x17, 0xc0, 0xcd, 0x2c, 0x0d, 0x8f, 0xeb, 0xd9,
+		0x6c, 0x05, 0x8f, 0x9b, 0xb9, 0xaf, 0x0f, 0x07, 0x17, 0x2d, 0xf3, 0xf0, 0x50, 0x68, 0x4e, 0x19,
+		0x1f, 0x94, 0xe0, 0x78, 0xef, 0xce, 0xf7, 0x78, 0xbf, 0x36, 0xef, 0x45, 0x95, 0x79, 0xdb, 0x61,
+		0xa8, 0x9c, 0x5d, 0xc5, 0xdb, 0x3c, 0xdb, 0xfa, 0x3f, 0x03, 0x00, 0x67, 0x9a, 0x5a, 0xa3, 0x0a,
+		0xad, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2091,6 +2190,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
 	if !this.MinGlobalSelfDelegation.Equal(that1.MinGlobalSelfDelegation) {
 		return false
 	}
@@ -2954,6 +3056,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3495,6 +3607,8 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	l = m.MinGlobalSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
@@ -6029,6 +6143,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MinGlobalSelfDelegation", wireType)
@@ -576,6 +576,117 @@ func (m *MsgUndelegateResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgCancelUnbondingDelegation defines a SDK message for cancelling an
+// unbonding delegation entry and delegating its balance back to the validator.
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// amount is always less than or equal to the unbonding delegation entry balance
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// creation_height is the height at which the unbonding delegation entry was
+	// created
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+}
+
+func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbondingDelegation{} }
+func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{10}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbondingDelegation proto.InternalMessageInfo
+
+func (m *MsgCancelUnbondingDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelUnbondingDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelUnbondingDelegation) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+func (m *MsgCancelUnbondingDelegation) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+// MsgCancelUnbondingDelegationResponse defines the
+// Msg/CancelUnbondingDelegation response type.
+type MsgCancelUnbondingDelegationResponse struct {
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Reset()         { *m = MsgCancelUnbondingDelegationResponse{} }
+func (m *MsgCancelUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegationResponse) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{11}
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegationResponse.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -587,66 +698,73 @@ func init() {
 	proto.RegisterType((*MsgBeginRedelegateResponse)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegateResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.v1beta1.MsgUndelegate")
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateResponse")
+	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegation")
+	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x15, 0x25, 0x59, 0x75, 0xc7, 0xf0, 0x8b, 0x7e, 0x40, 0x26, 0x0c, 0xd1, 0xa0, 0xfb, 0x30,
-	0xda, 0x9a, 0xaa, 0x5d, 0x14, 0x05, 0xbc, 0x29, 0x2c, 0xab, 0x46, 0x0d, 0x57, 0x40, 0x41, 0xbb,
-	0x5d, 0x14, 0x05, 0x04, 0x3e, 0x46, 0x2c, 0x21, 0x92, 0x43, 0x73, 0x46, 0x86, 0x05, 0xf4, 0x1f,
-	0xe2, 0x75, 0x56, 0xf9, 0x88, 0x7c, 0x84, 0xe3, 0x95, 0x97, 0x41, 0x16, 0x4a, 0x20, 0x67, 0x91,
-	0xb5, 0xbe, 0x20, 0xe0, 0x70, 0x48, 0x51, 0xd4, 0x03, 0x82, 0x11, 0x6d, 0xb2, 0x92, 0x30, 0x73,
-	0xe6, 0xdc, 0xb9, 0xe7, 0x9e, 0xb9, 0x97, 0x40, 0xd4, 0x11, 0x76, 0x10, 0x2e, 0x63, 0xa2, 0x36,
-	0x2d, 0xd7, 0x2c, 0x5f, 0x1f, 0x68, 0x90, 0xa8, 0x07, 0x65, 0x72, 0x23, 0x7b, 0x3e, 0x22, 0x88,
-	0xdf, 0x0c, 0x01, 0x32, 0x03, 0xc8, 0x0c, 0x20, 0x6c, 0x99, 0x08, 0x99, 0x36, 0x2c, 0x53, 0x94,
-	0xd6, 0x6a, 0x94, 0x55, 0xb7, 0x1d, 0x1e, 0x11, 0xc4, 0xf4, 0x16, 0xb1, 0x1c, 0x88, 0x89, 0xea,
-	0x78, 0x0c, 0xb0, 0x6e, 0x22, 0x13, 0xd1, 0xbf, 0xe5, 0xe0, 0x1f, 0x5b, 0xdd, 0x0a, 0x23, 0xd5,
-	0xc3, 0x0d, 0x16, 0x36, 0xdc, 0x2a, 0xb1, 0x5b, 0x6a, 0x2a, 0x86, 0xf1, 0x15, 0x75, 0x64, 0xb9,
-	0x6c, 0xff, 0xab, 0x31, 0x59, 0x44, 0x97, 0xa6, 0x28, 0xe9, 0x55, 0x1e, 0xf0, 0x35, 0x6c, 0x9e,
-	0xf8, 0x50, 0x25, 0xf0, 0x6f, 0xd5, 0xb6, 0x0c, 0x95, 0x20, 0x9f, 0x3f, 0x07, 0x0b, 0x06, 0xc4,
-	0xba, 0x6f, 0x79, 0xc4, 0x42, 0x6e, 0x91, 0xdb, 0xe1, 0xf6, 0x16, 0x0e, 0x77, 0xe5, 0xd1, 0x79,
-	0xcb, 0xd5, 0x3e, 0xb4, 0x92, 0xbf, 0xeb, 0x88, 0x19, 0x25, 0x79, 0x9a, 0xaf, 0x01, 0xa0, 0x23,
-	0xc7, 0xb1, 0x30, 0x0e, 0xb8, 0xb2, 0x94, 0xeb, 0xdb, 0x71, 0x5c, 0x27, 0x31, 0x52, 0x51, 0x09,
-	0xc4, 0x8c, 0x2f, 0x41, 0xc0, 0xff, 0x0f, 0xd6, 0x1c, 0xcb, 0xad, 0x63, 0x68, 0x37, 0xea, 0x06,
-	0xb4, 0xa1, 0xa9, 0xd2, 0x3b, 0xe6, 0x76, 0xb8, 0xbd, 0x2f, 0x2b, 0x7f, 0x04, 0xf0, 0x37, 0x1d,
-	0xf1, 0x1b, 0xd3, 0x22, 0xff, 0xb5, 0x34, 0x59, 0x47, 0x0e, 0x93, 0x8d, 0xfd, 0xec, 0x63, 0xa3,
-	0x59, 0x26, 0x6d, 0x0f, 0x62, 0xf9, 0xcc, 0x25, 0xbd, 0x8e, 0x28, 0xb4, 0x55, 0xc7, 0x3e, 0x92,
-	0x46, 0x50, 0x4a, 0xca, 0xaa, 0x63, 0xb9, 0x17, 0xd0, 0x6e, 0x54, 0xe3, 0x35, 0xfe, 0x0c, 0xac,
-	0x32, 0x04, 0xf2, 0xeb, 0xaa, 0x61, 0xf8, 0x10, 0xe3, 0x62, 0x9e, 0xc6, 0xde, 0xee, 0x75, 0xc4,
-	0x62, 0xc8, 0x36, 0x04, 0x91, 0x94, 0x95, 0x78, 0xed, 0x38, 0x5c, 0x0a, 0xa8, 0xae, 0x23, 0xc5,
-	0x63, 0xaa, 0xb9, 0x34, 0xd5, 0x10, 0x44, 0x52, 0x56, 0xe2, 0xb5, 0x88, 0xea, 0x14, 0x14, 0xbc,
-	0x96, 0xd6, 0x84, 0xed, 0x62, 0x81, 0xca, 0xbb, 0x2e, 0x87, 0x7e, 0x93, 0x23, 0xbf, 0xc9, 0xc7,
-	0x6e, 0xbb, 0x52, 0xbc, 0x7f, 0xb9, 0xbf, 0xce, 0x74, 0xd7, 0xfd, 0xb6, 0x47, 0x90, 0xfc, 0x67,
-	0x4b, 0x3b, 0x87, 0x6d, 0x85, 0x9d, 0xe6, 0x7f, 0x06, 0x73, 0xd7, 0xaa, 0xdd, 0x82, 0xc5, 0x2f,
-	0x28, 0xcd, 0x56, 0x54, 0xa5, 0xc0, 0x64, 0x89, 0x12, 0x59, 0x51, 0x9d, 0x43, 0xf4, 0x51, 0xfe,
-	0xc3, 0x0b, 0x31, 0x23, 0x6d, 0x03, 0x61, 0xd8, 0x4a, 0x0a, 0xc4, 0x1e, 0x72, 0x31, 0x94, 0x9e,
-	0xe5, 0xc0, 0x4a, 0x0d, 0x9b, 0xbf, 0x19, 0x16, 0x99, 0x91, 0xcf, 0x7e, 0x1d, 0xa5, 0x67, 0x96,
-	0xea, 0xc9, 0xf7, 0x3a, 0xe2, 0x52, 0xa8, 0xe7, 0x04, 0x15, 0x1d, 0xb0, 0xdc, 0xf7, 0x59, 0xdd,
-	0x57, 0x09, 0x64, 0xae, 0xaa, 0x4e, 0xe9, 0xa8, 0x2a, 0xd4, 0x7b, 0x1d, 0x71, 0x33, 0x0c, 0x94,
-	0xa2, 0x92, 0x94, 0x25, 0x7d, 0xc0, 0xdb, 0xfc, 0xcd, 0x68, 0x23, 0x87, 0x66, 0xfa, 0x7d, 0x86,
-	0x26, 0x66, 0xf5, 0x12, 0x40, 0x31, 0x5d, 0x90, 0xb8, 0x5a, 0x5d, 0x0e, 0x2c, 0xd4, 0xb0, 0xc9,
-	0xce, 0xc0, 0xd1, 0xb6, 0xe7, 0x3e, 0x9d, 0xed, 0xb3, 0x4f, 0xb2, 0xfd, 0x2f, 0xa0, 0xa0, 0x3a,
-	0xa8, 0xe5, 0x12, 0x5a, 0xa7, 0x29, 0xfc, 0xca, 0xe0, 0x4c, 0x80, 0x0d, 0xb0, 0x96, 0xc8, 0x31,
-	0xce, 0xfd, 0x3e, 0x4b, 0x7b, 0x62, 0x05, 0x9a, 0x96, 0xab, 0x40, 0x63, 0x06, 0x12, 0x5c, 0x82,
-	0x8d, 0x7e, 0x7e, 0xd8, 0xd7, 0x53, 0x32, 0xec, 0xf4, 0x3a, 0xe2, 0x76, 0x5a, 0x86, 0x04, 0x4c,
-	0x52, 0xd6, 0xe2, 0xf5, 0x0b, 0x5f, 0x1f, 0xc9, 0x6a, 0x60, 0x12, 0xb3, 0xe6, 0xc6, 0xb3, 0x26,
-	0x60, 0x49, 0xd6, 0x2a, 0x26, 0xc3, 0x1a, 0xe7, 0x9f, 0xa2, 0x71, 0x93, 0x36, 0x85, 0x94, 0x96,
-	0x91, 0xd4, 0x7c, 0x8d, 0xbe, 0x38, 0xcf, 0x86, 0x81, 0x2d, 0xeb, 0xc1, 0x4c, 0x64, 0x3d, 0x40,
-	0x18, 0x6a, 0x60, 0x97, 0xd1, 0xc0, 0xac, 0xcc, 0x07, 0x61, 0x6e, 0xdf, 0x8a, 0x1c, 0x7d, 0x51,
-	0xec, 0x70, 0xb0, 0x2d, 0xbd, 0xe7, 0xc0, 0x62, 0x0d, 0x9b, 0x7f, 0xb9, 0xc6, 0x67, 0xed, 0xdb,
-	0x06, 0xd8, 0x18, 0xc8, 0x72, 0x46, 0x72, 0x1e, 0x3e, 0xcf, 0x83, 0x5c, 0x0d, 0x9b, 0xfc, 0x15,
-	0x58, 0x4e, 0x7f, 0x20, 0x7c, 0x37, 0xae, 0x47, 0x0f, 0x4f, 0x00, 0xe1, 0x70, 0x7a, 0x6c, 0x9c,
-	0x49, 0x13, 0x2c, 0x0e, 0x4e, 0x8a, 0xbd, 0x09, 0x24, 0x03, 0x48, 0xe1, 0xc7, 0x69, 0x91, 0x71,
-	0xb0, 0x7f, 0xc1, 0x7c, 0xdc, 0xe8, 0x76, 0x27, 0x9c, 0x8e, 0x40, 0xc2, 0xf7, 0x53, 0x80, 0x62,
-	0xf6, 0x2b, 0xb0, 0x9c, 0x6e, 0x25, 0x93, 0xd4, 0x4b, 0x61, 0x27, 0xaa, 0x37, 0xee, 0x59, 0x69,
-	0x00, 0x24, 0xde, 0xc0, 0xd7, 0x13, 0x18, 0xfa, 0x30, 0x61, 0x7f, 0x2a, 0x58, 0x14, 0xa3, 0x72,
-	0x7a, 0xd7, 0x2d, 0x71, 0x0f, 0xdd, 0x12, 0xf7, 0xae, 0x5b, 0xe2, 0x6e, 0x1f, 0x4b, 0x99, 0x87,
-	0xc7, 0x52, 0xe6, 0xf5, 0x63, 0x29, 0xf3, 0xcf, 0x0f, 0x13, 0xc7, 0xd6, 0x4d, 0xfc, 0x45, 0x4a,
-	0x07, 0x98, 0x56, 0xa0, 0x96, 0xfc, 0xe9, 0x63, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0x63, 0x3b,
-	0x01, 0x76, 0x0b, 0x00, 0x00,
+	// 934 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x6e, 0xdb, 0x46,
+	0x14, 0x15, 0x25, 0xd9, 0x4d, 0xc7, 0x88, 0xed, 0xd0, 0x76, 0x40, 0x13, 0x86, 0x68, 0x30, 0x69,
+	0x6a, 0xb4, 0x35, 0xd5, 0xb8, 0x2d, 0x0a, 0x04, 0x05, 0x8a, 0xc8, 0x6a, 0x90, 0x20, 0x15, 0x50,
+	0x30, 0x49, 0x17, 0x45, 0x01, 0x61, 0x48, 0x8e, 0x69, 0x42, 0xe4, 0x0c, 0xc3, 0x19, 0x19, 0x16,
+	0xd0, 0x3b, 0x24, 0x47, 0xe8, 0x01, 0xba, 0xec, 0xa2, 0x47, 0x48, 0xb3, 0xca, 0xb2, 0xe8, 0x42,
+	0x2d, 0xec, 0x2e, 0xba, 0xd6, 0x09, 0x0a, 0x92, 0xc3, 0x11, 0x45, 0x49, 0xac, 0x60, 0x54, 0x8b,
+	0x66, 0x25, 0x62, 0xe6, 0xfd, 0xf7, 0x67, 0xde, 0x7f, 0xfc, 0x9f, 0x02, 0x9a, 0x4d, 0x68, 0x40,
+	0x68, 0x93, 0x32, 0xd8, 0xf3, 0xb0, 0xdb, 0x3c, 0xbb, 0x6b, 0x21, 0x06, 0xef, 0x36, 0xd9, 0xb9,
+	0x11, 0x46, 0x84, 0x11, 0xf9, 0x66, 0x0a, 0x30, 0x38, 0xc0, 0xe0, 0x00, 0x75, 0xd7, 0x25, 0xc4,
+	0xf5, 0x51, 0x33, 0x41, 0x59, 0xfd, 0x93, 0x26, 0xc4, 0x83, 0x34, 0x44, 0xd5, 0x8a, 0x5b, 0xcc,
+	0x0b, 0x10, 0x65, 0x30, 0x08, 0x39, 0x60, 0xdb, 0x25, 0x2e, 0x49, 0x1e, 0x9b, 0xf1, 0x13, 0x5f,
+	0xdd, 0x4d, 0x33, 0x75, 0xd3, 0x0d, 0x9e, 0x36, 0xdd, 0x6a, 0xf0, 0x53, 0x5a, 0x90, 0x22, 0x71,
+	0x44, 0x9b, 0x78, 0x98, 0xef, 0xdf, 0x9e, 0x73, 0x8b, 0xec, 0xd0, 0x09, 0x4a, 0xff, 0xb5, 0x0e,
+	0xe4, 0x0e, 0x75, 0x8f, 0x23, 0x04, 0x19, 0xfa, 0x16, 0xfa, 0x9e, 0x03, 0x19, 0x89, 0xe4, 0xc7,
+	0x60, 0xcd, 0x41, 0xd4, 0x8e, 0xbc, 0x90, 0x79, 0x04, 0x2b, 0xd2, 0xbe, 0x74, 0xb0, 0x76, 0x74,
+	0xcb, 0x98, 0x7d, 0x6f, 0xa3, 0x3d, 0x86, 0xb6, 0xea, 0xaf, 0x86, 0x5a, 0xc5, 0xcc, 0x47, 0xcb,
+	0x1d, 0x00, 0x6c, 0x12, 0x04, 0x1e, 0xa5, 0x31, 0x57, 0x35, 0xe1, 0x7a, 0x7f, 0x1e, 0xd7, 0xb1,
+	0x40, 0x9a, 0x90, 0x21, 0xca, 0xf9, 0x72, 0x04, 0xf2, 0x0f, 0x60, 0x2b, 0xf0, 0x70, 0x97, 0x22,
+	0xff, 0xa4, 0xeb, 0x20, 0x1f, 0xb9, 0x30, 0x39, 0x63, 0x6d, 0x5f, 0x3a, 0x78, 0xb7, 0xf5, 0x75,
+	0x0c, 0xff, 0x7d, 0xa8, 0xdd, 0x71, 0x3d, 0x76, 0xda, 0xb7, 0x0c, 0x9b, 0x04, 0x5c, 0x36, 0xfe,
+	0x73, 0x48, 0x9d, 0x5e, 0x93, 0x0d, 0x42, 0x44, 0x8d, 0x47, 0x98, 0x8d, 0x86, 0x9a, 0x3a, 0x80,
+	0x81, 0x7f, 0x4f, 0x9f, 0x41, 0xa9, 0x9b, 0x37, 0x02, 0x0f, 0x3f, 0x41, 0xfe, 0x49, 0x5b, 0xac,
+	0xc9, 0x8f, 0xc0, 0x0d, 0x8e, 0x20, 0x51, 0x17, 0x3a, 0x4e, 0x84, 0x28, 0x55, 0xea, 0x49, 0xee,
+	0xbd, 0xd1, 0x50, 0x53, 0x52, 0xb6, 0x29, 0x88, 0x6e, 0x6e, 0x8a, 0xb5, 0xfb, 0xe9, 0x52, 0x4c,
+	0x75, 0x96, 0x29, 0x2e, 0xa8, 0x56, 0x8a, 0x54, 0x53, 0x10, 0xdd, 0xdc, 0x14, 0x6b, 0x19, 0xd5,
+	0x03, 0xb0, 0x1a, 0xf6, 0xad, 0x1e, 0x1a, 0x28, 0xab, 0x89, 0xbc, 0xdb, 0x46, 0xea, 0x37, 0x23,
+	0xf3, 0x9b, 0x71, 0x1f, 0x0f, 0x5a, 0xca, 0xeb, 0x9f, 0x0f, 0xb7, 0xb9, 0xee, 0x76, 0x34, 0x08,
+	0x19, 0x31, 0xbe, 0xe9, 0x5b, 0x8f, 0xd1, 0xc0, 0xe4, 0xd1, 0xf2, 0x67, 0x60, 0xe5, 0x0c, 0xfa,
+	0x7d, 0xa4, 0xbc, 0x93, 0xd0, 0xec, 0x66, 0x55, 0x8a, 0x4d, 0x96, 0x2b, 0x91, 0x97, 0xd5, 0x39,
+	0x45, 0xdf, 0xab, 0xff, 0xfd, 0xa3, 0x56, 0xd1, 0xf7, 0x80, 0x3a, 0x6d, 0x25, 0x13, 0xd1, 0x90,
+	0x60, 0x8a, 0xf4, 0x17, 0x35, 0xb0, 0xd9, 0xa1, 0xee, 0x57, 0x8e, 0xc7, 0x96, 0xe4, 0xb3, 0x2f,
+	0x67, 0xe9, 0x59, 0x4d, 0xf4, 0x94, 0x47, 0x43, 0x6d, 0x3d, 0xd5, 0xb3, 0x44, 0xc5, 0x00, 0x6c,
+	0x8c, 0x7d, 0xd6, 0x8d, 0x20, 0x43, 0xdc, 0x55, 0xed, 0x05, 0x1d, 0xd5, 0x46, 0xf6, 0x68, 0xa8,
+	0xdd, 0x4c, 0x13, 0x15, 0xa8, 0x74, 0x73, 0xdd, 0x9e, 0xf0, 0xb6, 0x7c, 0x3e, 0xdb, 0xc8, 0xa9,
+	0x99, 0x1e, 0x2e, 0xd1, 0xc4, 0xbc, 0x5e, 0x2a, 0x50, 0x8a, 0x05, 0x11, 0xd5, 0xba, 0x90, 0xc0,
+	0x5a, 0x87, 0xba, 0x3c, 0x06, 0xcd, 0xb6, 0xbd, 0xf4, 0xdf, 0xd9, 0xbe, 0x7a, 0x25, 0xdb, 0x7f,
+	0x0e, 0x56, 0x61, 0x40, 0xfa, 0x98, 0x29, 0xb5, 0xc5, 0xfc, 0xca, 0xe1, 0x5c, 0x80, 0x1d, 0xb0,
+	0x95, 0xbb, 0xa3, 0xb8, 0xfb, 0xeb, 0x6a, 0xd2, 0x13, 0x5b, 0xc8, 0xf5, 0xb0, 0x89, 0x9c, 0x25,
+	0x48, 0xf0, 0x14, 0xec, 0x8c, 0xef, 0x47, 0x23, 0xbb, 0x20, 0xc3, 0xfe, 0x68, 0xa8, 0xed, 0x15,
+	0x65, 0xc8, 0xc1, 0x74, 0x73, 0x4b, 0xac, 0x3f, 0x89, 0xec, 0x99, 0xac, 0x0e, 0x65, 0x82, 0xb5,
+	0x36, 0x9f, 0x35, 0x07, 0xcb, 0xb3, 0xb6, 0x29, 0x9b, 0xd6, 0xb8, 0x7e, 0x15, 0x8d, 0x7b, 0x40,
+	0x9d, 0xd6, 0x32, 0x93, 0x5a, 0xee, 0x24, 0x6f, 0x5c, 0xe8, 0xa3, 0xd8, 0x96, 0xdd, 0x78, 0x26,
+	0xf2, 0x1e, 0xa0, 0x4e, 0x35, 0xb0, 0xa7, 0xd9, 0xc0, 0x6c, 0x5d, 0x8b, 0xd3, 0xbc, 0xfc, 0x43,
+	0x93, 0xcc, 0xf5, 0x71, 0x70, 0xbc, 0xad, 0xff, 0x25, 0x81, 0xeb, 0x1d, 0xea, 0x3e, 0xc3, 0xce,
+	0x5b, 0xed, 0xdb, 0x13, 0xb0, 0x33, 0x71, 0xcb, 0x65, 0xc9, 0xf9, 0x4b, 0x15, 0xec, 0xc5, 0x1d,
+	0x1d, 0x62, 0x1b, 0xf9, 0xcf, 0xb0, 0x45, 0xb0, 0xe3, 0x61, 0xf7, 0xdf, 0x86, 0xe1, 0xff, 0x56,
+	0x5d, 0xf9, 0x18, 0x6c, 0xd8, 0xf1, 0xf4, 0x8a, 0xc5, 0x3b, 0x45, 0x9e, 0x7b, 0x9a, 0x7a, 0xbe,
+	0xd6, 0x52, 0x73, 0x5d, 0x7d, 0x12, 0x10, 0x77, 0x75, 0xbe, 0xf2, 0x30, 0x59, 0xe0, 0x25, 0xba,
+	0x03, 0x6e, 0x97, 0x29, 0x97, 0x55, 0xec, 0xe8, 0xa7, 0x15, 0x50, 0xeb, 0x50, 0x57, 0x7e, 0x0e,
+	0x36, 0x8a, 0xdf, 0x60, 0x1f, 0xcc, 0x1b, 0x83, 0xd3, 0x43, 0x56, 0x3d, 0x5a, 0x1c, 0x2b, 0xcc,
+	0xd2, 0x03, 0xd7, 0x27, 0x87, 0xf1, 0x41, 0x09, 0xc9, 0x04, 0x52, 0xfd, 0x78, 0x51, 0xa4, 0x48,
+	0xf6, 0x3d, 0xb8, 0x26, 0x66, 0xc9, 0xad, 0x92, 0xe8, 0x0c, 0xa4, 0x7e, 0xb8, 0x00, 0x48, 0xb0,
+	0x3f, 0x07, 0x1b, 0xc5, 0x6e, 0x5d, 0xa6, 0x5e, 0x01, 0xab, 0x1e, 0x2d, 0x8e, 0x15, 0x29, 0x2d,
+	0x00, 0x72, 0x6d, 0xe6, 0xbd, 0x12, 0x86, 0x31, 0x4c, 0x3d, 0x5c, 0x08, 0x26, 0x72, 0xbc, 0x90,
+	0xc0, 0xee, 0xfc, 0x97, 0xef, 0xd3, 0xb2, 0x9a, 0xcf, 0x8b, 0x52, 0xbf, 0xb8, 0x4a, 0x54, 0x76,
+	0xa2, 0xd6, 0x83, 0x57, 0x17, 0x0d, 0xe9, 0xcd, 0x45, 0x43, 0xfa, 0xf3, 0xa2, 0x21, 0xbd, 0xbc,
+	0x6c, 0x54, 0xde, 0x5c, 0x36, 0x2a, 0xbf, 0x5d, 0x36, 0x2a, 0xdf, 0x7d, 0x54, 0xfa, 0xad, 0x72,
+	0x2e, 0xfe, 0x86, 0x24, 0x5f, 0x2d, 0xd6, 0x6a, 0xd2, 0x87, 0x3e, 0xf9, 0x67, 0x00, 0x89, 0xac,
+	0xc7, 0xdf, 0x6b, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -674,6 +792,10 @@ type MsgClient interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// CancelUnbondingDelegation defines a method for cancelling, fully or
+	// partially, an unbonding delegation entry and delegating its balance back
+	// to the validator.
+	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
 }
 
 type msgClient struct {
@@ -729,6 +851,15 @@ func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error) {
+	out := new(MsgCancelUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -744,6 +875,10 @@ type MsgServer interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	// CancelUnbondingDelegation defines a method for cancelling, fully or
+	// partially, an unbonding delegation entry and delegating its balance back
+	// to the validator.
+	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -765,6 +900,9 @@ func (*UnimplementedMsgServer) BeginRedelegate(ctx context.Context, req *MsgBegi
 func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
+func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, req *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -860,6 +998,24 @@ func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbondingDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, req.(*MsgCancelUnbondingDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -884,6 +1040,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
 		},
+		{
+			MethodName: "CancelUnbondingDelegation",
+			Handler:    _Msg_CancelUnbondingDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1321,6 +1481,81 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1494,6 +1729,37 @@ func (m *MsgUndelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovTx(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2759,6 +3025,222 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0